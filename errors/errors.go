@@ -1,6 +1,9 @@
 package errors
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 var EmptyIdError = errors.New("id is empty")
 
@@ -8,4 +11,19 @@ var NilArgumentError = errors.New("argument is nil")
 
 var NoTransactionError = errors.New("transaction is not opened")
 
+var VersionConflictError = errors.New("version conflict")
+
+var InvalidPageTokenError = errors.New("page token is invalid")
+
+var DuplicateKeyError = errors.New("objects share a primary key")
+
+// InvalidFieldMaskError is returned for a field mask path the object doesn't have
+type InvalidFieldMaskError struct {
+	Path string
+}
+
+func (e *InvalidFieldMaskError) Error() string {
+	return fmt.Sprintf("field mask path %q is invalid", e.Path)
+}
+
 var BadRepeatedFieldMaskTpl = "unexpected fieldmask count %d for objects count %d"
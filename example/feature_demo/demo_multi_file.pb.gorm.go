@@ -8,6 +8,8 @@ import (
 	go_uuid "github.com/satori/go.uuid"
 	field_mask "google.golang.org/genproto/protobuf/field_mask"
 	gorm "gorm.io/gorm"
+	clause "gorm.io/gorm/clause"
+	strings "strings"
 )
 
 type ExternalChildORM struct {
@@ -184,6 +186,70 @@ type ExternalChildORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm.DB) error
 }
 
+// DefaultCreateExternalChildSet executes gorm create calls in batches of 100 objects
+// in a transaction, either all of the objects are created or none of them. The BeforeCreate_ hook
+// of each object runs before the insert of its batch, which uses the handle the hooks of the
+// batch return
+func DefaultCreateExternalChildSet(ctx context.Context, in []*ExternalChild, db *gorm.DB) ([]*ExternalChild, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObjs := make([]ExternalChildORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, ormObj)
+	}
+	batchSize := 100
+	err := db.Transaction(func(db *gorm.DB) error {
+		var err error
+		for start := 0; start < len(ormObjs); start += batchSize {
+			end := start + batchSize
+			if end > len(ormObjs) {
+				end = len(ormObjs)
+			}
+			batch := ormObjs[start:end]
+			// the scopes the hooks add only apply to the insert of their own batch
+			batchDB := db
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(ExternalChildORMWithBeforeCreate_); ok {
+					if batchDB, err = hook.BeforeCreate_(ctx, batchDB); err != nil {
+						return err
+					}
+				}
+			}
+			if err = batchDB.Omit().CreateInBatches(&batch, batchSize).Error; err != nil {
+				return err
+			}
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(ExternalChildORMWithAfterCreate_); ok {
+					if err = hook.AfterCreate_(ctx, db); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*ExternalChild, 0, len(ormObjs))
+	for i := range ormObjs {
+		pbResponse, err := ormObjs[i].ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, &pbResponse)
+	}
+	return results, nil
+}
+
 func DefaultReadExternalChild(ctx context.Context, in *ExternalChild, db *gorm.DB) (*ExternalChild, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
@@ -349,37 +415,91 @@ type ExternalChildORMWithAfterStrictUpdateSave interface {
 	AfterStrictUpdateSave(context.Context, *gorm.DB) error
 }
 
+// DefaultUpsertExternalChild executes a gorm create call resolving conflicts on conflictTarget,
+// the name of a unique index or the primary key when empty, by updating the columns
+// in updateMask, all of them when updateMask is empty, and returns the stored object
+func DefaultUpsertExternalChild(ctx context.Context, in *ExternalChild, conflictTarget string, updateMask *field_mask.FieldMask, db *gorm.DB) (*ExternalChild, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conflict := clause.OnConflict{}
+	var lookup func() map[string]interface{}
+	switch conflictTarget {
+	case "":
+		conflict.Columns = []clause.Column{{Name: "id"}}
+		lookup = func() map[string]interface{} { return map[string]interface{}{"id": ormObj.Id} }
+	default:
+		return nil, fmt.Errorf("unknown conflict target %q of ExternalChild", conflictTarget)
+	}
+	if len(updateMask.GetPaths()) == 0 {
+		conflict.UpdateAll = true
+	} else {
+		return nil, &errors.InvalidFieldMaskError{Path: updateMask.GetPaths()[0]}
+	}
+	if hook, ok := interface{}(&ormObj).(ExternalChildORMWithBeforeUpsert); ok {
+		if db, err = hook.BeforeUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Clauses(conflict).Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if len(updateMask.GetPaths()) > 0 {
+		stored := ExternalChildORM{}
+		if err = db.Where(lookup()).Take(&stored).Error; err != nil {
+			return nil, err
+		}
+		ormObj = stored
+	}
+	if hook, ok := interface{}(&ormObj).(ExternalChildORMWithAfterUpsert); ok {
+		if err = hook.AfterUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type ExternalChildORMWithBeforeUpsert interface {
+	BeforeUpsert(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ExternalChildORMWithAfterUpsert interface {
+	AfterUpsert(context.Context, *gorm.DB) error
+}
+
 // DefaultPatchExternalChild executes a basic gorm update call with patch behavior
 func DefaultPatchExternalChild(ctx context.Context, in *ExternalChild, updateMask *field_mask.FieldMask, db *gorm.DB) (*ExternalChild, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	var pbObj ExternalChild
+	pbObj := &ExternalChild{}
 	var err error
-	if hook, ok := interface{}(&pbObj).(ExternalChildWithBeforePatchRead); ok {
+	if hook, ok := interface{}(pbObj).(ExternalChildWithBeforePatchRead); ok {
 		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbReadRes, err := DefaultReadExternalChild(ctx, &ExternalChild{Id: in.GetId()}, db)
-	if err != nil {
+	if pbObj, err = DefaultReadExternalChild(ctx, &ExternalChild{Id: in.GetId()}, db); err != nil {
 		return nil, err
 	}
-	pbObj = *pbReadRes
-	if hook, ok := interface{}(&pbObj).(ExternalChildWithBeforePatchApplyFieldMask); ok {
+	if hook, ok := interface{}(pbObj).(ExternalChildWithBeforePatchApplyFieldMask); ok {
 		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	if _, err := DefaultApplyFieldMaskExternalChild(ctx, &pbObj, in, updateMask, "", db); err != nil {
+	if _, err := DefaultApplyFieldMaskExternalChild(ctx, pbObj, in, updateMask, "", db); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&pbObj).(ExternalChildWithBeforePatchSave); ok {
+	if hook, ok := interface{}(pbObj).(ExternalChildWithBeforePatchSave); ok {
 		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse, err := DefaultStrictUpdateExternalChild(ctx, &pbObj, db)
+	pbResponse, err := DefaultStrictUpdateExternalChild(ctx, pbObj, db)
 	if err != nil {
 		return nil, err
 	}
@@ -404,22 +524,193 @@ type ExternalChildWithAfterPatchSave interface {
 	AfterPatchSave(context.Context, *ExternalChild, *field_mask.FieldMask, *gorm.DB) error
 }
 
-// DefaultPatchSetExternalChild executes a bulk gorm update call with patch behavior
+// DefaultPatchSetExternalChild patches the objects in a transaction: the masks are applied in memory to
+// the stored objects, read and locked with a single query, and the objects are written with an update
+// per batch of 100 objects. The objects are read and written with the handles their hooks
+// return, the objects of different handles don't share the queries. Only the associations in the
+// masks are updated, and objects sharing a primary key are rejected
 func DefaultPatchSetExternalChild(ctx context.Context, objects []*ExternalChild, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*ExternalChild, error) {
 	if len(objects) != len(updateMasks) {
 		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
 	}
-
-	results := make([]*ExternalChild, 0, len(objects))
-	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchExternalChild(ctx, patcher, updateMasks[i], db)
+	if len(objects) == 0 {
+		return []*ExternalChild{}, nil
+	}
+	var err error
+	objectKeys := make([]string, 0, len(objects))
+	seen := make(map[string]struct{}, len(objects))
+	for _, in := range objects {
+		if in == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := in.ToORM(ctx)
 		if err != nil {
 			return nil, err
 		}
-
-		results = append(results, pbResponse)
+		if ormObj.Id == "" {
+			return nil, errors.EmptyIdError
+		}
+		key := ormObj.Id
+		if _, ok := seen[key]; ok {
+			return nil, errors.DuplicateKeyError
+		}
+		seen[key] = struct{}{}
+		objectKeys = append(objectKeys, key)
+	}
+	batchSize := 100
+	results := make([]*ExternalChild, len(objects))
+	err = db.Transaction(func(db *gorm.DB) error {
+		var err error
+		// the objects sharing the handle their hooks return share the queries
+		dbs := make([]*gorm.DB, len(objects))
+		group := func() ([]*gorm.DB, map[*gorm.DB][]int) {
+			var handles []*gorm.DB
+			groups := make(map[*gorm.DB][]int)
+			for i, handle := range dbs {
+				if _, ok := groups[handle]; !ok {
+					handles = append(handles, handle)
+				}
+				groups[handle] = append(groups[handle], i)
+			}
+			return handles, groups
+		}
+		for i, in := range objects {
+			dbs[i] = db
+			if hook, ok := interface{}(&ExternalChild{}).(ExternalChildWithBeforePatchRead); ok {
+				if dbs[i], err = hook.BeforePatchRead(ctx, in, updateMasks[i], db); err != nil {
+					return err
+				}
+			}
+		}
+		targets := make(map[string]ExternalChildORM, len(objects))
+		handles, groups := group()
+		for _, handle := range handles {
+			keys := make([]string, 0, len(groups[handle]))
+			for _, i := range groups[handle] {
+				keys = append(keys, objectKeys[i])
+			}
+			query := handle.Session(&gorm.Session{}).Clauses(clause.Locking{Strength: "UPDATE"})
+			query = query.Where("id in (?)", keys)
+			rows := []ExternalChildORM{}
+			if err = query.Find(&rows).Error; err != nil {
+				return err
+			}
+			for _, row := range rows {
+				targets[row.Id] = row
+			}
+		}
+		ormObjs := make([]ExternalChildORM, len(objects))
+		prepare := func(i int) (*gorm.DB, error) {
+			in, key, updateMask, db := objects[i], objectKeys[i], updateMasks[i], dbs[i]
+			target, ok := targets[key]
+			if !ok {
+				return nil, gorm.ErrRecordNotFound
+			}
+			stored, err := target.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbObj := &stored
+			if hook, ok := interface{}(pbObj).(ExternalChildWithBeforePatchApplyFieldMask); ok {
+				if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			if _, err := DefaultApplyFieldMaskExternalChild(ctx, pbObj, in, updateMask, "", db); err != nil {
+				return nil, err
+			}
+			if hook, ok := interface{}(pbObj).(ExternalChildWithBeforePatchSave); ok {
+				if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObj, err := pbObj.ToORM(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if hook, ok := interface{}(&ormObj).(ExternalChildORMWithBeforeStrictUpdateCleanup); ok {
+				if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			if hook, ok := interface{}(&ormObj).(ExternalChildORMWithBeforeStrictUpdateSave); ok {
+				if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObjs[i] = ormObj
+			return db, nil
+		}
+		for i := range objects {
+			if dbs[i], err = prepare(i); err != nil {
+				return err
+			}
+		}
+		write := func(db *gorm.DB, batch []int) error {
+			conds := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*2)
+			for _, i := range batch {
+				conds = append(conds, "(id = ?)")
+				args = append(args, objectKeys[i])
+			}
+			column := func(name string, value func(ormObj *ExternalChildORM) interface{}) clause.Expr {
+				whens := make([]interface{}, 0, len(batch)*2)
+				for _, i := range batch {
+					whens = append(whens, objectKeys[i], value(&ormObjs[i]))
+				}
+				return gorm.Expr("CASE "+strings.Repeat("WHEN id = ? THEN ? ", len(batch))+"ELSE "+name+" END", whens...)
+			}
+			res := db.Session(&gorm.Session{}).Model(&ExternalChildORM{}).Where(strings.Join(conds, " OR "), args...).Updates(map[string]interface{}{
+				"primary_included_id":    column("primary_included_id", func(ormObj *ExternalChildORM) interface{} { return ormObj.PrimaryIncludedId }),
+				"primary_string_type_id": column("primary_string_type_id", func(ormObj *ExternalChildORM) interface{} { return ormObj.PrimaryStringTypeId }),
+				"primary_uuid_type_id":   column("primary_uuid_type_id", func(ormObj *ExternalChildORM) interface{} { return ormObj.PrimaryUUIDTypeId }),
+			})
+			return res.Error
+		}
+		handles, groups = group()
+		for _, handle := range handles {
+			indexes := groups[handle]
+			for start := 0; start < len(indexes); start += batchSize {
+				end := start + batchSize
+				if end > len(indexes) {
+					end = len(indexes)
+				}
+				batch := indexes[start:end]
+				if err = write(handle, batch); err != nil {
+					return err
+				}
+			}
+		}
+		finish := func(i int) (*ExternalChild, error) {
+			in, updateMask, db, ormObj := objects[i], updateMasks[i], dbs[i], ormObjs[i]
+			var err error
+			if hook, ok := interface{}(&ormObj).(ExternalChildORMWithAfterStrictUpdateSave); ok {
+				if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			saved, err := ormObj.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbResponse := &saved
+			if hook, ok := interface{}(pbResponse).(ExternalChildWithAfterPatchSave); ok {
+				if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			return pbResponse, nil
+		}
+		for i := range objects {
+			if results[i], err = finish(i); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
-
 	return results, nil
 }
 
@@ -430,6 +721,14 @@ func DefaultApplyFieldMaskExternalChild(ctx context.Context, patchee *ExternalCh
 	} else if patchee == nil {
 		return nil, errors.NilArgumentError
 	}
+	if len(updateMask.GetPaths()) == 1 && updateMask.Paths[0] == prefix+"*" {
+		updateMask = &field_mask.FieldMask{Paths: []string{prefix + "Id"}}
+	}
+	for _, f := range updateMask.GetPaths() {
+		if strings.HasPrefix(f, prefix) && !DefaultHasFieldMaskPathExternalChild(strings.TrimPrefix(f, prefix)) {
+			return nil, &errors.InvalidFieldMaskError{Path: f}
+		}
+	}
 	var err error
 	for _, f := range updateMask.Paths {
 		if f == prefix+"Id" {
@@ -443,6 +742,16 @@ func DefaultApplyFieldMaskExternalChild(ctx context.Context, patchee *ExternalCh
 	return patchee, nil
 }
 
+// DefaultHasFieldMaskPathExternalChild reports whether ExternalChild has the field of the field mask path
+func DefaultHasFieldMaskPathExternalChild(path string) bool {
+	parts := strings.SplitN(path, ".", 2)
+	switch parts[0] {
+	case "Id":
+		return len(parts) == 1
+	}
+	return false
+}
+
 // DefaultListExternalChild executes a gorm list call
 func DefaultListExternalChild(ctx context.Context, db *gorm.DB) ([]*ExternalChild, error) {
 	in := ExternalChild{}
@@ -525,6 +834,70 @@ type BlogPostORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm.DB) error
 }
 
+// DefaultCreateBlogPostSet executes gorm create calls in batches of 100 objects
+// in a transaction, either all of the objects are created or none of them. The BeforeCreate_ hook
+// of each object runs before the insert of its batch, which uses the handle the hooks of the
+// batch return
+func DefaultCreateBlogPostSet(ctx context.Context, in []*BlogPost, db *gorm.DB) ([]*BlogPost, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObjs := make([]BlogPostORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, ormObj)
+	}
+	batchSize := 100
+	err := db.Transaction(func(db *gorm.DB) error {
+		var err error
+		for start := 0; start < len(ormObjs); start += batchSize {
+			end := start + batchSize
+			if end > len(ormObjs) {
+				end = len(ormObjs)
+			}
+			batch := ormObjs[start:end]
+			// the scopes the hooks add only apply to the insert of their own batch
+			batchDB := db
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(BlogPostORMWithBeforeCreate_); ok {
+					if batchDB, err = hook.BeforeCreate_(ctx, batchDB); err != nil {
+						return err
+					}
+				}
+			}
+			if err = batchDB.Omit().CreateInBatches(&batch, batchSize).Error; err != nil {
+				return err
+			}
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(BlogPostORMWithAfterCreate_); ok {
+					if err = hook.AfterCreate_(ctx, db); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*BlogPost, 0, len(ormObjs))
+	for i := range ormObjs {
+		pbResponse, err := ormObjs[i].ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, &pbResponse)
+	}
+	return results, nil
+}
+
 func DefaultReadBlogPost(ctx context.Context, in *BlogPost, db *gorm.DB) (*BlogPost, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
@@ -690,37 +1063,111 @@ type BlogPostORMWithAfterStrictUpdateSave interface {
 	AfterStrictUpdateSave(context.Context, *gorm.DB) error
 }
 
+// DefaultUpsertBlogPost executes a gorm create call resolving conflicts on conflictTarget,
+// the name of a unique index or the primary key when empty, by updating the columns
+// in updateMask, all of them when updateMask is empty, and returns the stored object
+func DefaultUpsertBlogPost(ctx context.Context, in *BlogPost, conflictTarget string, updateMask *field_mask.FieldMask, db *gorm.DB) (*BlogPost, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conflict := clause.OnConflict{}
+	var lookup func() map[string]interface{}
+	switch conflictTarget {
+	case "":
+		conflict.Columns = []clause.Column{{Name: "id"}}
+		lookup = func() map[string]interface{} { return map[string]interface{}{"id": ormObj.Id} }
+	default:
+		return nil, fmt.Errorf("unknown conflict target %q of BlogPost", conflictTarget)
+	}
+	if len(updateMask.GetPaths()) == 0 {
+		conflict.UpdateAll = true
+	} else {
+		var columns []string
+		for _, path := range updateMask.GetPaths() {
+			var pathColumns []string
+			switch path {
+			case "Title":
+				pathColumns = []string{"title"}
+			case "Author":
+				pathColumns = []string{"author"}
+			default:
+				return nil, &errors.InvalidFieldMaskError{Path: path}
+			}
+			for _, column := range pathColumns {
+				for _, target := range conflict.Columns {
+					if column == target.Name {
+						return nil, &errors.InvalidFieldMaskError{Path: path}
+					}
+				}
+			}
+			columns = append(columns, pathColumns...)
+		}
+		conflict.DoUpdates = clause.AssignmentColumns(columns)
+	}
+	if hook, ok := interface{}(&ormObj).(BlogPostORMWithBeforeUpsert); ok {
+		if db, err = hook.BeforeUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Clauses(conflict).Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if len(updateMask.GetPaths()) > 0 {
+		stored := BlogPostORM{}
+		if err = db.Where(lookup()).Take(&stored).Error; err != nil {
+			return nil, err
+		}
+		ormObj = stored
+	}
+	if hook, ok := interface{}(&ormObj).(BlogPostORMWithAfterUpsert); ok {
+		if err = hook.AfterUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type BlogPostORMWithBeforeUpsert interface {
+	BeforeUpsert(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type BlogPostORMWithAfterUpsert interface {
+	AfterUpsert(context.Context, *gorm.DB) error
+}
+
 // DefaultPatchBlogPost executes a basic gorm update call with patch behavior
 func DefaultPatchBlogPost(ctx context.Context, in *BlogPost, updateMask *field_mask.FieldMask, db *gorm.DB) (*BlogPost, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	var pbObj BlogPost
+	pbObj := &BlogPost{}
 	var err error
-	if hook, ok := interface{}(&pbObj).(BlogPostWithBeforePatchRead); ok {
+	if hook, ok := interface{}(pbObj).(BlogPostWithBeforePatchRead); ok {
 		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbReadRes, err := DefaultReadBlogPost(ctx, &BlogPost{Id: in.GetId()}, db)
-	if err != nil {
+	if pbObj, err = DefaultReadBlogPost(ctx, &BlogPost{Id: in.GetId()}, db); err != nil {
 		return nil, err
 	}
-	pbObj = *pbReadRes
-	if hook, ok := interface{}(&pbObj).(BlogPostWithBeforePatchApplyFieldMask); ok {
+	if hook, ok := interface{}(pbObj).(BlogPostWithBeforePatchApplyFieldMask); ok {
 		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	if _, err := DefaultApplyFieldMaskBlogPost(ctx, &pbObj, in, updateMask, "", db); err != nil {
+	if _, err := DefaultApplyFieldMaskBlogPost(ctx, pbObj, in, updateMask, "", db); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&pbObj).(BlogPostWithBeforePatchSave); ok {
+	if hook, ok := interface{}(pbObj).(BlogPostWithBeforePatchSave); ok {
 		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse, err := DefaultStrictUpdateBlogPost(ctx, &pbObj, db)
+	pbResponse, err := DefaultStrictUpdateBlogPost(ctx, pbObj, db)
 	if err != nil {
 		return nil, err
 	}
@@ -745,22 +1192,192 @@ type BlogPostWithAfterPatchSave interface {
 	AfterPatchSave(context.Context, *BlogPost, *field_mask.FieldMask, *gorm.DB) error
 }
 
-// DefaultPatchSetBlogPost executes a bulk gorm update call with patch behavior
+// DefaultPatchSetBlogPost patches the objects in a transaction: the masks are applied in memory to
+// the stored objects, read and locked with a single query, and the objects are written with an update
+// per batch of 100 objects. The objects are read and written with the handles their hooks
+// return, the objects of different handles don't share the queries. Only the associations in the
+// masks are updated, and objects sharing a primary key are rejected
 func DefaultPatchSetBlogPost(ctx context.Context, objects []*BlogPost, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*BlogPost, error) {
 	if len(objects) != len(updateMasks) {
 		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
 	}
-
-	results := make([]*BlogPost, 0, len(objects))
-	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchBlogPost(ctx, patcher, updateMasks[i], db)
+	if len(objects) == 0 {
+		return []*BlogPost{}, nil
+	}
+	var err error
+	objectKeys := make([]uint64, 0, len(objects))
+	seen := make(map[uint64]struct{}, len(objects))
+	for _, in := range objects {
+		if in == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := in.ToORM(ctx)
 		if err != nil {
 			return nil, err
 		}
-
-		results = append(results, pbResponse)
+		if ormObj.Id == 0 {
+			return nil, errors.EmptyIdError
+		}
+		key := ormObj.Id
+		if _, ok := seen[key]; ok {
+			return nil, errors.DuplicateKeyError
+		}
+		seen[key] = struct{}{}
+		objectKeys = append(objectKeys, key)
+	}
+	batchSize := 100
+	results := make([]*BlogPost, len(objects))
+	err = db.Transaction(func(db *gorm.DB) error {
+		var err error
+		// the objects sharing the handle their hooks return share the queries
+		dbs := make([]*gorm.DB, len(objects))
+		group := func() ([]*gorm.DB, map[*gorm.DB][]int) {
+			var handles []*gorm.DB
+			groups := make(map[*gorm.DB][]int)
+			for i, handle := range dbs {
+				if _, ok := groups[handle]; !ok {
+					handles = append(handles, handle)
+				}
+				groups[handle] = append(groups[handle], i)
+			}
+			return handles, groups
+		}
+		for i, in := range objects {
+			dbs[i] = db
+			if hook, ok := interface{}(&BlogPost{}).(BlogPostWithBeforePatchRead); ok {
+				if dbs[i], err = hook.BeforePatchRead(ctx, in, updateMasks[i], db); err != nil {
+					return err
+				}
+			}
+		}
+		targets := make(map[uint64]BlogPostORM, len(objects))
+		handles, groups := group()
+		for _, handle := range handles {
+			keys := make([]uint64, 0, len(groups[handle]))
+			for _, i := range groups[handle] {
+				keys = append(keys, objectKeys[i])
+			}
+			query := handle.Session(&gorm.Session{}).Clauses(clause.Locking{Strength: "UPDATE"})
+			query = query.Where("id in (?)", keys)
+			rows := []BlogPostORM{}
+			if err = query.Find(&rows).Error; err != nil {
+				return err
+			}
+			for _, row := range rows {
+				targets[row.Id] = row
+			}
+		}
+		ormObjs := make([]BlogPostORM, len(objects))
+		prepare := func(i int) (*gorm.DB, error) {
+			in, key, updateMask, db := objects[i], objectKeys[i], updateMasks[i], dbs[i]
+			target, ok := targets[key]
+			if !ok {
+				return nil, gorm.ErrRecordNotFound
+			}
+			stored, err := target.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbObj := &stored
+			if hook, ok := interface{}(pbObj).(BlogPostWithBeforePatchApplyFieldMask); ok {
+				if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			if _, err := DefaultApplyFieldMaskBlogPost(ctx, pbObj, in, updateMask, "", db); err != nil {
+				return nil, err
+			}
+			if hook, ok := interface{}(pbObj).(BlogPostWithBeforePatchSave); ok {
+				if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObj, err := pbObj.ToORM(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if hook, ok := interface{}(&ormObj).(BlogPostORMWithBeforeStrictUpdateCleanup); ok {
+				if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			if hook, ok := interface{}(&ormObj).(BlogPostORMWithBeforeStrictUpdateSave); ok {
+				if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObjs[i] = ormObj
+			return db, nil
+		}
+		for i := range objects {
+			if dbs[i], err = prepare(i); err != nil {
+				return err
+			}
+		}
+		write := func(db *gorm.DB, batch []int) error {
+			conds := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*2)
+			for _, i := range batch {
+				conds = append(conds, "(id = ?)")
+				args = append(args, objectKeys[i])
+			}
+			column := func(name string, value func(ormObj *BlogPostORM) interface{}) clause.Expr {
+				whens := make([]interface{}, 0, len(batch)*2)
+				for _, i := range batch {
+					whens = append(whens, objectKeys[i], value(&ormObjs[i]))
+				}
+				return gorm.Expr("CASE "+strings.Repeat("WHEN id = ? THEN ? ", len(batch))+"ELSE "+name+" END", whens...)
+			}
+			res := db.Session(&gorm.Session{}).Model(&BlogPostORM{}).Where(strings.Join(conds, " OR "), args...).Updates(map[string]interface{}{
+				"author": column("author", func(ormObj *BlogPostORM) interface{} { return ormObj.Author }),
+				"title":  column("title", func(ormObj *BlogPostORM) interface{} { return ormObj.Title }),
+			})
+			return res.Error
+		}
+		handles, groups = group()
+		for _, handle := range handles {
+			indexes := groups[handle]
+			for start := 0; start < len(indexes); start += batchSize {
+				end := start + batchSize
+				if end > len(indexes) {
+					end = len(indexes)
+				}
+				batch := indexes[start:end]
+				if err = write(handle, batch); err != nil {
+					return err
+				}
+			}
+		}
+		finish := func(i int) (*BlogPost, error) {
+			in, updateMask, db, ormObj := objects[i], updateMasks[i], dbs[i], ormObjs[i]
+			var err error
+			if hook, ok := interface{}(&ormObj).(BlogPostORMWithAfterStrictUpdateSave); ok {
+				if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			saved, err := ormObj.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbResponse := &saved
+			if hook, ok := interface{}(pbResponse).(BlogPostWithAfterPatchSave); ok {
+				if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			return pbResponse, nil
+		}
+		for i := range objects {
+			if results[i], err = finish(i); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
-
 	return results, nil
 }
 
@@ -771,6 +1388,14 @@ func DefaultApplyFieldMaskBlogPost(ctx context.Context, patchee *BlogPost, patch
 	} else if patchee == nil {
 		return nil, errors.NilArgumentError
 	}
+	if len(updateMask.GetPaths()) == 1 && updateMask.Paths[0] == prefix+"*" {
+		updateMask = &field_mask.FieldMask{Paths: []string{prefix + "Id", prefix + "Title", prefix + "Author"}}
+	}
+	for _, f := range updateMask.GetPaths() {
+		if strings.HasPrefix(f, prefix) && !DefaultHasFieldMaskPathBlogPost(strings.TrimPrefix(f, prefix)) {
+			return nil, &errors.InvalidFieldMaskError{Path: f}
+		}
+	}
 	var err error
 	for _, f := range updateMask.Paths {
 		if f == prefix+"Id" {
@@ -792,6 +1417,16 @@ func DefaultApplyFieldMaskBlogPost(ctx context.Context, patchee *BlogPost, patch
 	return patchee, nil
 }
 
+// DefaultHasFieldMaskPathBlogPost reports whether BlogPost has the field of the field mask path
+func DefaultHasFieldMaskPathBlogPost(path string) bool {
+	parts := strings.SplitN(path, ".", 2)
+	switch parts[0] {
+	case "Id", "Title", "Author":
+		return len(parts) == 1
+	}
+	return false
+}
+
 // DefaultListBlogPost executes a gorm list call
 func DefaultListBlogPost(ctx context.Context, db *gorm.DB) ([]*BlogPost, error) {
 	in := BlogPost{}
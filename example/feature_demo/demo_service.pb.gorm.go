@@ -1,8 +1,11 @@
 package example
 
 import (
+	bytes "bytes"
 	context "context"
+	base64 "encoding/base64"
 	json "encoding/json"
+	errors1 "errors"
 	fmt "fmt"
 	gateway "github.com/infobloxopen/atlas-app-toolkit/v2/gateway"
 	gorm1 "github.com/infobloxopen/atlas-app-toolkit/v2/gorm"
@@ -10,8 +13,20 @@ import (
 	errors "github.com/infobloxopen/protoc-gen-gorm/errors"
 	trace "go.opencensus.io/trace"
 	field_mask "google.golang.org/genproto/protobuf/field_mask"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	metadata "google.golang.org/grpc/metadata"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	gorm "gorm.io/gorm"
+	clause "gorm.io/gorm/clause"
+	sort "sort"
+	strconv "strconv"
+	strings "strings"
+	time "time"
 )
 
 type IntPointORM struct {
@@ -218,610 +233,13275 @@ type CircleWithAfterToPB interface {
 	AfterToPB(context.Context, *Circle) error
 }
 
-// DefaultCreateIntPoint executes a basic gorm create call
-func DefaultCreateIntPoint(ctx context.Context, in *IntPoint, db *gorm.DB) (*IntPoint, error) {
-	if in == nil {
-		return nil, errors.NilArgumentError
-	}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(IntPointORMWithBeforeCreate_); ok {
-		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
-			return nil, err
-		}
-	}
-	if err = db.Omit().Create(&ormObj).Error; err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(IntPointORMWithAfterCreate_); ok {
-		if err = hook.AfterCreate_(ctx, db); err != nil {
-			return nil, err
-		}
-	}
-	pbResponse, err := ormObj.ToPB(ctx)
-	return &pbResponse, err
+type TenantEventORM struct {
+	AccountId string `gorm:"primaryKey"`
+	CreatedAt *time.Time
+	Id        uint64 `gorm:"primaryKey"`
+	Payload   string
 }
 
-type IntPointORMWithBeforeCreate_ interface {
-	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+// TableName overrides the default tablename generated by GORM
+func (TenantEventORM) TableName() string {
+	return "tenant_events"
 }
-type IntPointORMWithAfterCreate_ interface {
-	AfterCreate_(context.Context, *gorm.DB) error
+
+// TableOptions returns the partitioning clause of TenantEventORM, use it as
+// "gorm:table_options" when migrating so the table is created partitioned
+func (TenantEventORM) TableOptions() string {
+	return "PARTITION BY LIST (account_id)"
 }
 
-func DefaultReadIntPoint(ctx context.Context, in *IntPoint, db *gorm.DB, fs *query.FieldSelection) (*IntPoint, error) {
-	if in == nil {
-		return nil, errors.NilArgumentError
-	}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return nil, err
-	}
-	if ormObj.Id == 0 {
-		return nil, errors.EmptyIdError
-	}
-	if hook, ok := interface{}(&ormObj).(IntPointORMWithBeforeReadApplyQuery); ok {
-		if db, err = hook.BeforeReadApplyQuery(ctx, db, fs); err != nil {
-			return nil, err
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *TenantEvent) ToORM(ctx context.Context) (TenantEventORM, error) {
+	to := TenantEventORM{}
+	var err error
+	if prehook, ok := interface{}(m).(TenantEventWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
 		}
 	}
-	if db, err = gorm1.ApplyFieldSelection(ctx, db, fs, &IntPointORM{}); err != nil {
-		return nil, err
+	to.Id = m.Id
+	to.AccountId = m.AccountId
+	if m.CreatedAt != nil {
+		t := m.CreatedAt.AsTime()
+		to.CreatedAt = &t
 	}
-	if hook, ok := interface{}(&ormObj).(IntPointORMWithBeforeReadFind); ok {
-		if db, err = hook.BeforeReadFind(ctx, db, fs); err != nil {
-			return nil, err
+	to.Payload = m.Payload
+	if posthook, ok := interface{}(m).(TenantEventWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *TenantEventORM) ToPB(ctx context.Context) (TenantEvent, error) {
+	to := TenantEvent{}
+	var err error
+	if prehook, ok := interface{}(m).(TenantEventWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
 		}
 	}
-	ormResponse := IntPointORM{}
-	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
-		return nil, err
+	to.Id = m.Id
+	to.AccountId = m.AccountId
+	if m.CreatedAt != nil {
+		to.CreatedAt = timestamppb.New(*m.CreatedAt)
 	}
-	if hook, ok := interface{}(&ormResponse).(IntPointORMWithAfterReadFind); ok {
-		if err = hook.AfterReadFind(ctx, db, fs); err != nil {
-			return nil, err
-		}
+	to.Payload = m.Payload
+	if posthook, ok := interface{}(m).(TenantEventWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
 	}
-	pbResponse, err := ormResponse.ToPB(ctx)
-	return &pbResponse, err
+	return to, err
 }
 
-type IntPointORMWithBeforeReadApplyQuery interface {
-	BeforeReadApplyQuery(context.Context, *gorm.DB, *query.FieldSelection) (*gorm.DB, error)
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type TenantEvent the arg will be the target, the caller the one being converted from
+
+// TenantEventBeforeToORM called before default ToORM code
+type TenantEventWithBeforeToORM interface {
+	BeforeToORM(context.Context, *TenantEventORM) error
 }
-type IntPointORMWithBeforeReadFind interface {
-	BeforeReadFind(context.Context, *gorm.DB, *query.FieldSelection) (*gorm.DB, error)
+
+// TenantEventAfterToORM called after default ToORM code
+type TenantEventWithAfterToORM interface {
+	AfterToORM(context.Context, *TenantEventORM) error
 }
-type IntPointORMWithAfterReadFind interface {
-	AfterReadFind(context.Context, *gorm.DB, *query.FieldSelection) error
+
+// TenantEventBeforeToPB called before default ToPB code
+type TenantEventWithBeforeToPB interface {
+	BeforeToPB(context.Context, *TenantEvent) error
 }
 
-func DefaultDeleteIntPoint(ctx context.Context, in *IntPoint, db *gorm.DB) error {
-	if in == nil {
-		return errors.NilArgumentError
-	}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return err
-	}
-	if ormObj.Id == 0 {
-		return errors.EmptyIdError
-	}
-	if hook, ok := interface{}(&ormObj).(IntPointORMWithBeforeDelete_); ok {
-		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
-			return err
-		}
-	}
-	err = db.Where(&ormObj).Delete(&IntPointORM{}).Error
-	if err != nil {
-		return err
-	}
-	if hook, ok := interface{}(&ormObj).(IntPointORMWithAfterDelete_); ok {
-		err = hook.AfterDelete_(ctx, db)
-	}
-	return err
+// TenantEventAfterToPB called after default ToPB code
+type TenantEventWithAfterToPB interface {
+	AfterToPB(context.Context, *TenantEvent) error
 }
 
-type IntPointORMWithBeforeDelete_ interface {
-	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+type SettingORM struct {
+	Id    uint64
+	Name  string `gorm:"uniqueIndex:idx_setting_name"`
+	Value string
 }
-type IntPointORMWithAfterDelete_ interface {
-	AfterDelete_(context.Context, *gorm.DB) error
+
+// TableName overrides the default tablename generated by GORM
+func (SettingORM) TableName() string {
+	return "settings"
 }
 
-func DefaultDeleteIntPointSet(ctx context.Context, in []*IntPoint, db *gorm.DB) error {
-	if in == nil {
-		return errors.NilArgumentError
-	}
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *Setting) ToORM(ctx context.Context) (SettingORM, error) {
+	to := SettingORM{}
 	var err error
-	keys := []uint32{}
-	for _, obj := range in {
-		ormObj, err := obj.ToORM(ctx)
-		if err != nil {
-			return err
-		}
-		if ormObj.Id == 0 {
-			return errors.EmptyIdError
+	if prehook, ok := interface{}(m).(SettingWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
 		}
-		keys = append(keys, ormObj.Id)
 	}
-	if hook, ok := (interface{}(&IntPointORM{})).(IntPointORMWithBeforeDeleteSet); ok {
-		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
-			return err
-		}
+	to.Id = m.Id
+	to.Name = m.Name
+	to.Value = m.Value
+	if posthook, ok := interface{}(m).(SettingWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
 	}
-	err = db.Where("id in (?)", keys).Delete(&IntPointORM{}).Error
-	if err != nil {
-		return err
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *SettingORM) ToPB(ctx context.Context) (Setting, error) {
+	to := Setting{}
+	var err error
+	if prehook, ok := interface{}(m).(SettingWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
 	}
-	if hook, ok := (interface{}(&IntPointORM{})).(IntPointORMWithAfterDeleteSet); ok {
-		err = hook.AfterDeleteSet(ctx, in, db)
+	to.Id = m.Id
+	to.Name = m.Name
+	to.Value = m.Value
+	if posthook, ok := interface{}(m).(SettingWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
 	}
-	return err
+	return to, err
 }
 
-type IntPointORMWithBeforeDeleteSet interface {
-	BeforeDeleteSet(context.Context, []*IntPoint, *gorm.DB) (*gorm.DB, error)
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type Setting the arg will be the target, the caller the one being converted from
+
+// SettingBeforeToORM called before default ToORM code
+type SettingWithBeforeToORM interface {
+	BeforeToORM(context.Context, *SettingORM) error
 }
-type IntPointORMWithAfterDeleteSet interface {
-	AfterDeleteSet(context.Context, []*IntPoint, *gorm.DB) error
+
+// SettingAfterToORM called after default ToORM code
+type SettingWithAfterToORM interface {
+	AfterToORM(context.Context, *SettingORM) error
 }
 
-// DefaultStrictUpdateIntPoint clears / replaces / appends first level 1:many children and then executes a gorm update call
-func DefaultStrictUpdateIntPoint(ctx context.Context, in *IntPoint, db *gorm.DB) (*IntPoint, error) {
-	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateIntPoint")
-	}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return nil, err
-	}
-	var count int64
-	lockedRow := &IntPointORM{}
-	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
-	if hook, ok := interface{}(&ormObj).(IntPointORMWithBeforeStrictUpdateCleanup); ok {
-		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
-			return nil, err
-		}
-	}
-	if hook, ok := interface{}(&ormObj).(IntPointORMWithBeforeStrictUpdateSave); ok {
-		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
-			return nil, err
-		}
-	}
-	if err = db.Omit().Save(&ormObj).Error; err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(IntPointORMWithAfterStrictUpdateSave); ok {
-		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
-			return nil, err
-		}
-	}
-	pbResponse, err := ormObj.ToPB(ctx)
-	if err != nil {
-		return nil, err
-	}
-	if count == 0 {
-		err = gateway.SetCreated(ctx, "")
-	}
-	return &pbResponse, err
+// SettingBeforeToPB called before default ToPB code
+type SettingWithBeforeToPB interface {
+	BeforeToPB(context.Context, *Setting) error
 }
 
-type IntPointORMWithBeforeStrictUpdateCleanup interface {
-	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+// SettingAfterToPB called after default ToPB code
+type SettingWithAfterToPB interface {
+	AfterToPB(context.Context, *Setting) error
 }
-type IntPointORMWithBeforeStrictUpdateSave interface {
-	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+
+type BasketORM struct {
+	Id     uint64
+	Items  []*BasketItemORM  `gorm:"foreignKey:BasketId;references:Id"`
+	Labels []*BasketLabelORM `gorm:"foreignKey:Id;references:Id;many2many:basket_basket_labels;joinForeignKey:BasketId;joinReferences:BasketLabelId"`
+	Name   string
 }
-type IntPointORMWithAfterStrictUpdateSave interface {
-	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+
+// TableName overrides the default tablename generated by GORM
+func (BasketORM) TableName() string {
+	return "baskets"
 }
 
-// DefaultPatchIntPoint executes a basic gorm update call with patch behavior
-func DefaultPatchIntPoint(ctx context.Context, in *IntPoint, updateMask *field_mask.FieldMask, db *gorm.DB) (*IntPoint, error) {
-	if in == nil {
-		return nil, errors.NilArgumentError
-	}
-	var pbObj IntPoint
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *Basket) ToORM(ctx context.Context) (BasketORM, error) {
+	to := BasketORM{}
 	var err error
-	if hook, ok := interface{}(&pbObj).(IntPointWithBeforePatchRead); ok {
-		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
-			return nil, err
+	if prehook, ok := interface{}(m).(BasketWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
 		}
 	}
-	pbReadRes, err := DefaultReadIntPoint(ctx, &IntPoint{Id: in.GetId()}, db, nil)
-	if err != nil {
-		return nil, err
+	to.Id = m.Id
+	to.Name = m.Name
+	if m.Items != nil {
+		to.Items = make([]*BasketItemORM, 0, len(m.Items))
+	}
+	for _, v := range m.Items {
+		if v != nil {
+			if tempItems, cErr := v.ToORM(ctx); cErr == nil {
+				to.Items = append(to.Items, &tempItems)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.Items = append(to.Items, nil)
+		}
 	}
-	pbObj = *pbReadRes
-	if hook, ok := interface{}(&pbObj).(IntPointWithBeforePatchApplyFieldMask); ok {
-		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
-			return nil, err
+	if m.Labels != nil {
+		to.Labels = make([]*BasketLabelORM, 0, len(m.Labels))
+	}
+	for _, v := range m.Labels {
+		if v != nil {
+			if tempLabels, cErr := v.ToORM(ctx); cErr == nil {
+				to.Labels = append(to.Labels, &tempLabels)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.Labels = append(to.Labels, nil)
 		}
 	}
-	if _, err := DefaultApplyFieldMaskIntPoint(ctx, &pbObj, in, updateMask, "", db); err != nil {
-		return nil, err
+	if posthook, ok := interface{}(m).(BasketWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
 	}
-	if hook, ok := interface{}(&pbObj).(IntPointWithBeforePatchSave); ok {
-		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
-			return nil, err
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *BasketORM) ToPB(ctx context.Context) (Basket, error) {
+	to := Basket{}
+	var err error
+	if prehook, ok := interface{}(m).(BasketWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
 		}
 	}
-	pbResponse, err := DefaultStrictUpdateIntPoint(ctx, &pbObj, db)
-	if err != nil {
-		return nil, err
+	to.Id = m.Id
+	to.Name = m.Name
+	for _, v := range m.Items {
+		if v != nil {
+			if tempItems, cErr := v.ToPB(ctx); cErr == nil {
+				to.Items = append(to.Items, &tempItems)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.Items = append(to.Items, nil)
+		}
 	}
-	if hook, ok := interface{}(pbResponse).(IntPointWithAfterPatchSave); ok {
-		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
-			return nil, err
+	for _, v := range m.Labels {
+		if v != nil {
+			if tempLabels, cErr := v.ToPB(ctx); cErr == nil {
+				to.Labels = append(to.Labels, &tempLabels)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.Labels = append(to.Labels, nil)
 		}
 	}
-	return pbResponse, nil
+	if posthook, ok := interface{}(m).(BasketWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
 }
 
-type IntPointWithBeforePatchRead interface {
-	BeforePatchRead(context.Context, *IntPoint, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type Basket the arg will be the target, the caller the one being converted from
+
+// BasketBeforeToORM called before default ToORM code
+type BasketWithBeforeToORM interface {
+	BeforeToORM(context.Context, *BasketORM) error
 }
-type IntPointWithBeforePatchApplyFieldMask interface {
-	BeforePatchApplyFieldMask(context.Context, *IntPoint, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+
+// BasketAfterToORM called after default ToORM code
+type BasketWithAfterToORM interface {
+	AfterToORM(context.Context, *BasketORM) error
 }
-type IntPointWithBeforePatchSave interface {
-	BeforePatchSave(context.Context, *IntPoint, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+
+// BasketBeforeToPB called before default ToPB code
+type BasketWithBeforeToPB interface {
+	BeforeToPB(context.Context, *Basket) error
 }
-type IntPointWithAfterPatchSave interface {
-	AfterPatchSave(context.Context, *IntPoint, *field_mask.FieldMask, *gorm.DB) error
+
+// BasketAfterToPB called after default ToPB code
+type BasketWithAfterToPB interface {
+	AfterToPB(context.Context, *Basket) error
 }
 
-// DefaultPatchSetIntPoint executes a bulk gorm update call with patch behavior
-func DefaultPatchSetIntPoint(ctx context.Context, objects []*IntPoint, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*IntPoint, error) {
-	if len(objects) != len(updateMasks) {
-		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
-	}
+type BasketItemORM struct {
+	BasketId *uint64
+	Id       uint64
+	Name     string
+	Notes    []*BasketItemNoteORM `gorm:"foreignKey:BasketItemId;references:Id"`
+	Quantity int32
+}
 
-	results := make([]*IntPoint, 0, len(objects))
-	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchIntPoint(ctx, patcher, updateMasks[i], db)
-		if err != nil {
-			return nil, err
+// TableName overrides the default tablename generated by GORM
+func (BasketItemORM) TableName() string {
+	return "basket_items"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *BasketItem) ToORM(ctx context.Context) (BasketItemORM, error) {
+	to := BasketItemORM{}
+	var err error
+	if prehook, ok := interface{}(m).(BasketItemWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
 		}
+	}
+	to.Id = m.Id
+	to.Name = m.Name
+	to.Quantity = m.Quantity
+	for _, v := range m.Notes {
+		if v != nil {
+			if tempNotes, cErr := v.ToORM(ctx); cErr == nil {
+				to.Notes = append(to.Notes, &tempNotes)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.Notes = append(to.Notes, nil)
+		}
+	}
+	if posthook, ok := interface{}(m).(BasketItemWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
 
-		results = append(results, pbResponse)
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *BasketItemORM) ToPB(ctx context.Context) (BasketItem, error) {
+	to := BasketItem{}
+	var err error
+	if prehook, ok := interface{}(m).(BasketItemWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Name = m.Name
+	to.Quantity = m.Quantity
+	for _, v := range m.Notes {
+		if v != nil {
+			if tempNotes, cErr := v.ToPB(ctx); cErr == nil {
+				to.Notes = append(to.Notes, &tempNotes)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.Notes = append(to.Notes, nil)
+		}
+	}
+	if posthook, ok := interface{}(m).(BasketItemWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
 	}
+	return to, err
+}
 
-	return results, nil
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type BasketItem the arg will be the target, the caller the one being converted from
+
+// BasketItemBeforeToORM called before default ToORM code
+type BasketItemWithBeforeToORM interface {
+	BeforeToORM(context.Context, *BasketItemORM) error
 }
 
-// DefaultApplyFieldMaskIntPoint patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskIntPoint(ctx context.Context, patchee *IntPoint, patcher *IntPoint, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*IntPoint, error) {
-	if patcher == nil {
-		return nil, nil
-	} else if patchee == nil {
-		return nil, errors.NilArgumentError
+// BasketItemAfterToORM called after default ToORM code
+type BasketItemWithAfterToORM interface {
+	AfterToORM(context.Context, *BasketItemORM) error
+}
+
+// BasketItemBeforeToPB called before default ToPB code
+type BasketItemWithBeforeToPB interface {
+	BeforeToPB(context.Context, *BasketItem) error
+}
+
+// BasketItemAfterToPB called after default ToPB code
+type BasketItemWithAfterToPB interface {
+	AfterToPB(context.Context, *BasketItem) error
+}
+
+type BasketItemNoteORM struct {
+	BasketItemId *uint64
+	Id           uint64
+	Text         string
+}
+
+// TableName overrides the default tablename generated by GORM
+func (BasketItemNoteORM) TableName() string {
+	return "basket_item_notes"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *BasketItemNote) ToORM(ctx context.Context) (BasketItemNoteORM, error) {
+	to := BasketItemNoteORM{}
+	var err error
+	if prehook, ok := interface{}(m).(BasketItemNoteWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Text = m.Text
+	if posthook, ok := interface{}(m).(BasketItemNoteWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
 	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *BasketItemNoteORM) ToPB(ctx context.Context) (BasketItemNote, error) {
+	to := BasketItemNote{}
 	var err error
-	for _, f := range updateMask.Paths {
-		if f == prefix+"Id" {
-			patchee.Id = patcher.Id
-			continue
+	if prehook, ok := interface{}(m).(BasketItemNoteWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
 		}
-		if f == prefix+"X" {
-			patchee.X = patcher.X
-			continue
+	}
+	to.Id = m.Id
+	to.Text = m.Text
+	if posthook, ok := interface{}(m).(BasketItemNoteWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type BasketItemNote the arg will be the target, the caller the one being converted from
+
+// BasketItemNoteBeforeToORM called before default ToORM code
+type BasketItemNoteWithBeforeToORM interface {
+	BeforeToORM(context.Context, *BasketItemNoteORM) error
+}
+
+// BasketItemNoteAfterToORM called after default ToORM code
+type BasketItemNoteWithAfterToORM interface {
+	AfterToORM(context.Context, *BasketItemNoteORM) error
+}
+
+// BasketItemNoteBeforeToPB called before default ToPB code
+type BasketItemNoteWithBeforeToPB interface {
+	BeforeToPB(context.Context, *BasketItemNote) error
+}
+
+// BasketItemNoteAfterToPB called after default ToPB code
+type BasketItemNoteWithAfterToPB interface {
+	AfterToPB(context.Context, *BasketItemNote) error
+}
+
+type BasketLabelORM struct {
+	Id   uint64
+	Name string
+}
+
+// TableName overrides the default tablename generated by GORM
+func (BasketLabelORM) TableName() string {
+	return "basket_labels"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *BasketLabel) ToORM(ctx context.Context) (BasketLabelORM, error) {
+	to := BasketLabelORM{}
+	var err error
+	if prehook, ok := interface{}(m).(BasketLabelWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
 		}
-		if f == prefix+"Y" {
-			patchee.Y = patcher.Y
-			continue
+	}
+	to.Id = m.Id
+	to.Name = m.Name
+	if posthook, ok := interface{}(m).(BasketLabelWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *BasketLabelORM) ToPB(ctx context.Context) (BasketLabel, error) {
+	to := BasketLabel{}
+	var err error
+	if prehook, ok := interface{}(m).(BasketLabelWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
 		}
 	}
-	if err != nil {
-		return nil, err
+	to.Id = m.Id
+	to.Name = m.Name
+	if posthook, ok := interface{}(m).(BasketLabelWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
 	}
-	return patchee, nil
+	return to, err
 }
 
-// DefaultListIntPoint executes a gorm list call
-func DefaultListIntPoint(ctx context.Context, db *gorm.DB, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*IntPoint, error) {
-	in := IntPoint{}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return nil, err
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type BasketLabel the arg will be the target, the caller the one being converted from
+
+// BasketLabelBeforeToORM called before default ToORM code
+type BasketLabelWithBeforeToORM interface {
+	BeforeToORM(context.Context, *BasketLabelORM) error
+}
+
+// BasketLabelAfterToORM called after default ToORM code
+type BasketLabelWithAfterToORM interface {
+	AfterToORM(context.Context, *BasketLabelORM) error
+}
+
+// BasketLabelBeforeToPB called before default ToPB code
+type BasketLabelWithBeforeToPB interface {
+	BeforeToPB(context.Context, *BasketLabel) error
+}
+
+// BasketLabelAfterToPB called after default ToPB code
+type BasketLabelWithAfterToPB interface {
+	AfterToPB(context.Context, *BasketLabel) error
+}
+
+type TeamORM struct {
+	Id          uint64
+	Members     []*PersonORM     `gorm:"foreignKey:Id;references:Id;many2many:memberships;joinForeignKey:TeamId;joinReferences:PersonId"`
+	Memberships []*MembershipORM `gorm:"foreignKey:TeamId;references:Id"`
+	Name        string
+}
+
+// TableName overrides the default tablename generated by GORM
+func (TeamORM) TableName() string {
+	return "teams"
+}
+
+// SetupTeamJoinTables registers the join models of the many-to-many associations
+// of Team, it has to be called before the associations are used
+func SetupTeamJoinTables(db *gorm.DB) error {
+	if err := db.SetupJoinTable(&TeamORM{}, "Members", &MembershipORM{}); err != nil {
+		return err
 	}
-	if hook, ok := interface{}(&ormObj).(IntPointORMWithBeforeListApplyQuery); ok {
-		if db, err = hook.BeforeListApplyQuery(ctx, db, f, s, p, fs); err != nil {
-			return nil, err
+	return nil
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *Team) ToORM(ctx context.Context) (TeamORM, error) {
+	to := TeamORM{}
+	var err error
+	if prehook, ok := interface{}(m).(TeamWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
 		}
 	}
-	db, err = gorm1.ApplyCollectionOperators(ctx, db, &IntPointORM{}, &IntPoint{}, f, s, p, fs)
-	if err != nil {
-		return nil, err
+	to.Id = m.Id
+	to.Name = m.Name
+	for _, v := range m.Members {
+		if v != nil {
+			if tempMembers, cErr := v.ToORM(ctx); cErr == nil {
+				to.Members = append(to.Members, &tempMembers)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.Members = append(to.Members, nil)
+		}
 	}
-	if hook, ok := interface{}(&ormObj).(IntPointORMWithBeforeListFind); ok {
-		if db, err = hook.BeforeListFind(ctx, db, f, s, p, fs); err != nil {
-			return nil, err
+	for _, v := range m.Memberships {
+		if v != nil {
+			if tempMemberships, cErr := v.ToORM(ctx); cErr == nil {
+				to.Memberships = append(to.Memberships, &tempMemberships)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.Memberships = append(to.Memberships, nil)
 		}
 	}
-	db = db.Where(&ormObj)
-	db = db.Order("id")
-	ormResponse := []IntPointORM{}
-	if err := db.Find(&ormResponse).Error; err != nil {
-		return nil, err
+	if posthook, ok := interface{}(m).(TeamWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
 	}
-	if hook, ok := interface{}(&ormObj).(IntPointORMWithAfterListFind); ok {
-		if err = hook.AfterListFind(ctx, db, &ormResponse, f, s, p, fs); err != nil {
-			return nil, err
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *TeamORM) ToPB(ctx context.Context) (Team, error) {
+	to := Team{}
+	var err error
+	if prehook, ok := interface{}(m).(TeamWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
 		}
 	}
-	pbResponse := []*IntPoint{}
-	for _, responseEntry := range ormResponse {
-		temp, err := responseEntry.ToPB(ctx)
-		if err != nil {
-			return nil, err
+	to.Id = m.Id
+	to.Name = m.Name
+	for _, v := range m.Members {
+		if v != nil {
+			if tempMembers, cErr := v.ToPB(ctx); cErr == nil {
+				to.Members = append(to.Members, &tempMembers)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.Members = append(to.Members, nil)
 		}
-		pbResponse = append(pbResponse, &temp)
 	}
-	return pbResponse, nil
+	for _, v := range m.Memberships {
+		if v != nil {
+			if tempMemberships, cErr := v.ToPB(ctx); cErr == nil {
+				to.Memberships = append(to.Memberships, &tempMemberships)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.Memberships = append(to.Memberships, nil)
+		}
+	}
+	if posthook, ok := interface{}(m).(TeamWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
 }
 
-type IntPointORMWithBeforeListApplyQuery interface {
-	BeforeListApplyQuery(context.Context, *gorm.DB, *query.Filtering, *query.Sorting, *query.Pagination, *query.FieldSelection) (*gorm.DB, error)
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type Team the arg will be the target, the caller the one being converted from
+
+// TeamBeforeToORM called before default ToORM code
+type TeamWithBeforeToORM interface {
+	BeforeToORM(context.Context, *TeamORM) error
 }
-type IntPointORMWithBeforeListFind interface {
-	BeforeListFind(context.Context, *gorm.DB, *query.Filtering, *query.Sorting, *query.Pagination, *query.FieldSelection) (*gorm.DB, error)
+
+// TeamAfterToORM called after default ToORM code
+type TeamWithAfterToORM interface {
+	AfterToORM(context.Context, *TeamORM) error
 }
-type IntPointORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]IntPointORM, *query.Filtering, *query.Sorting, *query.Pagination, *query.FieldSelection) error
+
+// TeamBeforeToPB called before default ToPB code
+type TeamWithBeforeToPB interface {
+	BeforeToPB(context.Context, *Team) error
 }
 
-// DefaultCreateSomething executes a basic gorm create call
-func DefaultCreateSomething(ctx context.Context, in *Something, db *gorm.DB) (*Something, error) {
-	if in == nil {
-		return nil, errors.NilArgumentError
+// TeamAfterToPB called after default ToPB code
+type TeamWithAfterToPB interface {
+	AfterToPB(context.Context, *Team) error
+}
+
+type PersonORM struct {
+	Id   uint64
+	Name string
+}
+
+// TableName overrides the default tablename generated by GORM
+func (PersonORM) TableName() string {
+	return "people"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *Person) ToORM(ctx context.Context) (PersonORM, error) {
+	to := PersonORM{}
+	var err error
+	if prehook, ok := interface{}(m).(PersonWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
 	}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return nil, err
+	to.Id = m.Id
+	to.Name = m.Name
+	if posthook, ok := interface{}(m).(PersonWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
 	}
-	if hook, ok := interface{}(&ormObj).(SomethingORMWithBeforeCreate_); ok {
-		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
-			return nil, err
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *PersonORM) ToPB(ctx context.Context) (Person, error) {
+	to := Person{}
+	var err error
+	if prehook, ok := interface{}(m).(PersonWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
 		}
 	}
-	if err = db.Omit().Create(&ormObj).Error; err != nil {
-		return nil, err
+	to.Id = m.Id
+	to.Name = m.Name
+	if posthook, ok := interface{}(m).(PersonWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type Person the arg will be the target, the caller the one being converted from
+
+// PersonBeforeToORM called before default ToORM code
+type PersonWithBeforeToORM interface {
+	BeforeToORM(context.Context, *PersonORM) error
+}
+
+// PersonAfterToORM called after default ToORM code
+type PersonWithAfterToORM interface {
+	AfterToORM(context.Context, *PersonORM) error
+}
+
+// PersonBeforeToPB called before default ToPB code
+type PersonWithBeforeToPB interface {
+	BeforeToPB(context.Context, *Person) error
+}
+
+// PersonAfterToPB called after default ToPB code
+type PersonWithAfterToPB interface {
+	AfterToPB(context.Context, *Person) error
+}
+
+type MembershipORM struct {
+	JoinedAt *time.Time
+	PersonId uint64 `gorm:"primaryKey"`
+	Position int
+	Role     string
+	TeamId   uint64 `gorm:"primaryKey"`
+}
+
+// TableName overrides the default tablename generated by GORM
+func (MembershipORM) TableName() string {
+	return "memberships"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *Membership) ToORM(ctx context.Context) (MembershipORM, error) {
+	to := MembershipORM{}
+	var err error
+	if prehook, ok := interface{}(m).(MembershipWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.TeamId = m.TeamId
+	to.PersonId = m.PersonId
+	to.Role = m.Role
+	if m.JoinedAt != nil {
+		t := m.JoinedAt.AsTime()
+		to.JoinedAt = &t
+	}
+	if posthook, ok := interface{}(m).(MembershipWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *MembershipORM) ToPB(ctx context.Context) (Membership, error) {
+	to := Membership{}
+	var err error
+	if prehook, ok := interface{}(m).(MembershipWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.TeamId = m.TeamId
+	to.PersonId = m.PersonId
+	to.Role = m.Role
+	if m.JoinedAt != nil {
+		to.JoinedAt = timestamppb.New(*m.JoinedAt)
+	}
+	if posthook, ok := interface{}(m).(MembershipWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type Membership the arg will be the target, the caller the one being converted from
+
+// MembershipBeforeToORM called before default ToORM code
+type MembershipWithBeforeToORM interface {
+	BeforeToORM(context.Context, *MembershipORM) error
+}
+
+// MembershipAfterToORM called after default ToORM code
+type MembershipWithAfterToORM interface {
+	AfterToORM(context.Context, *MembershipORM) error
+}
+
+// MembershipBeforeToPB called before default ToPB code
+type MembershipWithBeforeToPB interface {
+	BeforeToPB(context.Context, *Membership) error
+}
+
+// MembershipAfterToPB called after default ToPB code
+type MembershipWithAfterToPB interface {
+	AfterToPB(context.Context, *Membership) error
+}
+
+type DocumentORM struct {
+	Comments  []*CommentORM   `gorm:"polymorphic:Owner;polymorphicValue:documents"`
+	DeletedAt *gorm.DeletedAt `gorm:"type:deleted_at"`
+	Id        uint64
+	Title     string
+}
+
+// TableName overrides the default tablename generated by GORM
+func (DocumentORM) TableName() string {
+	return "documents"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *Document) ToORM(ctx context.Context) (DocumentORM, error) {
+	to := DocumentORM{}
+	var err error
+	if prehook, ok := interface{}(m).(DocumentWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Title = m.Title
+	if m.DeletedAt != nil {
+		to.DeletedAt = &gorm.DeletedAt{
+			Time:  m.DeletedAt.AsTime(),
+			Valid: true,
+		}
+	} else {
+		to.DeletedAt = &gorm.DeletedAt{
+			Valid: false,
+		}
+	}
+	for _, v := range m.Comments {
+		if v != nil {
+			if tempComments, cErr := v.ToORM(ctx); cErr == nil {
+				to.Comments = append(to.Comments, &tempComments)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.Comments = append(to.Comments, nil)
+		}
+	}
+	if posthook, ok := interface{}(m).(DocumentWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *DocumentORM) ToPB(ctx context.Context) (Document, error) {
+	to := Document{}
+	var err error
+	if prehook, ok := interface{}(m).(DocumentWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Title = m.Title
+	if m.DeletedAt != nil && m.DeletedAt.Valid {
+		to.DeletedAt = timestamppb.New(m.DeletedAt.Time)
+	}
+	for _, v := range m.Comments {
+		if v != nil {
+			if tempComments, cErr := v.ToPB(ctx); cErr == nil {
+				to.Comments = append(to.Comments, &tempComments)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.Comments = append(to.Comments, nil)
+		}
+	}
+	if posthook, ok := interface{}(m).(DocumentWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type Document the arg will be the target, the caller the one being converted from
+
+// DocumentBeforeToORM called before default ToORM code
+type DocumentWithBeforeToORM interface {
+	BeforeToORM(context.Context, *DocumentORM) error
+}
+
+// DocumentAfterToORM called after default ToORM code
+type DocumentWithAfterToORM interface {
+	AfterToORM(context.Context, *DocumentORM) error
+}
+
+// DocumentBeforeToPB called before default ToPB code
+type DocumentWithBeforeToPB interface {
+	BeforeToPB(context.Context, *Document) error
+}
+
+// DocumentAfterToPB called after default ToPB code
+type DocumentWithAfterToPB interface {
+	AfterToPB(context.Context, *Document) error
+}
+
+type CommentORM struct {
+	Id        uint64
+	OwnerID   uint64
+	OwnerType string
+	Text      string
+}
+
+// TableName overrides the default tablename generated by GORM
+func (CommentORM) TableName() string {
+	return "comments"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *Comment) ToORM(ctx context.Context) (CommentORM, error) {
+	to := CommentORM{}
+	var err error
+	if prehook, ok := interface{}(m).(CommentWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Text = m.Text
+	if posthook, ok := interface{}(m).(CommentWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *CommentORM) ToPB(ctx context.Context) (Comment, error) {
+	to := Comment{}
+	var err error
+	if prehook, ok := interface{}(m).(CommentWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Text = m.Text
+	if posthook, ok := interface{}(m).(CommentWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type Comment the arg will be the target, the caller the one being converted from
+
+// CommentBeforeToORM called before default ToORM code
+type CommentWithBeforeToORM interface {
+	BeforeToORM(context.Context, *CommentORM) error
+}
+
+// CommentAfterToORM called after default ToORM code
+type CommentWithAfterToORM interface {
+	AfterToORM(context.Context, *CommentORM) error
+}
+
+// CommentBeforeToPB called before default ToPB code
+type CommentWithBeforeToPB interface {
+	BeforeToPB(context.Context, *Comment) error
+}
+
+// CommentAfterToPB called after default ToPB code
+type CommentWithAfterToPB interface {
+	AfterToPB(context.Context, *Comment) error
+}
+
+type TicketORM struct {
+	Comments []*CommentORM `gorm:"polymorphic:Owner;polymorphicValue:tickets"`
+	Id       uint64
+	Title    string
+	Version  int64 `gorm:"default:1"`
+}
+
+// TableName overrides the default tablename generated by GORM
+func (TicketORM) TableName() string {
+	return "tickets"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *Ticket) ToORM(ctx context.Context) (TicketORM, error) {
+	to := TicketORM{}
+	var err error
+	if prehook, ok := interface{}(m).(TicketWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Title = m.Title
+	to.Version = m.Version
+	for _, v := range m.Comments {
+		if v != nil {
+			if tempComments, cErr := v.ToORM(ctx); cErr == nil {
+				to.Comments = append(to.Comments, &tempComments)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.Comments = append(to.Comments, nil)
+		}
+	}
+	if posthook, ok := interface{}(m).(TicketWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *TicketORM) ToPB(ctx context.Context) (Ticket, error) {
+	to := Ticket{}
+	var err error
+	if prehook, ok := interface{}(m).(TicketWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Title = m.Title
+	to.Version = m.Version
+	for _, v := range m.Comments {
+		if v != nil {
+			if tempComments, cErr := v.ToPB(ctx); cErr == nil {
+				to.Comments = append(to.Comments, &tempComments)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.Comments = append(to.Comments, nil)
+		}
+	}
+	if posthook, ok := interface{}(m).(TicketWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type Ticket the arg will be the target, the caller the one being converted from
+
+// TicketBeforeToORM called before default ToORM code
+type TicketWithBeforeToORM interface {
+	BeforeToORM(context.Context, *TicketORM) error
+}
+
+// TicketAfterToORM called after default ToORM code
+type TicketWithAfterToORM interface {
+	AfterToORM(context.Context, *TicketORM) error
+}
+
+// TicketBeforeToPB called before default ToPB code
+type TicketWithBeforeToPB interface {
+	BeforeToPB(context.Context, *Ticket) error
+}
+
+// TicketAfterToPB called after default ToPB code
+type TicketWithAfterToPB interface {
+	AfterToPB(context.Context, *Ticket) error
+}
+
+type FolderORM struct {
+	Children []*FolderORM `gorm:"foreignKey:ParentId;references:Id"`
+	Id       uint64
+	Name     string
+	Parent   *FolderORM `gorm:"foreignKey:ParentId;references:Id"`
+	ParentId *uint64
+}
+
+// TableName overrides the default tablename generated by GORM
+func (FolderORM) TableName() string {
+	return "folders"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *Folder) ToORM(ctx context.Context) (FolderORM, error) {
+	to := FolderORM{}
+	var err error
+	if prehook, ok := interface{}(m).(FolderWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Name = m.Name
+	if m.ParentId != nil {
+		v := m.ParentId.Value
+		to.ParentId = &v
+	}
+	if m.Parent != nil {
+		tempParent, err := m.Parent.ToORM(ctx)
+		if err != nil {
+			return to, err
+		}
+		to.Parent = &tempParent
+	}
+	for _, v := range m.Children {
+		if v != nil {
+			if tempChildren, cErr := v.ToORM(ctx); cErr == nil {
+				to.Children = append(to.Children, &tempChildren)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.Children = append(to.Children, nil)
+		}
+	}
+	if posthook, ok := interface{}(m).(FolderWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *FolderORM) ToPB(ctx context.Context) (Folder, error) {
+	to := Folder{}
+	var err error
+	if prehook, ok := interface{}(m).(FolderWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Name = m.Name
+	if m.ParentId != nil {
+		to.ParentId = &wrapperspb.UInt64Value{Value: *m.ParentId}
+	}
+	if m.Parent != nil {
+		tempParent, err := m.Parent.ToPB(ctx)
+		if err != nil {
+			return to, err
+		}
+		to.Parent = &tempParent
+	}
+	for _, v := range m.Children {
+		if v != nil {
+			if tempChildren, cErr := v.ToPB(ctx); cErr == nil {
+				to.Children = append(to.Children, &tempChildren)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.Children = append(to.Children, nil)
+		}
+	}
+	if posthook, ok := interface{}(m).(FolderWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type Folder the arg will be the target, the caller the one being converted from
+
+// FolderBeforeToORM called before default ToORM code
+type FolderWithBeforeToORM interface {
+	BeforeToORM(context.Context, *FolderORM) error
+}
+
+// FolderAfterToORM called after default ToORM code
+type FolderWithAfterToORM interface {
+	AfterToORM(context.Context, *FolderORM) error
+}
+
+// FolderBeforeToPB called before default ToPB code
+type FolderWithBeforeToPB interface {
+	BeforeToPB(context.Context, *Folder) error
+}
+
+// FolderAfterToPB called after default ToPB code
+type FolderWithAfterToPB interface {
+	AfterToPB(context.Context, *Folder) error
+}
+
+// DefaultCreateIntPoint executes a basic gorm create call
+func DefaultCreateIntPoint(ctx context.Context, in *IntPoint, db *gorm.DB) (*IntPoint, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(IntPointORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(IntPointORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type IntPointORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type IntPointORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultCreateIntPointSet executes gorm create calls in batches of 100 objects
+// in a transaction, either all of the objects are created or none of them. The BeforeCreate_ hook
+// of each object runs before the insert of its batch, which uses the handle the hooks of the
+// batch return
+func DefaultCreateIntPointSet(ctx context.Context, in []*IntPoint, db *gorm.DB) ([]*IntPoint, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObjs := make([]IntPointORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, ormObj)
+	}
+	batchSize := 100
+	err := db.Transaction(func(db *gorm.DB) error {
+		var err error
+		for start := 0; start < len(ormObjs); start += batchSize {
+			end := start + batchSize
+			if end > len(ormObjs) {
+				end = len(ormObjs)
+			}
+			batch := ormObjs[start:end]
+			// the scopes the hooks add only apply to the insert of their own batch
+			batchDB := db
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(IntPointORMWithBeforeCreate_); ok {
+					if batchDB, err = hook.BeforeCreate_(ctx, batchDB); err != nil {
+						return err
+					}
+				}
+			}
+			if err = batchDB.Omit().CreateInBatches(&batch, batchSize).Error; err != nil {
+				return err
+			}
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(IntPointORMWithAfterCreate_); ok {
+					if err = hook.AfterCreate_(ctx, db); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*IntPoint, 0, len(ormObjs))
+	for i := range ormObjs {
+		pbResponse, err := ormObjs[i].ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, &pbResponse)
+	}
+	return results, nil
+}
+
+func DefaultReadIntPoint(ctx context.Context, in *IntPoint, db *gorm.DB, fs *query.FieldSelection) (*IntPoint, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(IntPointORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db, fs); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, fs, &IntPointORM{}); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(IntPointORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db, fs); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := IntPointORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(IntPointORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db, fs); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type IntPointORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB, *query.FieldSelection) (*gorm.DB, error)
+}
+type IntPointORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB, *query.FieldSelection) (*gorm.DB, error)
+}
+type IntPointORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB, *query.FieldSelection) error
+}
+
+func DefaultDeleteIntPoint(ctx context.Context, in *IntPoint, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(IntPointORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&IntPointORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(IntPointORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type IntPointORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type IntPointORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteIntPointSet(ctx context.Context, in []*IntPoint, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []uint32{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&IntPointORM{})).(IntPointORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&IntPointORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&IntPointORM{})).(IntPointORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type IntPointORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*IntPoint, *gorm.DB) (*gorm.DB, error)
+}
+type IntPointORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*IntPoint, *gorm.DB) error
+}
+
+// DefaultStrictUpdateIntPoint clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateIntPoint(ctx context.Context, in *IntPoint, db *gorm.DB) (*IntPoint, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateIntPoint")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var count int64
+	lockedRow := &IntPointORM{}
+	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
+	if hook, ok := interface{}(&ormObj).(IntPointORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(IntPointORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(IntPointORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		err = gateway.SetCreated(ctx, "")
+	}
+	return &pbResponse, err
+}
+
+type IntPointORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type IntPointORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type IntPointORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultUpsertIntPoint executes a gorm create call resolving conflicts on conflictTarget,
+// the name of a unique index or the primary key when empty, by updating the columns
+// in updateMask, all of them when updateMask is empty, and returns the stored object
+func DefaultUpsertIntPoint(ctx context.Context, in *IntPoint, conflictTarget string, updateMask *field_mask.FieldMask, db *gorm.DB) (*IntPoint, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conflict := clause.OnConflict{}
+	var lookup func() map[string]interface{}
+	switch conflictTarget {
+	case "":
+		conflict.Columns = []clause.Column{{Name: "id"}}
+		lookup = func() map[string]interface{} { return map[string]interface{}{"id": ormObj.Id} }
+	default:
+		return nil, fmt.Errorf("unknown conflict target %q of IntPoint", conflictTarget)
+	}
+	if len(updateMask.GetPaths()) == 0 {
+		conflict.UpdateAll = true
+	} else {
+		var columns []string
+		for _, path := range updateMask.GetPaths() {
+			var pathColumns []string
+			switch path {
+			case "X":
+				pathColumns = []string{"x"}
+			case "Y":
+				pathColumns = []string{"y"}
+			default:
+				return nil, &errors.InvalidFieldMaskError{Path: path}
+			}
+			for _, column := range pathColumns {
+				for _, target := range conflict.Columns {
+					if column == target.Name {
+						return nil, &errors.InvalidFieldMaskError{Path: path}
+					}
+				}
+			}
+			columns = append(columns, pathColumns...)
+		}
+		conflict.DoUpdates = clause.AssignmentColumns(columns)
+	}
+	if hook, ok := interface{}(&ormObj).(IntPointORMWithBeforeUpsert); ok {
+		if db, err = hook.BeforeUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Clauses(conflict).Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if len(updateMask.GetPaths()) > 0 {
+		stored := IntPointORM{}
+		if err = db.Where(lookup()).Take(&stored).Error; err != nil {
+			return nil, err
+		}
+		ormObj = stored
+	}
+	if hook, ok := interface{}(&ormObj).(IntPointORMWithAfterUpsert); ok {
+		if err = hook.AfterUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type IntPointORMWithBeforeUpsert interface {
+	BeforeUpsert(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type IntPointORMWithAfterUpsert interface {
+	AfterUpsert(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchIntPoint executes a basic gorm update call with patch behavior
+func DefaultPatchIntPoint(ctx context.Context, in *IntPoint, updateMask *field_mask.FieldMask, db *gorm.DB) (*IntPoint, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	pbObj := &IntPoint{}
+	var err error
+	if hook, ok := interface{}(pbObj).(IntPointWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if pbObj, err = DefaultReadIntPoint(ctx, &IntPoint{Id: in.GetId()}, db, nil); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbObj).(IntPointWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskIntPoint(ctx, pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbObj).(IntPointWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateIntPoint(ctx, pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(IntPointWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type IntPointWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *IntPoint, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type IntPointWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *IntPoint, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type IntPointWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *IntPoint, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type IntPointWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *IntPoint, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetIntPoint patches the objects in a transaction: the masks are applied in memory to
+// the stored objects, read and locked with a single query, and the objects are written with an update
+// per batch of 100 objects. The objects are read and written with the handles their hooks
+// return, the objects of different handles don't share the queries. Only the associations in the
+// masks are updated, and objects sharing a primary key are rejected
+func DefaultPatchSetIntPoint(ctx context.Context, objects []*IntPoint, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*IntPoint, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+	if len(objects) == 0 {
+		return []*IntPoint{}, nil
+	}
+	var err error
+	objectKeys := make([]uint32, 0, len(objects))
+	seen := make(map[uint32]struct{}, len(objects))
+	for _, in := range objects {
+		if in == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := in.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if ormObj.Id == 0 {
+			return nil, errors.EmptyIdError
+		}
+		key := ormObj.Id
+		if _, ok := seen[key]; ok {
+			return nil, errors.DuplicateKeyError
+		}
+		seen[key] = struct{}{}
+		objectKeys = append(objectKeys, key)
+	}
+	batchSize := 100
+	results := make([]*IntPoint, len(objects))
+	err = db.Transaction(func(db *gorm.DB) error {
+		var err error
+		// the objects sharing the handle their hooks return share the queries
+		dbs := make([]*gorm.DB, len(objects))
+		group := func() ([]*gorm.DB, map[*gorm.DB][]int) {
+			var handles []*gorm.DB
+			groups := make(map[*gorm.DB][]int)
+			for i, handle := range dbs {
+				if _, ok := groups[handle]; !ok {
+					handles = append(handles, handle)
+				}
+				groups[handle] = append(groups[handle], i)
+			}
+			return handles, groups
+		}
+		for i, in := range objects {
+			dbs[i] = db
+			if hook, ok := interface{}(&IntPoint{}).(IntPointWithBeforePatchRead); ok {
+				if dbs[i], err = hook.BeforePatchRead(ctx, in, updateMasks[i], db); err != nil {
+					return err
+				}
+			}
+		}
+		targets := make(map[uint32]IntPointORM, len(objects))
+		handles, groups := group()
+		for _, handle := range handles {
+			keys := make([]uint32, 0, len(groups[handle]))
+			for _, i := range groups[handle] {
+				keys = append(keys, objectKeys[i])
+			}
+			query := handle.Session(&gorm.Session{}).Clauses(clause.Locking{Strength: "UPDATE"})
+			query = query.Where("id in (?)", keys)
+			rows := []IntPointORM{}
+			if err = query.Find(&rows).Error; err != nil {
+				return err
+			}
+			for _, row := range rows {
+				targets[row.Id] = row
+			}
+		}
+		ormObjs := make([]IntPointORM, len(objects))
+		prepare := func(i int) (*gorm.DB, error) {
+			in, key, updateMask, db := objects[i], objectKeys[i], updateMasks[i], dbs[i]
+			target, ok := targets[key]
+			if !ok {
+				return nil, gorm.ErrRecordNotFound
+			}
+			stored, err := target.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbObj := &stored
+			if hook, ok := interface{}(pbObj).(IntPointWithBeforePatchApplyFieldMask); ok {
+				if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			if _, err := DefaultApplyFieldMaskIntPoint(ctx, pbObj, in, updateMask, "", db); err != nil {
+				return nil, err
+			}
+			if hook, ok := interface{}(pbObj).(IntPointWithBeforePatchSave); ok {
+				if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObj, err := pbObj.ToORM(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if hook, ok := interface{}(&ormObj).(IntPointORMWithBeforeStrictUpdateCleanup); ok {
+				if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			if hook, ok := interface{}(&ormObj).(IntPointORMWithBeforeStrictUpdateSave); ok {
+				if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObjs[i] = ormObj
+			return db, nil
+		}
+		for i := range objects {
+			if dbs[i], err = prepare(i); err != nil {
+				return err
+			}
+		}
+		write := func(db *gorm.DB, batch []int) error {
+			conds := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*2)
+			for _, i := range batch {
+				conds = append(conds, "(id = ?)")
+				args = append(args, objectKeys[i])
+			}
+			column := func(name string, value func(ormObj *IntPointORM) interface{}) clause.Expr {
+				whens := make([]interface{}, 0, len(batch)*2)
+				for _, i := range batch {
+					whens = append(whens, objectKeys[i], value(&ormObjs[i]))
+				}
+				return gorm.Expr("CASE "+strings.Repeat("WHEN id = ? THEN ? ", len(batch))+"ELSE "+name+" END", whens...)
+			}
+			res := db.Session(&gorm.Session{}).Model(&IntPointORM{}).Where(strings.Join(conds, " OR "), args...).Updates(map[string]interface{}{
+				"x": column("x", func(ormObj *IntPointORM) interface{} { return ormObj.X }),
+				"y": column("y", func(ormObj *IntPointORM) interface{} { return ormObj.Y }),
+			})
+			return res.Error
+		}
+		handles, groups = group()
+		for _, handle := range handles {
+			indexes := groups[handle]
+			for start := 0; start < len(indexes); start += batchSize {
+				end := start + batchSize
+				if end > len(indexes) {
+					end = len(indexes)
+				}
+				batch := indexes[start:end]
+				if err = write(handle, batch); err != nil {
+					return err
+				}
+			}
+		}
+		finish := func(i int) (*IntPoint, error) {
+			in, updateMask, db, ormObj := objects[i], updateMasks[i], dbs[i], ormObjs[i]
+			var err error
+			if hook, ok := interface{}(&ormObj).(IntPointORMWithAfterStrictUpdateSave); ok {
+				if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			saved, err := ormObj.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbResponse := &saved
+			if hook, ok := interface{}(pbResponse).(IntPointWithAfterPatchSave); ok {
+				if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			return pbResponse, nil
+		}
+		for i := range objects {
+			if results[i], err = finish(i); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// DefaultApplyFieldMaskIntPoint patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskIntPoint(ctx context.Context, patchee *IntPoint, patcher *IntPoint, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*IntPoint, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	if len(updateMask.GetPaths()) == 1 && updateMask.Paths[0] == prefix+"*" {
+		updateMask = &field_mask.FieldMask{Paths: []string{prefix + "Id", prefix + "X", prefix + "Y"}}
+	}
+	for _, f := range updateMask.GetPaths() {
+		if strings.HasPrefix(f, prefix) && !DefaultHasFieldMaskPathIntPoint(strings.TrimPrefix(f, prefix)) {
+			return nil, &errors.InvalidFieldMaskError{Path: f}
+		}
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"X" {
+			patchee.X = patcher.X
+			continue
+		}
+		if f == prefix+"Y" {
+			patchee.Y = patcher.Y
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultHasFieldMaskPathIntPoint reports whether IntPoint has the field of the field mask path
+func DefaultHasFieldMaskPathIntPoint(path string) bool {
+	parts := strings.SplitN(path, ".", 2)
+	switch parts[0] {
+	case "Id", "X", "Y":
+		return len(parts) == 1
+	}
+	return false
+}
+
+// DefaultListIntPoint executes a gorm list call
+func DefaultListIntPoint(ctx context.Context, db *gorm.DB, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*IntPoint, error) {
+	in := IntPoint{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(IntPointORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db, f, s, p, fs); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &IntPointORM{}, &IntPoint{}, f, s, p, fs)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(IntPointORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db, f, s, p, fs); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []IntPointORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(IntPointORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse, f, s, p, fs); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*IntPoint{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type IntPointORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB, *query.Filtering, *query.Sorting, *query.Pagination, *query.FieldSelection) (*gorm.DB, error)
+}
+type IntPointORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB, *query.Filtering, *query.Sorting, *query.Pagination, *query.FieldSelection) (*gorm.DB, error)
+}
+type IntPointORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]IntPointORM, *query.Filtering, *query.Sorting, *query.Pagination, *query.FieldSelection) error
+}
+
+// DefaultCreateSomething executes a basic gorm create call
+func DefaultCreateSomething(ctx context.Context, in *Something, db *gorm.DB) (*Something, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(SomethingORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(SomethingORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type SomethingORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type SomethingORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultCreateSomethingSet executes gorm create calls in batches of 100 objects
+// in a transaction, either all of the objects are created or none of them. The BeforeCreate_ hook
+// of each object runs before the insert of its batch, which uses the handle the hooks of the
+// batch return
+func DefaultCreateSomethingSet(ctx context.Context, in []*Something, db *gorm.DB) ([]*Something, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObjs := make([]SomethingORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, ormObj)
+	}
+	batchSize := 100
+	err := db.Transaction(func(db *gorm.DB) error {
+		var err error
+		for start := 0; start < len(ormObjs); start += batchSize {
+			end := start + batchSize
+			if end > len(ormObjs) {
+				end = len(ormObjs)
+			}
+			batch := ormObjs[start:end]
+			// the scopes the hooks add only apply to the insert of their own batch
+			batchDB := db
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(SomethingORMWithBeforeCreate_); ok {
+					if batchDB, err = hook.BeforeCreate_(ctx, batchDB); err != nil {
+						return err
+					}
+				}
+			}
+			if err = batchDB.Omit().CreateInBatches(&batch, batchSize).Error; err != nil {
+				return err
+			}
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(SomethingORMWithAfterCreate_); ok {
+					if err = hook.AfterCreate_(ctx, db); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*Something, 0, len(ormObjs))
+	for i := range ormObjs {
+		pbResponse, err := ormObjs[i].ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, &pbResponse)
+	}
+	return results, nil
+}
+
+// DefaultApplyFieldMaskSomething patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskSomething(ctx context.Context, patchee *Something, patcher *Something, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Something, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	if len(updateMask.GetPaths()) == 1 && updateMask.Paths[0] == prefix+"*" {
+		updateMask = &field_mask.FieldMask{Paths: []string{prefix + "Field"}}
+	}
+	for _, f := range updateMask.GetPaths() {
+		if strings.HasPrefix(f, prefix) && !DefaultHasFieldMaskPathSomething(strings.TrimPrefix(f, prefix)) {
+			return nil, &errors.InvalidFieldMaskError{Path: f}
+		}
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Field" {
+			patchee.Field = patcher.Field
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultHasFieldMaskPathSomething reports whether Something has the field of the field mask path
+func DefaultHasFieldMaskPathSomething(path string) bool {
+	parts := strings.SplitN(path, ".", 2)
+	switch parts[0] {
+	case "Field":
+		return len(parts) == 1
+	}
+	return false
+}
+
+// DefaultListSomething executes a gorm list call
+func DefaultListSomething(ctx context.Context, db *gorm.DB) ([]*Something, error) {
+	in := Something{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(SomethingORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(SomethingORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	ormResponse := []SomethingORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(SomethingORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Something{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type SomethingORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type SomethingORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type SomethingORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]SomethingORM) error
+}
+
+// DefaultCreateCircle executes a basic gorm create call
+func DefaultCreateCircle(ctx context.Context, in *Circle, db *gorm.DB) (*Circle, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(CircleORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(CircleORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type CircleORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type CircleORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultCreateCircleSet executes gorm create calls in batches of 100 objects
+// in a transaction, either all of the objects are created or none of them. The BeforeCreate_ hook
+// of each object runs before the insert of its batch, which uses the handle the hooks of the
+// batch return
+func DefaultCreateCircleSet(ctx context.Context, in []*Circle, db *gorm.DB) ([]*Circle, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObjs := make([]CircleORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, ormObj)
+	}
+	batchSize := 100
+	err := db.Transaction(func(db *gorm.DB) error {
+		var err error
+		for start := 0; start < len(ormObjs); start += batchSize {
+			end := start + batchSize
+			if end > len(ormObjs) {
+				end = len(ormObjs)
+			}
+			batch := ormObjs[start:end]
+			// the scopes the hooks add only apply to the insert of their own batch
+			batchDB := db
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(CircleORMWithBeforeCreate_); ok {
+					if batchDB, err = hook.BeforeCreate_(ctx, batchDB); err != nil {
+						return err
+					}
+				}
+			}
+			if err = batchDB.Omit().CreateInBatches(&batch, batchSize).Error; err != nil {
+				return err
+			}
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(CircleORMWithAfterCreate_); ok {
+					if err = hook.AfterCreate_(ctx, db); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*Circle, 0, len(ormObjs))
+	for i := range ormObjs {
+		pbResponse, err := ormObjs[i].ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, &pbResponse)
+	}
+	return results, nil
+}
+
+// DefaultApplyFieldMaskCircle patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskCircle(ctx context.Context, patchee *Circle, patcher *Circle, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Circle, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	if len(updateMask.GetPaths()) == 1 && updateMask.Paths[0] == prefix+"*" {
+		updateMask = &field_mask.FieldMask{Paths: []string{prefix + "R"}}
+	}
+	for _, f := range updateMask.GetPaths() {
+		if strings.HasPrefix(f, prefix) && !DefaultHasFieldMaskPathCircle(strings.TrimPrefix(f, prefix)) {
+			return nil, &errors.InvalidFieldMaskError{Path: f}
+		}
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"R" {
+			patchee.R = patcher.R
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultHasFieldMaskPathCircle reports whether Circle has the field of the field mask path
+func DefaultHasFieldMaskPathCircle(path string) bool {
+	parts := strings.SplitN(path, ".", 2)
+	switch parts[0] {
+	case "R":
+		return len(parts) == 1
+	}
+	return false
+}
+
+// DefaultListCircle executes a gorm list call
+func DefaultListCircle(ctx context.Context, db *gorm.DB) ([]*Circle, error) {
+	in := Circle{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(CircleORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(CircleORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	ormResponse := []CircleORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(CircleORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Circle{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type CircleORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type CircleORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type CircleORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]CircleORM) error
+}
+
+// DefaultCreateTenantEvent executes a basic gorm create call
+func DefaultCreateTenantEvent(ctx context.Context, in *TenantEvent, db *gorm.DB) (*TenantEvent, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TenantEventORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TenantEventORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type TenantEventORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TenantEventORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultCreateTenantEventSet executes gorm create calls in batches of 100 objects
+// in a transaction, either all of the objects are created or none of them. The BeforeCreate_ hook
+// of each object runs before the insert of its batch, which uses the handle the hooks of the
+// batch return
+func DefaultCreateTenantEventSet(ctx context.Context, in []*TenantEvent, db *gorm.DB) ([]*TenantEvent, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObjs := make([]TenantEventORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, ormObj)
+	}
+	batchSize := 100
+	err := db.Transaction(func(db *gorm.DB) error {
+		var err error
+		for start := 0; start < len(ormObjs); start += batchSize {
+			end := start + batchSize
+			if end > len(ormObjs) {
+				end = len(ormObjs)
+			}
+			batch := ormObjs[start:end]
+			// the scopes the hooks add only apply to the insert of their own batch
+			batchDB := db
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(TenantEventORMWithBeforeCreate_); ok {
+					if batchDB, err = hook.BeforeCreate_(ctx, batchDB); err != nil {
+						return err
+					}
+				}
+			}
+			if err = batchDB.Omit().CreateInBatches(&batch, batchSize).Error; err != nil {
+				return err
+			}
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(TenantEventORMWithAfterCreate_); ok {
+					if err = hook.AfterCreate_(ctx, db); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*TenantEvent, 0, len(ormObjs))
+	for i := range ormObjs {
+		pbResponse, err := ormObjs[i].ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, &pbResponse)
+	}
+	return results, nil
+}
+
+func DefaultReadTenantEvent(ctx context.Context, in *TenantEvent, db *gorm.DB) (*TenantEvent, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.AccountId == "" {
+		return nil, errors.EmptyIdError
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(TenantEventORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(TenantEventORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := TenantEventORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(TenantEventORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type TenantEventORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TenantEventORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TenantEventORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteTenantEvent(ctx context.Context, in *TenantEvent, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.AccountId == "" {
+		return errors.EmptyIdError
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(TenantEventORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&TenantEventORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(TenantEventORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type TenantEventORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TenantEventORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteTenantEventSet(ctx context.Context, in []*TenantEvent, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := [][]interface{}{}
+	partitionAccountId := []string{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.AccountId == "" {
+			return errors.EmptyIdError
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, []interface{}{ormObj.AccountId, ormObj.Id})
+		if ormObj.AccountId != "" {
+			partitionAccountId = append(partitionAccountId, ormObj.AccountId)
+		}
+	}
+	if len(partitionAccountId) == len(keys) {
+		db = db.Where("account_id in (?)", partitionAccountId)
+	}
+	if hook, ok := (interface{}(&TenantEventORM{})).(TenantEventORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	db = db.Where("(account_id, id) in ?", keys)
+	err = db.Delete(&TenantEventORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&TenantEventORM{})).(TenantEventORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type TenantEventORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*TenantEvent, *gorm.DB) (*gorm.DB, error)
+}
+type TenantEventORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*TenantEvent, *gorm.DB) error
+}
+
+// DefaultStrictUpdateTenantEvent clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateTenantEvent(ctx context.Context, in *TenantEvent, db *gorm.DB) (*TenantEvent, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateTenantEvent")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.AccountId != "" {
+		db = db.Where("account_id = ?", ormObj.AccountId)
+	}
+	var count int64
+	lockedRow := &TenantEventORM{}
+	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("account_id=? AND id=?", ormObj.AccountId, ormObj.Id).First(lockedRow).RowsAffected
+	if hook, ok := interface{}(&ormObj).(TenantEventORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(TenantEventORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TenantEventORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		err = gateway.SetCreated(ctx, "")
+	}
+	return &pbResponse, err
+}
+
+type TenantEventORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TenantEventORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TenantEventORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultUpsertTenantEvent executes a gorm create call resolving conflicts on conflictTarget,
+// the name of a unique index or the primary key when empty, by updating the columns
+// in updateMask, all of them when updateMask is empty, and returns the stored object
+func DefaultUpsertTenantEvent(ctx context.Context, in *TenantEvent, conflictTarget string, updateMask *field_mask.FieldMask, db *gorm.DB) (*TenantEvent, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conflict := clause.OnConflict{}
+	var lookup func() map[string]interface{}
+	switch conflictTarget {
+	case "":
+		conflict.Columns = []clause.Column{{Name: "account_id"}, {Name: "id"}}
+		lookup = func() map[string]interface{} {
+			return map[string]interface{}{"account_id": ormObj.AccountId, "id": ormObj.Id}
+		}
+	default:
+		return nil, fmt.Errorf("unknown conflict target %q of TenantEvent", conflictTarget)
+	}
+	if len(updateMask.GetPaths()) == 0 {
+		conflict.UpdateAll = true
+	} else {
+		var columns []string
+		for _, path := range updateMask.GetPaths() {
+			var pathColumns []string
+			switch path {
+			case "CreatedAt":
+				pathColumns = []string{"created_at"}
+			case "Payload":
+				pathColumns = []string{"payload"}
+			default:
+				return nil, &errors.InvalidFieldMaskError{Path: path}
+			}
+			for _, column := range pathColumns {
+				for _, target := range conflict.Columns {
+					if column == target.Name {
+						return nil, &errors.InvalidFieldMaskError{Path: path}
+					}
+				}
+			}
+			columns = append(columns, pathColumns...)
+		}
+		conflict.DoUpdates = clause.AssignmentColumns(columns)
+	}
+	if hook, ok := interface{}(&ormObj).(TenantEventORMWithBeforeUpsert); ok {
+		if db, err = hook.BeforeUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Clauses(conflict).Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if len(updateMask.GetPaths()) > 0 {
+		stored := TenantEventORM{}
+		if err = db.Where(lookup()).Take(&stored).Error; err != nil {
+			return nil, err
+		}
+		ormObj = stored
+	}
+	if hook, ok := interface{}(&ormObj).(TenantEventORMWithAfterUpsert); ok {
+		if err = hook.AfterUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type TenantEventORMWithBeforeUpsert interface {
+	BeforeUpsert(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TenantEventORMWithAfterUpsert interface {
+	AfterUpsert(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchTenantEvent executes a basic gorm update call with patch behavior
+func DefaultPatchTenantEvent(ctx context.Context, in *TenantEvent, updateMask *field_mask.FieldMask, db *gorm.DB) (*TenantEvent, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	pbObj := &TenantEvent{}
+	var err error
+	if hook, ok := interface{}(pbObj).(TenantEventWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if pbObj, err = DefaultReadTenantEvent(ctx, &TenantEvent{AccountId: in.GetAccountId(), Id: in.GetId()}, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbObj).(TenantEventWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskTenantEvent(ctx, pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbObj).(TenantEventWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateTenantEvent(ctx, pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(TenantEventWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type TenantEventWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *TenantEvent, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type TenantEventWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *TenantEvent, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type TenantEventWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *TenantEvent, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type TenantEventWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *TenantEvent, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetTenantEvent patches the objects in a transaction: the masks are applied in memory to
+// the stored objects, read and locked with a single query, and the objects are written with an update
+// per batch of 100 objects. The objects are read and written with the handles their hooks
+// return, the objects of different handles don't share the queries. Only the associations in the
+// masks are updated, and objects sharing a primary key are rejected
+func DefaultPatchSetTenantEvent(ctx context.Context, objects []*TenantEvent, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*TenantEvent, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+	if len(objects) == 0 {
+		return []*TenantEvent{}, nil
+	}
+	var err error
+	type patchSetKey struct {
+		AccountId string
+		Id        uint64
+	}
+	objectKeys := make([]patchSetKey, 0, len(objects))
+	seen := make(map[patchSetKey]struct{}, len(objects))
+	for _, in := range objects {
+		if in == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := in.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if ormObj.AccountId == "" {
+			return nil, errors.EmptyIdError
+		}
+		if ormObj.Id == 0 {
+			return nil, errors.EmptyIdError
+		}
+		key := patchSetKey{AccountId: ormObj.AccountId, Id: ormObj.Id}
+		if _, ok := seen[key]; ok {
+			return nil, errors.DuplicateKeyError
+		}
+		seen[key] = struct{}{}
+		objectKeys = append(objectKeys, key)
+	}
+	batchSize := 100
+	results := make([]*TenantEvent, len(objects))
+	err = db.Transaction(func(db *gorm.DB) error {
+		var err error
+		// the objects sharing the handle their hooks return share the queries
+		dbs := make([]*gorm.DB, len(objects))
+		group := func() ([]*gorm.DB, map[*gorm.DB][]int) {
+			var handles []*gorm.DB
+			groups := make(map[*gorm.DB][]int)
+			for i, handle := range dbs {
+				if _, ok := groups[handle]; !ok {
+					handles = append(handles, handle)
+				}
+				groups[handle] = append(groups[handle], i)
+			}
+			return handles, groups
+		}
+		for i, in := range objects {
+			dbs[i] = db
+			if hook, ok := interface{}(&TenantEvent{}).(TenantEventWithBeforePatchRead); ok {
+				if dbs[i], err = hook.BeforePatchRead(ctx, in, updateMasks[i], db); err != nil {
+					return err
+				}
+			}
+		}
+		targets := make(map[patchSetKey]TenantEventORM, len(objects))
+		handles, groups := group()
+		for _, handle := range handles {
+			keys := make([][]interface{}, 0, len(groups[handle]))
+			for _, i := range groups[handle] {
+				key := objectKeys[i]
+				keys = append(keys, []interface{}{key.AccountId, key.Id})
+			}
+			query := handle.Session(&gorm.Session{}).Clauses(clause.Locking{Strength: "UPDATE"})
+			query = query.Where("(account_id, id) in ?", keys)
+			rows := []TenantEventORM{}
+			if err = query.Find(&rows).Error; err != nil {
+				return err
+			}
+			for _, row := range rows {
+				targets[patchSetKey{AccountId: row.AccountId, Id: row.Id}] = row
+			}
+		}
+		ormObjs := make([]TenantEventORM, len(objects))
+		prepare := func(i int) (*gorm.DB, error) {
+			in, key, updateMask, db := objects[i], objectKeys[i], updateMasks[i], dbs[i]
+			target, ok := targets[key]
+			if !ok {
+				return nil, gorm.ErrRecordNotFound
+			}
+			stored, err := target.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbObj := &stored
+			if hook, ok := interface{}(pbObj).(TenantEventWithBeforePatchApplyFieldMask); ok {
+				if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			if _, err := DefaultApplyFieldMaskTenantEvent(ctx, pbObj, in, updateMask, "", db); err != nil {
+				return nil, err
+			}
+			if hook, ok := interface{}(pbObj).(TenantEventWithBeforePatchSave); ok {
+				if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObj, err := pbObj.ToORM(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if hook, ok := interface{}(&ormObj).(TenantEventORMWithBeforeStrictUpdateCleanup); ok {
+				if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			if hook, ok := interface{}(&ormObj).(TenantEventORMWithBeforeStrictUpdateSave); ok {
+				if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObjs[i] = ormObj
+			return db, nil
+		}
+		for i := range objects {
+			if dbs[i], err = prepare(i); err != nil {
+				return err
+			}
+		}
+		write := func(db *gorm.DB, batch []int) error {
+			conds := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*3)
+			for _, i := range batch {
+				conds = append(conds, "(account_id = ? AND id = ?)")
+				args = append(args, objectKeys[i].AccountId, objectKeys[i].Id)
+			}
+			column := func(name string, value func(ormObj *TenantEventORM) interface{}) clause.Expr {
+				whens := make([]interface{}, 0, len(batch)*3)
+				for _, i := range batch {
+					whens = append(whens, objectKeys[i].AccountId, objectKeys[i].Id, value(&ormObjs[i]))
+				}
+				return gorm.Expr("CASE "+strings.Repeat("WHEN account_id = ? AND id = ? THEN ? ", len(batch))+"ELSE "+name+" END", whens...)
+			}
+			res := db.Session(&gorm.Session{}).Model(&TenantEventORM{}).Where(strings.Join(conds, " OR "), args...).Updates(map[string]interface{}{
+				"created_at": column("created_at", func(ormObj *TenantEventORM) interface{} { return ormObj.CreatedAt }),
+				"payload":    column("payload", func(ormObj *TenantEventORM) interface{} { return ormObj.Payload }),
+			})
+			return res.Error
+		}
+		handles, groups = group()
+		for _, handle := range handles {
+			indexes := groups[handle]
+			for start := 0; start < len(indexes); start += batchSize {
+				end := start + batchSize
+				if end > len(indexes) {
+					end = len(indexes)
+				}
+				batch := indexes[start:end]
+				if err = write(handle, batch); err != nil {
+					return err
+				}
+			}
+		}
+		finish := func(i int) (*TenantEvent, error) {
+			in, updateMask, db, ormObj := objects[i], updateMasks[i], dbs[i], ormObjs[i]
+			var err error
+			if hook, ok := interface{}(&ormObj).(TenantEventORMWithAfterStrictUpdateSave); ok {
+				if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			saved, err := ormObj.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbResponse := &saved
+			if hook, ok := interface{}(pbResponse).(TenantEventWithAfterPatchSave); ok {
+				if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			return pbResponse, nil
+		}
+		for i := range objects {
+			if results[i], err = finish(i); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// DefaultApplyFieldMaskTenantEvent patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskTenantEvent(ctx context.Context, patchee *TenantEvent, patcher *TenantEvent, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*TenantEvent, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	if len(updateMask.GetPaths()) == 1 && updateMask.Paths[0] == prefix+"*" {
+		updateMask = &field_mask.FieldMask{Paths: []string{prefix + "Id", prefix + "AccountId", prefix + "CreatedAt", prefix + "Payload"}}
+	}
+	for _, f := range updateMask.GetPaths() {
+		if strings.HasPrefix(f, prefix) && !DefaultHasFieldMaskPathTenantEvent(strings.TrimPrefix(f, prefix)) {
+			return nil, &errors.InvalidFieldMaskError{Path: f}
+		}
+	}
+	var err error
+	var updatedCreatedAt bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"AccountId" {
+			patchee.AccountId = patcher.AccountId
+			continue
+		}
+		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
+			if patcher.CreatedAt == nil {
+				patchee.CreatedAt = nil
+				continue
+			}
+			if patchee.CreatedAt == nil {
+				patchee.CreatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
+				return nil, &errors.InvalidFieldMaskError{Path: f}
+			}
+		}
+		if f == prefix+"CreatedAt" {
+			updatedCreatedAt = true
+			patchee.CreatedAt = patcher.CreatedAt
+			continue
+		}
+		if f == prefix+"Payload" {
+			patchee.Payload = patcher.Payload
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultHasFieldMaskPathTenantEvent reports whether TenantEvent has the field of the field mask path
+func DefaultHasFieldMaskPathTenantEvent(path string) bool {
+	parts := strings.SplitN(path, ".", 2)
+	switch parts[0] {
+	case "CreatedAt":
+		if len(parts) == 1 {
+			return true
+		}
+		parts = strings.SplitN(parts[1], ".", 2)
+		switch parts[0] {
+		case "Seconds", "Nanos":
+			return len(parts) == 1
+		}
+		return false
+	case "Id", "AccountId", "Payload":
+		return len(parts) == 1
+	}
+	return false
+}
+
+// DefaultListTenantEvent executes a gorm list call
+func DefaultListTenantEvent(ctx context.Context, db *gorm.DB) ([]*TenantEvent, error) {
+	in := TenantEvent{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TenantEventORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(TenantEventORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("account_id, id")
+	ormResponse := []TenantEventORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TenantEventORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*TenantEvent{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type TenantEventORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TenantEventORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TenantEventORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]TenantEventORM) error
+}
+
+// DefaultCreateSetting executes a basic gorm create call
+func DefaultCreateSetting(ctx context.Context, in *Setting, db *gorm.DB) (*Setting, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(SettingORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(SettingORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type SettingORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type SettingORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultCreateSettingSet executes gorm create calls in batches of 100 objects
+// in a transaction, either all of the objects are created or none of them. The BeforeCreate_ hook
+// of each object runs before the insert of its batch, which uses the handle the hooks of the
+// batch return
+func DefaultCreateSettingSet(ctx context.Context, in []*Setting, db *gorm.DB) ([]*Setting, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObjs := make([]SettingORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, ormObj)
+	}
+	batchSize := 100
+	err := db.Transaction(func(db *gorm.DB) error {
+		var err error
+		for start := 0; start < len(ormObjs); start += batchSize {
+			end := start + batchSize
+			if end > len(ormObjs) {
+				end = len(ormObjs)
+			}
+			batch := ormObjs[start:end]
+			// the scopes the hooks add only apply to the insert of their own batch
+			batchDB := db
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(SettingORMWithBeforeCreate_); ok {
+					if batchDB, err = hook.BeforeCreate_(ctx, batchDB); err != nil {
+						return err
+					}
+				}
+			}
+			if err = batchDB.Omit().CreateInBatches(&batch, batchSize).Error; err != nil {
+				return err
+			}
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(SettingORMWithAfterCreate_); ok {
+					if err = hook.AfterCreate_(ctx, db); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*Setting, 0, len(ormObjs))
+	for i := range ormObjs {
+		pbResponse, err := ormObjs[i].ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, &pbResponse)
+	}
+	return results, nil
+}
+
+func DefaultReadSetting(ctx context.Context, in *Setting, db *gorm.DB) (*Setting, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(SettingORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(SettingORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := SettingORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(SettingORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type SettingORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type SettingORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type SettingORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+// DefaultReadByNameSetting executes a basic gorm read call looking the object up by its idx_setting_name unique key
+func DefaultReadByNameSetting(ctx context.Context, in *Setting, db *gorm.DB) (*Setting, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Name == "" {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(SettingORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(SettingORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := SettingORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(SettingORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+func DefaultDeleteSetting(ctx context.Context, in *Setting, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(SettingORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&SettingORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(SettingORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type SettingORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type SettingORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteSettingSet(ctx context.Context, in []*Setting, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []uint64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&SettingORM{})).(SettingORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&SettingORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&SettingORM{})).(SettingORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type SettingORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Setting, *gorm.DB) (*gorm.DB, error)
+}
+type SettingORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Setting, *gorm.DB) error
+}
+
+// DefaultStrictUpdateSetting clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateSetting(ctx context.Context, in *Setting, db *gorm.DB) (*Setting, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateSetting")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var count int64
+	lockedRow := &SettingORM{}
+	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
+	if hook, ok := interface{}(&ormObj).(SettingORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(SettingORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(SettingORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		err = gateway.SetCreated(ctx, "")
+	}
+	return &pbResponse, err
+}
+
+type SettingORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type SettingORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type SettingORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultUpsertSetting executes a gorm create call resolving conflicts on conflictTarget,
+// the name of a unique index or the primary key when empty, by updating the columns
+// in updateMask, all of them when updateMask is empty, and returns the stored object
+func DefaultUpsertSetting(ctx context.Context, in *Setting, conflictTarget string, updateMask *field_mask.FieldMask, db *gorm.DB) (*Setting, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conflict := clause.OnConflict{}
+	var lookup func() map[string]interface{}
+	switch conflictTarget {
+	case "":
+		conflict.Columns = []clause.Column{{Name: "id"}}
+		lookup = func() map[string]interface{} { return map[string]interface{}{"id": ormObj.Id} }
+	case "idx_setting_name":
+		conflict.Columns = []clause.Column{{Name: "name"}}
+		lookup = func() map[string]interface{} { return map[string]interface{}{"name": ormObj.Name} }
+	default:
+		return nil, fmt.Errorf("unknown conflict target %q of Setting", conflictTarget)
+	}
+	if len(updateMask.GetPaths()) == 0 {
+		conflict.UpdateAll = true
+	} else {
+		var columns []string
+		for _, path := range updateMask.GetPaths() {
+			var pathColumns []string
+			switch path {
+			case "Name":
+				pathColumns = []string{"name"}
+			case "Value":
+				pathColumns = []string{"value"}
+			default:
+				return nil, &errors.InvalidFieldMaskError{Path: path}
+			}
+			for _, column := range pathColumns {
+				for _, target := range conflict.Columns {
+					if column == target.Name {
+						return nil, &errors.InvalidFieldMaskError{Path: path}
+					}
+				}
+			}
+			columns = append(columns, pathColumns...)
+		}
+		conflict.DoUpdates = clause.AssignmentColumns(columns)
+	}
+	if hook, ok := interface{}(&ormObj).(SettingORMWithBeforeUpsert); ok {
+		if db, err = hook.BeforeUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Clauses(conflict).Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if len(updateMask.GetPaths()) > 0 {
+		stored := SettingORM{}
+		if err = db.Where(lookup()).Take(&stored).Error; err != nil {
+			return nil, err
+		}
+		ormObj = stored
+	}
+	if hook, ok := interface{}(&ormObj).(SettingORMWithAfterUpsert); ok {
+		if err = hook.AfterUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type SettingORMWithBeforeUpsert interface {
+	BeforeUpsert(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type SettingORMWithAfterUpsert interface {
+	AfterUpsert(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchSetting executes a basic gorm update call with patch behavior
+func DefaultPatchSetting(ctx context.Context, in *Setting, updateMask *field_mask.FieldMask, db *gorm.DB) (*Setting, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	pbObj := &Setting{}
+	var err error
+	if hook, ok := interface{}(pbObj).(SettingWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if pbObj, err = DefaultReadSetting(ctx, &Setting{Id: in.GetId()}, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbObj).(SettingWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskSetting(ctx, pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbObj).(SettingWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateSetting(ctx, pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(SettingWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type SettingWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Setting, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type SettingWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Setting, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type SettingWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Setting, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type SettingWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Setting, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetSetting patches the objects in a transaction: the masks are applied in memory to
+// the stored objects, read and locked with a single query, and the objects are written with an update
+// per batch of 100 objects. The objects are read and written with the handles their hooks
+// return, the objects of different handles don't share the queries. Only the associations in the
+// masks are updated, and objects sharing a primary key are rejected
+func DefaultPatchSetSetting(ctx context.Context, objects []*Setting, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Setting, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+	if len(objects) == 0 {
+		return []*Setting{}, nil
+	}
+	var err error
+	objectKeys := make([]uint64, 0, len(objects))
+	seen := make(map[uint64]struct{}, len(objects))
+	for _, in := range objects {
+		if in == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := in.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if ormObj.Id == 0 {
+			return nil, errors.EmptyIdError
+		}
+		key := ormObj.Id
+		if _, ok := seen[key]; ok {
+			return nil, errors.DuplicateKeyError
+		}
+		seen[key] = struct{}{}
+		objectKeys = append(objectKeys, key)
+	}
+	batchSize := 100
+	results := make([]*Setting, len(objects))
+	err = db.Transaction(func(db *gorm.DB) error {
+		var err error
+		// the objects sharing the handle their hooks return share the queries
+		dbs := make([]*gorm.DB, len(objects))
+		group := func() ([]*gorm.DB, map[*gorm.DB][]int) {
+			var handles []*gorm.DB
+			groups := make(map[*gorm.DB][]int)
+			for i, handle := range dbs {
+				if _, ok := groups[handle]; !ok {
+					handles = append(handles, handle)
+				}
+				groups[handle] = append(groups[handle], i)
+			}
+			return handles, groups
+		}
+		for i, in := range objects {
+			dbs[i] = db
+			if hook, ok := interface{}(&Setting{}).(SettingWithBeforePatchRead); ok {
+				if dbs[i], err = hook.BeforePatchRead(ctx, in, updateMasks[i], db); err != nil {
+					return err
+				}
+			}
+		}
+		targets := make(map[uint64]SettingORM, len(objects))
+		handles, groups := group()
+		for _, handle := range handles {
+			keys := make([]uint64, 0, len(groups[handle]))
+			for _, i := range groups[handle] {
+				keys = append(keys, objectKeys[i])
+			}
+			query := handle.Session(&gorm.Session{}).Clauses(clause.Locking{Strength: "UPDATE"})
+			query = query.Where("id in (?)", keys)
+			rows := []SettingORM{}
+			if err = query.Find(&rows).Error; err != nil {
+				return err
+			}
+			for _, row := range rows {
+				targets[row.Id] = row
+			}
+		}
+		ormObjs := make([]SettingORM, len(objects))
+		prepare := func(i int) (*gorm.DB, error) {
+			in, key, updateMask, db := objects[i], objectKeys[i], updateMasks[i], dbs[i]
+			target, ok := targets[key]
+			if !ok {
+				return nil, gorm.ErrRecordNotFound
+			}
+			stored, err := target.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbObj := &stored
+			if hook, ok := interface{}(pbObj).(SettingWithBeforePatchApplyFieldMask); ok {
+				if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			if _, err := DefaultApplyFieldMaskSetting(ctx, pbObj, in, updateMask, "", db); err != nil {
+				return nil, err
+			}
+			if hook, ok := interface{}(pbObj).(SettingWithBeforePatchSave); ok {
+				if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObj, err := pbObj.ToORM(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if hook, ok := interface{}(&ormObj).(SettingORMWithBeforeStrictUpdateCleanup); ok {
+				if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			if hook, ok := interface{}(&ormObj).(SettingORMWithBeforeStrictUpdateSave); ok {
+				if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObjs[i] = ormObj
+			return db, nil
+		}
+		for i := range objects {
+			if dbs[i], err = prepare(i); err != nil {
+				return err
+			}
+		}
+		write := func(db *gorm.DB, batch []int) error {
+			conds := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*2)
+			for _, i := range batch {
+				conds = append(conds, "(id = ?)")
+				args = append(args, objectKeys[i])
+			}
+			column := func(name string, value func(ormObj *SettingORM) interface{}) clause.Expr {
+				whens := make([]interface{}, 0, len(batch)*2)
+				for _, i := range batch {
+					whens = append(whens, objectKeys[i], value(&ormObjs[i]))
+				}
+				return gorm.Expr("CASE "+strings.Repeat("WHEN id = ? THEN ? ", len(batch))+"ELSE "+name+" END", whens...)
+			}
+			res := db.Session(&gorm.Session{}).Model(&SettingORM{}).Where(strings.Join(conds, " OR "), args...).Updates(map[string]interface{}{
+				"name":  column("name", func(ormObj *SettingORM) interface{} { return ormObj.Name }),
+				"value": column("value", func(ormObj *SettingORM) interface{} { return ormObj.Value }),
+			})
+			return res.Error
+		}
+		handles, groups = group()
+		for _, handle := range handles {
+			indexes := groups[handle]
+			for start := 0; start < len(indexes); start += batchSize {
+				end := start + batchSize
+				if end > len(indexes) {
+					end = len(indexes)
+				}
+				batch := indexes[start:end]
+				if err = write(handle, batch); err != nil {
+					return err
+				}
+			}
+		}
+		finish := func(i int) (*Setting, error) {
+			in, updateMask, db, ormObj := objects[i], updateMasks[i], dbs[i], ormObjs[i]
+			var err error
+			if hook, ok := interface{}(&ormObj).(SettingORMWithAfterStrictUpdateSave); ok {
+				if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			saved, err := ormObj.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbResponse := &saved
+			if hook, ok := interface{}(pbResponse).(SettingWithAfterPatchSave); ok {
+				if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			return pbResponse, nil
+		}
+		for i := range objects {
+			if results[i], err = finish(i); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// DefaultApplyFieldMaskSetting patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskSetting(ctx context.Context, patchee *Setting, patcher *Setting, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Setting, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	if len(updateMask.GetPaths()) == 1 && updateMask.Paths[0] == prefix+"*" {
+		updateMask = &field_mask.FieldMask{Paths: []string{prefix + "Id", prefix + "Name", prefix + "Value"}}
+	}
+	for _, f := range updateMask.GetPaths() {
+		if strings.HasPrefix(f, prefix) && !DefaultHasFieldMaskPathSetting(strings.TrimPrefix(f, prefix)) {
+			return nil, &errors.InvalidFieldMaskError{Path: f}
+		}
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"Name" {
+			patchee.Name = patcher.Name
+			continue
+		}
+		if f == prefix+"Value" {
+			patchee.Value = patcher.Value
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultHasFieldMaskPathSetting reports whether Setting has the field of the field mask path
+func DefaultHasFieldMaskPathSetting(path string) bool {
+	parts := strings.SplitN(path, ".", 2)
+	switch parts[0] {
+	case "Id", "Name", "Value":
+		return len(parts) == 1
+	}
+	return false
+}
+
+// DefaultListSetting executes a gorm list call
+func DefaultListSetting(ctx context.Context, db *gorm.DB) ([]*Setting, error) {
+	in := Setting{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(SettingORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(SettingORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []SettingORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(SettingORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Setting{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type SettingORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type SettingORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type SettingORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]SettingORM) error
+}
+
+// DefaultCreateBasket executes a basic gorm create call
+func DefaultCreateBasket(ctx context.Context, in *Basket, db *gorm.DB) (*Basket, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(BasketORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(BasketORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type BasketORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type BasketORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultCreateBasketSet executes gorm create calls in batches of 100 objects
+// in a transaction, either all of the objects are created or none of them. The BeforeCreate_ hook
+// of each object runs before the insert of its batch, which uses the handle the hooks of the
+// batch return
+func DefaultCreateBasketSet(ctx context.Context, in []*Basket, db *gorm.DB) ([]*Basket, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObjs := make([]BasketORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, ormObj)
+	}
+	batchSize := 100
+	err := db.Transaction(func(db *gorm.DB) error {
+		var err error
+		for start := 0; start < len(ormObjs); start += batchSize {
+			end := start + batchSize
+			if end > len(ormObjs) {
+				end = len(ormObjs)
+			}
+			batch := ormObjs[start:end]
+			// the scopes the hooks add only apply to the insert of their own batch
+			batchDB := db
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(BasketORMWithBeforeCreate_); ok {
+					if batchDB, err = hook.BeforeCreate_(ctx, batchDB); err != nil {
+						return err
+					}
+				}
+			}
+			if err = batchDB.Omit().CreateInBatches(&batch, batchSize).Error; err != nil {
+				return err
+			}
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(BasketORMWithAfterCreate_); ok {
+					if err = hook.AfterCreate_(ctx, db); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*Basket, 0, len(ormObjs))
+	for i := range ormObjs {
+		pbResponse, err := ormObjs[i].ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, &pbResponse)
+	}
+	return results, nil
+}
+
+func DefaultReadBasket(ctx context.Context, in *Basket, db *gorm.DB, fs *query.FieldSelection) (*Basket, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(BasketORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db, fs); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, fs, &BasketORM{}); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(BasketORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db, fs); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := BasketORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(BasketORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db, fs); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type BasketORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB, *query.FieldSelection) (*gorm.DB, error)
+}
+type BasketORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB, *query.FieldSelection) (*gorm.DB, error)
+}
+type BasketORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB, *query.FieldSelection) error
+}
+
+func DefaultDeleteBasket(ctx context.Context, in *Basket, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	return db.Transaction(func(db *gorm.DB) error {
+		if hook, ok := interface{}(&ormObj).(BasketORMWithBeforeDelete_); ok {
+			if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+				return err
+			}
+		}
+		keys := []uint64{}
+		if err = db.Model(&BasketORM{}).Where(&ormObj).Pluck("id", &keys).Error; err != nil {
+			return err
+		}
+		if err = DefaultCascadeDeleteBasket(ctx, keys, db.Session(&gorm.Session{NewDB: true})); err != nil {
+			return err
+		}
+		err = db.Where(&ormObj).Delete(&BasketORM{}).Error
+		if err != nil {
+			return err
+		}
+		if hook, ok := interface{}(&ormObj).(BasketORMWithAfterDelete_); ok {
+			err = hook.AfterDelete_(ctx, db)
+		}
+		return err
+	})
+}
+
+type BasketORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type BasketORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteBasketSet(ctx context.Context, in []*Basket, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []uint64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	return db.Transaction(func(db *gorm.DB) error {
+		if hook, ok := (interface{}(&BasketORM{})).(BasketORMWithBeforeDeleteSet); ok {
+			if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+				return err
+			}
+		}
+		owned := []uint64{}
+		query := db.Model(&BasketORM{}).Where("id in (?)", keys)
+		if err = query.Pluck("id", &owned).Error; err != nil {
+			return err
+		}
+		if err = DefaultCascadeDeleteBasket(ctx, owned, db.Session(&gorm.Session{NewDB: true})); err != nil {
+			return err
+		}
+		err = db.Where("id in (?)", keys).Delete(&BasketORM{}).Error
+		if err != nil {
+			return err
+		}
+		if hook, ok := (interface{}(&BasketORM{})).(BasketORMWithAfterDeleteSet); ok {
+			err = hook.AfterDeleteSet(ctx, in, db)
+		}
+		return err
+	})
+}
+
+type BasketORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Basket, *gorm.DB) (*gorm.DB, error)
+}
+type BasketORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Basket, *gorm.DB) error
+}
+
+// DefaultStrictUpdateBasket clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateBasket(ctx context.Context, in *Basket, db *gorm.DB) (*Basket, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateBasket")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var count int64
+	lockedRow := &BasketORM{}
+	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
+	if hook, ok := interface{}(&ormObj).(BasketORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if ormObj.Items != nil {
+		if ormObj.Id == 0 {
+			return nil, errors.EmptyIdError
+		}
+		filterItems := BasketItemORM{}
+		filterItems.BasketId = new(uint64)
+		*filterItems.BasketId = ormObj.Id
+		newItems := []*BasketItemORM{}
+		keepItems := []interface{}{}
+		for _, child := range ormObj.Items {
+			child.BasketId = new(uint64)
+			*child.BasketId = ormObj.Id
+			if child.Id == 0 {
+				newItems = append(newItems, child)
+				continue
+			}
+			keepItems = append(keepItems, child.Id)
+			// the update is scoped to the object, the children of other objects are left as they are
+			if err = db.Session(&gorm.Session{}).Where(filterItems).Select("*").Updates(child).Error; err != nil {
+				return nil, err
+			}
+		}
+		queryItems := db.Where(filterItems)
+		if len(keepItems) > 0 {
+			queryItems = queryItems.Where("id NOT IN ?", keepItems)
+		}
+		if err = queryItems.Delete(BasketItemORM{}).Error; err != nil {
+			return nil, err
+		}
+		if len(newItems) > 0 {
+			if err = db.Session(&gorm.Session{}).Create(&newItems).Error; err != nil {
+				return nil, err
+			}
+		}
+	}
+	if ormObj.Labels != nil {
+		if len(ormObj.Labels) > 0 {
+			if err = db.Session(&gorm.Session{}).Save(&ormObj.Labels).Error; err != nil {
+				return nil, err
+			}
+			if err = db.Model(&ormObj).Association("Labels").Append(ormObj.Labels); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(BasketORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit("Items", "Labels").Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(BasketORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		err = gateway.SetCreated(ctx, "")
+	}
+	return &pbResponse, err
+}
+
+type BasketORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type BasketORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type BasketORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultUpsertBasket executes a gorm create call resolving conflicts on conflictTarget,
+// the name of a unique index or the primary key when empty, by updating the columns
+// in updateMask, all of them when updateMask is empty, and returns the stored object
+func DefaultUpsertBasket(ctx context.Context, in *Basket, conflictTarget string, updateMask *field_mask.FieldMask, db *gorm.DB) (*Basket, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conflict := clause.OnConflict{}
+	var lookup func() map[string]interface{}
+	switch conflictTarget {
+	case "":
+		conflict.Columns = []clause.Column{{Name: "id"}}
+		lookup = func() map[string]interface{} { return map[string]interface{}{"id": ormObj.Id} }
+	default:
+		return nil, fmt.Errorf("unknown conflict target %q of Basket", conflictTarget)
+	}
+	if len(updateMask.GetPaths()) == 0 {
+		conflict.UpdateAll = true
+	} else {
+		var columns []string
+		for _, path := range updateMask.GetPaths() {
+			var pathColumns []string
+			switch path {
+			case "Name":
+				pathColumns = []string{"name"}
+			default:
+				return nil, &errors.InvalidFieldMaskError{Path: path}
+			}
+			for _, column := range pathColumns {
+				for _, target := range conflict.Columns {
+					if column == target.Name {
+						return nil, &errors.InvalidFieldMaskError{Path: path}
+					}
+				}
+			}
+			columns = append(columns, pathColumns...)
+		}
+		conflict.DoUpdates = clause.AssignmentColumns(columns)
+	}
+	if hook, ok := interface{}(&ormObj).(BasketORMWithBeforeUpsert); ok {
+		if db, err = hook.BeforeUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Clauses(conflict).Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if len(updateMask.GetPaths()) > 0 {
+		stored := BasketORM{}
+		if err = db.Where(lookup()).Take(&stored).Error; err != nil {
+			return nil, err
+		}
+		ormObj = stored
+	}
+	if hook, ok := interface{}(&ormObj).(BasketORMWithAfterUpsert); ok {
+		if err = hook.AfterUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type BasketORMWithBeforeUpsert interface {
+	BeforeUpsert(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type BasketORMWithAfterUpsert interface {
+	AfterUpsert(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchBasket executes a basic gorm update call with patch behavior
+func DefaultPatchBasket(ctx context.Context, in *Basket, updateMask *field_mask.FieldMask, db *gorm.DB) (*Basket, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	pbObj := &Basket{}
+	var err error
+	if hook, ok := interface{}(pbObj).(BasketWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if pbObj, err = DefaultReadBasket(ctx, &Basket{Id: in.GetId()}, db, nil); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbObj).(BasketWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskBasket(ctx, pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbObj).(BasketWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateBasket(ctx, pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(BasketWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type BasketWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Basket, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type BasketWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Basket, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type BasketWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Basket, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type BasketWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Basket, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetBasket patches the objects in a transaction: the masks are applied in memory to
+// the stored objects, read and locked with a single query, and the objects are written with an update
+// per batch of 100 objects. The objects are read and written with the handles their hooks
+// return, the objects of different handles don't share the queries. Only the associations in the
+// masks are updated, and objects sharing a primary key are rejected
+func DefaultPatchSetBasket(ctx context.Context, objects []*Basket, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Basket, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+	if len(objects) == 0 {
+		return []*Basket{}, nil
+	}
+	var err error
+	objectKeys := make([]uint64, 0, len(objects))
+	seen := make(map[uint64]struct{}, len(objects))
+	for _, in := range objects {
+		if in == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := in.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if ormObj.Id == 0 {
+			return nil, errors.EmptyIdError
+		}
+		key := ormObj.Id
+		if _, ok := seen[key]; ok {
+			return nil, errors.DuplicateKeyError
+		}
+		seen[key] = struct{}{}
+		objectKeys = append(objectKeys, key)
+	}
+	batchSize := 100
+	results := make([]*Basket, len(objects))
+	err = db.Transaction(func(db *gorm.DB) error {
+		var err error
+		// the objects sharing the handle their hooks return share the queries
+		dbs := make([]*gorm.DB, len(objects))
+		group := func() ([]*gorm.DB, map[*gorm.DB][]int) {
+			var handles []*gorm.DB
+			groups := make(map[*gorm.DB][]int)
+			for i, handle := range dbs {
+				if _, ok := groups[handle]; !ok {
+					handles = append(handles, handle)
+				}
+				groups[handle] = append(groups[handle], i)
+			}
+			return handles, groups
+		}
+		for i, in := range objects {
+			dbs[i] = db
+			if hook, ok := interface{}(&Basket{}).(BasketWithBeforePatchRead); ok {
+				if dbs[i], err = hook.BeforePatchRead(ctx, in, updateMasks[i], db); err != nil {
+					return err
+				}
+			}
+		}
+		targets := make(map[uint64]BasketORM, len(objects))
+		handles, groups := group()
+		for _, handle := range handles {
+			keys := make([]uint64, 0, len(groups[handle]))
+			for _, i := range groups[handle] {
+				keys = append(keys, objectKeys[i])
+			}
+			query := handle.Session(&gorm.Session{}).Clauses(clause.Locking{Strength: "UPDATE"})
+			query = query.Where("id in (?)", keys)
+			rows := []BasketORM{}
+			if err = query.Find(&rows).Error; err != nil {
+				return err
+			}
+			for _, row := range rows {
+				targets[row.Id] = row
+			}
+		}
+		ormObjs := make([]BasketORM, len(objects))
+		prepare := func(i int) (*gorm.DB, error) {
+			in, key, updateMask, db := objects[i], objectKeys[i], updateMasks[i], dbs[i]
+			target, ok := targets[key]
+			if !ok {
+				return nil, gorm.ErrRecordNotFound
+			}
+			stored, err := target.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbObj := &stored
+			if hook, ok := interface{}(pbObj).(BasketWithBeforePatchApplyFieldMask); ok {
+				if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			if _, err := DefaultApplyFieldMaskBasket(ctx, pbObj, in, updateMask, "", db); err != nil {
+				return nil, err
+			}
+			if hook, ok := interface{}(pbObj).(BasketWithBeforePatchSave); ok {
+				if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObj, err := pbObj.ToORM(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if hook, ok := interface{}(&ormObj).(BasketORMWithBeforeStrictUpdateCleanup); ok {
+				if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			masked := make(map[string]bool, len(updateMask.GetPaths()))
+			for _, path := range updateMask.GetPaths() {
+				masked[strings.SplitN(path, ".", 2)[0]] = true
+			}
+			if masked["Items"] || masked["*"] {
+				if ormObj.Items != nil {
+					if ormObj.Id == 0 {
+						return nil, errors.EmptyIdError
+					}
+					filterItems := BasketItemORM{}
+					filterItems.BasketId = new(uint64)
+					*filterItems.BasketId = ormObj.Id
+					newItems := []*BasketItemORM{}
+					keepItems := []interface{}{}
+					for _, child := range ormObj.Items {
+						child.BasketId = new(uint64)
+						*child.BasketId = ormObj.Id
+						if child.Id == 0 {
+							newItems = append(newItems, child)
+							continue
+						}
+						keepItems = append(keepItems, child.Id)
+						// the update is scoped to the object, the children of other objects are left as they are
+						if err = db.Session(&gorm.Session{}).Where(filterItems).Select("*").Updates(child).Error; err != nil {
+							return nil, err
+						}
+					}
+					queryItems := db.Where(filterItems)
+					if len(keepItems) > 0 {
+						queryItems = queryItems.Where("id NOT IN ?", keepItems)
+					}
+					if err = queryItems.Delete(BasketItemORM{}).Error; err != nil {
+						return nil, err
+					}
+					if len(newItems) > 0 {
+						if err = db.Session(&gorm.Session{}).Create(&newItems).Error; err != nil {
+							return nil, err
+						}
+					}
+				}
+			}
+			if masked["Labels"] || masked["*"] {
+				if ormObj.Labels != nil {
+					if len(ormObj.Labels) > 0 {
+						if err = db.Session(&gorm.Session{}).Save(&ormObj.Labels).Error; err != nil {
+							return nil, err
+						}
+						if err = db.Model(&ormObj).Association("Labels").Append(ormObj.Labels); err != nil {
+							return nil, err
+						}
+					}
+				}
+			}
+			if hook, ok := interface{}(&ormObj).(BasketORMWithBeforeStrictUpdateSave); ok {
+				if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObjs[i] = ormObj
+			return db, nil
+		}
+		for i := range objects {
+			if dbs[i], err = prepare(i); err != nil {
+				return err
+			}
+		}
+		write := func(db *gorm.DB, batch []int) error {
+			conds := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*2)
+			for _, i := range batch {
+				conds = append(conds, "(id = ?)")
+				args = append(args, objectKeys[i])
+			}
+			column := func(name string, value func(ormObj *BasketORM) interface{}) clause.Expr {
+				whens := make([]interface{}, 0, len(batch)*2)
+				for _, i := range batch {
+					whens = append(whens, objectKeys[i], value(&ormObjs[i]))
+				}
+				return gorm.Expr("CASE "+strings.Repeat("WHEN id = ? THEN ? ", len(batch))+"ELSE "+name+" END", whens...)
+			}
+			res := db.Session(&gorm.Session{}).Model(&BasketORM{}).Where(strings.Join(conds, " OR "), args...).Updates(map[string]interface{}{
+				"name": column("name", func(ormObj *BasketORM) interface{} { return ormObj.Name }),
+			})
+			return res.Error
+		}
+		handles, groups = group()
+		for _, handle := range handles {
+			indexes := groups[handle]
+			for start := 0; start < len(indexes); start += batchSize {
+				end := start + batchSize
+				if end > len(indexes) {
+					end = len(indexes)
+				}
+				batch := indexes[start:end]
+				objs := make([]*BasketORM, 0, len(batch))
+				for _, i := range batch {
+					objs = append(objs, &ormObjs[i])
+				}
+				// the associations are saved first, the batch is then written with the
+				// foreign keys of its belongs-to associations
+				if err = handle.Session(&gorm.Session{}).Select("Items", "Labels").Omit("Items", "Labels").Model(&objs).Updates(map[string]interface{}{}).Error; err != nil {
+					return err
+				}
+				if err = write(handle, batch); err != nil {
+					return err
+				}
+			}
+		}
+		finish := func(i int) (*Basket, error) {
+			in, updateMask, db, ormObj := objects[i], updateMasks[i], dbs[i], ormObjs[i]
+			var err error
+			if hook, ok := interface{}(&ormObj).(BasketORMWithAfterStrictUpdateSave); ok {
+				if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			saved, err := ormObj.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbResponse := &saved
+			if hook, ok := interface{}(pbResponse).(BasketWithAfterPatchSave); ok {
+				if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			return pbResponse, nil
+		}
+		for i := range objects {
+			if results[i], err = finish(i); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// DefaultAddLabelsToBasket adds the stored objects to the Labels of the object
+func DefaultAddLabelsToBasket(ctx context.Context, in *Basket, objects []*BasketLabel, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	owner := BasketORM{}
+	if err = db.Where(&BasketORM{Id: ormObj.Id}).First(&owner).Error; err != nil {
+		return err
+	}
+	keys := make(map[string]interface{}, len(objects))
+	for _, object := range objects {
+		if object == nil {
+			return errors.NilArgumentError
+		}
+		child, err := object.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if child.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys[fmt.Sprintf("%#v", child.Id)] = child.Id
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	values := make([]interface{}, 0, len(keys))
+	for _, value := range keys {
+		values = append(values, value)
+	}
+	children := []BasketLabelORM{}
+	if err = db.Where("id IN ?", values).Find(&children).Error; err != nil {
+		return err
+	}
+	if len(children) != len(values) {
+		return gorm.ErrRecordNotFound
+	}
+	return db.Model(&owner).Association("Labels").Append(&children)
+}
+
+// DefaultRemoveLabelsFromBasket removes the objects from the Labels of the object
+func DefaultRemoveLabelsFromBasket(ctx context.Context, in *Basket, objects []*BasketLabel, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	owner := BasketORM{}
+	if err = db.Where(&BasketORM{Id: ormObj.Id}).First(&owner).Error; err != nil {
+		return err
+	}
+	children := make([]BasketLabelORM, 0, len(objects))
+	for _, object := range objects {
+		if object == nil {
+			return errors.NilArgumentError
+		}
+		child, err := object.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if child.Id == 0 {
+			return errors.EmptyIdError
+		}
+		children = append(children, child)
+	}
+	if len(children) == 0 {
+		return nil
+	}
+	return db.Model(&owner).Association("Labels").Delete(&children)
+}
+
+// DefaultListLabelsOfBasket returns the Labels of the object
+func DefaultListLabelsOfBasket(ctx context.Context, in *Basket, db *gorm.DB) ([]*BasketLabel, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	owner := BasketORM{}
+	if err = db.Where(&BasketORM{Id: ormObj.Id}).First(&owner).Error; err != nil {
+		return nil, err
+	}
+	children := []BasketLabelORM{}
+	if err = db.Model(&owner).Association("Labels").Find(&children); err != nil {
+		return nil, err
+	}
+	pbResponse := make([]*BasketLabel, 0, len(children))
+	for _, child := range children {
+		temp, err := child.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+// DefaultCascadeDeleteBasket deletes the children owned by the objects of the given
+// primary keys, along with the children they own in turn
+func DefaultCascadeDeleteBasket(ctx context.Context, keys interface{}, db *gorm.DB) error {
+	keysItems := []uint64{}
+	if err := db.Model(&BasketItemORM{}).Where("basket_id IN ?", keys).Pluck("id", &keysItems).Error; err != nil {
+		return err
+	}
+	if len(keysItems) > 0 {
+		if err := DefaultCascadeDeleteBasketItem(ctx, keysItems, db); err != nil {
+			return err
+		}
+		if err := db.Where("id IN ?", keysItems).Delete(&BasketItemORM{}).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DefaultApplyFieldMaskBasket patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskBasket(ctx context.Context, patchee *Basket, patcher *Basket, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Basket, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	if len(updateMask.GetPaths()) == 1 && updateMask.Paths[0] == prefix+"*" {
+		updateMask = &field_mask.FieldMask{Paths: []string{prefix + "Id", prefix + "Name", prefix + "Items", prefix + "Labels"}}
+	}
+	for _, f := range updateMask.GetPaths() {
+		if strings.HasPrefix(f, prefix) && !DefaultHasFieldMaskPathBasket(strings.TrimPrefix(f, prefix)) {
+			return nil, &errors.InvalidFieldMaskError{Path: f}
+		}
+	}
+	var err error
+	var updatedItems bool
+	var updatedLabels bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"Name" {
+			patchee.Name = patcher.Name
+			continue
+		}
+		if !updatedItems && strings.HasPrefix(f, prefix+"Items.") {
+			updatedItems = true
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"Items."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			patcheeORM, err := patchee.ToORM(ctx)
+			if err != nil {
+				return nil, err
+			}
+			storedItems := []*BasketItemORM{}
+			if err = db.Model(&patcheeORM).Association("Items").Find(&storedItems); err != nil {
+				return nil, err
+			}
+			storedByKey := map[interface{}]*BasketItem{}
+			for _, child := range storedItems {
+				pbChild, err := child.ToPB(ctx)
+				if err != nil {
+					return nil, err
+				}
+				storedByKey[child.Id] = &pbChild
+			}
+			patchee.Items = nil
+			for _, child := range patcher.Items {
+				childORM, err := child.ToORM(ctx)
+				if err != nil {
+					return nil, err
+				}
+				if storedChild, ok := storedByKey[childORM.Id]; ok {
+					if child, err = DefaultApplyFieldMaskBasketItem(ctx, storedChild, child, childMask, "", db); err != nil {
+						return nil, err
+					}
+				}
+				patchee.Items = append(patchee.Items, child)
+			}
+			continue
+		}
+		if f == prefix+"Items" {
+			updatedItems = true
+			patchee.Items = patcher.Items
+			if patchee.Items == nil {
+				// the masked collection is emptied rather than left as it is
+				patchee.Items = []*BasketItem{}
+			}
+			continue
+		}
+		if !updatedLabels && strings.HasPrefix(f, prefix+"Labels.") {
+			updatedLabels = true
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"Labels."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			patcheeORM, err := patchee.ToORM(ctx)
+			if err != nil {
+				return nil, err
+			}
+			storedLabels := []*BasketLabelORM{}
+			if err = db.Model(&patcheeORM).Association("Labels").Find(&storedLabels); err != nil {
+				return nil, err
+			}
+			storedByKey := map[interface{}]*BasketLabel{}
+			for _, child := range storedLabels {
+				pbChild, err := child.ToPB(ctx)
+				if err != nil {
+					return nil, err
+				}
+				storedByKey[child.Id] = &pbChild
+			}
+			patchee.Labels = nil
+			for _, child := range patcher.Labels {
+				childORM, err := child.ToORM(ctx)
+				if err != nil {
+					return nil, err
+				}
+				if storedChild, ok := storedByKey[childORM.Id]; ok {
+					if child, err = DefaultApplyFieldMaskBasketLabel(ctx, storedChild, child, childMask, "", db); err != nil {
+						return nil, err
+					}
+				}
+				patchee.Labels = append(patchee.Labels, child)
+			}
+			continue
+		}
+		if f == prefix+"Labels" {
+			updatedLabels = true
+			patchee.Labels = patcher.Labels
+			if patchee.Labels == nil {
+				// the masked collection is emptied rather than left as it is
+				patchee.Labels = []*BasketLabel{}
+			}
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultHasFieldMaskPathBasket reports whether Basket has the field of the field mask path
+func DefaultHasFieldMaskPathBasket(path string) bool {
+	parts := strings.SplitN(path, ".", 2)
+	switch parts[0] {
+	case "Items":
+		return len(parts) == 1 || DefaultHasFieldMaskPathBasketItem(parts[1])
+	case "Labels":
+		return len(parts) == 1 || DefaultHasFieldMaskPathBasketLabel(parts[1])
+	case "Id", "Name":
+		return len(parts) == 1
+	}
+	return false
+}
+
+// DefaultListBasket executes a gorm list call
+func DefaultListBasket(ctx context.Context, db *gorm.DB, fs *query.FieldSelection) ([]*Basket, error) {
+	in := Basket{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(BasketORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db, fs); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &BasketORM{}, &Basket{}, nil, nil, nil, fs)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(BasketORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db, fs); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []BasketORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(BasketORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse, fs); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Basket{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type BasketORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB, *query.FieldSelection) (*gorm.DB, error)
+}
+type BasketORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB, *query.FieldSelection) (*gorm.DB, error)
+}
+type BasketORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]BasketORM, *query.FieldSelection) error
+}
+
+// DefaultCreateBasketItem executes a basic gorm create call
+func DefaultCreateBasketItem(ctx context.Context, in *BasketItem, db *gorm.DB) (*BasketItem, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(BasketItemORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(BasketItemORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type BasketItemORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type BasketItemORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultCreateBasketItemSet executes gorm create calls in batches of 100 objects
+// in a transaction, either all of the objects are created or none of them. The BeforeCreate_ hook
+// of each object runs before the insert of its batch, which uses the handle the hooks of the
+// batch return
+func DefaultCreateBasketItemSet(ctx context.Context, in []*BasketItem, db *gorm.DB) ([]*BasketItem, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObjs := make([]BasketItemORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, ormObj)
+	}
+	batchSize := 100
+	err := db.Transaction(func(db *gorm.DB) error {
+		var err error
+		for start := 0; start < len(ormObjs); start += batchSize {
+			end := start + batchSize
+			if end > len(ormObjs) {
+				end = len(ormObjs)
+			}
+			batch := ormObjs[start:end]
+			// the scopes the hooks add only apply to the insert of their own batch
+			batchDB := db
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(BasketItemORMWithBeforeCreate_); ok {
+					if batchDB, err = hook.BeforeCreate_(ctx, batchDB); err != nil {
+						return err
+					}
+				}
+			}
+			if err = batchDB.Omit().CreateInBatches(&batch, batchSize).Error; err != nil {
+				return err
+			}
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(BasketItemORMWithAfterCreate_); ok {
+					if err = hook.AfterCreate_(ctx, db); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*BasketItem, 0, len(ormObjs))
+	for i := range ormObjs {
+		pbResponse, err := ormObjs[i].ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, &pbResponse)
+	}
+	return results, nil
+}
+
+func DefaultReadBasketItem(ctx context.Context, in *BasketItem, db *gorm.DB) (*BasketItem, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(BasketItemORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(BasketItemORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := BasketItemORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(BasketItemORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type BasketItemORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type BasketItemORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type BasketItemORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteBasketItem(ctx context.Context, in *BasketItem, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	return db.Transaction(func(db *gorm.DB) error {
+		if hook, ok := interface{}(&ormObj).(BasketItemORMWithBeforeDelete_); ok {
+			if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+				return err
+			}
+		}
+		keys := []uint64{}
+		if err = db.Model(&BasketItemORM{}).Where(&ormObj).Pluck("id", &keys).Error; err != nil {
+			return err
+		}
+		if err = DefaultCascadeDeleteBasketItem(ctx, keys, db.Session(&gorm.Session{NewDB: true})); err != nil {
+			return err
+		}
+		err = db.Where(&ormObj).Delete(&BasketItemORM{}).Error
+		if err != nil {
+			return err
+		}
+		if hook, ok := interface{}(&ormObj).(BasketItemORMWithAfterDelete_); ok {
+			err = hook.AfterDelete_(ctx, db)
+		}
+		return err
+	})
+}
+
+type BasketItemORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type BasketItemORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteBasketItemSet(ctx context.Context, in []*BasketItem, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []uint64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	return db.Transaction(func(db *gorm.DB) error {
+		if hook, ok := (interface{}(&BasketItemORM{})).(BasketItemORMWithBeforeDeleteSet); ok {
+			if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+				return err
+			}
+		}
+		owned := []uint64{}
+		query := db.Model(&BasketItemORM{}).Where("id in (?)", keys)
+		if err = query.Pluck("id", &owned).Error; err != nil {
+			return err
+		}
+		if err = DefaultCascadeDeleteBasketItem(ctx, owned, db.Session(&gorm.Session{NewDB: true})); err != nil {
+			return err
+		}
+		err = db.Where("id in (?)", keys).Delete(&BasketItemORM{}).Error
+		if err != nil {
+			return err
+		}
+		if hook, ok := (interface{}(&BasketItemORM{})).(BasketItemORMWithAfterDeleteSet); ok {
+			err = hook.AfterDeleteSet(ctx, in, db)
+		}
+		return err
+	})
+}
+
+type BasketItemORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*BasketItem, *gorm.DB) (*gorm.DB, error)
+}
+type BasketItemORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*BasketItem, *gorm.DB) error
+}
+
+// DefaultStrictUpdateBasketItem clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateBasketItem(ctx context.Context, in *BasketItem, db *gorm.DB) (*BasketItem, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateBasketItem")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var count int64
+	lockedRow := &BasketItemORM{}
+	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
+	if hook, ok := interface{}(&ormObj).(BasketItemORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	filterNotes := BasketItemNoteORM{}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	filterNotes.BasketItemId = new(uint64)
+	*filterNotes.BasketItemId = ormObj.Id
+	if err = db.Where(filterNotes).Delete(BasketItemNoteORM{}).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(BasketItemORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(BasketItemORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		err = gateway.SetCreated(ctx, "")
+	}
+	return &pbResponse, err
+}
+
+type BasketItemORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type BasketItemORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type BasketItemORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultUpsertBasketItem executes a gorm create call resolving conflicts on conflictTarget,
+// the name of a unique index or the primary key when empty, by updating the columns
+// in updateMask, all of them when updateMask is empty, and returns the stored object
+func DefaultUpsertBasketItem(ctx context.Context, in *BasketItem, conflictTarget string, updateMask *field_mask.FieldMask, db *gorm.DB) (*BasketItem, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conflict := clause.OnConflict{}
+	var lookup func() map[string]interface{}
+	switch conflictTarget {
+	case "":
+		conflict.Columns = []clause.Column{{Name: "id"}}
+		lookup = func() map[string]interface{} { return map[string]interface{}{"id": ormObj.Id} }
+	default:
+		return nil, fmt.Errorf("unknown conflict target %q of BasketItem", conflictTarget)
+	}
+	if len(updateMask.GetPaths()) == 0 {
+		conflict.UpdateAll = true
+	} else {
+		var columns []string
+		for _, path := range updateMask.GetPaths() {
+			var pathColumns []string
+			switch path {
+			case "Name":
+				pathColumns = []string{"name"}
+			case "Quantity":
+				pathColumns = []string{"quantity"}
+			default:
+				return nil, &errors.InvalidFieldMaskError{Path: path}
+			}
+			for _, column := range pathColumns {
+				for _, target := range conflict.Columns {
+					if column == target.Name {
+						return nil, &errors.InvalidFieldMaskError{Path: path}
+					}
+				}
+			}
+			columns = append(columns, pathColumns...)
+		}
+		conflict.DoUpdates = clause.AssignmentColumns(columns)
+	}
+	if hook, ok := interface{}(&ormObj).(BasketItemORMWithBeforeUpsert); ok {
+		if db, err = hook.BeforeUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Clauses(conflict).Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if len(updateMask.GetPaths()) > 0 {
+		stored := BasketItemORM{}
+		if err = db.Where(lookup()).Take(&stored).Error; err != nil {
+			return nil, err
+		}
+		ormObj = stored
+	}
+	if hook, ok := interface{}(&ormObj).(BasketItemORMWithAfterUpsert); ok {
+		if err = hook.AfterUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type BasketItemORMWithBeforeUpsert interface {
+	BeforeUpsert(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type BasketItemORMWithAfterUpsert interface {
+	AfterUpsert(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchBasketItem executes a basic gorm update call with patch behavior
+func DefaultPatchBasketItem(ctx context.Context, in *BasketItem, updateMask *field_mask.FieldMask, db *gorm.DB) (*BasketItem, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	pbObj := &BasketItem{}
+	var err error
+	if hook, ok := interface{}(pbObj).(BasketItemWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if pbObj, err = DefaultReadBasketItem(ctx, &BasketItem{Id: in.GetId()}, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbObj).(BasketItemWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskBasketItem(ctx, pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbObj).(BasketItemWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateBasketItem(ctx, pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(BasketItemWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type BasketItemWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *BasketItem, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type BasketItemWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *BasketItem, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type BasketItemWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *BasketItem, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type BasketItemWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *BasketItem, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetBasketItem patches the objects in a transaction: the masks are applied in memory to
+// the stored objects, read and locked with a single query, and the objects are written with an update
+// per batch of 100 objects. The objects are read and written with the handles their hooks
+// return, the objects of different handles don't share the queries. Only the associations in the
+// masks are updated, and objects sharing a primary key are rejected
+func DefaultPatchSetBasketItem(ctx context.Context, objects []*BasketItem, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*BasketItem, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+	if len(objects) == 0 {
+		return []*BasketItem{}, nil
+	}
+	var err error
+	objectKeys := make([]uint64, 0, len(objects))
+	seen := make(map[uint64]struct{}, len(objects))
+	for _, in := range objects {
+		if in == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := in.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if ormObj.Id == 0 {
+			return nil, errors.EmptyIdError
+		}
+		key := ormObj.Id
+		if _, ok := seen[key]; ok {
+			return nil, errors.DuplicateKeyError
+		}
+		seen[key] = struct{}{}
+		objectKeys = append(objectKeys, key)
+	}
+	batchSize := 100
+	results := make([]*BasketItem, len(objects))
+	err = db.Transaction(func(db *gorm.DB) error {
+		var err error
+		// the objects sharing the handle their hooks return share the queries
+		dbs := make([]*gorm.DB, len(objects))
+		group := func() ([]*gorm.DB, map[*gorm.DB][]int) {
+			var handles []*gorm.DB
+			groups := make(map[*gorm.DB][]int)
+			for i, handle := range dbs {
+				if _, ok := groups[handle]; !ok {
+					handles = append(handles, handle)
+				}
+				groups[handle] = append(groups[handle], i)
+			}
+			return handles, groups
+		}
+		for i, in := range objects {
+			dbs[i] = db
+			if hook, ok := interface{}(&BasketItem{}).(BasketItemWithBeforePatchRead); ok {
+				if dbs[i], err = hook.BeforePatchRead(ctx, in, updateMasks[i], db); err != nil {
+					return err
+				}
+			}
+		}
+		targets := make(map[uint64]BasketItemORM, len(objects))
+		handles, groups := group()
+		for _, handle := range handles {
+			keys := make([]uint64, 0, len(groups[handle]))
+			for _, i := range groups[handle] {
+				keys = append(keys, objectKeys[i])
+			}
+			query := handle.Session(&gorm.Session{}).Clauses(clause.Locking{Strength: "UPDATE"})
+			query = query.Where("id in (?)", keys)
+			rows := []BasketItemORM{}
+			if err = query.Find(&rows).Error; err != nil {
+				return err
+			}
+			for _, row := range rows {
+				targets[row.Id] = row
+			}
+		}
+		ormObjs := make([]BasketItemORM, len(objects))
+		prepare := func(i int) (*gorm.DB, error) {
+			in, key, updateMask, db := objects[i], objectKeys[i], updateMasks[i], dbs[i]
+			target, ok := targets[key]
+			if !ok {
+				return nil, gorm.ErrRecordNotFound
+			}
+			stored, err := target.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbObj := &stored
+			if hook, ok := interface{}(pbObj).(BasketItemWithBeforePatchApplyFieldMask); ok {
+				if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			if _, err := DefaultApplyFieldMaskBasketItem(ctx, pbObj, in, updateMask, "", db); err != nil {
+				return nil, err
+			}
+			if hook, ok := interface{}(pbObj).(BasketItemWithBeforePatchSave); ok {
+				if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObj, err := pbObj.ToORM(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if hook, ok := interface{}(&ormObj).(BasketItemORMWithBeforeStrictUpdateCleanup); ok {
+				if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			masked := make(map[string]bool, len(updateMask.GetPaths()))
+			for _, path := range updateMask.GetPaths() {
+				masked[strings.SplitN(path, ".", 2)[0]] = true
+			}
+			if masked["Notes"] || masked["*"] {
+				filterNotes := BasketItemNoteORM{}
+				if ormObj.Id == 0 {
+					return nil, errors.EmptyIdError
+				}
+				filterNotes.BasketItemId = new(uint64)
+				*filterNotes.BasketItemId = ormObj.Id
+				if err = db.Where(filterNotes).Delete(BasketItemNoteORM{}).Error; err != nil {
+					return nil, err
+				}
+			}
+			if hook, ok := interface{}(&ormObj).(BasketItemORMWithBeforeStrictUpdateSave); ok {
+				if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObjs[i] = ormObj
+			return db, nil
+		}
+		for i := range objects {
+			if dbs[i], err = prepare(i); err != nil {
+				return err
+			}
+		}
+		write := func(db *gorm.DB, batch []int) error {
+			conds := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*2)
+			for _, i := range batch {
+				conds = append(conds, "(id = ?)")
+				args = append(args, objectKeys[i])
+			}
+			column := func(name string, value func(ormObj *BasketItemORM) interface{}) clause.Expr {
+				whens := make([]interface{}, 0, len(batch)*2)
+				for _, i := range batch {
+					whens = append(whens, objectKeys[i], value(&ormObjs[i]))
+				}
+				return gorm.Expr("CASE "+strings.Repeat("WHEN id = ? THEN ? ", len(batch))+"ELSE "+name+" END", whens...)
+			}
+			res := db.Session(&gorm.Session{}).Model(&BasketItemORM{}).Where(strings.Join(conds, " OR "), args...).Updates(map[string]interface{}{
+				"basket_id": column("basket_id", func(ormObj *BasketItemORM) interface{} { return ormObj.BasketId }),
+				"name":      column("name", func(ormObj *BasketItemORM) interface{} { return ormObj.Name }),
+				"quantity":  column("quantity", func(ormObj *BasketItemORM) interface{} { return ormObj.Quantity }),
+			})
+			return res.Error
+		}
+		handles, groups = group()
+		for _, handle := range handles {
+			indexes := groups[handle]
+			for start := 0; start < len(indexes); start += batchSize {
+				end := start + batchSize
+				if end > len(indexes) {
+					end = len(indexes)
+				}
+				batch := indexes[start:end]
+				objs := make([]*BasketItemORM, 0, len(batch))
+				for _, i := range batch {
+					objs = append(objs, &ormObjs[i])
+				}
+				// the associations are saved first, the batch is then written with the
+				// foreign keys of its belongs-to associations
+				if err = handle.Session(&gorm.Session{}).Select("Notes").Model(&objs).Updates(map[string]interface{}{}).Error; err != nil {
+					return err
+				}
+				if err = write(handle, batch); err != nil {
+					return err
+				}
+			}
+		}
+		finish := func(i int) (*BasketItem, error) {
+			in, updateMask, db, ormObj := objects[i], updateMasks[i], dbs[i], ormObjs[i]
+			var err error
+			if hook, ok := interface{}(&ormObj).(BasketItemORMWithAfterStrictUpdateSave); ok {
+				if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			saved, err := ormObj.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbResponse := &saved
+			if hook, ok := interface{}(pbResponse).(BasketItemWithAfterPatchSave); ok {
+				if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			return pbResponse, nil
+		}
+		for i := range objects {
+			if results[i], err = finish(i); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// DefaultCascadeDeleteBasketItem deletes the children owned by the objects of the given
+// primary keys, along with the children they own in turn
+func DefaultCascadeDeleteBasketItem(ctx context.Context, keys interface{}, db *gorm.DB) error {
+	if err := db.Where("basket_item_id IN ?", keys).Delete(&BasketItemNoteORM{}).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+// DefaultApplyFieldMaskBasketItem patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskBasketItem(ctx context.Context, patchee *BasketItem, patcher *BasketItem, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*BasketItem, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	if len(updateMask.GetPaths()) == 1 && updateMask.Paths[0] == prefix+"*" {
+		updateMask = &field_mask.FieldMask{Paths: []string{prefix + "Id", prefix + "Name", prefix + "Quantity", prefix + "Notes"}}
+	}
+	for _, f := range updateMask.GetPaths() {
+		if strings.HasPrefix(f, prefix) && !DefaultHasFieldMaskPathBasketItem(strings.TrimPrefix(f, prefix)) {
+			return nil, &errors.InvalidFieldMaskError{Path: f}
+		}
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"Name" {
+			patchee.Name = patcher.Name
+			continue
+		}
+		if f == prefix+"Quantity" {
+			patchee.Quantity = patcher.Quantity
+			continue
+		}
+		if f == prefix+"Notes" {
+			patchee.Notes = patcher.Notes
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultHasFieldMaskPathBasketItem reports whether BasketItem has the field of the field mask path
+func DefaultHasFieldMaskPathBasketItem(path string) bool {
+	parts := strings.SplitN(path, ".", 2)
+	switch parts[0] {
+	case "Id", "Name", "Quantity", "Notes":
+		return len(parts) == 1
+	}
+	return false
+}
+
+// DefaultListBasketItem executes a gorm list call
+func DefaultListBasketItem(ctx context.Context, db *gorm.DB) ([]*BasketItem, error) {
+	in := BasketItem{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(BasketItemORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(BasketItemORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []BasketItemORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(BasketItemORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*BasketItem{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type BasketItemORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type BasketItemORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type BasketItemORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]BasketItemORM) error
+}
+
+// DefaultCreateBasketItemNote executes a basic gorm create call
+func DefaultCreateBasketItemNote(ctx context.Context, in *BasketItemNote, db *gorm.DB) (*BasketItemNote, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(BasketItemNoteORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(BasketItemNoteORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type BasketItemNoteORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type BasketItemNoteORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultCreateBasketItemNoteSet executes gorm create calls in batches of 100 objects
+// in a transaction, either all of the objects are created or none of them. The BeforeCreate_ hook
+// of each object runs before the insert of its batch, which uses the handle the hooks of the
+// batch return
+func DefaultCreateBasketItemNoteSet(ctx context.Context, in []*BasketItemNote, db *gorm.DB) ([]*BasketItemNote, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObjs := make([]BasketItemNoteORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, ormObj)
+	}
+	batchSize := 100
+	err := db.Transaction(func(db *gorm.DB) error {
+		var err error
+		for start := 0; start < len(ormObjs); start += batchSize {
+			end := start + batchSize
+			if end > len(ormObjs) {
+				end = len(ormObjs)
+			}
+			batch := ormObjs[start:end]
+			// the scopes the hooks add only apply to the insert of their own batch
+			batchDB := db
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(BasketItemNoteORMWithBeforeCreate_); ok {
+					if batchDB, err = hook.BeforeCreate_(ctx, batchDB); err != nil {
+						return err
+					}
+				}
+			}
+			if err = batchDB.Omit().CreateInBatches(&batch, batchSize).Error; err != nil {
+				return err
+			}
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(BasketItemNoteORMWithAfterCreate_); ok {
+					if err = hook.AfterCreate_(ctx, db); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*BasketItemNote, 0, len(ormObjs))
+	for i := range ormObjs {
+		pbResponse, err := ormObjs[i].ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, &pbResponse)
+	}
+	return results, nil
+}
+
+func DefaultReadBasketItemNote(ctx context.Context, in *BasketItemNote, db *gorm.DB) (*BasketItemNote, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(BasketItemNoteORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(BasketItemNoteORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := BasketItemNoteORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(BasketItemNoteORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type BasketItemNoteORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type BasketItemNoteORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type BasketItemNoteORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteBasketItemNote(ctx context.Context, in *BasketItemNote, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(BasketItemNoteORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&BasketItemNoteORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(BasketItemNoteORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type BasketItemNoteORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type BasketItemNoteORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteBasketItemNoteSet(ctx context.Context, in []*BasketItemNote, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []uint64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&BasketItemNoteORM{})).(BasketItemNoteORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&BasketItemNoteORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&BasketItemNoteORM{})).(BasketItemNoteORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type BasketItemNoteORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*BasketItemNote, *gorm.DB) (*gorm.DB, error)
+}
+type BasketItemNoteORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*BasketItemNote, *gorm.DB) error
+}
+
+// DefaultStrictUpdateBasketItemNote clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateBasketItemNote(ctx context.Context, in *BasketItemNote, db *gorm.DB) (*BasketItemNote, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateBasketItemNote")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var count int64
+	lockedRow := &BasketItemNoteORM{}
+	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
+	if hook, ok := interface{}(&ormObj).(BasketItemNoteORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(BasketItemNoteORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(BasketItemNoteORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		err = gateway.SetCreated(ctx, "")
+	}
+	return &pbResponse, err
+}
+
+type BasketItemNoteORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type BasketItemNoteORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type BasketItemNoteORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultUpsertBasketItemNote executes a gorm create call resolving conflicts on conflictTarget,
+// the name of a unique index or the primary key when empty, by updating the columns
+// in updateMask, all of them when updateMask is empty, and returns the stored object
+func DefaultUpsertBasketItemNote(ctx context.Context, in *BasketItemNote, conflictTarget string, updateMask *field_mask.FieldMask, db *gorm.DB) (*BasketItemNote, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conflict := clause.OnConflict{}
+	var lookup func() map[string]interface{}
+	switch conflictTarget {
+	case "":
+		conflict.Columns = []clause.Column{{Name: "id"}}
+		lookup = func() map[string]interface{} { return map[string]interface{}{"id": ormObj.Id} }
+	default:
+		return nil, fmt.Errorf("unknown conflict target %q of BasketItemNote", conflictTarget)
+	}
+	if len(updateMask.GetPaths()) == 0 {
+		conflict.UpdateAll = true
+	} else {
+		var columns []string
+		for _, path := range updateMask.GetPaths() {
+			var pathColumns []string
+			switch path {
+			case "Text":
+				pathColumns = []string{"text"}
+			default:
+				return nil, &errors.InvalidFieldMaskError{Path: path}
+			}
+			for _, column := range pathColumns {
+				for _, target := range conflict.Columns {
+					if column == target.Name {
+						return nil, &errors.InvalidFieldMaskError{Path: path}
+					}
+				}
+			}
+			columns = append(columns, pathColumns...)
+		}
+		conflict.DoUpdates = clause.AssignmentColumns(columns)
+	}
+	if hook, ok := interface{}(&ormObj).(BasketItemNoteORMWithBeforeUpsert); ok {
+		if db, err = hook.BeforeUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Clauses(conflict).Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if len(updateMask.GetPaths()) > 0 {
+		stored := BasketItemNoteORM{}
+		if err = db.Where(lookup()).Take(&stored).Error; err != nil {
+			return nil, err
+		}
+		ormObj = stored
+	}
+	if hook, ok := interface{}(&ormObj).(BasketItemNoteORMWithAfterUpsert); ok {
+		if err = hook.AfterUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type BasketItemNoteORMWithBeforeUpsert interface {
+	BeforeUpsert(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type BasketItemNoteORMWithAfterUpsert interface {
+	AfterUpsert(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchBasketItemNote executes a basic gorm update call with patch behavior
+func DefaultPatchBasketItemNote(ctx context.Context, in *BasketItemNote, updateMask *field_mask.FieldMask, db *gorm.DB) (*BasketItemNote, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	pbObj := &BasketItemNote{}
+	var err error
+	if hook, ok := interface{}(pbObj).(BasketItemNoteWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if pbObj, err = DefaultReadBasketItemNote(ctx, &BasketItemNote{Id: in.GetId()}, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbObj).(BasketItemNoteWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskBasketItemNote(ctx, pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbObj).(BasketItemNoteWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateBasketItemNote(ctx, pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(BasketItemNoteWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type BasketItemNoteWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *BasketItemNote, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type BasketItemNoteWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *BasketItemNote, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type BasketItemNoteWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *BasketItemNote, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type BasketItemNoteWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *BasketItemNote, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetBasketItemNote patches the objects in a transaction: the masks are applied in memory to
+// the stored objects, read and locked with a single query, and the objects are written with an update
+// per batch of 100 objects. The objects are read and written with the handles their hooks
+// return, the objects of different handles don't share the queries. Only the associations in the
+// masks are updated, and objects sharing a primary key are rejected
+func DefaultPatchSetBasketItemNote(ctx context.Context, objects []*BasketItemNote, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*BasketItemNote, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+	if len(objects) == 0 {
+		return []*BasketItemNote{}, nil
+	}
+	var err error
+	objectKeys := make([]uint64, 0, len(objects))
+	seen := make(map[uint64]struct{}, len(objects))
+	for _, in := range objects {
+		if in == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := in.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if ormObj.Id == 0 {
+			return nil, errors.EmptyIdError
+		}
+		key := ormObj.Id
+		if _, ok := seen[key]; ok {
+			return nil, errors.DuplicateKeyError
+		}
+		seen[key] = struct{}{}
+		objectKeys = append(objectKeys, key)
+	}
+	batchSize := 100
+	results := make([]*BasketItemNote, len(objects))
+	err = db.Transaction(func(db *gorm.DB) error {
+		var err error
+		// the objects sharing the handle their hooks return share the queries
+		dbs := make([]*gorm.DB, len(objects))
+		group := func() ([]*gorm.DB, map[*gorm.DB][]int) {
+			var handles []*gorm.DB
+			groups := make(map[*gorm.DB][]int)
+			for i, handle := range dbs {
+				if _, ok := groups[handle]; !ok {
+					handles = append(handles, handle)
+				}
+				groups[handle] = append(groups[handle], i)
+			}
+			return handles, groups
+		}
+		for i, in := range objects {
+			dbs[i] = db
+			if hook, ok := interface{}(&BasketItemNote{}).(BasketItemNoteWithBeforePatchRead); ok {
+				if dbs[i], err = hook.BeforePatchRead(ctx, in, updateMasks[i], db); err != nil {
+					return err
+				}
+			}
+		}
+		targets := make(map[uint64]BasketItemNoteORM, len(objects))
+		handles, groups := group()
+		for _, handle := range handles {
+			keys := make([]uint64, 0, len(groups[handle]))
+			for _, i := range groups[handle] {
+				keys = append(keys, objectKeys[i])
+			}
+			query := handle.Session(&gorm.Session{}).Clauses(clause.Locking{Strength: "UPDATE"})
+			query = query.Where("id in (?)", keys)
+			rows := []BasketItemNoteORM{}
+			if err = query.Find(&rows).Error; err != nil {
+				return err
+			}
+			for _, row := range rows {
+				targets[row.Id] = row
+			}
+		}
+		ormObjs := make([]BasketItemNoteORM, len(objects))
+		prepare := func(i int) (*gorm.DB, error) {
+			in, key, updateMask, db := objects[i], objectKeys[i], updateMasks[i], dbs[i]
+			target, ok := targets[key]
+			if !ok {
+				return nil, gorm.ErrRecordNotFound
+			}
+			stored, err := target.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbObj := &stored
+			if hook, ok := interface{}(pbObj).(BasketItemNoteWithBeforePatchApplyFieldMask); ok {
+				if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			if _, err := DefaultApplyFieldMaskBasketItemNote(ctx, pbObj, in, updateMask, "", db); err != nil {
+				return nil, err
+			}
+			if hook, ok := interface{}(pbObj).(BasketItemNoteWithBeforePatchSave); ok {
+				if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObj, err := pbObj.ToORM(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if hook, ok := interface{}(&ormObj).(BasketItemNoteORMWithBeforeStrictUpdateCleanup); ok {
+				if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			if hook, ok := interface{}(&ormObj).(BasketItemNoteORMWithBeforeStrictUpdateSave); ok {
+				if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObjs[i] = ormObj
+			return db, nil
+		}
+		for i := range objects {
+			if dbs[i], err = prepare(i); err != nil {
+				return err
+			}
+		}
+		write := func(db *gorm.DB, batch []int) error {
+			conds := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*2)
+			for _, i := range batch {
+				conds = append(conds, "(id = ?)")
+				args = append(args, objectKeys[i])
+			}
+			column := func(name string, value func(ormObj *BasketItemNoteORM) interface{}) clause.Expr {
+				whens := make([]interface{}, 0, len(batch)*2)
+				for _, i := range batch {
+					whens = append(whens, objectKeys[i], value(&ormObjs[i]))
+				}
+				return gorm.Expr("CASE "+strings.Repeat("WHEN id = ? THEN ? ", len(batch))+"ELSE "+name+" END", whens...)
+			}
+			res := db.Session(&gorm.Session{}).Model(&BasketItemNoteORM{}).Where(strings.Join(conds, " OR "), args...).Updates(map[string]interface{}{
+				"basket_item_id": column("basket_item_id", func(ormObj *BasketItemNoteORM) interface{} { return ormObj.BasketItemId }),
+				"text":           column("text", func(ormObj *BasketItemNoteORM) interface{} { return ormObj.Text }),
+			})
+			return res.Error
+		}
+		handles, groups = group()
+		for _, handle := range handles {
+			indexes := groups[handle]
+			for start := 0; start < len(indexes); start += batchSize {
+				end := start + batchSize
+				if end > len(indexes) {
+					end = len(indexes)
+				}
+				batch := indexes[start:end]
+				if err = write(handle, batch); err != nil {
+					return err
+				}
+			}
+		}
+		finish := func(i int) (*BasketItemNote, error) {
+			in, updateMask, db, ormObj := objects[i], updateMasks[i], dbs[i], ormObjs[i]
+			var err error
+			if hook, ok := interface{}(&ormObj).(BasketItemNoteORMWithAfterStrictUpdateSave); ok {
+				if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			saved, err := ormObj.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbResponse := &saved
+			if hook, ok := interface{}(pbResponse).(BasketItemNoteWithAfterPatchSave); ok {
+				if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			return pbResponse, nil
+		}
+		for i := range objects {
+			if results[i], err = finish(i); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// DefaultApplyFieldMaskBasketItemNote patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskBasketItemNote(ctx context.Context, patchee *BasketItemNote, patcher *BasketItemNote, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*BasketItemNote, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	if len(updateMask.GetPaths()) == 1 && updateMask.Paths[0] == prefix+"*" {
+		updateMask = &field_mask.FieldMask{Paths: []string{prefix + "Id", prefix + "Text"}}
+	}
+	for _, f := range updateMask.GetPaths() {
+		if strings.HasPrefix(f, prefix) && !DefaultHasFieldMaskPathBasketItemNote(strings.TrimPrefix(f, prefix)) {
+			return nil, &errors.InvalidFieldMaskError{Path: f}
+		}
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"Text" {
+			patchee.Text = patcher.Text
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultHasFieldMaskPathBasketItemNote reports whether BasketItemNote has the field of the field mask path
+func DefaultHasFieldMaskPathBasketItemNote(path string) bool {
+	parts := strings.SplitN(path, ".", 2)
+	switch parts[0] {
+	case "Id", "Text":
+		return len(parts) == 1
+	}
+	return false
+}
+
+// DefaultListBasketItemNote executes a gorm list call
+func DefaultListBasketItemNote(ctx context.Context, db *gorm.DB) ([]*BasketItemNote, error) {
+	in := BasketItemNote{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(BasketItemNoteORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(BasketItemNoteORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []BasketItemNoteORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(BasketItemNoteORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*BasketItemNote{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type BasketItemNoteORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type BasketItemNoteORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type BasketItemNoteORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]BasketItemNoteORM) error
+}
+
+// DefaultCreateBasketLabel executes a basic gorm create call
+func DefaultCreateBasketLabel(ctx context.Context, in *BasketLabel, db *gorm.DB) (*BasketLabel, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(BasketLabelORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(BasketLabelORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type BasketLabelORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type BasketLabelORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultCreateBasketLabelSet executes gorm create calls in batches of 100 objects
+// in a transaction, either all of the objects are created or none of them. The BeforeCreate_ hook
+// of each object runs before the insert of its batch, which uses the handle the hooks of the
+// batch return
+func DefaultCreateBasketLabelSet(ctx context.Context, in []*BasketLabel, db *gorm.DB) ([]*BasketLabel, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObjs := make([]BasketLabelORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, ormObj)
+	}
+	batchSize := 100
+	err := db.Transaction(func(db *gorm.DB) error {
+		var err error
+		for start := 0; start < len(ormObjs); start += batchSize {
+			end := start + batchSize
+			if end > len(ormObjs) {
+				end = len(ormObjs)
+			}
+			batch := ormObjs[start:end]
+			// the scopes the hooks add only apply to the insert of their own batch
+			batchDB := db
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(BasketLabelORMWithBeforeCreate_); ok {
+					if batchDB, err = hook.BeforeCreate_(ctx, batchDB); err != nil {
+						return err
+					}
+				}
+			}
+			if err = batchDB.Omit().CreateInBatches(&batch, batchSize).Error; err != nil {
+				return err
+			}
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(BasketLabelORMWithAfterCreate_); ok {
+					if err = hook.AfterCreate_(ctx, db); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*BasketLabel, 0, len(ormObjs))
+	for i := range ormObjs {
+		pbResponse, err := ormObjs[i].ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, &pbResponse)
+	}
+	return results, nil
+}
+
+func DefaultReadBasketLabel(ctx context.Context, in *BasketLabel, db *gorm.DB) (*BasketLabel, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(BasketLabelORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(BasketLabelORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := BasketLabelORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(BasketLabelORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type BasketLabelORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type BasketLabelORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type BasketLabelORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteBasketLabel(ctx context.Context, in *BasketLabel, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(BasketLabelORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&BasketLabelORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(BasketLabelORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type BasketLabelORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type BasketLabelORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteBasketLabelSet(ctx context.Context, in []*BasketLabel, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []uint64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&BasketLabelORM{})).(BasketLabelORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&BasketLabelORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&BasketLabelORM{})).(BasketLabelORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type BasketLabelORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*BasketLabel, *gorm.DB) (*gorm.DB, error)
+}
+type BasketLabelORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*BasketLabel, *gorm.DB) error
+}
+
+// DefaultStrictUpdateBasketLabel clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateBasketLabel(ctx context.Context, in *BasketLabel, db *gorm.DB) (*BasketLabel, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateBasketLabel")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var count int64
+	lockedRow := &BasketLabelORM{}
+	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
+	if hook, ok := interface{}(&ormObj).(BasketLabelORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(BasketLabelORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(BasketLabelORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		err = gateway.SetCreated(ctx, "")
+	}
+	return &pbResponse, err
+}
+
+type BasketLabelORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type BasketLabelORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type BasketLabelORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultUpsertBasketLabel executes a gorm create call resolving conflicts on conflictTarget,
+// the name of a unique index or the primary key when empty, by updating the columns
+// in updateMask, all of them when updateMask is empty, and returns the stored object
+func DefaultUpsertBasketLabel(ctx context.Context, in *BasketLabel, conflictTarget string, updateMask *field_mask.FieldMask, db *gorm.DB) (*BasketLabel, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conflict := clause.OnConflict{}
+	var lookup func() map[string]interface{}
+	switch conflictTarget {
+	case "":
+		conflict.Columns = []clause.Column{{Name: "id"}}
+		lookup = func() map[string]interface{} { return map[string]interface{}{"id": ormObj.Id} }
+	default:
+		return nil, fmt.Errorf("unknown conflict target %q of BasketLabel", conflictTarget)
+	}
+	if len(updateMask.GetPaths()) == 0 {
+		conflict.UpdateAll = true
+	} else {
+		var columns []string
+		for _, path := range updateMask.GetPaths() {
+			var pathColumns []string
+			switch path {
+			case "Name":
+				pathColumns = []string{"name"}
+			default:
+				return nil, &errors.InvalidFieldMaskError{Path: path}
+			}
+			for _, column := range pathColumns {
+				for _, target := range conflict.Columns {
+					if column == target.Name {
+						return nil, &errors.InvalidFieldMaskError{Path: path}
+					}
+				}
+			}
+			columns = append(columns, pathColumns...)
+		}
+		conflict.DoUpdates = clause.AssignmentColumns(columns)
+	}
+	if hook, ok := interface{}(&ormObj).(BasketLabelORMWithBeforeUpsert); ok {
+		if db, err = hook.BeforeUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Clauses(conflict).Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if len(updateMask.GetPaths()) > 0 {
+		stored := BasketLabelORM{}
+		if err = db.Where(lookup()).Take(&stored).Error; err != nil {
+			return nil, err
+		}
+		ormObj = stored
+	}
+	if hook, ok := interface{}(&ormObj).(BasketLabelORMWithAfterUpsert); ok {
+		if err = hook.AfterUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type BasketLabelORMWithBeforeUpsert interface {
+	BeforeUpsert(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type BasketLabelORMWithAfterUpsert interface {
+	AfterUpsert(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchBasketLabel executes a basic gorm update call with patch behavior
+func DefaultPatchBasketLabel(ctx context.Context, in *BasketLabel, updateMask *field_mask.FieldMask, db *gorm.DB) (*BasketLabel, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	pbObj := &BasketLabel{}
+	var err error
+	if hook, ok := interface{}(pbObj).(BasketLabelWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if pbObj, err = DefaultReadBasketLabel(ctx, &BasketLabel{Id: in.GetId()}, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbObj).(BasketLabelWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskBasketLabel(ctx, pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbObj).(BasketLabelWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateBasketLabel(ctx, pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(BasketLabelWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type BasketLabelWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *BasketLabel, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type BasketLabelWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *BasketLabel, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type BasketLabelWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *BasketLabel, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type BasketLabelWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *BasketLabel, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetBasketLabel patches the objects in a transaction: the masks are applied in memory to
+// the stored objects, read and locked with a single query, and the objects are written with an update
+// per batch of 100 objects. The objects are read and written with the handles their hooks
+// return, the objects of different handles don't share the queries. Only the associations in the
+// masks are updated, and objects sharing a primary key are rejected
+func DefaultPatchSetBasketLabel(ctx context.Context, objects []*BasketLabel, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*BasketLabel, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+	if len(objects) == 0 {
+		return []*BasketLabel{}, nil
+	}
+	var err error
+	objectKeys := make([]uint64, 0, len(objects))
+	seen := make(map[uint64]struct{}, len(objects))
+	for _, in := range objects {
+		if in == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := in.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if ormObj.Id == 0 {
+			return nil, errors.EmptyIdError
+		}
+		key := ormObj.Id
+		if _, ok := seen[key]; ok {
+			return nil, errors.DuplicateKeyError
+		}
+		seen[key] = struct{}{}
+		objectKeys = append(objectKeys, key)
+	}
+	batchSize := 100
+	results := make([]*BasketLabel, len(objects))
+	err = db.Transaction(func(db *gorm.DB) error {
+		var err error
+		// the objects sharing the handle their hooks return share the queries
+		dbs := make([]*gorm.DB, len(objects))
+		group := func() ([]*gorm.DB, map[*gorm.DB][]int) {
+			var handles []*gorm.DB
+			groups := make(map[*gorm.DB][]int)
+			for i, handle := range dbs {
+				if _, ok := groups[handle]; !ok {
+					handles = append(handles, handle)
+				}
+				groups[handle] = append(groups[handle], i)
+			}
+			return handles, groups
+		}
+		for i, in := range objects {
+			dbs[i] = db
+			if hook, ok := interface{}(&BasketLabel{}).(BasketLabelWithBeforePatchRead); ok {
+				if dbs[i], err = hook.BeforePatchRead(ctx, in, updateMasks[i], db); err != nil {
+					return err
+				}
+			}
+		}
+		targets := make(map[uint64]BasketLabelORM, len(objects))
+		handles, groups := group()
+		for _, handle := range handles {
+			keys := make([]uint64, 0, len(groups[handle]))
+			for _, i := range groups[handle] {
+				keys = append(keys, objectKeys[i])
+			}
+			query := handle.Session(&gorm.Session{}).Clauses(clause.Locking{Strength: "UPDATE"})
+			query = query.Where("id in (?)", keys)
+			rows := []BasketLabelORM{}
+			if err = query.Find(&rows).Error; err != nil {
+				return err
+			}
+			for _, row := range rows {
+				targets[row.Id] = row
+			}
+		}
+		ormObjs := make([]BasketLabelORM, len(objects))
+		prepare := func(i int) (*gorm.DB, error) {
+			in, key, updateMask, db := objects[i], objectKeys[i], updateMasks[i], dbs[i]
+			target, ok := targets[key]
+			if !ok {
+				return nil, gorm.ErrRecordNotFound
+			}
+			stored, err := target.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbObj := &stored
+			if hook, ok := interface{}(pbObj).(BasketLabelWithBeforePatchApplyFieldMask); ok {
+				if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			if _, err := DefaultApplyFieldMaskBasketLabel(ctx, pbObj, in, updateMask, "", db); err != nil {
+				return nil, err
+			}
+			if hook, ok := interface{}(pbObj).(BasketLabelWithBeforePatchSave); ok {
+				if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObj, err := pbObj.ToORM(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if hook, ok := interface{}(&ormObj).(BasketLabelORMWithBeforeStrictUpdateCleanup); ok {
+				if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			if hook, ok := interface{}(&ormObj).(BasketLabelORMWithBeforeStrictUpdateSave); ok {
+				if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObjs[i] = ormObj
+			return db, nil
+		}
+		for i := range objects {
+			if dbs[i], err = prepare(i); err != nil {
+				return err
+			}
+		}
+		write := func(db *gorm.DB, batch []int) error {
+			conds := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*2)
+			for _, i := range batch {
+				conds = append(conds, "(id = ?)")
+				args = append(args, objectKeys[i])
+			}
+			column := func(name string, value func(ormObj *BasketLabelORM) interface{}) clause.Expr {
+				whens := make([]interface{}, 0, len(batch)*2)
+				for _, i := range batch {
+					whens = append(whens, objectKeys[i], value(&ormObjs[i]))
+				}
+				return gorm.Expr("CASE "+strings.Repeat("WHEN id = ? THEN ? ", len(batch))+"ELSE "+name+" END", whens...)
+			}
+			res := db.Session(&gorm.Session{}).Model(&BasketLabelORM{}).Where(strings.Join(conds, " OR "), args...).Updates(map[string]interface{}{
+				"name": column("name", func(ormObj *BasketLabelORM) interface{} { return ormObj.Name }),
+			})
+			return res.Error
+		}
+		handles, groups = group()
+		for _, handle := range handles {
+			indexes := groups[handle]
+			for start := 0; start < len(indexes); start += batchSize {
+				end := start + batchSize
+				if end > len(indexes) {
+					end = len(indexes)
+				}
+				batch := indexes[start:end]
+				if err = write(handle, batch); err != nil {
+					return err
+				}
+			}
+		}
+		finish := func(i int) (*BasketLabel, error) {
+			in, updateMask, db, ormObj := objects[i], updateMasks[i], dbs[i], ormObjs[i]
+			var err error
+			if hook, ok := interface{}(&ormObj).(BasketLabelORMWithAfterStrictUpdateSave); ok {
+				if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			saved, err := ormObj.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbResponse := &saved
+			if hook, ok := interface{}(pbResponse).(BasketLabelWithAfterPatchSave); ok {
+				if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			return pbResponse, nil
+		}
+		for i := range objects {
+			if results[i], err = finish(i); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// DefaultApplyFieldMaskBasketLabel patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskBasketLabel(ctx context.Context, patchee *BasketLabel, patcher *BasketLabel, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*BasketLabel, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	if len(updateMask.GetPaths()) == 1 && updateMask.Paths[0] == prefix+"*" {
+		updateMask = &field_mask.FieldMask{Paths: []string{prefix + "Id", prefix + "Name"}}
+	}
+	for _, f := range updateMask.GetPaths() {
+		if strings.HasPrefix(f, prefix) && !DefaultHasFieldMaskPathBasketLabel(strings.TrimPrefix(f, prefix)) {
+			return nil, &errors.InvalidFieldMaskError{Path: f}
+		}
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"Name" {
+			patchee.Name = patcher.Name
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultHasFieldMaskPathBasketLabel reports whether BasketLabel has the field of the field mask path
+func DefaultHasFieldMaskPathBasketLabel(path string) bool {
+	parts := strings.SplitN(path, ".", 2)
+	switch parts[0] {
+	case "Id", "Name":
+		return len(parts) == 1
+	}
+	return false
+}
+
+// DefaultListBasketLabel executes a gorm list call
+func DefaultListBasketLabel(ctx context.Context, db *gorm.DB) ([]*BasketLabel, error) {
+	in := BasketLabel{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(BasketLabelORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(BasketLabelORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []BasketLabelORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(BasketLabelORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*BasketLabel{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type BasketLabelORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type BasketLabelORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type BasketLabelORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]BasketLabelORM) error
+}
+
+// DefaultCreateTeam executes a basic gorm create call
+func DefaultCreateTeam(ctx context.Context, in *Team, db *gorm.DB) (*Team, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TeamORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if err = DefaultSaveTeamPositions(ctx, db.Session(&gorm.Session{NewDB: true}), &ormObj); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TeamORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type TeamORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TeamORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultCreateTeamSet executes gorm create calls in batches of 100 objects
+// in a transaction, either all of the objects are created or none of them. The BeforeCreate_ hook
+// of each object runs before the insert of its batch, which uses the handle the hooks of the
+// batch return
+func DefaultCreateTeamSet(ctx context.Context, in []*Team, db *gorm.DB) ([]*Team, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObjs := make([]TeamORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, ormObj)
+	}
+	batchSize := 100
+	err := db.Transaction(func(db *gorm.DB) error {
+		var err error
+		for start := 0; start < len(ormObjs); start += batchSize {
+			end := start + batchSize
+			if end > len(ormObjs) {
+				end = len(ormObjs)
+			}
+			batch := ormObjs[start:end]
+			// the scopes the hooks add only apply to the insert of their own batch
+			batchDB := db
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(TeamORMWithBeforeCreate_); ok {
+					if batchDB, err = hook.BeforeCreate_(ctx, batchDB); err != nil {
+						return err
+					}
+				}
+			}
+			if err = batchDB.Omit().CreateInBatches(&batch, batchSize).Error; err != nil {
+				return err
+			}
+			for i := range batch {
+				if err = DefaultSaveTeamPositions(ctx, db.Session(&gorm.Session{NewDB: true}), &batch[i]); err != nil {
+					return err
+				}
+				if hook, ok := interface{}(&batch[i]).(TeamORMWithAfterCreate_); ok {
+					if err = hook.AfterCreate_(ctx, db); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*Team, 0, len(ormObjs))
+	for i := range ormObjs {
+		pbResponse, err := ormObjs[i].ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, &pbResponse)
+	}
+	return results, nil
+}
+
+func DefaultReadTeam(ctx context.Context, in *Team, db *gorm.DB) (*Team, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(TeamORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(TeamORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := TeamORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if err = DefaultSortTeamPositions(ctx, db.Session(&gorm.Session{NewDB: true}), &ormResponse); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(TeamORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type TeamORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TeamORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TeamORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteTeam(ctx context.Context, in *Team, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(TeamORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&TeamORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(TeamORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type TeamORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TeamORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteTeamSet(ctx context.Context, in []*Team, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []uint64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&TeamORM{})).(TeamORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&TeamORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&TeamORM{})).(TeamORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type TeamORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Team, *gorm.DB) (*gorm.DB, error)
+}
+type TeamORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Team, *gorm.DB) error
+}
+
+// DefaultStrictUpdateTeam clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateTeam(ctx context.Context, in *Team, db *gorm.DB) (*Team, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateTeam")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var count int64
+	lockedRow := &TeamORM{}
+	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
+	if hook, ok := interface{}(&ormObj).(TeamORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Model(&ormObj).Association("Members").Replace(ormObj.Members); err != nil {
+		return nil, err
+	}
+	ormObj.Members = nil
+	filterMemberships := MembershipORM{}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	filterMemberships.TeamId = ormObj.Id
+	if err = db.Where(filterMemberships).Delete(MembershipORM{}).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TeamORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if err = DefaultSaveTeamPositions(ctx, db.Session(&gorm.Session{NewDB: true}), &ormObj); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TeamORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		err = gateway.SetCreated(ctx, "")
+	}
+	return &pbResponse, err
+}
+
+type TeamORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TeamORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TeamORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultUpsertTeam executes a gorm create call resolving conflicts on conflictTarget,
+// the name of a unique index or the primary key when empty, by updating the columns
+// in updateMask, all of them when updateMask is empty, and returns the stored object
+func DefaultUpsertTeam(ctx context.Context, in *Team, conflictTarget string, updateMask *field_mask.FieldMask, db *gorm.DB) (*Team, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conflict := clause.OnConflict{}
+	var lookup func() map[string]interface{}
+	switch conflictTarget {
+	case "":
+		conflict.Columns = []clause.Column{{Name: "id"}}
+		lookup = func() map[string]interface{} { return map[string]interface{}{"id": ormObj.Id} }
+	default:
+		return nil, fmt.Errorf("unknown conflict target %q of Team", conflictTarget)
+	}
+	if len(updateMask.GetPaths()) == 0 {
+		conflict.UpdateAll = true
+	} else {
+		var columns []string
+		for _, path := range updateMask.GetPaths() {
+			var pathColumns []string
+			switch path {
+			case "Name":
+				pathColumns = []string{"name"}
+			default:
+				return nil, &errors.InvalidFieldMaskError{Path: path}
+			}
+			for _, column := range pathColumns {
+				for _, target := range conflict.Columns {
+					if column == target.Name {
+						return nil, &errors.InvalidFieldMaskError{Path: path}
+					}
+				}
+			}
+			columns = append(columns, pathColumns...)
+		}
+		conflict.DoUpdates = clause.AssignmentColumns(columns)
+	}
+	if hook, ok := interface{}(&ormObj).(TeamORMWithBeforeUpsert); ok {
+		if db, err = hook.BeforeUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Clauses(conflict).Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if err = DefaultSaveTeamPositions(ctx, db.Session(&gorm.Session{NewDB: true}), &ormObj); err != nil {
+		return nil, err
+	}
+	if len(updateMask.GetPaths()) > 0 {
+		stored := TeamORM{}
+		if err = db.Where(lookup()).Take(&stored).Error; err != nil {
+			return nil, err
+		}
+		ormObj = stored
+	}
+	if hook, ok := interface{}(&ormObj).(TeamORMWithAfterUpsert); ok {
+		if err = hook.AfterUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type TeamORMWithBeforeUpsert interface {
+	BeforeUpsert(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TeamORMWithAfterUpsert interface {
+	AfterUpsert(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchTeam executes a basic gorm update call with patch behavior
+func DefaultPatchTeam(ctx context.Context, in *Team, updateMask *field_mask.FieldMask, db *gorm.DB) (*Team, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	pbObj := &Team{}
+	var err error
+	if hook, ok := interface{}(pbObj).(TeamWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if pbObj, err = DefaultReadTeam(ctx, &Team{Id: in.GetId()}, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbObj).(TeamWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskTeam(ctx, pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbObj).(TeamWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateTeam(ctx, pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(TeamWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type TeamWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Team, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type TeamWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Team, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type TeamWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Team, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type TeamWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Team, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetTeam patches the objects in a transaction: the masks are applied in memory to
+// the stored objects, read and locked with a single query, and the objects are written with an update
+// per batch of 100 objects. The objects are read and written with the handles their hooks
+// return, the objects of different handles don't share the queries. Only the associations in the
+// masks are updated, and objects sharing a primary key are rejected
+func DefaultPatchSetTeam(ctx context.Context, objects []*Team, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Team, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+	if len(objects) == 0 {
+		return []*Team{}, nil
+	}
+	var err error
+	objectKeys := make([]uint64, 0, len(objects))
+	seen := make(map[uint64]struct{}, len(objects))
+	for _, in := range objects {
+		if in == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := in.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if ormObj.Id == 0 {
+			return nil, errors.EmptyIdError
+		}
+		key := ormObj.Id
+		if _, ok := seen[key]; ok {
+			return nil, errors.DuplicateKeyError
+		}
+		seen[key] = struct{}{}
+		objectKeys = append(objectKeys, key)
+	}
+	batchSize := 100
+	results := make([]*Team, len(objects))
+	err = db.Transaction(func(db *gorm.DB) error {
+		var err error
+		// the objects sharing the handle their hooks return share the queries
+		dbs := make([]*gorm.DB, len(objects))
+		group := func() ([]*gorm.DB, map[*gorm.DB][]int) {
+			var handles []*gorm.DB
+			groups := make(map[*gorm.DB][]int)
+			for i, handle := range dbs {
+				if _, ok := groups[handle]; !ok {
+					handles = append(handles, handle)
+				}
+				groups[handle] = append(groups[handle], i)
+			}
+			return handles, groups
+		}
+		for i, in := range objects {
+			dbs[i] = db
+			if hook, ok := interface{}(&Team{}).(TeamWithBeforePatchRead); ok {
+				if dbs[i], err = hook.BeforePatchRead(ctx, in, updateMasks[i], db); err != nil {
+					return err
+				}
+			}
+		}
+		targets := make(map[uint64]TeamORM, len(objects))
+		handles, groups := group()
+		for _, handle := range handles {
+			keys := make([]uint64, 0, len(groups[handle]))
+			for _, i := range groups[handle] {
+				keys = append(keys, objectKeys[i])
+			}
+			query := handle.Session(&gorm.Session{}).Clauses(clause.Locking{Strength: "UPDATE"})
+			query = query.Where("id in (?)", keys)
+			rows := []TeamORM{}
+			if err = query.Find(&rows).Error; err != nil {
+				return err
+			}
+			for _, row := range rows {
+				targets[row.Id] = row
+			}
+		}
+		ormObjs := make([]TeamORM, len(objects))
+		prepare := func(i int) (*gorm.DB, error) {
+			in, key, updateMask, db := objects[i], objectKeys[i], updateMasks[i], dbs[i]
+			target, ok := targets[key]
+			if !ok {
+				return nil, gorm.ErrRecordNotFound
+			}
+			stored, err := target.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbObj := &stored
+			if hook, ok := interface{}(pbObj).(TeamWithBeforePatchApplyFieldMask); ok {
+				if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			if _, err := DefaultApplyFieldMaskTeam(ctx, pbObj, in, updateMask, "", db); err != nil {
+				return nil, err
+			}
+			if hook, ok := interface{}(pbObj).(TeamWithBeforePatchSave); ok {
+				if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObj, err := pbObj.ToORM(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if hook, ok := interface{}(&ormObj).(TeamORMWithBeforeStrictUpdateCleanup); ok {
+				if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			masked := make(map[string]bool, len(updateMask.GetPaths()))
+			for _, path := range updateMask.GetPaths() {
+				masked[strings.SplitN(path, ".", 2)[0]] = true
+			}
+			if masked["Members"] || masked["*"] {
+				if err = db.Model(&ormObj).Association("Members").Replace(ormObj.Members); err != nil {
+					return nil, err
+				}
+				ormObj.Members = nil
+			}
+			if masked["Memberships"] || masked["*"] {
+				filterMemberships := MembershipORM{}
+				if ormObj.Id == 0 {
+					return nil, errors.EmptyIdError
+				}
+				filterMemberships.TeamId = ormObj.Id
+				if err = db.Where(filterMemberships).Delete(MembershipORM{}).Error; err != nil {
+					return nil, err
+				}
+			}
+			if hook, ok := interface{}(&ormObj).(TeamORMWithBeforeStrictUpdateSave); ok {
+				if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObjs[i] = ormObj
+			return db, nil
+		}
+		for i := range objects {
+			if dbs[i], err = prepare(i); err != nil {
+				return err
+			}
+		}
+		write := func(db *gorm.DB, batch []int) error {
+			conds := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*2)
+			for _, i := range batch {
+				conds = append(conds, "(id = ?)")
+				args = append(args, objectKeys[i])
+			}
+			column := func(name string, value func(ormObj *TeamORM) interface{}) clause.Expr {
+				whens := make([]interface{}, 0, len(batch)*2)
+				for _, i := range batch {
+					whens = append(whens, objectKeys[i], value(&ormObjs[i]))
+				}
+				return gorm.Expr("CASE "+strings.Repeat("WHEN id = ? THEN ? ", len(batch))+"ELSE "+name+" END", whens...)
+			}
+			res := db.Session(&gorm.Session{}).Model(&TeamORM{}).Where(strings.Join(conds, " OR "), args...).Updates(map[string]interface{}{
+				"name": column("name", func(ormObj *TeamORM) interface{} { return ormObj.Name }),
+			})
+			return res.Error
+		}
+		handles, groups = group()
+		for _, handle := range handles {
+			indexes := groups[handle]
+			for start := 0; start < len(indexes); start += batchSize {
+				end := start + batchSize
+				if end > len(indexes) {
+					end = len(indexes)
+				}
+				batch := indexes[start:end]
+				objs := make([]*TeamORM, 0, len(batch))
+				for _, i := range batch {
+					objs = append(objs, &ormObjs[i])
+				}
+				// the associations are saved first, the batch is then written with the
+				// foreign keys of its belongs-to associations
+				if err = handle.Session(&gorm.Session{}).Select("Members", "Memberships").Model(&objs).Updates(map[string]interface{}{}).Error; err != nil {
+					return err
+				}
+				if err = write(handle, batch); err != nil {
+					return err
+				}
+				if err = DefaultSaveTeamPositions(ctx, db.Session(&gorm.Session{NewDB: true}), objs...); err != nil {
+					return err
+				}
+			}
+		}
+		finish := func(i int) (*Team, error) {
+			in, updateMask, db, ormObj := objects[i], updateMasks[i], dbs[i], ormObjs[i]
+			var err error
+			if hook, ok := interface{}(&ormObj).(TeamORMWithAfterStrictUpdateSave); ok {
+				if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			saved, err := ormObj.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbResponse := &saved
+			if hook, ok := interface{}(pbResponse).(TeamWithAfterPatchSave); ok {
+				if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			return pbResponse, nil
+		}
+		for i := range objects {
+			if results[i], err = finish(i); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// DefaultAddMembersToTeam adds the stored objects to the Members of the object
+func DefaultAddMembersToTeam(ctx context.Context, in *Team, objects []*Person, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	owner := TeamORM{}
+	if err = db.Where(&TeamORM{Id: ormObj.Id}).First(&owner).Error; err != nil {
+		return err
+	}
+	keys := make(map[string]interface{}, len(objects))
+	for _, object := range objects {
+		if object == nil {
+			return errors.NilArgumentError
+		}
+		child, err := object.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if child.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys[fmt.Sprintf("%#v", child.Id)] = child.Id
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	values := make([]interface{}, 0, len(keys))
+	for _, value := range keys {
+		values = append(values, value)
+	}
+	children := []PersonORM{}
+	if err = db.Where("id IN ?", values).Find(&children).Error; err != nil {
+		return err
+	}
+	if len(children) != len(values) {
+		return gorm.ErrRecordNotFound
+	}
+	return db.Model(&owner).Association("Members").Append(&children)
+}
+
+// DefaultRemoveMembersFromTeam removes the objects from the Members of the object
+func DefaultRemoveMembersFromTeam(ctx context.Context, in *Team, objects []*Person, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	owner := TeamORM{}
+	if err = db.Where(&TeamORM{Id: ormObj.Id}).First(&owner).Error; err != nil {
+		return err
+	}
+	children := make([]PersonORM, 0, len(objects))
+	for _, object := range objects {
+		if object == nil {
+			return errors.NilArgumentError
+		}
+		child, err := object.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if child.Id == 0 {
+			return errors.EmptyIdError
+		}
+		children = append(children, child)
+	}
+	if len(children) == 0 {
+		return nil
+	}
+	return db.Model(&owner).Association("Members").Delete(&children)
+}
+
+// DefaultListMembersOfTeam returns the Members of the object
+func DefaultListMembersOfTeam(ctx context.Context, in *Team, db *gorm.DB) ([]*Person, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	owner := TeamORM{}
+	if err = db.Where(&TeamORM{Id: ormObj.Id}).First(&owner).Error; err != nil {
+		return nil, err
+	}
+	children := []PersonORM{}
+	if err = db.Model(&owner).Association("Members").Find(&children); err != nil {
+		return nil, err
+	}
+	pbResponse := make([]*Person, 0, len(children))
+	for _, child := range children {
+		temp, err := child.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+// DefaultSaveTeamPositions stores the position of the children of the
+// many-to-many associations of the saved objects in their links
+func DefaultSaveTeamPositions(ctx context.Context, db *gorm.DB, objs ...*TeamORM) error {
+	for _, obj := range objs {
+		for i, child := range obj.Members {
+			if err := db.WithContext(ctx).Model(&MembershipORM{}).Where("team_id = ? AND person_id = ?", obj.Id, child.Id).Update("position", i).Error; err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// DefaultSortTeamPositions orders the children of the many-to-many
+// associations of the loaded objects by the position of their links
+func DefaultSortTeamPositions(ctx context.Context, db *gorm.DB, objs ...*TeamORM) error {
+	membersKeys := []uint64{}
+	for _, obj := range objs {
+		if len(obj.Members) > 1 {
+			membersKeys = append(membersKeys, obj.Id)
+		}
+	}
+	if len(membersKeys) > 0 {
+		membersLinks := []MembershipORM{}
+		if err := db.WithContext(ctx).Where("team_id IN ?", membersKeys).Find(&membersLinks).Error; err != nil {
+			return err
+		}
+		membersPositions := map[uint64]map[uint64]int{}
+		for _, link := range membersLinks {
+			if membersPositions[link.TeamId] == nil {
+				membersPositions[link.TeamId] = map[uint64]int{}
+			}
+			membersPositions[link.TeamId][link.PersonId] = int(link.Position)
+		}
+		for _, obj := range objs {
+			if len(obj.Members) < 2 {
+				continue
+			}
+			children, positions := obj.Members, membersPositions[obj.Id]
+			sort.SliceStable(children, func(i, j int) bool {
+				return positions[children[i].Id] < positions[children[j].Id]
+			})
+		}
+	}
+	return nil
+}
+
+// DefaultApplyFieldMaskTeam patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskTeam(ctx context.Context, patchee *Team, patcher *Team, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Team, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	if len(updateMask.GetPaths()) == 1 && updateMask.Paths[0] == prefix+"*" {
+		updateMask = &field_mask.FieldMask{Paths: []string{prefix + "Id", prefix + "Name", prefix + "Members", prefix + "Memberships"}}
+	}
+	for _, f := range updateMask.GetPaths() {
+		if strings.HasPrefix(f, prefix) && !DefaultHasFieldMaskPathTeam(strings.TrimPrefix(f, prefix)) {
+			return nil, &errors.InvalidFieldMaskError{Path: f}
+		}
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"Name" {
+			patchee.Name = patcher.Name
+			continue
+		}
+		if f == prefix+"Members" {
+			patchee.Members = patcher.Members
+			continue
+		}
+		if f == prefix+"Memberships" {
+			patchee.Memberships = patcher.Memberships
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultHasFieldMaskPathTeam reports whether Team has the field of the field mask path
+func DefaultHasFieldMaskPathTeam(path string) bool {
+	parts := strings.SplitN(path, ".", 2)
+	switch parts[0] {
+	case "Id", "Name", "Members", "Memberships":
+		return len(parts) == 1
+	}
+	return false
+}
+
+// DefaultListTeam executes a gorm list call
+func DefaultListTeam(ctx context.Context, db *gorm.DB) ([]*Team, error) {
+	in := Team{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TeamORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(TeamORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []TeamORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	positioned := make([]*TeamORM, len(ormResponse))
+	for i := range ormResponse {
+		positioned[i] = &ormResponse[i]
+	}
+	if err = DefaultSortTeamPositions(ctx, db.Session(&gorm.Session{NewDB: true}), positioned...); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TeamORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Team{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type TeamORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TeamORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TeamORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]TeamORM) error
+}
+
+// DefaultCreatePerson executes a basic gorm create call
+func DefaultCreatePerson(ctx context.Context, in *Person, db *gorm.DB) (*Person, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PersonORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PersonORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type PersonORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PersonORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultCreatePersonSet executes gorm create calls in batches of 100 objects
+// in a transaction, either all of the objects are created or none of them. The BeforeCreate_ hook
+// of each object runs before the insert of its batch, which uses the handle the hooks of the
+// batch return
+func DefaultCreatePersonSet(ctx context.Context, in []*Person, db *gorm.DB) ([]*Person, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObjs := make([]PersonORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, ormObj)
+	}
+	batchSize := 100
+	err := db.Transaction(func(db *gorm.DB) error {
+		var err error
+		for start := 0; start < len(ormObjs); start += batchSize {
+			end := start + batchSize
+			if end > len(ormObjs) {
+				end = len(ormObjs)
+			}
+			batch := ormObjs[start:end]
+			// the scopes the hooks add only apply to the insert of their own batch
+			batchDB := db
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(PersonORMWithBeforeCreate_); ok {
+					if batchDB, err = hook.BeforeCreate_(ctx, batchDB); err != nil {
+						return err
+					}
+				}
+			}
+			if err = batchDB.Omit().CreateInBatches(&batch, batchSize).Error; err != nil {
+				return err
+			}
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(PersonORMWithAfterCreate_); ok {
+					if err = hook.AfterCreate_(ctx, db); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*Person, 0, len(ormObjs))
+	for i := range ormObjs {
+		pbResponse, err := ormObjs[i].ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, &pbResponse)
+	}
+	return results, nil
+}
+
+func DefaultReadPerson(ctx context.Context, in *Person, db *gorm.DB) (*Person, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(PersonORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(PersonORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := PersonORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(PersonORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type PersonORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PersonORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PersonORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeletePerson(ctx context.Context, in *Person, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(PersonORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&PersonORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(PersonORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type PersonORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PersonORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeletePersonSet(ctx context.Context, in []*Person, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []uint64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&PersonORM{})).(PersonORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&PersonORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&PersonORM{})).(PersonORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type PersonORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Person, *gorm.DB) (*gorm.DB, error)
+}
+type PersonORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Person, *gorm.DB) error
+}
+
+// DefaultStrictUpdatePerson clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdatePerson(ctx context.Context, in *Person, db *gorm.DB) (*Person, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdatePerson")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var count int64
+	lockedRow := &PersonORM{}
+	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
+	if hook, ok := interface{}(&ormObj).(PersonORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(PersonORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PersonORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		err = gateway.SetCreated(ctx, "")
+	}
+	return &pbResponse, err
+}
+
+type PersonORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PersonORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PersonORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultUpsertPerson executes a gorm create call resolving conflicts on conflictTarget,
+// the name of a unique index or the primary key when empty, by updating the columns
+// in updateMask, all of them when updateMask is empty, and returns the stored object
+func DefaultUpsertPerson(ctx context.Context, in *Person, conflictTarget string, updateMask *field_mask.FieldMask, db *gorm.DB) (*Person, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conflict := clause.OnConflict{}
+	var lookup func() map[string]interface{}
+	switch conflictTarget {
+	case "":
+		conflict.Columns = []clause.Column{{Name: "id"}}
+		lookup = func() map[string]interface{} { return map[string]interface{}{"id": ormObj.Id} }
+	default:
+		return nil, fmt.Errorf("unknown conflict target %q of Person", conflictTarget)
+	}
+	if len(updateMask.GetPaths()) == 0 {
+		conflict.UpdateAll = true
+	} else {
+		var columns []string
+		for _, path := range updateMask.GetPaths() {
+			var pathColumns []string
+			switch path {
+			case "Name":
+				pathColumns = []string{"name"}
+			default:
+				return nil, &errors.InvalidFieldMaskError{Path: path}
+			}
+			for _, column := range pathColumns {
+				for _, target := range conflict.Columns {
+					if column == target.Name {
+						return nil, &errors.InvalidFieldMaskError{Path: path}
+					}
+				}
+			}
+			columns = append(columns, pathColumns...)
+		}
+		conflict.DoUpdates = clause.AssignmentColumns(columns)
+	}
+	if hook, ok := interface{}(&ormObj).(PersonORMWithBeforeUpsert); ok {
+		if db, err = hook.BeforeUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Clauses(conflict).Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if len(updateMask.GetPaths()) > 0 {
+		stored := PersonORM{}
+		if err = db.Where(lookup()).Take(&stored).Error; err != nil {
+			return nil, err
+		}
+		ormObj = stored
+	}
+	if hook, ok := interface{}(&ormObj).(PersonORMWithAfterUpsert); ok {
+		if err = hook.AfterUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type PersonORMWithBeforeUpsert interface {
+	BeforeUpsert(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PersonORMWithAfterUpsert interface {
+	AfterUpsert(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchPerson executes a basic gorm update call with patch behavior
+func DefaultPatchPerson(ctx context.Context, in *Person, updateMask *field_mask.FieldMask, db *gorm.DB) (*Person, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	pbObj := &Person{}
+	var err error
+	if hook, ok := interface{}(pbObj).(PersonWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if pbObj, err = DefaultReadPerson(ctx, &Person{Id: in.GetId()}, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbObj).(PersonWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskPerson(ctx, pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbObj).(PersonWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdatePerson(ctx, pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(PersonWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type PersonWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Person, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type PersonWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Person, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type PersonWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Person, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type PersonWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Person, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetPerson patches the objects in a transaction: the masks are applied in memory to
+// the stored objects, read and locked with a single query, and the objects are written with an update
+// per batch of 100 objects. The objects are read and written with the handles their hooks
+// return, the objects of different handles don't share the queries. Only the associations in the
+// masks are updated, and objects sharing a primary key are rejected
+func DefaultPatchSetPerson(ctx context.Context, objects []*Person, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Person, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+	if len(objects) == 0 {
+		return []*Person{}, nil
+	}
+	var err error
+	objectKeys := make([]uint64, 0, len(objects))
+	seen := make(map[uint64]struct{}, len(objects))
+	for _, in := range objects {
+		if in == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := in.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if ormObj.Id == 0 {
+			return nil, errors.EmptyIdError
+		}
+		key := ormObj.Id
+		if _, ok := seen[key]; ok {
+			return nil, errors.DuplicateKeyError
+		}
+		seen[key] = struct{}{}
+		objectKeys = append(objectKeys, key)
+	}
+	batchSize := 100
+	results := make([]*Person, len(objects))
+	err = db.Transaction(func(db *gorm.DB) error {
+		var err error
+		// the objects sharing the handle their hooks return share the queries
+		dbs := make([]*gorm.DB, len(objects))
+		group := func() ([]*gorm.DB, map[*gorm.DB][]int) {
+			var handles []*gorm.DB
+			groups := make(map[*gorm.DB][]int)
+			for i, handle := range dbs {
+				if _, ok := groups[handle]; !ok {
+					handles = append(handles, handle)
+				}
+				groups[handle] = append(groups[handle], i)
+			}
+			return handles, groups
+		}
+		for i, in := range objects {
+			dbs[i] = db
+			if hook, ok := interface{}(&Person{}).(PersonWithBeforePatchRead); ok {
+				if dbs[i], err = hook.BeforePatchRead(ctx, in, updateMasks[i], db); err != nil {
+					return err
+				}
+			}
+		}
+		targets := make(map[uint64]PersonORM, len(objects))
+		handles, groups := group()
+		for _, handle := range handles {
+			keys := make([]uint64, 0, len(groups[handle]))
+			for _, i := range groups[handle] {
+				keys = append(keys, objectKeys[i])
+			}
+			query := handle.Session(&gorm.Session{}).Clauses(clause.Locking{Strength: "UPDATE"})
+			query = query.Where("id in (?)", keys)
+			rows := []PersonORM{}
+			if err = query.Find(&rows).Error; err != nil {
+				return err
+			}
+			for _, row := range rows {
+				targets[row.Id] = row
+			}
+		}
+		ormObjs := make([]PersonORM, len(objects))
+		prepare := func(i int) (*gorm.DB, error) {
+			in, key, updateMask, db := objects[i], objectKeys[i], updateMasks[i], dbs[i]
+			target, ok := targets[key]
+			if !ok {
+				return nil, gorm.ErrRecordNotFound
+			}
+			stored, err := target.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbObj := &stored
+			if hook, ok := interface{}(pbObj).(PersonWithBeforePatchApplyFieldMask); ok {
+				if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			if _, err := DefaultApplyFieldMaskPerson(ctx, pbObj, in, updateMask, "", db); err != nil {
+				return nil, err
+			}
+			if hook, ok := interface{}(pbObj).(PersonWithBeforePatchSave); ok {
+				if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObj, err := pbObj.ToORM(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if hook, ok := interface{}(&ormObj).(PersonORMWithBeforeStrictUpdateCleanup); ok {
+				if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			if hook, ok := interface{}(&ormObj).(PersonORMWithBeforeStrictUpdateSave); ok {
+				if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObjs[i] = ormObj
+			return db, nil
+		}
+		for i := range objects {
+			if dbs[i], err = prepare(i); err != nil {
+				return err
+			}
+		}
+		write := func(db *gorm.DB, batch []int) error {
+			conds := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*2)
+			for _, i := range batch {
+				conds = append(conds, "(id = ?)")
+				args = append(args, objectKeys[i])
+			}
+			column := func(name string, value func(ormObj *PersonORM) interface{}) clause.Expr {
+				whens := make([]interface{}, 0, len(batch)*2)
+				for _, i := range batch {
+					whens = append(whens, objectKeys[i], value(&ormObjs[i]))
+				}
+				return gorm.Expr("CASE "+strings.Repeat("WHEN id = ? THEN ? ", len(batch))+"ELSE "+name+" END", whens...)
+			}
+			res := db.Session(&gorm.Session{}).Model(&PersonORM{}).Where(strings.Join(conds, " OR "), args...).Updates(map[string]interface{}{
+				"name": column("name", func(ormObj *PersonORM) interface{} { return ormObj.Name }),
+			})
+			return res.Error
+		}
+		handles, groups = group()
+		for _, handle := range handles {
+			indexes := groups[handle]
+			for start := 0; start < len(indexes); start += batchSize {
+				end := start + batchSize
+				if end > len(indexes) {
+					end = len(indexes)
+				}
+				batch := indexes[start:end]
+				if err = write(handle, batch); err != nil {
+					return err
+				}
+			}
+		}
+		finish := func(i int) (*Person, error) {
+			in, updateMask, db, ormObj := objects[i], updateMasks[i], dbs[i], ormObjs[i]
+			var err error
+			if hook, ok := interface{}(&ormObj).(PersonORMWithAfterStrictUpdateSave); ok {
+				if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			saved, err := ormObj.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbResponse := &saved
+			if hook, ok := interface{}(pbResponse).(PersonWithAfterPatchSave); ok {
+				if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			return pbResponse, nil
+		}
+		for i := range objects {
+			if results[i], err = finish(i); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// DefaultApplyFieldMaskPerson patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskPerson(ctx context.Context, patchee *Person, patcher *Person, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Person, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	if len(updateMask.GetPaths()) == 1 && updateMask.Paths[0] == prefix+"*" {
+		updateMask = &field_mask.FieldMask{Paths: []string{prefix + "Id", prefix + "Name"}}
+	}
+	for _, f := range updateMask.GetPaths() {
+		if strings.HasPrefix(f, prefix) && !DefaultHasFieldMaskPathPerson(strings.TrimPrefix(f, prefix)) {
+			return nil, &errors.InvalidFieldMaskError{Path: f}
+		}
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"Name" {
+			patchee.Name = patcher.Name
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultHasFieldMaskPathPerson reports whether Person has the field of the field mask path
+func DefaultHasFieldMaskPathPerson(path string) bool {
+	parts := strings.SplitN(path, ".", 2)
+	switch parts[0] {
+	case "Id", "Name":
+		return len(parts) == 1
+	}
+	return false
+}
+
+// DefaultListPerson executes a gorm list call
+func DefaultListPerson(ctx context.Context, db *gorm.DB) ([]*Person, error) {
+	in := Person{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PersonORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(PersonORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []PersonORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PersonORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Person{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type PersonORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PersonORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PersonORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]PersonORM) error
+}
+
+// DefaultCreateMembership executes a basic gorm create call
+func DefaultCreateMembership(ctx context.Context, in *Membership, db *gorm.DB) (*Membership, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(MembershipORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(MembershipORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type MembershipORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MembershipORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultCreateMembershipSet executes gorm create calls in batches of 100 objects
+// in a transaction, either all of the objects are created or none of them. The BeforeCreate_ hook
+// of each object runs before the insert of its batch, which uses the handle the hooks of the
+// batch return
+func DefaultCreateMembershipSet(ctx context.Context, in []*Membership, db *gorm.DB) ([]*Membership, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObjs := make([]MembershipORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, ormObj)
+	}
+	batchSize := 100
+	err := db.Transaction(func(db *gorm.DB) error {
+		var err error
+		for start := 0; start < len(ormObjs); start += batchSize {
+			end := start + batchSize
+			if end > len(ormObjs) {
+				end = len(ormObjs)
+			}
+			batch := ormObjs[start:end]
+			// the scopes the hooks add only apply to the insert of their own batch
+			batchDB := db
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(MembershipORMWithBeforeCreate_); ok {
+					if batchDB, err = hook.BeforeCreate_(ctx, batchDB); err != nil {
+						return err
+					}
+				}
+			}
+			if err = batchDB.Omit().CreateInBatches(&batch, batchSize).Error; err != nil {
+				return err
+			}
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(MembershipORMWithAfterCreate_); ok {
+					if err = hook.AfterCreate_(ctx, db); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*Membership, 0, len(ormObjs))
+	for i := range ormObjs {
+		pbResponse, err := ormObjs[i].ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, &pbResponse)
+	}
+	return results, nil
+}
+
+func DefaultReadMembership(ctx context.Context, in *Membership, db *gorm.DB) (*Membership, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.PersonId == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if ormObj.TeamId == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(MembershipORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(MembershipORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := MembershipORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(MembershipORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type MembershipORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MembershipORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MembershipORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteMembership(ctx context.Context, in *Membership, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.PersonId == 0 {
+		return errors.EmptyIdError
+	}
+	if ormObj.TeamId == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(MembershipORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&MembershipORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(MembershipORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type MembershipORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MembershipORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteMembershipSet(ctx context.Context, in []*Membership, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := [][]interface{}{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.PersonId == 0 {
+			return errors.EmptyIdError
+		}
+		if ormObj.TeamId == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, []interface{}{ormObj.PersonId, ormObj.TeamId})
+	}
+	if hook, ok := (interface{}(&MembershipORM{})).(MembershipORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	db = db.Where("(person_id, team_id) in ?", keys)
+	err = db.Delete(&MembershipORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&MembershipORM{})).(MembershipORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type MembershipORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Membership, *gorm.DB) (*gorm.DB, error)
+}
+type MembershipORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Membership, *gorm.DB) error
+}
+
+// DefaultStrictUpdateMembership clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateMembership(ctx context.Context, in *Membership, db *gorm.DB) (*Membership, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateMembership")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var count int64
+	lockedRow := &MembershipORM{}
+	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("person_id=? AND team_id=?", ormObj.PersonId, ormObj.TeamId).First(lockedRow).RowsAffected
+	if hook, ok := interface{}(&ormObj).(MembershipORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(MembershipORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(MembershipORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		err = gateway.SetCreated(ctx, "")
+	}
+	return &pbResponse, err
+}
+
+type MembershipORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MembershipORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MembershipORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultUpsertMembership executes a gorm create call resolving conflicts on conflictTarget,
+// the name of a unique index or the primary key when empty, by updating the columns
+// in updateMask, all of them when updateMask is empty, and returns the stored object
+func DefaultUpsertMembership(ctx context.Context, in *Membership, conflictTarget string, updateMask *field_mask.FieldMask, db *gorm.DB) (*Membership, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conflict := clause.OnConflict{}
+	var lookup func() map[string]interface{}
+	switch conflictTarget {
+	case "":
+		conflict.Columns = []clause.Column{{Name: "person_id"}, {Name: "team_id"}}
+		lookup = func() map[string]interface{} {
+			return map[string]interface{}{"person_id": ormObj.PersonId, "team_id": ormObj.TeamId}
+		}
+	default:
+		return nil, fmt.Errorf("unknown conflict target %q of Membership", conflictTarget)
+	}
+	if len(updateMask.GetPaths()) == 0 {
+		conflict.UpdateAll = true
+	} else {
+		var columns []string
+		for _, path := range updateMask.GetPaths() {
+			var pathColumns []string
+			switch path {
+			case "Role":
+				pathColumns = []string{"role"}
+			case "JoinedAt":
+				pathColumns = []string{"joined_at"}
+			default:
+				return nil, &errors.InvalidFieldMaskError{Path: path}
+			}
+			for _, column := range pathColumns {
+				for _, target := range conflict.Columns {
+					if column == target.Name {
+						return nil, &errors.InvalidFieldMaskError{Path: path}
+					}
+				}
+			}
+			columns = append(columns, pathColumns...)
+		}
+		conflict.DoUpdates = clause.AssignmentColumns(columns)
+	}
+	if hook, ok := interface{}(&ormObj).(MembershipORMWithBeforeUpsert); ok {
+		if db, err = hook.BeforeUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Clauses(conflict).Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if len(updateMask.GetPaths()) > 0 {
+		stored := MembershipORM{}
+		if err = db.Where(lookup()).Take(&stored).Error; err != nil {
+			return nil, err
+		}
+		ormObj = stored
+	}
+	if hook, ok := interface{}(&ormObj).(MembershipORMWithAfterUpsert); ok {
+		if err = hook.AfterUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type MembershipORMWithBeforeUpsert interface {
+	BeforeUpsert(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MembershipORMWithAfterUpsert interface {
+	AfterUpsert(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchMembership executes a basic gorm update call with patch behavior
+func DefaultPatchMembership(ctx context.Context, in *Membership, updateMask *field_mask.FieldMask, db *gorm.DB) (*Membership, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	pbObj := &Membership{}
+	var err error
+	if hook, ok := interface{}(pbObj).(MembershipWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if pbObj, err = DefaultReadMembership(ctx, &Membership{PersonId: in.GetPersonId(), TeamId: in.GetTeamId()}, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbObj).(MembershipWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskMembership(ctx, pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbObj).(MembershipWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateMembership(ctx, pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(MembershipWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type MembershipWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Membership, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type MembershipWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Membership, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type MembershipWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Membership, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type MembershipWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Membership, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetMembership patches the objects in a transaction: the masks are applied in memory to
+// the stored objects, read and locked with a single query, and the objects are written with an update
+// per batch of 100 objects. The objects are read and written with the handles their hooks
+// return, the objects of different handles don't share the queries. Only the associations in the
+// masks are updated, and objects sharing a primary key are rejected
+func DefaultPatchSetMembership(ctx context.Context, objects []*Membership, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Membership, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+	if len(objects) == 0 {
+		return []*Membership{}, nil
+	}
+	var err error
+	type patchSetKey struct {
+		PersonId uint64
+		TeamId   uint64
+	}
+	objectKeys := make([]patchSetKey, 0, len(objects))
+	seen := make(map[patchSetKey]struct{}, len(objects))
+	for _, in := range objects {
+		if in == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := in.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if ormObj.PersonId == 0 {
+			return nil, errors.EmptyIdError
+		}
+		if ormObj.TeamId == 0 {
+			return nil, errors.EmptyIdError
+		}
+		key := patchSetKey{PersonId: ormObj.PersonId, TeamId: ormObj.TeamId}
+		if _, ok := seen[key]; ok {
+			return nil, errors.DuplicateKeyError
+		}
+		seen[key] = struct{}{}
+		objectKeys = append(objectKeys, key)
+	}
+	batchSize := 100
+	results := make([]*Membership, len(objects))
+	err = db.Transaction(func(db *gorm.DB) error {
+		var err error
+		// the objects sharing the handle their hooks return share the queries
+		dbs := make([]*gorm.DB, len(objects))
+		group := func() ([]*gorm.DB, map[*gorm.DB][]int) {
+			var handles []*gorm.DB
+			groups := make(map[*gorm.DB][]int)
+			for i, handle := range dbs {
+				if _, ok := groups[handle]; !ok {
+					handles = append(handles, handle)
+				}
+				groups[handle] = append(groups[handle], i)
+			}
+			return handles, groups
+		}
+		for i, in := range objects {
+			dbs[i] = db
+			if hook, ok := interface{}(&Membership{}).(MembershipWithBeforePatchRead); ok {
+				if dbs[i], err = hook.BeforePatchRead(ctx, in, updateMasks[i], db); err != nil {
+					return err
+				}
+			}
+		}
+		targets := make(map[patchSetKey]MembershipORM, len(objects))
+		handles, groups := group()
+		for _, handle := range handles {
+			keys := make([][]interface{}, 0, len(groups[handle]))
+			for _, i := range groups[handle] {
+				key := objectKeys[i]
+				keys = append(keys, []interface{}{key.PersonId, key.TeamId})
+			}
+			query := handle.Session(&gorm.Session{}).Clauses(clause.Locking{Strength: "UPDATE"})
+			query = query.Where("(person_id, team_id) in ?", keys)
+			rows := []MembershipORM{}
+			if err = query.Find(&rows).Error; err != nil {
+				return err
+			}
+			for _, row := range rows {
+				targets[patchSetKey{PersonId: row.PersonId, TeamId: row.TeamId}] = row
+			}
+		}
+		ormObjs := make([]MembershipORM, len(objects))
+		prepare := func(i int) (*gorm.DB, error) {
+			in, key, updateMask, db := objects[i], objectKeys[i], updateMasks[i], dbs[i]
+			target, ok := targets[key]
+			if !ok {
+				return nil, gorm.ErrRecordNotFound
+			}
+			stored, err := target.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbObj := &stored
+			if hook, ok := interface{}(pbObj).(MembershipWithBeforePatchApplyFieldMask); ok {
+				if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			if _, err := DefaultApplyFieldMaskMembership(ctx, pbObj, in, updateMask, "", db); err != nil {
+				return nil, err
+			}
+			if hook, ok := interface{}(pbObj).(MembershipWithBeforePatchSave); ok {
+				if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObj, err := pbObj.ToORM(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if hook, ok := interface{}(&ormObj).(MembershipORMWithBeforeStrictUpdateCleanup); ok {
+				if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			if hook, ok := interface{}(&ormObj).(MembershipORMWithBeforeStrictUpdateSave); ok {
+				if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObjs[i] = ormObj
+			return db, nil
+		}
+		for i := range objects {
+			if dbs[i], err = prepare(i); err != nil {
+				return err
+			}
+		}
+		write := func(db *gorm.DB, batch []int) error {
+			conds := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*3)
+			for _, i := range batch {
+				conds = append(conds, "(person_id = ? AND team_id = ?)")
+				args = append(args, objectKeys[i].PersonId, objectKeys[i].TeamId)
+			}
+			column := func(name string, value func(ormObj *MembershipORM) interface{}) clause.Expr {
+				whens := make([]interface{}, 0, len(batch)*3)
+				for _, i := range batch {
+					whens = append(whens, objectKeys[i].PersonId, objectKeys[i].TeamId, value(&ormObjs[i]))
+				}
+				return gorm.Expr("CASE "+strings.Repeat("WHEN person_id = ? AND team_id = ? THEN ? ", len(batch))+"ELSE "+name+" END", whens...)
+			}
+			res := db.Session(&gorm.Session{}).Model(&MembershipORM{}).Where(strings.Join(conds, " OR "), args...).Updates(map[string]interface{}{
+				"joined_at": column("joined_at", func(ormObj *MembershipORM) interface{} { return ormObj.JoinedAt }),
+				"position":  column("position", func(ormObj *MembershipORM) interface{} { return ormObj.Position }),
+				"role":      column("role", func(ormObj *MembershipORM) interface{} { return ormObj.Role }),
+			})
+			return res.Error
+		}
+		handles, groups = group()
+		for _, handle := range handles {
+			indexes := groups[handle]
+			for start := 0; start < len(indexes); start += batchSize {
+				end := start + batchSize
+				if end > len(indexes) {
+					end = len(indexes)
+				}
+				batch := indexes[start:end]
+				if err = write(handle, batch); err != nil {
+					return err
+				}
+			}
+		}
+		finish := func(i int) (*Membership, error) {
+			in, updateMask, db, ormObj := objects[i], updateMasks[i], dbs[i], ormObjs[i]
+			var err error
+			if hook, ok := interface{}(&ormObj).(MembershipORMWithAfterStrictUpdateSave); ok {
+				if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			saved, err := ormObj.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbResponse := &saved
+			if hook, ok := interface{}(pbResponse).(MembershipWithAfterPatchSave); ok {
+				if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			return pbResponse, nil
+		}
+		for i := range objects {
+			if results[i], err = finish(i); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// DefaultApplyFieldMaskMembership patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskMembership(ctx context.Context, patchee *Membership, patcher *Membership, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Membership, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	if len(updateMask.GetPaths()) == 1 && updateMask.Paths[0] == prefix+"*" {
+		updateMask = &field_mask.FieldMask{Paths: []string{prefix + "TeamId", prefix + "PersonId", prefix + "Role", prefix + "JoinedAt"}}
+	}
+	for _, f := range updateMask.GetPaths() {
+		if strings.HasPrefix(f, prefix) && !DefaultHasFieldMaskPathMembership(strings.TrimPrefix(f, prefix)) {
+			return nil, &errors.InvalidFieldMaskError{Path: f}
+		}
+	}
+	var err error
+	var updatedJoinedAt bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"TeamId" {
+			patchee.TeamId = patcher.TeamId
+			continue
+		}
+		if f == prefix+"PersonId" {
+			patchee.PersonId = patcher.PersonId
+			continue
+		}
+		if f == prefix+"Role" {
+			patchee.Role = patcher.Role
+			continue
+		}
+		if !updatedJoinedAt && strings.HasPrefix(f, prefix+"JoinedAt.") {
+			if patcher.JoinedAt == nil {
+				patchee.JoinedAt = nil
+				continue
+			}
+			if patchee.JoinedAt == nil {
+				patchee.JoinedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"JoinedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.JoinedAt, patchee.JoinedAt, childMask); err != nil {
+				return nil, &errors.InvalidFieldMaskError{Path: f}
+			}
+		}
+		if f == prefix+"JoinedAt" {
+			updatedJoinedAt = true
+			patchee.JoinedAt = patcher.JoinedAt
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultHasFieldMaskPathMembership reports whether Membership has the field of the field mask path
+func DefaultHasFieldMaskPathMembership(path string) bool {
+	parts := strings.SplitN(path, ".", 2)
+	switch parts[0] {
+	case "JoinedAt":
+		if len(parts) == 1 {
+			return true
+		}
+		parts = strings.SplitN(parts[1], ".", 2)
+		switch parts[0] {
+		case "Seconds", "Nanos":
+			return len(parts) == 1
+		}
+		return false
+	case "TeamId", "PersonId", "Role":
+		return len(parts) == 1
+	}
+	return false
+}
+
+// DefaultListMembership executes a gorm list call
+func DefaultListMembership(ctx context.Context, db *gorm.DB) ([]*Membership, error) {
+	in := Membership{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(MembershipORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(MembershipORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("person_id, team_id")
+	ormResponse := []MembershipORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(MembershipORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Membership{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type MembershipORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MembershipORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MembershipORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]MembershipORM) error
+}
+
+// DefaultCreateDocument executes a basic gorm create call
+func DefaultCreateDocument(ctx context.Context, in *Document, db *gorm.DB) (*Document, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(DocumentORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(DocumentORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type DocumentORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type DocumentORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultCreateDocumentSet executes gorm create calls in batches of 100 objects
+// in a transaction, either all of the objects are created or none of them. The BeforeCreate_ hook
+// of each object runs before the insert of its batch, which uses the handle the hooks of the
+// batch return
+func DefaultCreateDocumentSet(ctx context.Context, in []*Document, db *gorm.DB) ([]*Document, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObjs := make([]DocumentORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, ormObj)
+	}
+	batchSize := 100
+	err := db.Transaction(func(db *gorm.DB) error {
+		var err error
+		for start := 0; start < len(ormObjs); start += batchSize {
+			end := start + batchSize
+			if end > len(ormObjs) {
+				end = len(ormObjs)
+			}
+			batch := ormObjs[start:end]
+			// the scopes the hooks add only apply to the insert of their own batch
+			batchDB := db
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(DocumentORMWithBeforeCreate_); ok {
+					if batchDB, err = hook.BeforeCreate_(ctx, batchDB); err != nil {
+						return err
+					}
+				}
+			}
+			if err = batchDB.Omit().CreateInBatches(&batch, batchSize).Error; err != nil {
+				return err
+			}
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(DocumentORMWithAfterCreate_); ok {
+					if err = hook.AfterCreate_(ctx, db); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*Document, 0, len(ormObjs))
+	for i := range ormObjs {
+		pbResponse, err := ormObjs[i].ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, &pbResponse)
+	}
+	return results, nil
+}
+
+func DefaultReadDocument(ctx context.Context, in *Document, db *gorm.DB, showDeleted bool) (*Document, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if showDeleted {
+		db = db.Unscoped()
+		ormObj.DeletedAt = nil
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(DocumentORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(DocumentORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := DocumentORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(DocumentORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type DocumentORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type DocumentORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type DocumentORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteDocument(ctx context.Context, in *Document, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(DocumentORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&DocumentORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(DocumentORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type DocumentORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type DocumentORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteDocumentSet(ctx context.Context, in []*Document, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []uint64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&DocumentORM{})).(DocumentORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&DocumentORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&DocumentORM{})).(DocumentORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type DocumentORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Document, *gorm.DB) (*gorm.DB, error)
+}
+type DocumentORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Document, *gorm.DB) error
+}
+
+// DefaultStrictUpdateDocument clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateDocument(ctx context.Context, in *Document, db *gorm.DB) (*Document, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateDocument")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var count int64
+	lockedRow := &DocumentORM{}
+	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
+	if hook, ok := interface{}(&ormObj).(DocumentORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	filterComments := CommentORM{}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	filterComments.OwnerID = ormObj.Id
+	filterComments.OwnerType = "documents"
+	if err = db.Where(filterComments).Delete(CommentORM{}).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(DocumentORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(DocumentORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		err = gateway.SetCreated(ctx, "")
+	}
+	return &pbResponse, err
+}
+
+type DocumentORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type DocumentORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type DocumentORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultUpsertDocument executes a gorm create call resolving conflicts on conflictTarget,
+// the name of a unique index or the primary key when empty, by updating the columns
+// in updateMask, all of them when updateMask is empty, and returns the stored object
+func DefaultUpsertDocument(ctx context.Context, in *Document, conflictTarget string, updateMask *field_mask.FieldMask, db *gorm.DB) (*Document, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conflict := clause.OnConflict{}
+	var lookup func() map[string]interface{}
+	switch conflictTarget {
+	case "":
+		conflict.Columns = []clause.Column{{Name: "id"}}
+		lookup = func() map[string]interface{} { return map[string]interface{}{"id": ormObj.Id} }
+	default:
+		return nil, fmt.Errorf("unknown conflict target %q of Document", conflictTarget)
+	}
+	if len(updateMask.GetPaths()) == 0 {
+		conflict.UpdateAll = true
+	} else {
+		var columns []string
+		for _, path := range updateMask.GetPaths() {
+			var pathColumns []string
+			switch path {
+			case "Title":
+				pathColumns = []string{"title"}
+			case "DeletedAt":
+				pathColumns = []string{"deleted_at"}
+			default:
+				return nil, &errors.InvalidFieldMaskError{Path: path}
+			}
+			for _, column := range pathColumns {
+				for _, target := range conflict.Columns {
+					if column == target.Name {
+						return nil, &errors.InvalidFieldMaskError{Path: path}
+					}
+				}
+			}
+			columns = append(columns, pathColumns...)
+		}
+		conflict.DoUpdates = clause.AssignmentColumns(columns)
+	}
+	if hook, ok := interface{}(&ormObj).(DocumentORMWithBeforeUpsert); ok {
+		if db, err = hook.BeforeUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Clauses(conflict).Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if len(updateMask.GetPaths()) > 0 {
+		stored := DocumentORM{}
+		if err = db.Where(lookup()).Take(&stored).Error; err != nil {
+			return nil, err
+		}
+		ormObj = stored
+	}
+	if hook, ok := interface{}(&ormObj).(DocumentORMWithAfterUpsert); ok {
+		if err = hook.AfterUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type DocumentORMWithBeforeUpsert interface {
+	BeforeUpsert(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type DocumentORMWithAfterUpsert interface {
+	AfterUpsert(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchDocument executes a basic gorm update call with patch behavior
+func DefaultPatchDocument(ctx context.Context, in *Document, updateMask *field_mask.FieldMask, db *gorm.DB) (*Document, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	pbObj := &Document{}
+	var err error
+	if hook, ok := interface{}(pbObj).(DocumentWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if pbObj, err = DefaultReadDocument(ctx, &Document{Id: in.GetId()}, db, false); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbObj).(DocumentWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskDocument(ctx, pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbObj).(DocumentWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateDocument(ctx, pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(DocumentWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type DocumentWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Document, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type DocumentWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Document, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type DocumentWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Document, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type DocumentWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Document, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetDocument patches the objects in a transaction: the masks are applied in memory to
+// the stored objects, read and locked with a single query, and the objects are written with an update
+// per batch of 100 objects. The objects are read and written with the handles their hooks
+// return, the objects of different handles don't share the queries. Only the associations in the
+// masks are updated, and objects sharing a primary key are rejected
+func DefaultPatchSetDocument(ctx context.Context, objects []*Document, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Document, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+	if len(objects) == 0 {
+		return []*Document{}, nil
+	}
+	var err error
+	objectKeys := make([]uint64, 0, len(objects))
+	seen := make(map[uint64]struct{}, len(objects))
+	for _, in := range objects {
+		if in == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := in.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if ormObj.Id == 0 {
+			return nil, errors.EmptyIdError
+		}
+		key := ormObj.Id
+		if _, ok := seen[key]; ok {
+			return nil, errors.DuplicateKeyError
+		}
+		seen[key] = struct{}{}
+		objectKeys = append(objectKeys, key)
+	}
+	batchSize := 100
+	results := make([]*Document, len(objects))
+	err = db.Transaction(func(db *gorm.DB) error {
+		var err error
+		// the objects sharing the handle their hooks return share the queries
+		dbs := make([]*gorm.DB, len(objects))
+		group := func() ([]*gorm.DB, map[*gorm.DB][]int) {
+			var handles []*gorm.DB
+			groups := make(map[*gorm.DB][]int)
+			for i, handle := range dbs {
+				if _, ok := groups[handle]; !ok {
+					handles = append(handles, handle)
+				}
+				groups[handle] = append(groups[handle], i)
+			}
+			return handles, groups
+		}
+		for i, in := range objects {
+			dbs[i] = db
+			if hook, ok := interface{}(&Document{}).(DocumentWithBeforePatchRead); ok {
+				if dbs[i], err = hook.BeforePatchRead(ctx, in, updateMasks[i], db); err != nil {
+					return err
+				}
+			}
+		}
+		targets := make(map[uint64]DocumentORM, len(objects))
+		handles, groups := group()
+		for _, handle := range handles {
+			keys := make([]uint64, 0, len(groups[handle]))
+			for _, i := range groups[handle] {
+				keys = append(keys, objectKeys[i])
+			}
+			query := handle.Session(&gorm.Session{}).Clauses(clause.Locking{Strength: "UPDATE"})
+			query = query.Where("id in (?)", keys)
+			rows := []DocumentORM{}
+			if err = query.Find(&rows).Error; err != nil {
+				return err
+			}
+			for _, row := range rows {
+				targets[row.Id] = row
+			}
+		}
+		ormObjs := make([]DocumentORM, len(objects))
+		prepare := func(i int) (*gorm.DB, error) {
+			in, key, updateMask, db := objects[i], objectKeys[i], updateMasks[i], dbs[i]
+			target, ok := targets[key]
+			if !ok {
+				return nil, gorm.ErrRecordNotFound
+			}
+			stored, err := target.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbObj := &stored
+			if hook, ok := interface{}(pbObj).(DocumentWithBeforePatchApplyFieldMask); ok {
+				if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			if _, err := DefaultApplyFieldMaskDocument(ctx, pbObj, in, updateMask, "", db); err != nil {
+				return nil, err
+			}
+			if hook, ok := interface{}(pbObj).(DocumentWithBeforePatchSave); ok {
+				if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObj, err := pbObj.ToORM(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if hook, ok := interface{}(&ormObj).(DocumentORMWithBeforeStrictUpdateCleanup); ok {
+				if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			masked := make(map[string]bool, len(updateMask.GetPaths()))
+			for _, path := range updateMask.GetPaths() {
+				masked[strings.SplitN(path, ".", 2)[0]] = true
+			}
+			if masked["Comments"] || masked["*"] {
+				filterComments := CommentORM{}
+				if ormObj.Id == 0 {
+					return nil, errors.EmptyIdError
+				}
+				filterComments.OwnerID = ormObj.Id
+				filterComments.OwnerType = "documents"
+				if err = db.Where(filterComments).Delete(CommentORM{}).Error; err != nil {
+					return nil, err
+				}
+			}
+			if hook, ok := interface{}(&ormObj).(DocumentORMWithBeforeStrictUpdateSave); ok {
+				if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObjs[i] = ormObj
+			return db, nil
+		}
+		for i := range objects {
+			if dbs[i], err = prepare(i); err != nil {
+				return err
+			}
+		}
+		write := func(db *gorm.DB, batch []int) error {
+			conds := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*2)
+			for _, i := range batch {
+				conds = append(conds, "(id = ?)")
+				args = append(args, objectKeys[i])
+			}
+			column := func(name string, value func(ormObj *DocumentORM) interface{}) clause.Expr {
+				whens := make([]interface{}, 0, len(batch)*2)
+				for _, i := range batch {
+					whens = append(whens, objectKeys[i], value(&ormObjs[i]))
+				}
+				return gorm.Expr("CASE "+strings.Repeat("WHEN id = ? THEN ? ", len(batch))+"ELSE "+name+" END", whens...)
+			}
+			res := db.Session(&gorm.Session{}).Model(&DocumentORM{}).Where(strings.Join(conds, " OR "), args...).Updates(map[string]interface{}{
+				"deleted_at": column("deleted_at", func(ormObj *DocumentORM) interface{} { return ormObj.DeletedAt }),
+				"title":      column("title", func(ormObj *DocumentORM) interface{} { return ormObj.Title }),
+			})
+			return res.Error
+		}
+		handles, groups = group()
+		for _, handle := range handles {
+			indexes := groups[handle]
+			for start := 0; start < len(indexes); start += batchSize {
+				end := start + batchSize
+				if end > len(indexes) {
+					end = len(indexes)
+				}
+				batch := indexes[start:end]
+				objs := make([]*DocumentORM, 0, len(batch))
+				for _, i := range batch {
+					objs = append(objs, &ormObjs[i])
+				}
+				// the associations are saved first, the batch is then written with the
+				// foreign keys of its belongs-to associations
+				if err = handle.Session(&gorm.Session{}).Select("Comments").Model(&objs).Updates(map[string]interface{}{}).Error; err != nil {
+					return err
+				}
+				if err = write(handle, batch); err != nil {
+					return err
+				}
+			}
+		}
+		finish := func(i int) (*Document, error) {
+			in, updateMask, db, ormObj := objects[i], updateMasks[i], dbs[i], ormObjs[i]
+			var err error
+			if hook, ok := interface{}(&ormObj).(DocumentORMWithAfterStrictUpdateSave); ok {
+				if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			saved, err := ormObj.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbResponse := &saved
+			if hook, ok := interface{}(pbResponse).(DocumentWithAfterPatchSave); ok {
+				if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			return pbResponse, nil
+		}
+		for i := range objects {
+			if results[i], err = finish(i); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+func DefaultUndeleteDocument(ctx context.Context, in *Document, db *gorm.DB) (*Document, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	ormObj.DeletedAt = nil
+	if hook, ok := interface{}(&ormObj).(DocumentORMWithBeforeUndelete_); ok {
+		if db, err = hook.BeforeUndelete_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	res := db.Unscoped().Model(&DocumentORM{}).Where(&ormObj).Update("deleted_at", nil)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	if res.RowsAffected == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+	if err = db.Where(&ormObj).First(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(DocumentORMWithAfterUndelete_); ok {
+		if err = hook.AfterUndelete_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type DocumentORMWithBeforeUndelete_ interface {
+	BeforeUndelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type DocumentORMWithAfterUndelete_ interface {
+	AfterUndelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultHardDeleteDocument(ctx context.Context, in *Document, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	ormObj.DeletedAt = nil
+	return db.Transaction(func(db *gorm.DB) error {
+		if hook, ok := interface{}(&ormObj).(DocumentORMWithBeforeHardDelete_); ok {
+			if db, err = hook.BeforeHardDelete_(ctx, db); err != nil {
+				return err
+			}
+		}
+		keys := []uint64{}
+		if err = db.Unscoped().Model(&DocumentORM{}).Where(&ormObj).Pluck("id", &keys).Error; err != nil {
+			return err
+		}
+		if err = DefaultCascadeDeleteDocument(ctx, keys, db.Session(&gorm.Session{NewDB: true}).Unscoped().Session(&gorm.Session{})); err != nil {
+			return err
+		}
+		err = db.Unscoped().Where(&ormObj).Delete(&DocumentORM{}).Error
+		if err != nil {
+			return err
+		}
+		if hook, ok := interface{}(&ormObj).(DocumentORMWithAfterHardDelete_); ok {
+			err = hook.AfterHardDelete_(ctx, db)
+		}
+		return err
+	})
+}
+
+type DocumentORMWithBeforeHardDelete_ interface {
+	BeforeHardDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type DocumentORMWithAfterHardDelete_ interface {
+	AfterHardDelete_(context.Context, *gorm.DB) error
+}
+
+// DefaultCascadeDeleteDocument deletes the children owned by the objects of the given
+// primary keys, along with the children they own in turn
+func DefaultCascadeDeleteDocument(ctx context.Context, keys interface{}, db *gorm.DB) error {
+	if err := db.Where("owner_id IN ? AND owner_type = ?", keys, "documents").Delete(&CommentORM{}).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+// DefaultApplyFieldMaskDocument patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskDocument(ctx context.Context, patchee *Document, patcher *Document, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Document, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	if len(updateMask.GetPaths()) == 1 && updateMask.Paths[0] == prefix+"*" {
+		updateMask = &field_mask.FieldMask{Paths: []string{prefix + "Id", prefix + "Title", prefix + "DeletedAt", prefix + "Comments"}}
+	}
+	for _, f := range updateMask.GetPaths() {
+		if strings.HasPrefix(f, prefix) && !DefaultHasFieldMaskPathDocument(strings.TrimPrefix(f, prefix)) {
+			return nil, &errors.InvalidFieldMaskError{Path: f}
+		}
+	}
+	var err error
+	var updatedDeletedAt bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"Title" {
+			patchee.Title = patcher.Title
+			continue
+		}
+		if !updatedDeletedAt && strings.HasPrefix(f, prefix+"DeletedAt.") {
+			if patcher.DeletedAt == nil {
+				patchee.DeletedAt = nil
+				continue
+			}
+			if patchee.DeletedAt == nil {
+				patchee.DeletedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"DeletedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.DeletedAt, patchee.DeletedAt, childMask); err != nil {
+				return nil, &errors.InvalidFieldMaskError{Path: f}
+			}
+		}
+		if f == prefix+"DeletedAt" {
+			updatedDeletedAt = true
+			patchee.DeletedAt = patcher.DeletedAt
+			continue
+		}
+		if f == prefix+"Comments" {
+			patchee.Comments = patcher.Comments
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultHasFieldMaskPathDocument reports whether Document has the field of the field mask path
+func DefaultHasFieldMaskPathDocument(path string) bool {
+	parts := strings.SplitN(path, ".", 2)
+	switch parts[0] {
+	case "DeletedAt":
+		if len(parts) == 1 {
+			return true
+		}
+		parts = strings.SplitN(parts[1], ".", 2)
+		switch parts[0] {
+		case "Seconds", "Nanos":
+			return len(parts) == 1
+		}
+		return false
+	case "Id", "Title", "Comments":
+		return len(parts) == 1
+	}
+	return false
+}
+
+// DefaultListDocument executes a gorm list call
+func DefaultListDocument(ctx context.Context, db *gorm.DB, showDeleted bool) ([]*Document, error) {
+	in := Document{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if showDeleted {
+		db = db.Unscoped()
+		ormObj.DeletedAt = nil
+	}
+	if hook, ok := interface{}(&ormObj).(DocumentORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(DocumentORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []DocumentORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(DocumentORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Document{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type DocumentORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type DocumentORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type DocumentORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]DocumentORM) error
+}
+
+// DefaultCreateComment executes a basic gorm create call
+func DefaultCreateComment(ctx context.Context, in *Comment, db *gorm.DB) (*Comment, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(CommentORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(CommentORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type CommentORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type CommentORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultCreateCommentSet executes gorm create calls in batches of 100 objects
+// in a transaction, either all of the objects are created or none of them. The BeforeCreate_ hook
+// of each object runs before the insert of its batch, which uses the handle the hooks of the
+// batch return
+func DefaultCreateCommentSet(ctx context.Context, in []*Comment, db *gorm.DB) ([]*Comment, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObjs := make([]CommentORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, ormObj)
+	}
+	batchSize := 100
+	err := db.Transaction(func(db *gorm.DB) error {
+		var err error
+		for start := 0; start < len(ormObjs); start += batchSize {
+			end := start + batchSize
+			if end > len(ormObjs) {
+				end = len(ormObjs)
+			}
+			batch := ormObjs[start:end]
+			// the scopes the hooks add only apply to the insert of their own batch
+			batchDB := db
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(CommentORMWithBeforeCreate_); ok {
+					if batchDB, err = hook.BeforeCreate_(ctx, batchDB); err != nil {
+						return err
+					}
+				}
+			}
+			if err = batchDB.Omit().CreateInBatches(&batch, batchSize).Error; err != nil {
+				return err
+			}
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(CommentORMWithAfterCreate_); ok {
+					if err = hook.AfterCreate_(ctx, db); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*Comment, 0, len(ormObjs))
+	for i := range ormObjs {
+		pbResponse, err := ormObjs[i].ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, &pbResponse)
+	}
+	return results, nil
+}
+
+func DefaultReadComment(ctx context.Context, in *Comment, db *gorm.DB) (*Comment, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(CommentORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(CommentORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := CommentORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(CommentORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type CommentORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type CommentORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type CommentORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteComment(ctx context.Context, in *Comment, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(CommentORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&CommentORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(CommentORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type CommentORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type CommentORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteCommentSet(ctx context.Context, in []*Comment, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []uint64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&CommentORM{})).(CommentORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&CommentORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&CommentORM{})).(CommentORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type CommentORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Comment, *gorm.DB) (*gorm.DB, error)
+}
+type CommentORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Comment, *gorm.DB) error
+}
+
+// DefaultStrictUpdateComment clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateComment(ctx context.Context, in *Comment, db *gorm.DB) (*Comment, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateComment")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var count int64
+	lockedRow := &CommentORM{}
+	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
+	if hook, ok := interface{}(&ormObj).(CommentORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(CommentORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(CommentORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		err = gateway.SetCreated(ctx, "")
+	}
+	return &pbResponse, err
+}
+
+type CommentORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type CommentORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type CommentORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultUpsertComment executes a gorm create call resolving conflicts on conflictTarget,
+// the name of a unique index or the primary key when empty, by updating the columns
+// in updateMask, all of them when updateMask is empty, and returns the stored object
+func DefaultUpsertComment(ctx context.Context, in *Comment, conflictTarget string, updateMask *field_mask.FieldMask, db *gorm.DB) (*Comment, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conflict := clause.OnConflict{}
+	var lookup func() map[string]interface{}
+	switch conflictTarget {
+	case "":
+		conflict.Columns = []clause.Column{{Name: "id"}}
+		lookup = func() map[string]interface{} { return map[string]interface{}{"id": ormObj.Id} }
+	default:
+		return nil, fmt.Errorf("unknown conflict target %q of Comment", conflictTarget)
+	}
+	if len(updateMask.GetPaths()) == 0 {
+		conflict.UpdateAll = true
+	} else {
+		var columns []string
+		for _, path := range updateMask.GetPaths() {
+			var pathColumns []string
+			switch path {
+			case "Text":
+				pathColumns = []string{"text"}
+			default:
+				return nil, &errors.InvalidFieldMaskError{Path: path}
+			}
+			for _, column := range pathColumns {
+				for _, target := range conflict.Columns {
+					if column == target.Name {
+						return nil, &errors.InvalidFieldMaskError{Path: path}
+					}
+				}
+			}
+			columns = append(columns, pathColumns...)
+		}
+		conflict.DoUpdates = clause.AssignmentColumns(columns)
+	}
+	if hook, ok := interface{}(&ormObj).(CommentORMWithBeforeUpsert); ok {
+		if db, err = hook.BeforeUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Clauses(conflict).Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if len(updateMask.GetPaths()) > 0 {
+		stored := CommentORM{}
+		if err = db.Where(lookup()).Take(&stored).Error; err != nil {
+			return nil, err
+		}
+		ormObj = stored
+	}
+	if hook, ok := interface{}(&ormObj).(CommentORMWithAfterUpsert); ok {
+		if err = hook.AfterUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type CommentORMWithBeforeUpsert interface {
+	BeforeUpsert(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type CommentORMWithAfterUpsert interface {
+	AfterUpsert(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchComment executes a basic gorm update call with patch behavior
+func DefaultPatchComment(ctx context.Context, in *Comment, updateMask *field_mask.FieldMask, db *gorm.DB) (*Comment, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	pbObj := &Comment{}
+	var err error
+	if hook, ok := interface{}(pbObj).(CommentWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if pbObj, err = DefaultReadComment(ctx, &Comment{Id: in.GetId()}, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbObj).(CommentWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskComment(ctx, pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbObj).(CommentWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateComment(ctx, pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(CommentWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type CommentWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Comment, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type CommentWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Comment, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type CommentWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Comment, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type CommentWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Comment, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetComment patches the objects in a transaction: the masks are applied in memory to
+// the stored objects, read and locked with a single query, and the objects are written with an update
+// per batch of 100 objects. The objects are read and written with the handles their hooks
+// return, the objects of different handles don't share the queries. Only the associations in the
+// masks are updated, and objects sharing a primary key are rejected
+func DefaultPatchSetComment(ctx context.Context, objects []*Comment, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Comment, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+	if len(objects) == 0 {
+		return []*Comment{}, nil
+	}
+	var err error
+	objectKeys := make([]uint64, 0, len(objects))
+	seen := make(map[uint64]struct{}, len(objects))
+	for _, in := range objects {
+		if in == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := in.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if ormObj.Id == 0 {
+			return nil, errors.EmptyIdError
+		}
+		key := ormObj.Id
+		if _, ok := seen[key]; ok {
+			return nil, errors.DuplicateKeyError
+		}
+		seen[key] = struct{}{}
+		objectKeys = append(objectKeys, key)
+	}
+	batchSize := 100
+	results := make([]*Comment, len(objects))
+	err = db.Transaction(func(db *gorm.DB) error {
+		var err error
+		// the objects sharing the handle their hooks return share the queries
+		dbs := make([]*gorm.DB, len(objects))
+		group := func() ([]*gorm.DB, map[*gorm.DB][]int) {
+			var handles []*gorm.DB
+			groups := make(map[*gorm.DB][]int)
+			for i, handle := range dbs {
+				if _, ok := groups[handle]; !ok {
+					handles = append(handles, handle)
+				}
+				groups[handle] = append(groups[handle], i)
+			}
+			return handles, groups
+		}
+		for i, in := range objects {
+			dbs[i] = db
+			if hook, ok := interface{}(&Comment{}).(CommentWithBeforePatchRead); ok {
+				if dbs[i], err = hook.BeforePatchRead(ctx, in, updateMasks[i], db); err != nil {
+					return err
+				}
+			}
+		}
+		targets := make(map[uint64]CommentORM, len(objects))
+		handles, groups := group()
+		for _, handle := range handles {
+			keys := make([]uint64, 0, len(groups[handle]))
+			for _, i := range groups[handle] {
+				keys = append(keys, objectKeys[i])
+			}
+			query := handle.Session(&gorm.Session{}).Clauses(clause.Locking{Strength: "UPDATE"})
+			query = query.Where("id in (?)", keys)
+			rows := []CommentORM{}
+			if err = query.Find(&rows).Error; err != nil {
+				return err
+			}
+			for _, row := range rows {
+				targets[row.Id] = row
+			}
+		}
+		ormObjs := make([]CommentORM, len(objects))
+		prepare := func(i int) (*gorm.DB, error) {
+			in, key, updateMask, db := objects[i], objectKeys[i], updateMasks[i], dbs[i]
+			target, ok := targets[key]
+			if !ok {
+				return nil, gorm.ErrRecordNotFound
+			}
+			stored, err := target.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbObj := &stored
+			if hook, ok := interface{}(pbObj).(CommentWithBeforePatchApplyFieldMask); ok {
+				if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			if _, err := DefaultApplyFieldMaskComment(ctx, pbObj, in, updateMask, "", db); err != nil {
+				return nil, err
+			}
+			if hook, ok := interface{}(pbObj).(CommentWithBeforePatchSave); ok {
+				if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObj, err := pbObj.ToORM(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if hook, ok := interface{}(&ormObj).(CommentORMWithBeforeStrictUpdateCleanup); ok {
+				if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			if hook, ok := interface{}(&ormObj).(CommentORMWithBeforeStrictUpdateSave); ok {
+				if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObjs[i] = ormObj
+			return db, nil
+		}
+		for i := range objects {
+			if dbs[i], err = prepare(i); err != nil {
+				return err
+			}
+		}
+		write := func(db *gorm.DB, batch []int) error {
+			conds := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*2)
+			for _, i := range batch {
+				conds = append(conds, "(id = ?)")
+				args = append(args, objectKeys[i])
+			}
+			column := func(name string, value func(ormObj *CommentORM) interface{}) clause.Expr {
+				whens := make([]interface{}, 0, len(batch)*2)
+				for _, i := range batch {
+					whens = append(whens, objectKeys[i], value(&ormObjs[i]))
+				}
+				return gorm.Expr("CASE "+strings.Repeat("WHEN id = ? THEN ? ", len(batch))+"ELSE "+name+" END", whens...)
+			}
+			res := db.Session(&gorm.Session{}).Model(&CommentORM{}).Where(strings.Join(conds, " OR "), args...).Updates(map[string]interface{}{
+				"owner_id":   column("owner_id", func(ormObj *CommentORM) interface{} { return ormObj.OwnerID }),
+				"owner_type": column("owner_type", func(ormObj *CommentORM) interface{} { return ormObj.OwnerType }),
+				"text":       column("text", func(ormObj *CommentORM) interface{} { return ormObj.Text }),
+			})
+			return res.Error
+		}
+		handles, groups = group()
+		for _, handle := range handles {
+			indexes := groups[handle]
+			for start := 0; start < len(indexes); start += batchSize {
+				end := start + batchSize
+				if end > len(indexes) {
+					end = len(indexes)
+				}
+				batch := indexes[start:end]
+				if err = write(handle, batch); err != nil {
+					return err
+				}
+			}
+		}
+		finish := func(i int) (*Comment, error) {
+			in, updateMask, db, ormObj := objects[i], updateMasks[i], dbs[i], ormObjs[i]
+			var err error
+			if hook, ok := interface{}(&ormObj).(CommentORMWithAfterStrictUpdateSave); ok {
+				if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			saved, err := ormObj.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbResponse := &saved
+			if hook, ok := interface{}(pbResponse).(CommentWithAfterPatchSave); ok {
+				if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			return pbResponse, nil
+		}
+		for i := range objects {
+			if results[i], err = finish(i); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// DefaultApplyFieldMaskComment patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskComment(ctx context.Context, patchee *Comment, patcher *Comment, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Comment, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	if len(updateMask.GetPaths()) == 1 && updateMask.Paths[0] == prefix+"*" {
+		updateMask = &field_mask.FieldMask{Paths: []string{prefix + "Id", prefix + "Text"}}
+	}
+	for _, f := range updateMask.GetPaths() {
+		if strings.HasPrefix(f, prefix) && !DefaultHasFieldMaskPathComment(strings.TrimPrefix(f, prefix)) {
+			return nil, &errors.InvalidFieldMaskError{Path: f}
+		}
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"Text" {
+			patchee.Text = patcher.Text
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultHasFieldMaskPathComment reports whether Comment has the field of the field mask path
+func DefaultHasFieldMaskPathComment(path string) bool {
+	parts := strings.SplitN(path, ".", 2)
+	switch parts[0] {
+	case "Id", "Text":
+		return len(parts) == 1
+	}
+	return false
+}
+
+// DefaultListComment executes a gorm list call
+func DefaultListComment(ctx context.Context, db *gorm.DB) ([]*Comment, error) {
+	in := Comment{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(CommentORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(CommentORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []CommentORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(CommentORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Comment{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type CommentORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type CommentORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type CommentORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]CommentORM) error
+}
+
+// DefaultCreateTicket executes a basic gorm create call
+func DefaultCreateTicket(ctx context.Context, in *Ticket, db *gorm.DB) (*Ticket, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TicketORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TicketORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type TicketORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TicketORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultCreateTicketSet executes gorm create calls in batches of 100 objects
+// in a transaction, either all of the objects are created or none of them. The BeforeCreate_ hook
+// of each object runs before the insert of its batch, which uses the handle the hooks of the
+// batch return
+func DefaultCreateTicketSet(ctx context.Context, in []*Ticket, db *gorm.DB) ([]*Ticket, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObjs := make([]TicketORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, ormObj)
+	}
+	batchSize := 100
+	err := db.Transaction(func(db *gorm.DB) error {
+		var err error
+		for start := 0; start < len(ormObjs); start += batchSize {
+			end := start + batchSize
+			if end > len(ormObjs) {
+				end = len(ormObjs)
+			}
+			batch := ormObjs[start:end]
+			// the scopes the hooks add only apply to the insert of their own batch
+			batchDB := db
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(TicketORMWithBeforeCreate_); ok {
+					if batchDB, err = hook.BeforeCreate_(ctx, batchDB); err != nil {
+						return err
+					}
+				}
+			}
+			if err = batchDB.Omit().CreateInBatches(&batch, batchSize).Error; err != nil {
+				return err
+			}
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(TicketORMWithAfterCreate_); ok {
+					if err = hook.AfterCreate_(ctx, db); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*Ticket, 0, len(ormObjs))
+	for i := range ormObjs {
+		pbResponse, err := ormObjs[i].ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, &pbResponse)
+	}
+	return results, nil
+}
+
+func DefaultReadTicket(ctx context.Context, in *Ticket, db *gorm.DB) (*Ticket, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(TicketORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(TicketORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := TicketORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(TicketORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type TicketORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TicketORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TicketORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteTicket(ctx context.Context, in *Ticket, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	return db.Transaction(func(db *gorm.DB) error {
+		if hook, ok := interface{}(&ormObj).(TicketORMWithBeforeDelete_); ok {
+			if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+				return err
+			}
+		}
+		keys := []uint64{}
+		if err = db.Model(&TicketORM{}).Where(&ormObj).Pluck("id", &keys).Error; err != nil {
+			return err
+		}
+		if err = DefaultCascadeDeleteTicket(ctx, keys, db.Session(&gorm.Session{NewDB: true})); err != nil {
+			return err
+		}
+		err = db.Where(&ormObj).Delete(&TicketORM{}).Error
+		if err != nil {
+			return err
+		}
+		if hook, ok := interface{}(&ormObj).(TicketORMWithAfterDelete_); ok {
+			err = hook.AfterDelete_(ctx, db)
+		}
+		return err
+	})
+}
+
+type TicketORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TicketORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteTicketSet(ctx context.Context, in []*Ticket, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []uint64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	return db.Transaction(func(db *gorm.DB) error {
+		if hook, ok := (interface{}(&TicketORM{})).(TicketORMWithBeforeDeleteSet); ok {
+			if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+				return err
+			}
+		}
+		owned := []uint64{}
+		query := db.Model(&TicketORM{}).Where("id in (?)", keys)
+		if err = query.Pluck("id", &owned).Error; err != nil {
+			return err
+		}
+		if err = DefaultCascadeDeleteTicket(ctx, owned, db.Session(&gorm.Session{NewDB: true})); err != nil {
+			return err
+		}
+		err = db.Where("id in (?)", keys).Delete(&TicketORM{}).Error
+		if err != nil {
+			return err
+		}
+		if hook, ok := (interface{}(&TicketORM{})).(TicketORMWithAfterDeleteSet); ok {
+			err = hook.AfterDeleteSet(ctx, in, db)
+		}
+		return err
+	})
+}
+
+type TicketORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Ticket, *gorm.DB) (*gorm.DB, error)
+}
+type TicketORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Ticket, *gorm.DB) error
+}
+
+// DefaultStrictUpdateTicket clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateTicket(ctx context.Context, in *Ticket, db *gorm.DB) (*Ticket, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateTicket")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var count int64
+	lockedRow := &TicketORM{}
+	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
+	if count != 0 {
+		if ormObj.Version == 0 {
+			ormObj.Version = lockedRow.Version
+		}
+		res := db.Session(&gorm.Session{}).Model(&TicketORM{}).Where("id=?", ormObj.Id).Where("version = ?", ormObj.Version).Update("version", ormObj.Version+1)
+		if res.Error != nil {
+			return nil, res.Error
+		}
+		if res.RowsAffected == 0 {
+			return nil, errors.VersionConflictError
+		}
+		ormObj.Version++
+	}
+	if hook, ok := interface{}(&ormObj).(TicketORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	filterComments := CommentORM{}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	filterComments.OwnerID = ormObj.Id
+	filterComments.OwnerType = "tickets"
+	if err = db.Where(filterComments).Delete(CommentORM{}).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TicketORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TicketORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		err = gateway.SetCreated(ctx, "")
+	}
+	return &pbResponse, err
+}
+
+type TicketORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TicketORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TicketORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchTicket executes a basic gorm update call with patch behavior
+func DefaultPatchTicket(ctx context.Context, in *Ticket, updateMask *field_mask.FieldMask, db *gorm.DB) (*Ticket, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	pbObj := &Ticket{}
+	var err error
+	if hook, ok := interface{}(pbObj).(TicketWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if pbObj, err = DefaultReadTicket(ctx, &Ticket{Id: in.GetId()}, db); err != nil {
+		return nil, err
+	}
+	if in.Version != 0 && in.Version != pbObj.Version {
+		return nil, errors.VersionConflictError
+	}
+	if hook, ok := interface{}(pbObj).(TicketWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskTicket(ctx, pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbObj).(TicketWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateTicket(ctx, pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(TicketWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type TicketWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Ticket, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type TicketWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Ticket, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type TicketWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Ticket, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type TicketWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Ticket, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetTicket patches the objects in a transaction: the masks are applied in memory to
+// the stored objects, read and locked with a single query, and the objects are written with an update
+// per batch of 100 objects. The objects are read and written with the handles their hooks
+// return, the objects of different handles don't share the queries. Only the associations in the
+// masks are updated, and objects sharing a primary key are rejected
+func DefaultPatchSetTicket(ctx context.Context, objects []*Ticket, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Ticket, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+	if len(objects) == 0 {
+		return []*Ticket{}, nil
+	}
+	var err error
+	objectKeys := make([]uint64, 0, len(objects))
+	seen := make(map[uint64]struct{}, len(objects))
+	for _, in := range objects {
+		if in == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := in.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if ormObj.Id == 0 {
+			return nil, errors.EmptyIdError
+		}
+		key := ormObj.Id
+		if _, ok := seen[key]; ok {
+			return nil, errors.DuplicateKeyError
+		}
+		seen[key] = struct{}{}
+		objectKeys = append(objectKeys, key)
+	}
+	batchSize := 100
+	results := make([]*Ticket, len(objects))
+	err = db.Transaction(func(db *gorm.DB) error {
+		var err error
+		// the objects sharing the handle their hooks return share the queries
+		dbs := make([]*gorm.DB, len(objects))
+		group := func() ([]*gorm.DB, map[*gorm.DB][]int) {
+			var handles []*gorm.DB
+			groups := make(map[*gorm.DB][]int)
+			for i, handle := range dbs {
+				if _, ok := groups[handle]; !ok {
+					handles = append(handles, handle)
+				}
+				groups[handle] = append(groups[handle], i)
+			}
+			return handles, groups
+		}
+		for i, in := range objects {
+			dbs[i] = db
+			if hook, ok := interface{}(&Ticket{}).(TicketWithBeforePatchRead); ok {
+				if dbs[i], err = hook.BeforePatchRead(ctx, in, updateMasks[i], db); err != nil {
+					return err
+				}
+			}
+		}
+		targets := make(map[uint64]TicketORM, len(objects))
+		handles, groups := group()
+		for _, handle := range handles {
+			keys := make([]uint64, 0, len(groups[handle]))
+			for _, i := range groups[handle] {
+				keys = append(keys, objectKeys[i])
+			}
+			query := handle.Session(&gorm.Session{}).Clauses(clause.Locking{Strength: "UPDATE"})
+			query = query.Where("id in (?)", keys)
+			rows := []TicketORM{}
+			if err = query.Find(&rows).Error; err != nil {
+				return err
+			}
+			for _, row := range rows {
+				targets[row.Id] = row
+			}
+		}
+		ormObjs := make([]TicketORM, len(objects))
+		prepare := func(i int) (*gorm.DB, error) {
+			in, key, updateMask, db := objects[i], objectKeys[i], updateMasks[i], dbs[i]
+			target, ok := targets[key]
+			if !ok {
+				return nil, gorm.ErrRecordNotFound
+			}
+			if in.Version != 0 && in.Version != target.Version {
+				return nil, errors.VersionConflictError
+			}
+			stored, err := target.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbObj := &stored
+			if hook, ok := interface{}(pbObj).(TicketWithBeforePatchApplyFieldMask); ok {
+				if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			if _, err := DefaultApplyFieldMaskTicket(ctx, pbObj, in, updateMask, "", db); err != nil {
+				return nil, err
+			}
+			if hook, ok := interface{}(pbObj).(TicketWithBeforePatchSave); ok {
+				if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObj, err := pbObj.ToORM(ctx)
+			if err != nil {
+				return nil, err
+			}
+			ormObj.Version = target.Version + 1
+			if hook, ok := interface{}(&ormObj).(TicketORMWithBeforeStrictUpdateCleanup); ok {
+				if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			masked := make(map[string]bool, len(updateMask.GetPaths()))
+			for _, path := range updateMask.GetPaths() {
+				masked[strings.SplitN(path, ".", 2)[0]] = true
+			}
+			if masked["Comments"] || masked["*"] {
+				filterComments := CommentORM{}
+				if ormObj.Id == 0 {
+					return nil, errors.EmptyIdError
+				}
+				filterComments.OwnerID = ormObj.Id
+				filterComments.OwnerType = "tickets"
+				if err = db.Where(filterComments).Delete(CommentORM{}).Error; err != nil {
+					return nil, err
+				}
+			}
+			if hook, ok := interface{}(&ormObj).(TicketORMWithBeforeStrictUpdateSave); ok {
+				if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObjs[i] = ormObj
+			return db, nil
+		}
+		for i := range objects {
+			if dbs[i], err = prepare(i); err != nil {
+				return err
+			}
+		}
+		write := func(db *gorm.DB, batch []int) error {
+			conds := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*2)
+			for _, i := range batch {
+				conds = append(conds, "(id = ? AND version = ?)")
+				args = append(args, objectKeys[i], targets[objectKeys[i]].Version)
+			}
+			column := func(name string, value func(ormObj *TicketORM) interface{}) clause.Expr {
+				whens := make([]interface{}, 0, len(batch)*2)
+				for _, i := range batch {
+					whens = append(whens, objectKeys[i], value(&ormObjs[i]))
+				}
+				return gorm.Expr("CASE "+strings.Repeat("WHEN id = ? THEN ? ", len(batch))+"ELSE "+name+" END", whens...)
+			}
+			res := db.Session(&gorm.Session{}).Model(&TicketORM{}).Where(strings.Join(conds, " OR "), args...).Updates(map[string]interface{}{
+				"title":   column("title", func(ormObj *TicketORM) interface{} { return ormObj.Title }),
+				"version": column("version", func(ormObj *TicketORM) interface{} { return ormObj.Version }),
+			})
+			if res.Error != nil {
+				return res.Error
+			}
+			if res.RowsAffected != int64(len(batch)) {
+				return errors.VersionConflictError
+			}
+			return nil
+		}
+		handles, groups = group()
+		for _, handle := range handles {
+			indexes := groups[handle]
+			for start := 0; start < len(indexes); start += batchSize {
+				end := start + batchSize
+				if end > len(indexes) {
+					end = len(indexes)
+				}
+				batch := indexes[start:end]
+				objs := make([]*TicketORM, 0, len(batch))
+				for _, i := range batch {
+					objs = append(objs, &ormObjs[i])
+				}
+				// the associations are saved first, the batch is then written with the
+				// foreign keys of its belongs-to associations
+				if err = handle.Session(&gorm.Session{}).Select("Comments").Model(&objs).Updates(map[string]interface{}{}).Error; err != nil {
+					return err
+				}
+				if err = write(handle, batch); err != nil {
+					return err
+				}
+			}
+		}
+		finish := func(i int) (*Ticket, error) {
+			in, updateMask, db, ormObj := objects[i], updateMasks[i], dbs[i], ormObjs[i]
+			var err error
+			if hook, ok := interface{}(&ormObj).(TicketORMWithAfterStrictUpdateSave); ok {
+				if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			saved, err := ormObj.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbResponse := &saved
+			if hook, ok := interface{}(pbResponse).(TicketWithAfterPatchSave); ok {
+				if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			return pbResponse, nil
+		}
+		for i := range objects {
+			if results[i], err = finish(i); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// DefaultCascadeDeleteTicket deletes the children owned by the objects of the given
+// primary keys, along with the children they own in turn
+func DefaultCascadeDeleteTicket(ctx context.Context, keys interface{}, db *gorm.DB) error {
+	if err := db.Where("owner_id IN ? AND owner_type = ?", keys, "tickets").Delete(&CommentORM{}).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+// DefaultApplyFieldMaskTicket patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskTicket(ctx context.Context, patchee *Ticket, patcher *Ticket, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Ticket, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	if len(updateMask.GetPaths()) == 1 && updateMask.Paths[0] == prefix+"*" {
+		updateMask = &field_mask.FieldMask{Paths: []string{prefix + "Id", prefix + "Title", prefix + "Version", prefix + "Comments"}}
+	}
+	for _, f := range updateMask.GetPaths() {
+		if strings.HasPrefix(f, prefix) && !DefaultHasFieldMaskPathTicket(strings.TrimPrefix(f, prefix)) {
+			return nil, &errors.InvalidFieldMaskError{Path: f}
+		}
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"Title" {
+			patchee.Title = patcher.Title
+			continue
+		}
+		if f == prefix+"Version" {
+			patchee.Version = patcher.Version
+			continue
+		}
+		if f == prefix+"Comments" {
+			patchee.Comments = patcher.Comments
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultHasFieldMaskPathTicket reports whether Ticket has the field of the field mask path
+func DefaultHasFieldMaskPathTicket(path string) bool {
+	parts := strings.SplitN(path, ".", 2)
+	switch parts[0] {
+	case "Id", "Title", "Version", "Comments":
+		return len(parts) == 1
+	}
+	return false
+}
+
+// DefaultImpliedFieldMaskTicket returns the field mask of the fields set in the object
+func DefaultImpliedFieldMaskTicket(in *Ticket) *field_mask.FieldMask {
+	mask := &field_mask.FieldMask{}
+	if in == nil {
+		return mask
+	}
+	in.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		switch fd.Name() {
+		case "id":
+			mask.Paths = append(mask.Paths, "Id")
+		case "title":
+			mask.Paths = append(mask.Paths, "Title")
+		case "version":
+			mask.Paths = append(mask.Paths, "Version")
+		case "comments":
+			mask.Paths = append(mask.Paths, "Comments")
+		}
+		return true
+	})
+	return mask
+}
+
+// DefaultListTicket executes a gorm list call
+// and returns the page token of the next page, empty on the last page
+func DefaultListTicket(ctx context.Context, db *gorm.DB, f *query.Filtering, s *query.Sorting, p *query.Pagination) ([]*Ticket, string, int64, error) {
+	in := Ticket{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, "", 0, err
+	}
+	if hook, ok := interface{}(&ormObj).(TicketORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db, f, s, p); err != nil {
+			return nil, "", 0, err
+		}
+	}
+	desc := false
+	columns := []string{}
+	sorted := map[string]bool{}
+	for i, cr := range s.GetCriterias() {
+		if i > 0 && cr.IsDesc() != desc {
+			return nil, "", 0, fmt.Errorf("cursor pagination of Ticket needs a single sort direction")
+		}
+		desc = cr.IsDesc()
+		switch cr.GetTag() {
+		case "id":
+			columns = append(columns, "id")
+			sorted["id"] = true
+		case "title":
+			columns = append(columns, "title")
+			sorted["title"] = true
+		case "version":
+			columns = append(columns, "version")
+			sorted["version"] = true
+		default:
+			return nil, "", 0, fmt.Errorf("cannot paginate Ticket sorted by %q", cr.GetTag())
+		}
+	}
+	if !sorted["id"] {
+		columns = append(columns, "id")
+	}
+	var seek []interface{}
+	if token := p.GetPageToken(); token != "" {
+		raw, err := base64.URLEncoding.DecodeString(token)
+		if err != nil {
+			return nil, "", 0, errors.InvalidPageTokenError
+		}
+		decoder := json.NewDecoder(bytes.NewReader(raw))
+		decoder.UseNumber()
+		if err = decoder.Decode(&seek); err != nil || len(seek) != len(columns) {
+			return nil, "", 0, errors.InvalidPageTokenError
+		}
+	}
+	p = &query.Pagination{Limit: p.GetLimit()}
+	limit := p.GetLimit()
+	if limit > 0 {
+		// the row following the page tells whether there is a next one
+		p.Limit++
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &TicketORM{}, &Ticket{}, f, s, p, nil)
+	if err != nil {
+		return nil, "", 0, err
+	}
+	if hook, ok := interface{}(&ormObj).(TicketORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db, f, s, p); err != nil {
+			return nil, "", 0, err
+		}
+	}
+	db = db.Where(&ormObj)
+	countDB := db.Session(&gorm.Session{}).Limit(-1).Offset(-1)
+	countDB.Statement.Preloads = nil
+	var total int64
+	if err = countDB.Model(&TicketORM{}).Count(&total).Error; err != nil {
+		return nil, "", 0, err
+	}
+	if seek != nil {
+		op := ">"
+		if desc {
+			op = "<"
+		}
+		db = db.Where("("+strings.Join(columns, ", ")+") "+op+" ?", seek)
+	}
+	if !sorted["id"] {
+		if desc {
+			db = db.Order("id desc")
+		} else {
+			db = db.Order("id")
+		}
+	}
+	ormResponse := []TicketORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, "", 0, err
+	}
+	var pageToken string
+	if limit > 0 && int32(len(ormResponse)) > limit {
+		ormResponse = ormResponse[:limit]
+		if pageToken, err = DefaultPageTokenTicket(ctx, &ormResponse[limit-1], s); err != nil {
+			return nil, "", 0, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(TicketORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse, f, s, p); err != nil {
+			return nil, "", 0, err
+		}
+	}
+	pbResponse := []*Ticket{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, "", 0, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, pageToken, total, nil
+}
+
+type TicketORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB, *query.Filtering, *query.Sorting, *query.Pagination) (*gorm.DB, error)
+}
+type TicketORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB, *query.Filtering, *query.Sorting, *query.Pagination) (*gorm.DB, error)
+}
+type TicketORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]TicketORM, *query.Filtering, *query.Sorting, *query.Pagination) error
+}
+
+// DefaultPageTokenTicket encodes the sort key of in, the last row of a page sorted
+// by s, into the page token of the next page
+func DefaultPageTokenTicket(ctx context.Context, in *TicketORM, s *query.Sorting) (string, error) {
+	if in == nil {
+		return "", errors.NilArgumentError
+	}
+	key := []interface{}{}
+	sorted := map[string]bool{}
+	for _, cr := range s.GetCriterias() {
+		switch cr.GetTag() {
+		case "id":
+			key = append(key, in.Id)
+			sorted["id"] = true
+		case "title":
+			key = append(key, in.Title)
+			sorted["title"] = true
+		case "version":
+			key = append(key, in.Version)
+			sorted["version"] = true
+		default:
+			return "", fmt.Errorf("cannot paginate Ticket sorted by %q", cr.GetTag())
+		}
+	}
+	if !sorted["id"] {
+		key = append(key, in.Id)
+	}
+	raw, err := json.Marshal(key)
+	if err != nil {
+		return "", err
+	}
+	return base64.URLEncoding.EncodeToString(raw), nil
+}
+
+// DefaultCountTicket executes a gorm count call with the filtering of the list
+func DefaultCountTicket(ctx context.Context, db *gorm.DB, f *query.Filtering) (int64, error) {
+	in := Ticket{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return 0, err
+	}
+	db, assocToJoin, err := gorm1.ApplyFilteringEx(ctx, db, f, &TicketORM{}, gorm1.NewDefaultPbToOrmConverter(&Ticket{}))
+	if err != nil {
+		return 0, err
+	}
+	if db, err = gorm1.JoinAssociations(ctx, db, assocToJoin, &TicketORM{}); err != nil {
+		return 0, err
+	}
+	var count int64
+	if err = db.Model(&TicketORM{}).Where(&ormObj).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// DefaultStreamListTicket executes a gorm list call and sends each row
+func DefaultStreamListTicket(ctx context.Context, db *gorm.DB, f *query.Filtering, s *query.Sorting, p *query.Pagination, send func(*Ticket) error) error {
+	in := Ticket{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(TicketORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db, f, s, p); err != nil {
+			return err
+		}
+	}
+	desc := false
+	columns := []string{}
+	sorted := map[string]bool{}
+	for i, cr := range s.GetCriterias() {
+		if i > 0 && cr.IsDesc() != desc {
+			return fmt.Errorf("cursor pagination of Ticket needs a single sort direction")
+		}
+		desc = cr.IsDesc()
+		switch cr.GetTag() {
+		case "id":
+			columns = append(columns, "id")
+			sorted["id"] = true
+		case "title":
+			columns = append(columns, "title")
+			sorted["title"] = true
+		case "version":
+			columns = append(columns, "version")
+			sorted["version"] = true
+		default:
+			return fmt.Errorf("cannot paginate Ticket sorted by %q", cr.GetTag())
+		}
+	}
+	if !sorted["id"] {
+		columns = append(columns, "id")
+	}
+	var seek []interface{}
+	if token := p.GetPageToken(); token != "" {
+		raw, err := base64.URLEncoding.DecodeString(token)
+		if err != nil {
+			return errors.InvalidPageTokenError
+		}
+		decoder := json.NewDecoder(bytes.NewReader(raw))
+		decoder.UseNumber()
+		if err = decoder.Decode(&seek); err != nil || len(seek) != len(columns) {
+			return errors.InvalidPageTokenError
+		}
+	}
+	p = &query.Pagination{Limit: p.GetLimit()}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &TicketORM{}, &Ticket{}, f, s, p, nil)
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(TicketORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db, f, s, p); err != nil {
+			return err
+		}
+	}
+	db = db.Where(&ormObj)
+	if seek != nil {
+		op := ">"
+		if desc {
+			op = "<"
+		}
+		db = db.Where("("+strings.Join(columns, ", ")+") "+op+" ?", seek)
+	}
+	if !sorted["id"] {
+		if desc {
+			db = db.Order("id desc")
+		} else {
+			db = db.Order("id")
+		}
+	}
+	rows, err := db.Model(&TicketORM{}).Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		row := TicketORM{}
+		if err = db.ScanRows(rows, &row); err != nil {
+			return err
+		}
+		pbObj, err := row.ToPB(ctx)
+		if err != nil {
+			return err
+		}
+		if err = send(&pbObj); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// DefaultCreateFolder executes a basic gorm create call
+func DefaultCreateFolder(ctx context.Context, in *Folder, db *gorm.DB) (*Folder, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(FolderORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(FolderORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type FolderORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type FolderORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultCreateFolderSet executes gorm create calls in batches of 100 objects
+// in a transaction, either all of the objects are created or none of them. The BeforeCreate_ hook
+// of each object runs before the insert of its batch, which uses the handle the hooks of the
+// batch return
+func DefaultCreateFolderSet(ctx context.Context, in []*Folder, db *gorm.DB) ([]*Folder, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObjs := make([]FolderORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, ormObj)
+	}
+	batchSize := 100
+	err := db.Transaction(func(db *gorm.DB) error {
+		var err error
+		for start := 0; start < len(ormObjs); start += batchSize {
+			end := start + batchSize
+			if end > len(ormObjs) {
+				end = len(ormObjs)
+			}
+			batch := ormObjs[start:end]
+			// the scopes the hooks add only apply to the insert of their own batch
+			batchDB := db
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(FolderORMWithBeforeCreate_); ok {
+					if batchDB, err = hook.BeforeCreate_(ctx, batchDB); err != nil {
+						return err
+					}
+				}
+			}
+			if err = batchDB.Omit().CreateInBatches(&batch, batchSize).Error; err != nil {
+				return err
+			}
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(FolderORMWithAfterCreate_); ok {
+					if err = hook.AfterCreate_(ctx, db); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*Folder, 0, len(ormObjs))
+	for i := range ormObjs {
+		pbResponse, err := ormObjs[i].ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, &pbResponse)
+	}
+	return results, nil
+}
+
+func DefaultReadFolder(ctx context.Context, in *Folder, db *gorm.DB) (*Folder, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(FolderORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(FolderORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := FolderORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(FolderORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type FolderORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type FolderORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type FolderORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteFolder(ctx context.Context, in *Folder, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(FolderORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&FolderORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(FolderORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type FolderORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type FolderORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteFolderSet(ctx context.Context, in []*Folder, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []uint64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&FolderORM{})).(FolderORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&FolderORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&FolderORM{})).(FolderORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type FolderORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Folder, *gorm.DB) (*gorm.DB, error)
+}
+type FolderORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Folder, *gorm.DB) error
+}
+
+// DefaultStrictUpdateFolder clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateFolder(ctx context.Context, in *Folder, db *gorm.DB) (*Folder, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateFolder")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var count int64
+	lockedRow := &FolderORM{}
+	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
+	if hook, ok := interface{}(&ormObj).(FolderORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	filterChildren := FolderORM{}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	filterChildren.ParentId = new(uint64)
+	*filterChildren.ParentId = ormObj.Id
+	if err = db.Model(&FolderORM{}).Where(filterChildren).Update("parent_id", nil).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(FolderORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(FolderORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		err = gateway.SetCreated(ctx, "")
+	}
+	return &pbResponse, err
+}
+
+type FolderORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type FolderORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type FolderORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultUpsertFolder executes a gorm create call resolving conflicts on conflictTarget,
+// the name of a unique index or the primary key when empty, by updating the columns
+// in updateMask, all of them when updateMask is empty, and returns the stored object
+func DefaultUpsertFolder(ctx context.Context, in *Folder, conflictTarget string, updateMask *field_mask.FieldMask, db *gorm.DB) (*Folder, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conflict := clause.OnConflict{}
+	var lookup func() map[string]interface{}
+	switch conflictTarget {
+	case "":
+		conflict.Columns = []clause.Column{{Name: "id"}}
+		lookup = func() map[string]interface{} { return map[string]interface{}{"id": ormObj.Id} }
+	default:
+		return nil, fmt.Errorf("unknown conflict target %q of Folder", conflictTarget)
+	}
+	if len(updateMask.GetPaths()) == 0 {
+		conflict.UpdateAll = true
+	} else {
+		var columns []string
+		for _, path := range updateMask.GetPaths() {
+			var pathColumns []string
+			switch path {
+			case "Name":
+				pathColumns = []string{"name"}
+			case "ParentId":
+				pathColumns = []string{"parent_id"}
+			default:
+				return nil, &errors.InvalidFieldMaskError{Path: path}
+			}
+			for _, column := range pathColumns {
+				for _, target := range conflict.Columns {
+					if column == target.Name {
+						return nil, &errors.InvalidFieldMaskError{Path: path}
+					}
+				}
+			}
+			columns = append(columns, pathColumns...)
+		}
+		conflict.DoUpdates = clause.AssignmentColumns(columns)
+	}
+	if hook, ok := interface{}(&ormObj).(FolderORMWithBeforeUpsert); ok {
+		if db, err = hook.BeforeUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Clauses(conflict).Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if len(updateMask.GetPaths()) > 0 {
+		stored := FolderORM{}
+		if err = db.Where(lookup()).Take(&stored).Error; err != nil {
+			return nil, err
+		}
+		ormObj = stored
+	}
+	if hook, ok := interface{}(&ormObj).(FolderORMWithAfterUpsert); ok {
+		if err = hook.AfterUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type FolderORMWithBeforeUpsert interface {
+	BeforeUpsert(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type FolderORMWithAfterUpsert interface {
+	AfterUpsert(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchFolder executes a basic gorm update call with patch behavior
+func DefaultPatchFolder(ctx context.Context, in *Folder, updateMask *field_mask.FieldMask, db *gorm.DB) (*Folder, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	pbObj := &Folder{}
+	var err error
+	if hook, ok := interface{}(pbObj).(FolderWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if pbObj, err = DefaultReadFolder(ctx, &Folder{Id: in.GetId()}, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbObj).(FolderWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskFolder(ctx, pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbObj).(FolderWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateFolder(ctx, pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(FolderWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type FolderWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Folder, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type FolderWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Folder, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type FolderWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Folder, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type FolderWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Folder, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetFolder patches the objects in a transaction: the masks are applied in memory to
+// the stored objects, read and locked with a single query, and the objects are written with an update
+// per batch of 100 objects. The objects are read and written with the handles their hooks
+// return, the objects of different handles don't share the queries. Only the associations in the
+// masks are updated, and objects sharing a primary key are rejected
+func DefaultPatchSetFolder(ctx context.Context, objects []*Folder, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Folder, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+	if len(objects) == 0 {
+		return []*Folder{}, nil
+	}
+	var err error
+	objectKeys := make([]uint64, 0, len(objects))
+	seen := make(map[uint64]struct{}, len(objects))
+	for _, in := range objects {
+		if in == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := in.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if ormObj.Id == 0 {
+			return nil, errors.EmptyIdError
+		}
+		key := ormObj.Id
+		if _, ok := seen[key]; ok {
+			return nil, errors.DuplicateKeyError
+		}
+		seen[key] = struct{}{}
+		objectKeys = append(objectKeys, key)
+	}
+	batchSize := 100
+	results := make([]*Folder, len(objects))
+	err = db.Transaction(func(db *gorm.DB) error {
+		var err error
+		// the objects sharing the handle their hooks return share the queries
+		dbs := make([]*gorm.DB, len(objects))
+		group := func() ([]*gorm.DB, map[*gorm.DB][]int) {
+			var handles []*gorm.DB
+			groups := make(map[*gorm.DB][]int)
+			for i, handle := range dbs {
+				if _, ok := groups[handle]; !ok {
+					handles = append(handles, handle)
+				}
+				groups[handle] = append(groups[handle], i)
+			}
+			return handles, groups
+		}
+		for i, in := range objects {
+			dbs[i] = db
+			if hook, ok := interface{}(&Folder{}).(FolderWithBeforePatchRead); ok {
+				if dbs[i], err = hook.BeforePatchRead(ctx, in, updateMasks[i], db); err != nil {
+					return err
+				}
+			}
+		}
+		targets := make(map[uint64]FolderORM, len(objects))
+		handles, groups := group()
+		for _, handle := range handles {
+			keys := make([]uint64, 0, len(groups[handle]))
+			for _, i := range groups[handle] {
+				keys = append(keys, objectKeys[i])
+			}
+			query := handle.Session(&gorm.Session{}).Clauses(clause.Locking{Strength: "UPDATE"})
+			query = query.Where("id in (?)", keys)
+			rows := []FolderORM{}
+			if err = query.Find(&rows).Error; err != nil {
+				return err
+			}
+			for _, row := range rows {
+				targets[row.Id] = row
+			}
+		}
+		ormObjs := make([]FolderORM, len(objects))
+		prepare := func(i int) (*gorm.DB, error) {
+			in, key, updateMask, db := objects[i], objectKeys[i], updateMasks[i], dbs[i]
+			target, ok := targets[key]
+			if !ok {
+				return nil, gorm.ErrRecordNotFound
+			}
+			stored, err := target.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbObj := &stored
+			if hook, ok := interface{}(pbObj).(FolderWithBeforePatchApplyFieldMask); ok {
+				if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			if _, err := DefaultApplyFieldMaskFolder(ctx, pbObj, in, updateMask, "", db); err != nil {
+				return nil, err
+			}
+			if hook, ok := interface{}(pbObj).(FolderWithBeforePatchSave); ok {
+				if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObj, err := pbObj.ToORM(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if hook, ok := interface{}(&ormObj).(FolderORMWithBeforeStrictUpdateCleanup); ok {
+				if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			masked := make(map[string]bool, len(updateMask.GetPaths()))
+			for _, path := range updateMask.GetPaths() {
+				masked[strings.SplitN(path, ".", 2)[0]] = true
+			}
+			if masked["Children"] || masked["*"] {
+				filterChildren := FolderORM{}
+				if ormObj.Id == 0 {
+					return nil, errors.EmptyIdError
+				}
+				filterChildren.ParentId = new(uint64)
+				*filterChildren.ParentId = ormObj.Id
+				if err = db.Model(&FolderORM{}).Where(filterChildren).Update("parent_id", nil).Error; err != nil {
+					return nil, err
+				}
+			}
+			if hook, ok := interface{}(&ormObj).(FolderORMWithBeforeStrictUpdateSave); ok {
+				if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObjs[i] = ormObj
+			return db, nil
+		}
+		for i := range objects {
+			if dbs[i], err = prepare(i); err != nil {
+				return err
+			}
+		}
+		write := func(db *gorm.DB, batch []int) error {
+			conds := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*2)
+			for _, i := range batch {
+				conds = append(conds, "(id = ?)")
+				args = append(args, objectKeys[i])
+			}
+			column := func(name string, value func(ormObj *FolderORM) interface{}) clause.Expr {
+				whens := make([]interface{}, 0, len(batch)*2)
+				for _, i := range batch {
+					whens = append(whens, objectKeys[i], value(&ormObjs[i]))
+				}
+				return gorm.Expr("CASE "+strings.Repeat("WHEN id = ? THEN ? ", len(batch))+"ELSE "+name+" END", whens...)
+			}
+			res := db.Session(&gorm.Session{}).Model(&FolderORM{}).Where(strings.Join(conds, " OR "), args...).Updates(map[string]interface{}{
+				"name":      column("name", func(ormObj *FolderORM) interface{} { return ormObj.Name }),
+				"parent_id": column("parent_id", func(ormObj *FolderORM) interface{} { return ormObj.ParentId }),
+			})
+			return res.Error
+		}
+		handles, groups = group()
+		for _, handle := range handles {
+			indexes := groups[handle]
+			for start := 0; start < len(indexes); start += batchSize {
+				end := start + batchSize
+				if end > len(indexes) {
+					end = len(indexes)
+				}
+				batch := indexes[start:end]
+				objs := make([]*FolderORM, 0, len(batch))
+				for _, i := range batch {
+					objs = append(objs, &ormObjs[i])
+				}
+				// the associations are saved first, the batch is then written with the
+				// foreign keys of its belongs-to associations
+				if err = handle.Session(&gorm.Session{}).Select("Children", "Parent").Model(&objs).Updates(map[string]interface{}{}).Error; err != nil {
+					return err
+				}
+				if err = write(handle, batch); err != nil {
+					return err
+				}
+			}
+		}
+		finish := func(i int) (*Folder, error) {
+			in, updateMask, db, ormObj := objects[i], updateMasks[i], dbs[i], ormObjs[i]
+			var err error
+			if hook, ok := interface{}(&ormObj).(FolderORMWithAfterStrictUpdateSave); ok {
+				if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			saved, err := ormObj.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbResponse := &saved
+			if hook, ok := interface{}(pbResponse).(FolderWithAfterPatchSave); ok {
+				if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			return pbResponse, nil
+		}
+		for i := range objects {
+			if results[i], err = finish(i); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// DefaultListDescendantsFolder lists the objects below the given one in the tree, nearest first
+func DefaultListDescendantsFolder(ctx context.Context, in *Folder, db *gorm.DB) ([]*Folder, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	ormResponse := []FolderORM{}
+	if err = db.Joins("JOIN (WITH RECURSIVE descendants AS (SELECT id, 1 AS depth, ARRAY[parent_id, id] AS path FROM folders WHERE parent_id = ? UNION ALL SELECT folders.id, descendants.depth + 1, descendants.path || folders.id FROM folders JOIN descendants ON folders.parent_id = descendants.id WHERE NOT folders.id = ANY(descendants.path)) SELECT id, depth FROM descendants) AS descendants ON descendants.id = folders.id", ormObj.Id).Order("descendants.depth").Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	pbResponse := []*Folder{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+// DefaultListAncestorsFolder lists the objects above the given one in the tree, nearest first
+func DefaultListAncestorsFolder(ctx context.Context, in *Folder, db *gorm.DB) ([]*Folder, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	ormResponse := []FolderORM{}
+	if err = db.Joins("JOIN (WITH RECURSIVE ancestors AS (SELECT parent_id AS id, 1 AS depth, ARRAY[id, parent_id] AS path FROM folders WHERE id = ? UNION ALL SELECT folders.parent_id, ancestors.depth + 1, ancestors.path || folders.parent_id FROM folders JOIN ancestors ON folders.id = ancestors.id WHERE NOT folders.parent_id = ANY(ancestors.path)) SELECT id, depth FROM ancestors) AS ancestors ON ancestors.id = folders.id", ormObj.Id).Order("ancestors.depth").Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	pbResponse := []*Folder{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+// DefaultApplyFieldMaskFolder patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskFolder(ctx context.Context, patchee *Folder, patcher *Folder, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Folder, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	if len(updateMask.GetPaths()) == 1 && updateMask.Paths[0] == prefix+"*" {
+		updateMask = &field_mask.FieldMask{Paths: []string{prefix + "Id", prefix + "Name", prefix + "ParentId", prefix + "Parent", prefix + "Children"}}
+	}
+	for _, f := range updateMask.GetPaths() {
+		if strings.HasPrefix(f, prefix) && !DefaultHasFieldMaskPathFolder(strings.TrimPrefix(f, prefix)) {
+			return nil, &errors.InvalidFieldMaskError{Path: f}
+		}
+	}
+	var err error
+	var updatedParentId bool
+	var updatedParent bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"Name" {
+			patchee.Name = patcher.Name
+			continue
+		}
+		if !updatedParentId && strings.HasPrefix(f, prefix+"ParentId.") {
+			if patcher.ParentId == nil {
+				patchee.ParentId = nil
+				continue
+			}
+			if patchee.ParentId == nil {
+				patchee.ParentId = &wrapperspb.UInt64Value{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"ParentId."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.ParentId, patchee.ParentId, childMask); err != nil {
+				return nil, &errors.InvalidFieldMaskError{Path: f}
+			}
+		}
+		if f == prefix+"ParentId" {
+			updatedParentId = true
+			patchee.ParentId = patcher.ParentId
+			continue
+		}
+		if !updatedParent && strings.HasPrefix(f, prefix+"Parent.") {
+			updatedParent = true
+			if patcher.Parent == nil {
+				patchee.Parent = nil
+				continue
+			}
+			if patchee.Parent == nil {
+				patchee.Parent = &Folder{}
+			}
+			if o, err := DefaultApplyFieldMaskFolder(ctx, patchee.Parent, patcher.Parent, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"Parent.", db); err != nil {
+				return nil, err
+			} else {
+				patchee.Parent = o
+			}
+			continue
+		}
+		if f == prefix+"Parent" {
+			updatedParent = true
+			patchee.Parent = patcher.Parent
+			continue
+		}
+		if f == prefix+"Children" {
+			patchee.Children = patcher.Children
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultHasFieldMaskPathFolder reports whether Folder has the field of the field mask path
+func DefaultHasFieldMaskPathFolder(path string) bool {
+	parts := strings.SplitN(path, ".", 2)
+	switch parts[0] {
+	case "ParentId":
+		if len(parts) == 1 {
+			return true
+		}
+		parts = strings.SplitN(parts[1], ".", 2)
+		switch parts[0] {
+		case "Value":
+			return len(parts) == 1
+		}
+		return false
+	case "Parent":
+		return len(parts) == 1 || DefaultHasFieldMaskPathFolder(parts[1])
+	case "Id", "Name", "Children":
+		return len(parts) == 1
+	}
+	return false
+}
+
+// DefaultListFolder executes a gorm list call
+func DefaultListFolder(ctx context.Context, db *gorm.DB) ([]*Folder, error) {
+	in := Folder{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(FolderORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(FolderORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []FolderORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(FolderORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Folder{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type FolderORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type FolderORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type FolderORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]FolderORM) error
+}
+type IntPointServiceDefaultServer struct {
+	DB *gorm.DB
+}
+
+// Create ...
+func (m *IntPointServiceDefaultServer) Create(ctx context.Context, in *CreateIntPointRequest) (*CreateIntPointResponse, error) {
+	db := m.DB
+	if custom, ok := interface{}(in).(IntPointServiceIntPointWithBeforeCreate); ok {
+		var err error
+		if db, err = custom.BeforeCreate(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	res, err := DefaultCreateIntPoint(ctx, in.GetPayload(), db)
+	if err != nil {
+		return nil, err
+	}
+	out := &CreateIntPointResponse{Result: res}
+	err = gateway.SetCreated(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	if custom, ok := interface{}(in).(IntPointServiceIntPointWithAfterCreate); ok {
+		var err error
+		if err = custom.AfterCreate(ctx, out, db); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// IntPointServiceIntPointWithBeforeCreate called before DefaultCreateIntPoint in the default Create handler
+type IntPointServiceIntPointWithBeforeCreate interface {
+	BeforeCreate(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+
+// IntPointServiceIntPointWithAfterCreate called before DefaultCreateIntPoint in the default Create handler
+type IntPointServiceIntPointWithAfterCreate interface {
+	AfterCreate(context.Context, *CreateIntPointResponse, *gorm.DB) error
+}
+
+// CreateSet ...
+func (m *IntPointServiceDefaultServer) CreateSet(ctx context.Context, in *CreateSetIntPointRequest) (*CreateSetIntPointResponse, error) {
+	db := m.DB
+	if custom, ok := interface{}(in).(IntPointServiceIntPointWithBeforeCreateSet); ok {
+		var err error
+		if db, err = custom.BeforeCreateSet(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	res, err := DefaultCreateIntPointSet(ctx, in.GetObjects(), db)
+	if err != nil {
+		return nil, err
+	}
+	out := &CreateSetIntPointResponse{Results: res}
+	err = gateway.SetCreated(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	if custom, ok := interface{}(in).(IntPointServiceIntPointWithAfterCreateSet); ok {
+		var err error
+		if err = custom.AfterCreateSet(ctx, out, db); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// IntPointServiceIntPointWithBeforeCreateSet called before DefaultCreateSetIntPoint in the default CreateSet handler
+type IntPointServiceIntPointWithBeforeCreateSet interface {
+	BeforeCreateSet(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+
+// IntPointServiceIntPointWithAfterCreateSet called before DefaultCreateSetIntPoint in the default CreateSet handler
+type IntPointServiceIntPointWithAfterCreateSet interface {
+	AfterCreateSet(context.Context, *CreateSetIntPointResponse, *gorm.DB) error
+}
+
+// Read ...
+func (m *IntPointServiceDefaultServer) Read(ctx context.Context, in *ReadIntPointRequest) (*ReadIntPointResponse, error) {
+	db := m.DB
+	if custom, ok := interface{}(in).(IntPointServiceIntPointWithBeforeRead); ok {
+		var err error
+		if db, err = custom.BeforeRead(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	res, err := DefaultReadIntPoint(ctx, &IntPoint{Id: in.GetId()}, db, in.Fields)
+	if err != nil {
+		return nil, err
+	}
+	out := &ReadIntPointResponse{Result: res}
+	if custom, ok := interface{}(in).(IntPointServiceIntPointWithAfterRead); ok {
+		var err error
+		if err = custom.AfterRead(ctx, out, db); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// IntPointServiceIntPointWithBeforeRead called before DefaultReadIntPoint in the default Read handler
+type IntPointServiceIntPointWithBeforeRead interface {
+	BeforeRead(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+
+// IntPointServiceIntPointWithAfterRead called before DefaultReadIntPoint in the default Read handler
+type IntPointServiceIntPointWithAfterRead interface {
+	AfterRead(context.Context, *ReadIntPointResponse, *gorm.DB) error
+}
+
+// Update ...
+func (m *IntPointServiceDefaultServer) Update(ctx context.Context, in *UpdateIntPointRequest) (*UpdateIntPointResponse, error) {
+	var err error
+	var res *IntPoint
+	db := m.DB
+	if custom, ok := interface{}(in).(IntPointServiceIntPointWithBeforeUpdate); ok {
+		var err error
+		if db, err = custom.BeforeUpdate(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if in.GetGerogeriGegege() == nil {
+		res, err = DefaultStrictUpdateIntPoint(ctx, in.GetPayload(), db)
+	} else {
+		res, err = DefaultPatchIntPoint(ctx, in.GetPayload(), in.GetGerogeriGegege(), db)
+	}
+	if err != nil {
+		var fmErr *errors.InvalidFieldMaskError
+		if errors1.As(err, &fmErr) {
+			err = status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, err
+	}
+	out := &UpdateIntPointResponse{Result: res}
+	if custom, ok := interface{}(in).(IntPointServiceIntPointWithAfterUpdate); ok {
+		var err error
+		if err = custom.AfterUpdate(ctx, out, db); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// IntPointServiceIntPointWithBeforeUpdate called before DefaultUpdateIntPoint in the default Update handler
+type IntPointServiceIntPointWithBeforeUpdate interface {
+	BeforeUpdate(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+
+// IntPointServiceIntPointWithAfterUpdate called before DefaultUpdateIntPoint in the default Update handler
+type IntPointServiceIntPointWithAfterUpdate interface {
+	AfterUpdate(context.Context, *UpdateIntPointResponse, *gorm.DB) error
+}
+
+// UpdateSet ...
+func (m *IntPointServiceDefaultServer) UpdateSet(ctx context.Context, in *UpdateSetIntPointRequest) (*UpdateSetIntPointResponse, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+
+	db := m.DB
+
+	if custom, ok := interface{}(in).(IntPointServiceIntPointWithBeforeUpdateSet); ok {
+		var err error
+		if db, err = custom.BeforeUpdateSet(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+
+	res, err := DefaultPatchSetIntPoint(ctx, in.GetObjects(), in.GetMasks(), db)
+	if err != nil {
+		var fmErr *errors.InvalidFieldMaskError
+		if errors1.As(err, &fmErr) {
+			err = status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, err
+	}
+
+	out := &UpdateSetIntPointResponse{Results: res}
+
+	if custom, ok := interface{}(in).(IntPointServiceIntPointWithAfterUpdateSet); ok {
+		var err error
+		if err = custom.AfterUpdateSet(ctx, out, db); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// IntPointServiceIntPointWithBeforeUpdateSet called before DefaultUpdateSetIntPoint in the default UpdateSet handler
+type IntPointServiceIntPointWithBeforeUpdateSet interface {
+	BeforeUpdateSet(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+
+// IntPointServiceIntPointWithAfterUpdateSet called before DefaultUpdateSetIntPoint in the default UpdateSet handler
+type IntPointServiceIntPointWithAfterUpdateSet interface {
+	AfterUpdateSet(context.Context, *UpdateSetIntPointResponse, *gorm.DB) error
+}
+
+// List ...
+func (m *IntPointServiceDefaultServer) List(ctx context.Context, in *ListIntPointRequest) (*ListIntPointResponse, error) {
+	db := m.DB
+	if custom, ok := interface{}(in).(IntPointServiceIntPointWithBeforeList); ok {
+		var err error
+		if db, err = custom.BeforeList(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pagedRequest := false
+	if in.GetPaging().GetLimit() >= 1 {
+		in.Paging.Limit++
+		pagedRequest = true
+	}
+	res, err := DefaultListIntPoint(ctx, db, in.Filter, in.OrderBy, in.Paging, in.Fields)
+	if err != nil {
+		return nil, err
+	}
+	var resPaging *query.PageInfo
+	if pagedRequest {
+		var offset int32
+		var size int32 = int32(len(res))
+		if size == in.GetPaging().GetLimit() {
+			size--
+			res = res[:size]
+			offset = in.GetPaging().GetOffset() + size
+		}
+		resPaging = &query.PageInfo{Offset: offset}
+	}
+	out := &ListIntPointResponse{Results: res, PageInfo: resPaging}
+	if custom, ok := interface{}(in).(IntPointServiceIntPointWithAfterList); ok {
+		var err error
+		if err = custom.AfterList(ctx, out, db); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// IntPointServiceIntPointWithBeforeList called before DefaultListIntPoint in the default List handler
+type IntPointServiceIntPointWithBeforeList interface {
+	BeforeList(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+
+// IntPointServiceIntPointWithAfterList called before DefaultListIntPoint in the default List handler
+type IntPointServiceIntPointWithAfterList interface {
+	AfterList(context.Context, *ListIntPointResponse, *gorm.DB) error
+}
+
+// ListSomething ...
+func (m *IntPointServiceDefaultServer) ListSomething(ctx context.Context, in *emptypb.Empty) (*ListSomethingResponse, error) {
+	db := m.DB
+	if custom, ok := interface{}(in).(IntPointServiceSomethingWithBeforeListSomething); ok {
+		var err error
+		if db, err = custom.BeforeListSomething(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	res, err := DefaultListSomething(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	out := &ListSomethingResponse{Results: res}
+	if custom, ok := interface{}(in).(IntPointServiceSomethingWithAfterListSomething); ok {
+		var err error
+		if err = custom.AfterListSomething(ctx, out, db); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// IntPointServiceSomethingWithBeforeListSomething called before DefaultListSomethingSomething in the default ListSomething handler
+type IntPointServiceSomethingWithBeforeListSomething interface {
+	BeforeListSomething(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+
+// IntPointServiceSomethingWithAfterListSomething called before DefaultListSomethingSomething in the default ListSomething handler
+type IntPointServiceSomethingWithAfterListSomething interface {
+	AfterListSomething(context.Context, *ListSomethingResponse, *gorm.DB) error
+}
+
+// Delete ...
+func (m *IntPointServiceDefaultServer) Delete(ctx context.Context, in *DeleteIntPointRequest) (*DeleteIntPointResponse, error) {
+	db := m.DB
+	if custom, ok := interface{}(in).(IntPointServiceIntPointWithBeforeDelete); ok {
+		var err error
+		if db, err = custom.BeforeDelete(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	err := DefaultDeleteIntPoint(ctx, &IntPoint{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	out := &DeleteIntPointResponse{}
+	if custom, ok := interface{}(in).(IntPointServiceIntPointWithAfterDelete); ok {
+		var err error
+		if err = custom.AfterDelete(ctx, out, db); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// IntPointServiceIntPointWithBeforeDelete called before DefaultDeleteIntPoint in the default Delete handler
+type IntPointServiceIntPointWithBeforeDelete interface {
+	BeforeDelete(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+
+// IntPointServiceIntPointWithAfterDelete called before DefaultDeleteIntPoint in the default Delete handler
+type IntPointServiceIntPointWithAfterDelete interface {
+	AfterDelete(context.Context, *DeleteIntPointResponse, *gorm.DB) error
+}
+
+// CustomMethod ...
+func (m *IntPointServiceDefaultServer) CustomMethod(ctx context.Context, in *emptypb.Empty) (*emptypb.Empty, error) {
+	out := &emptypb.Empty{}
+	return out, nil
+}
+
+// CreateSomething ...
+func (m *IntPointServiceDefaultServer) CreateSomething(ctx context.Context, in *Something) (*Something, error) {
+	out := &Something{}
+	return out, nil
+}
+
+type IntPointServiceBDefaultServer struct {
+	DB *gorm.DB
+}
+
+// List ...
+func (m *IntPointServiceBDefaultServer) List(ctx context.Context, in *ListFooRequest) (*ListIntPointResponse, error) {
+	db := m.DB
+	if custom, ok := interface{}(in).(IntPointServiceBIntPointWithBeforeList); ok {
+		var err error
+		if db, err = custom.BeforeList(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	res, err := DefaultListIntPoint(ctx, db, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	out := &ListIntPointResponse{Results: res}
+	if custom, ok := interface{}(in).(IntPointServiceBIntPointWithAfterList); ok {
+		var err error
+		if err = custom.AfterList(ctx, out, db); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// IntPointServiceBIntPointWithBeforeList called before DefaultListIntPoint in the default List handler
+type IntPointServiceBIntPointWithBeforeList interface {
+	BeforeList(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+
+// IntPointServiceBIntPointWithAfterList called before DefaultListIntPoint in the default List handler
+type IntPointServiceBIntPointWithAfterList interface {
+	AfterList(context.Context, *ListIntPointResponse, *gorm.DB) error
+}
+
+// Create ...
+func (m *IntPointServiceBDefaultServer) Create(ctx context.Context, in *CreateFooRequest) (*ListIntPointResponse, error) {
+	out := &ListIntPointResponse{}
+	return out, nil
+}
+
+type IntPointTxnDefaultServer struct {
+}
+
+func (m *IntPointTxnDefaultServer) spanCreate(ctx context.Context, in interface{}, methodName string) (*trace.Span, error) {
+	_, span := trace.StartSpan(ctx, fmt.Sprint("IntPointTxnDefaultServer.", methodName))
+	raw, err := json.Marshal(in)
+	if err != nil {
+		return nil, err
+	}
+	span.Annotate([]trace.Attribute{trace.StringAttribute("in", string(raw))}, "in parameter")
+	return span, nil
+}
+
+// spanError ...
+func (m *IntPointTxnDefaultServer) spanError(span *trace.Span, err error) error {
+	span.SetStatus(trace.Status{
+		Code:    trace.StatusCodeUnknown,
+		Message: err.Error(),
+	})
+	return err
+}
+
+// spanResult ...
+func (m *IntPointTxnDefaultServer) spanResult(span *trace.Span, out interface{}) error {
+	raw, err := json.Marshal(out)
+	if err != nil {
+		return err
+	}
+	span.Annotate([]trace.Attribute{trace.StringAttribute("out", string(raw))}, "out parameter")
+	return nil
+}
+
+// Create ...
+func (m *IntPointTxnDefaultServer) Create(ctx context.Context, in *CreateIntPointRequest) (*CreateIntPointResponse, error) {
+	span, errSpanCreate := m.spanCreate(ctx, in, "Create")
+	if errSpanCreate != nil {
+		return nil, errSpanCreate
+	}
+	defer span.End()
+	txn, ok := gorm1.FromContext(ctx)
+	if !ok {
+		return nil, errors.NoTransactionError
+	}
+	db := txn.Begin()
+	if db.Error != nil {
+		return nil, db.Error
+	}
+	if custom, ok := interface{}(in).(IntPointTxnIntPointWithBeforeCreate); ok {
+		var err error
+		if db, err = custom.BeforeCreate(ctx, db); err != nil {
+			return nil, m.spanError(span, err)
+		}
+	}
+	res, err := DefaultCreateIntPoint(ctx, in.GetPayload(), db)
+	if err != nil {
+		return nil, m.spanError(span, err)
+	}
+	out := &CreateIntPointResponse{Result: res}
+	err = gateway.SetCreated(ctx, "")
+	if err != nil {
+		return nil, m.spanError(span, err)
+	}
+	if custom, ok := interface{}(in).(IntPointTxnIntPointWithAfterCreate); ok {
+		var err error
+		if err = custom.AfterCreate(ctx, out, db); err != nil {
+			return nil, m.spanError(span, err)
+		}
+	}
+	errSpanResult := m.spanResult(span, out)
+	if errSpanResult != nil {
+		return nil, m.spanError(span, errSpanResult)
+	}
+	return out, nil
+}
+
+// IntPointTxnIntPointWithBeforeCreate called before DefaultCreateIntPoint in the default Create handler
+type IntPointTxnIntPointWithBeforeCreate interface {
+	BeforeCreate(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+
+// IntPointTxnIntPointWithAfterCreate called before DefaultCreateIntPoint in the default Create handler
+type IntPointTxnIntPointWithAfterCreate interface {
+	AfterCreate(context.Context, *CreateIntPointResponse, *gorm.DB) error
+}
+
+// Read ...
+func (m *IntPointTxnDefaultServer) Read(ctx context.Context, in *ReadIntPointRequest) (*ReadIntPointResponse, error) {
+	span, errSpanCreate := m.spanCreate(ctx, in, "Read")
+	if errSpanCreate != nil {
+		return nil, errSpanCreate
+	}
+	defer span.End()
+	txn, ok := gorm1.FromContext(ctx)
+	if !ok {
+		return nil, errors.NoTransactionError
+	}
+	db := txn.Begin()
+	if db.Error != nil {
+		return nil, db.Error
+	}
+	if custom, ok := interface{}(in).(IntPointTxnIntPointWithBeforeRead); ok {
+		var err error
+		if db, err = custom.BeforeRead(ctx, db); err != nil {
+			return nil, m.spanError(span, err)
+		}
+	}
+	res, err := DefaultReadIntPoint(ctx, &IntPoint{Id: in.GetId()}, db, in.Fields)
+	if err != nil {
+		return nil, m.spanError(span, err)
+	}
+	out := &ReadIntPointResponse{Result: res}
+	if custom, ok := interface{}(in).(IntPointTxnIntPointWithAfterRead); ok {
+		var err error
+		if err = custom.AfterRead(ctx, out, db); err != nil {
+			return nil, m.spanError(span, err)
+		}
+	}
+	errSpanResult := m.spanResult(span, out)
+	if errSpanResult != nil {
+		return nil, m.spanError(span, errSpanResult)
+	}
+	return out, nil
+}
+
+// IntPointTxnIntPointWithBeforeRead called before DefaultReadIntPoint in the default Read handler
+type IntPointTxnIntPointWithBeforeRead interface {
+	BeforeRead(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+
+// IntPointTxnIntPointWithAfterRead called before DefaultReadIntPoint in the default Read handler
+type IntPointTxnIntPointWithAfterRead interface {
+	AfterRead(context.Context, *ReadIntPointResponse, *gorm.DB) error
+}
+
+// Update ...
+func (m *IntPointTxnDefaultServer) Update(ctx context.Context, in *UpdateIntPointRequest) (*UpdateIntPointResponse, error) {
+	span, errSpanCreate := m.spanCreate(ctx, in, "Update")
+	if errSpanCreate != nil {
+		return nil, errSpanCreate
+	}
+	defer span.End()
+	var err error
+	var res *IntPoint
+	txn, ok := gorm1.FromContext(ctx)
+	if !ok {
+		return nil, errors.NoTransactionError
+	}
+	db := txn.Begin()
+	if db.Error != nil {
+		return nil, db.Error
+	}
+	if custom, ok := interface{}(in).(IntPointTxnIntPointWithBeforeUpdate); ok {
+		var err error
+		if db, err = custom.BeforeUpdate(ctx, db); err != nil {
+			return nil, m.spanError(span, err)
+		}
+	}
+	if in.GetGerogeriGegege() == nil {
+		res, err = DefaultStrictUpdateIntPoint(ctx, in.GetPayload(), db)
+	} else {
+		res, err = DefaultPatchIntPoint(ctx, in.GetPayload(), in.GetGerogeriGegege(), db)
+	}
+	if err != nil {
+		var fmErr *errors.InvalidFieldMaskError
+		if errors1.As(err, &fmErr) {
+			err = status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, m.spanError(span, err)
+	}
+	out := &UpdateIntPointResponse{Result: res}
+	if custom, ok := interface{}(in).(IntPointTxnIntPointWithAfterUpdate); ok {
+		var err error
+		if err = custom.AfterUpdate(ctx, out, db); err != nil {
+			return nil, m.spanError(span, err)
+		}
+	}
+	errSpanResult := m.spanResult(span, out)
+	if errSpanResult != nil {
+		return nil, m.spanError(span, errSpanResult)
+	}
+	return out, nil
+}
+
+// IntPointTxnIntPointWithBeforeUpdate called before DefaultUpdateIntPoint in the default Update handler
+type IntPointTxnIntPointWithBeforeUpdate interface {
+	BeforeUpdate(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+
+// IntPointTxnIntPointWithAfterUpdate called before DefaultUpdateIntPoint in the default Update handler
+type IntPointTxnIntPointWithAfterUpdate interface {
+	AfterUpdate(context.Context, *UpdateIntPointResponse, *gorm.DB) error
+}
+
+// List ...
+func (m *IntPointTxnDefaultServer) List(ctx context.Context, in *ListIntPointRequest) (*ListIntPointResponse, error) {
+	span, errSpanCreate := m.spanCreate(ctx, in, "List")
+	if errSpanCreate != nil {
+		return nil, errSpanCreate
+	}
+	defer span.End()
+	txn, ok := gorm1.FromContext(ctx)
+	if !ok {
+		return nil, errors.NoTransactionError
+	}
+	db := txn.Begin()
+	if db.Error != nil {
+		return nil, db.Error
+	}
+	if custom, ok := interface{}(in).(IntPointTxnIntPointWithBeforeList); ok {
+		var err error
+		if db, err = custom.BeforeList(ctx, db); err != nil {
+			return nil, m.spanError(span, err)
+		}
+	}
+	pagedRequest := false
+	if in.GetPaging().GetLimit() >= 1 {
+		in.Paging.Limit++
+		pagedRequest = true
+	}
+	res, err := DefaultListIntPoint(ctx, db, in.Filter, in.OrderBy, in.Paging, in.Fields)
+	if err != nil {
+		return nil, m.spanError(span, err)
+	}
+	var resPaging *query.PageInfo
+	if pagedRequest {
+		var offset int32
+		var size int32 = int32(len(res))
+		if size == in.GetPaging().GetLimit() {
+			size--
+			res = res[:size]
+			offset = in.GetPaging().GetOffset() + size
+		}
+		resPaging = &query.PageInfo{Offset: offset}
+	}
+	out := &ListIntPointResponse{Results: res, PageInfo: resPaging}
+	if custom, ok := interface{}(in).(IntPointTxnIntPointWithAfterList); ok {
+		var err error
+		if err = custom.AfterList(ctx, out, db); err != nil {
+			return nil, m.spanError(span, err)
+		}
+	}
+	errSpanResult := m.spanResult(span, out)
+	if errSpanResult != nil {
+		return nil, m.spanError(span, errSpanResult)
+	}
+	return out, nil
+}
+
+// IntPointTxnIntPointWithBeforeList called before DefaultListIntPoint in the default List handler
+type IntPointTxnIntPointWithBeforeList interface {
+	BeforeList(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+
+// IntPointTxnIntPointWithAfterList called before DefaultListIntPoint in the default List handler
+type IntPointTxnIntPointWithAfterList interface {
+	AfterList(context.Context, *ListIntPointResponse, *gorm.DB) error
+}
+
+// Delete ...
+func (m *IntPointTxnDefaultServer) Delete(ctx context.Context, in *DeleteIntPointRequest) (*DeleteIntPointResponse, error) {
+	span, errSpanCreate := m.spanCreate(ctx, in, "Delete")
+	if errSpanCreate != nil {
+		return nil, errSpanCreate
+	}
+	defer span.End()
+	txn, ok := gorm1.FromContext(ctx)
+	if !ok {
+		return nil, errors.NoTransactionError
+	}
+	db := txn.Begin()
+	if db.Error != nil {
+		return nil, db.Error
+	}
+	if custom, ok := interface{}(in).(IntPointTxnIntPointWithBeforeDelete); ok {
+		var err error
+		if db, err = custom.BeforeDelete(ctx, db); err != nil {
+			return nil, m.spanError(span, err)
+		}
+	}
+	err := DefaultDeleteIntPoint(ctx, &IntPoint{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, m.spanError(span, err)
+	}
+	out := &DeleteIntPointResponse{}
+	if custom, ok := interface{}(in).(IntPointTxnIntPointWithAfterDelete); ok {
+		var err error
+		if err = custom.AfterDelete(ctx, out, db); err != nil {
+			return nil, m.spanError(span, err)
+		}
+	}
+	errSpanResult := m.spanResult(span, out)
+	if errSpanResult != nil {
+		return nil, m.spanError(span, errSpanResult)
+	}
+	return out, nil
+}
+
+// IntPointTxnIntPointWithBeforeDelete called before DefaultDeleteIntPoint in the default Delete handler
+type IntPointTxnIntPointWithBeforeDelete interface {
+	BeforeDelete(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+
+// IntPointTxnIntPointWithAfterDelete called before DefaultDeleteIntPoint in the default Delete handler
+type IntPointTxnIntPointWithAfterDelete interface {
+	AfterDelete(context.Context, *DeleteIntPointResponse, *gorm.DB) error
+}
+
+// DeleteSet ...
+func (m *IntPointTxnDefaultServer) DeleteSet(ctx context.Context, in *DeleteIntPointsRequest) (*DeleteIntPointResponse, error) {
+	span, errSpanCreate := m.spanCreate(ctx, in, "DeleteSet")
+	if errSpanCreate != nil {
+		return nil, errSpanCreate
+	}
+	defer span.End()
+	txn, ok := gorm1.FromContext(ctx)
+	if !ok {
+		return nil, errors.NoTransactionError
+	}
+	db := txn.Begin()
+	if db.Error != nil {
+		return nil, db.Error
+	}
+	objs := []*IntPoint{}
+	for _, id := range in.Ids {
+		objs = append(objs, &IntPoint{Id: id})
+	}
+	if custom, ok := interface{}(in).(IntPointTxnIntPointWithBeforeDeleteSet); ok {
+		var err error
+		if db, err = custom.BeforeDeleteSet(ctx, db); err != nil {
+			return nil, m.spanError(span, err)
+		}
+	}
+	err := DefaultDeleteIntPointSet(ctx, objs, db)
+	if err != nil {
+		return nil, m.spanError(span, err)
 	}
-	if hook, ok := interface{}(&ormObj).(SomethingORMWithAfterCreate_); ok {
-		if err = hook.AfterCreate_(ctx, db); err != nil {
-			return nil, err
+	out := &DeleteIntPointResponse{}
+	if custom, ok := interface{}(in).(IntPointTxnIntPointWithAfterDeleteSet); ok {
+		var err error
+		if err = custom.AfterDeleteSet(ctx, out, db); err != nil {
+			return nil, m.spanError(span, err)
 		}
 	}
-	pbResponse, err := ormObj.ToPB(ctx)
-	return &pbResponse, err
+	errSpanResult := m.spanResult(span, out)
+	if errSpanResult != nil {
+		return nil, m.spanError(span, errSpanResult)
+	}
+	return out, nil
 }
 
-type SomethingORMWithBeforeCreate_ interface {
-	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+// IntPointTxnIntPointWithBeforeDeleteSet called before DefaultDeleteSetIntPoint in the default DeleteSet handler
+type IntPointTxnIntPointWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type SomethingORMWithAfterCreate_ interface {
-	AfterCreate_(context.Context, *gorm.DB) error
+
+// IntPointTxnIntPointWithAfterDeleteSet called before DefaultDeleteSetIntPoint in the default DeleteSet handler
+type IntPointTxnIntPointWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, *DeleteIntPointResponse, *gorm.DB) error
 }
 
-// DefaultApplyFieldMaskSomething patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskSomething(ctx context.Context, patchee *Something, patcher *Something, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Something, error) {
-	if patcher == nil {
-		return nil, nil
-	} else if patchee == nil {
-		return nil, errors.NilArgumentError
-	}
-	var err error
-	for _, f := range updateMask.Paths {
-		if f == prefix+"Field" {
-			patchee.Field = patcher.Field
-			continue
-		}
+// CustomMethod ...
+func (m *IntPointTxnDefaultServer) CustomMethod(ctx context.Context, in *emptypb.Empty) (*emptypb.Empty, error) {
+	span, errSpanCreate := m.spanCreate(ctx, in, "CustomMethod")
+	if errSpanCreate != nil {
+		return nil, errSpanCreate
 	}
-	if err != nil {
-		return nil, err
+	defer span.End()
+	out := &emptypb.Empty{}
+	errSpanResult := m.spanResult(span, out)
+	if errSpanResult != nil {
+		return nil, m.spanError(span, errSpanResult)
 	}
-	return patchee, nil
+	return out, nil
 }
 
-// DefaultListSomething executes a gorm list call
-func DefaultListSomething(ctx context.Context, db *gorm.DB) ([]*Something, error) {
-	in := Something{}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return nil, err
+// CreateSomething ...
+func (m *IntPointTxnDefaultServer) CreateSomething(ctx context.Context, in *Something) (*Something, error) {
+	span, errSpanCreate := m.spanCreate(ctx, in, "CreateSomething")
+	if errSpanCreate != nil {
+		return nil, errSpanCreate
 	}
-	if hook, ok := interface{}(&ormObj).(SomethingORMWithBeforeListApplyQuery); ok {
-		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
-			return nil, err
-		}
+	defer span.End()
+	out := &Something{}
+	errSpanResult := m.spanResult(span, out)
+	if errSpanResult != nil {
+		return nil, m.spanError(span, errSpanResult)
 	}
-	if hook, ok := interface{}(&ormObj).(SomethingORMWithBeforeListFind); ok {
-		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+	return out, nil
+}
+
+type CircleServiceDefaultServer struct {
+	DB *gorm.DB
+}
+
+// List ...
+func (m *CircleServiceDefaultServer) List(ctx context.Context, in *ListCircleRequest) (*ListCircleResponse, error) {
+	db := m.DB
+	if custom, ok := interface{}(in).(CircleServiceCircleWithBeforeList); ok {
+		var err error
+		if db, err = custom.BeforeList(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	db = db.Where(&ormObj)
-	ormResponse := []SomethingORM{}
-	if err := db.Find(&ormResponse).Error; err != nil {
+	res, err := DefaultListCircle(ctx, db)
+	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(SomethingORMWithAfterListFind); ok {
-		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
-			return nil, err
-		}
-	}
-	pbResponse := []*Something{}
-	for _, responseEntry := range ormResponse {
-		temp, err := responseEntry.ToPB(ctx)
-		if err != nil {
+	out := &ListCircleResponse{Results: res}
+	if custom, ok := interface{}(in).(CircleServiceCircleWithAfterList); ok {
+		var err error
+		if err = custom.AfterList(ctx, out, db); err != nil {
 			return nil, err
 		}
-		pbResponse = append(pbResponse, &temp)
 	}
-	return pbResponse, nil
+	return out, nil
 }
 
-type SomethingORMWithBeforeListApplyQuery interface {
-	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+// CircleServiceCircleWithBeforeList called before DefaultListCircle in the default List handler
+type CircleServiceCircleWithBeforeList interface {
+	BeforeList(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type SomethingORMWithBeforeListFind interface {
-	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+
+// CircleServiceCircleWithAfterList called before DefaultListCircle in the default List handler
+type CircleServiceCircleWithAfterList interface {
+	AfterList(context.Context, *ListCircleResponse, *gorm.DB) error
 }
-type SomethingORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]SomethingORM) error
+type MultipleMethodsAutoGenDefaultServer struct {
+	DB *gorm.DB
 }
 
-// DefaultCreateCircle executes a basic gorm create call
-func DefaultCreateCircle(ctx context.Context, in *Circle, db *gorm.DB) (*Circle, error) {
-	if in == nil {
-		return nil, errors.NilArgumentError
+// CreateA ...
+func (m *MultipleMethodsAutoGenDefaultServer) CreateA(ctx context.Context, in *CreateIntPointRequest) (*CreateIntPointResponse, error) {
+	db := m.DB
+	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithBeforeCreateA); ok {
+		var err error
+		if db, err = custom.BeforeCreateA(ctx, db); err != nil {
+			return nil, err
+		}
 	}
-	ormObj, err := in.ToORM(ctx)
+	res, err := DefaultCreateIntPoint(ctx, in.GetPayload(), db)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(CircleORMWithBeforeCreate_); ok {
-		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
-			return nil, err
-		}
-	}
-	if err = db.Omit().Create(&ormObj).Error; err != nil {
+	out := &CreateIntPointResponse{Result: res}
+	err = gateway.SetCreated(ctx, "")
+	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(CircleORMWithAfterCreate_); ok {
-		if err = hook.AfterCreate_(ctx, db); err != nil {
+	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithAfterCreateA); ok {
+		var err error
+		if err = custom.AfterCreateA(ctx, out, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse, err := ormObj.ToPB(ctx)
-	return &pbResponse, err
+	return out, nil
 }
 
-type CircleORMWithBeforeCreate_ interface {
-	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+// MultipleMethodsAutoGenIntPointWithBeforeCreateA called before DefaultCreateAIntPoint in the default CreateA handler
+type MultipleMethodsAutoGenIntPointWithBeforeCreateA interface {
+	BeforeCreateA(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type CircleORMWithAfterCreate_ interface {
-	AfterCreate_(context.Context, *gorm.DB) error
+
+// MultipleMethodsAutoGenIntPointWithAfterCreateA called before DefaultCreateAIntPoint in the default CreateA handler
+type MultipleMethodsAutoGenIntPointWithAfterCreateA interface {
+	AfterCreateA(context.Context, *CreateIntPointResponse, *gorm.DB) error
 }
 
-// DefaultApplyFieldMaskCircle patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskCircle(ctx context.Context, patchee *Circle, patcher *Circle, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Circle, error) {
-	if patcher == nil {
-		return nil, nil
-	} else if patchee == nil {
-		return nil, errors.NilArgumentError
-	}
-	var err error
-	for _, f := range updateMask.Paths {
-		if f == prefix+"R" {
-			patchee.R = patcher.R
-			continue
+// CreateB ...
+func (m *MultipleMethodsAutoGenDefaultServer) CreateB(ctx context.Context, in *CreateIntPointRequest) (*CreateIntPointResponse, error) {
+	db := m.DB
+	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithBeforeCreateB); ok {
+		var err error
+		if db, err = custom.BeforeCreateB(ctx, db); err != nil {
+			return nil, err
 		}
 	}
+	res, err := DefaultCreateIntPoint(ctx, in.GetPayload(), db)
 	if err != nil {
 		return nil, err
 	}
-	return patchee, nil
-}
-
-// DefaultListCircle executes a gorm list call
-func DefaultListCircle(ctx context.Context, db *gorm.DB) ([]*Circle, error) {
-	in := Circle{}
-	ormObj, err := in.ToORM(ctx)
+	out := &CreateIntPointResponse{Result: res}
+	err = gateway.SetCreated(ctx, "")
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(CircleORMWithBeforeListApplyQuery); ok {
-		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
-			return nil, err
-		}
-	}
-	if hook, ok := interface{}(&ormObj).(CircleORMWithBeforeListFind); ok {
-		if db, err = hook.BeforeListFind(ctx, db); err != nil {
-			return nil, err
-		}
-	}
-	db = db.Where(&ormObj)
-	ormResponse := []CircleORM{}
-	if err := db.Find(&ormResponse).Error; err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(CircleORMWithAfterListFind); ok {
-		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
-			return nil, err
-		}
-	}
-	pbResponse := []*Circle{}
-	for _, responseEntry := range ormResponse {
-		temp, err := responseEntry.ToPB(ctx)
-		if err != nil {
+	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithAfterCreateB); ok {
+		var err error
+		if err = custom.AfterCreateB(ctx, out, db); err != nil {
 			return nil, err
 		}
-		pbResponse = append(pbResponse, &temp)
 	}
-	return pbResponse, nil
+	return out, nil
 }
 
-type CircleORMWithBeforeListApplyQuery interface {
-	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type CircleORMWithBeforeListFind interface {
-	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type CircleORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]CircleORM) error
+// MultipleMethodsAutoGenIntPointWithBeforeCreateB called before DefaultCreateBIntPoint in the default CreateB handler
+type MultipleMethodsAutoGenIntPointWithBeforeCreateB interface {
+	BeforeCreateB(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type IntPointServiceDefaultServer struct {
-	DB *gorm.DB
+
+// MultipleMethodsAutoGenIntPointWithAfterCreateB called before DefaultCreateBIntPoint in the default CreateB handler
+type MultipleMethodsAutoGenIntPointWithAfterCreateB interface {
+	AfterCreateB(context.Context, *CreateIntPointResponse, *gorm.DB) error
 }
 
-// Create ...
-func (m *IntPointServiceDefaultServer) Create(ctx context.Context, in *CreateIntPointRequest) (*CreateIntPointResponse, error) {
+// ReadA ...
+func (m *MultipleMethodsAutoGenDefaultServer) ReadA(ctx context.Context, in *ReadIntPointRequest) (*ReadIntPointResponse, error) {
 	db := m.DB
-	if custom, ok := interface{}(in).(IntPointServiceIntPointWithBeforeCreate); ok {
+	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithBeforeReadA); ok {
 		var err error
-		if db, err = custom.BeforeCreate(ctx, db); err != nil {
+		if db, err = custom.BeforeReadA(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	res, err := DefaultCreateIntPoint(ctx, in.GetPayload(), db)
-	if err != nil {
-		return nil, err
-	}
-	out := &CreateIntPointResponse{Result: res}
-	err = gateway.SetCreated(ctx, "")
+	res, err := DefaultReadIntPoint(ctx, &IntPoint{Id: in.GetId()}, db, in.Fields)
 	if err != nil {
 		return nil, err
 	}
-	if custom, ok := interface{}(in).(IntPointServiceIntPointWithAfterCreate); ok {
+	out := &ReadIntPointResponse{Result: res}
+	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithAfterReadA); ok {
 		var err error
-		if err = custom.AfterCreate(ctx, out, db); err != nil {
+		if err = custom.AfterReadA(ctx, out, db); err != nil {
 			return nil, err
 		}
 	}
 	return out, nil
 }
 
-// IntPointServiceIntPointWithBeforeCreate called before DefaultCreateIntPoint in the default Create handler
-type IntPointServiceIntPointWithBeforeCreate interface {
-	BeforeCreate(context.Context, *gorm.DB) (*gorm.DB, error)
+// MultipleMethodsAutoGenIntPointWithBeforeReadA called before DefaultReadAIntPoint in the default ReadA handler
+type MultipleMethodsAutoGenIntPointWithBeforeReadA interface {
+	BeforeReadA(context.Context, *gorm.DB) (*gorm.DB, error)
 }
 
-// IntPointServiceIntPointWithAfterCreate called before DefaultCreateIntPoint in the default Create handler
-type IntPointServiceIntPointWithAfterCreate interface {
-	AfterCreate(context.Context, *CreateIntPointResponse, *gorm.DB) error
+// MultipleMethodsAutoGenIntPointWithAfterReadA called before DefaultReadAIntPoint in the default ReadA handler
+type MultipleMethodsAutoGenIntPointWithAfterReadA interface {
+	AfterReadA(context.Context, *ReadIntPointResponse, *gorm.DB) error
 }
 
-// Read ...
-func (m *IntPointServiceDefaultServer) Read(ctx context.Context, in *ReadIntPointRequest) (*ReadIntPointResponse, error) {
+// ReadB ...
+func (m *MultipleMethodsAutoGenDefaultServer) ReadB(ctx context.Context, in *ReadIntPointRequest) (*ReadIntPointResponse, error) {
 	db := m.DB
-	if custom, ok := interface{}(in).(IntPointServiceIntPointWithBeforeRead); ok {
+	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithBeforeReadB); ok {
 		var err error
-		if db, err = custom.BeforeRead(ctx, db); err != nil {
+		if db, err = custom.BeforeReadB(ctx, db); err != nil {
 			return nil, err
 		}
 	}
@@ -830,33 +13510,33 @@ func (m *IntPointServiceDefaultServer) Read(ctx context.Context, in *ReadIntPoin
 		return nil, err
 	}
 	out := &ReadIntPointResponse{Result: res}
-	if custom, ok := interface{}(in).(IntPointServiceIntPointWithAfterRead); ok {
+	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithAfterReadB); ok {
 		var err error
-		if err = custom.AfterRead(ctx, out, db); err != nil {
+		if err = custom.AfterReadB(ctx, out, db); err != nil {
 			return nil, err
 		}
 	}
 	return out, nil
 }
 
-// IntPointServiceIntPointWithBeforeRead called before DefaultReadIntPoint in the default Read handler
-type IntPointServiceIntPointWithBeforeRead interface {
-	BeforeRead(context.Context, *gorm.DB) (*gorm.DB, error)
+// MultipleMethodsAutoGenIntPointWithBeforeReadB called before DefaultReadBIntPoint in the default ReadB handler
+type MultipleMethodsAutoGenIntPointWithBeforeReadB interface {
+	BeforeReadB(context.Context, *gorm.DB) (*gorm.DB, error)
 }
 
-// IntPointServiceIntPointWithAfterRead called before DefaultReadIntPoint in the default Read handler
-type IntPointServiceIntPointWithAfterRead interface {
-	AfterRead(context.Context, *ReadIntPointResponse, *gorm.DB) error
+// MultipleMethodsAutoGenIntPointWithAfterReadB called before DefaultReadBIntPoint in the default ReadB handler
+type MultipleMethodsAutoGenIntPointWithAfterReadB interface {
+	AfterReadB(context.Context, *ReadIntPointResponse, *gorm.DB) error
 }
 
-// Update ...
-func (m *IntPointServiceDefaultServer) Update(ctx context.Context, in *UpdateIntPointRequest) (*UpdateIntPointResponse, error) {
+// UpdateA ...
+func (m *MultipleMethodsAutoGenDefaultServer) UpdateA(ctx context.Context, in *UpdateIntPointRequest) (*UpdateIntPointResponse, error) {
 	var err error
 	var res *IntPoint
 	db := m.DB
-	if custom, ok := interface{}(in).(IntPointServiceIntPointWithBeforeUpdate); ok {
+	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithBeforeUpdateA); ok {
 		var err error
-		if db, err = custom.BeforeUpdate(ctx, db); err != nil {
+		if db, err = custom.BeforeUpdateA(ctx, db); err != nil {
 			return nil, err
 		}
 	}
@@ -866,76 +13546,81 @@ func (m *IntPointServiceDefaultServer) Update(ctx context.Context, in *UpdateInt
 		res, err = DefaultPatchIntPoint(ctx, in.GetPayload(), in.GetGerogeriGegege(), db)
 	}
 	if err != nil {
+		var fmErr *errors.InvalidFieldMaskError
+		if errors1.As(err, &fmErr) {
+			err = status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, err
 	}
 	out := &UpdateIntPointResponse{Result: res}
-	if custom, ok := interface{}(in).(IntPointServiceIntPointWithAfterUpdate); ok {
+	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithAfterUpdateA); ok {
 		var err error
-		if err = custom.AfterUpdate(ctx, out, db); err != nil {
+		if err = custom.AfterUpdateA(ctx, out, db); err != nil {
 			return nil, err
 		}
 	}
 	return out, nil
 }
 
-// IntPointServiceIntPointWithBeforeUpdate called before DefaultUpdateIntPoint in the default Update handler
-type IntPointServiceIntPointWithBeforeUpdate interface {
-	BeforeUpdate(context.Context, *gorm.DB) (*gorm.DB, error)
+// MultipleMethodsAutoGenIntPointWithBeforeUpdateA called before DefaultUpdateAIntPoint in the default UpdateA handler
+type MultipleMethodsAutoGenIntPointWithBeforeUpdateA interface {
+	BeforeUpdateA(context.Context, *gorm.DB) (*gorm.DB, error)
 }
 
-// IntPointServiceIntPointWithAfterUpdate called before DefaultUpdateIntPoint in the default Update handler
-type IntPointServiceIntPointWithAfterUpdate interface {
-	AfterUpdate(context.Context, *UpdateIntPointResponse, *gorm.DB) error
+// MultipleMethodsAutoGenIntPointWithAfterUpdateA called before DefaultUpdateAIntPoint in the default UpdateA handler
+type MultipleMethodsAutoGenIntPointWithAfterUpdateA interface {
+	AfterUpdateA(context.Context, *UpdateIntPointResponse, *gorm.DB) error
 }
 
-// UpdateSet ...
-func (m *IntPointServiceDefaultServer) UpdateSet(ctx context.Context, in *UpdateSetIntPointRequest) (*UpdateSetIntPointResponse, error) {
-	if in == nil {
-		return nil, errors.NilArgumentError
-	}
-
+// UpdateB ...
+func (m *MultipleMethodsAutoGenDefaultServer) UpdateB(ctx context.Context, in *UpdateIntPointRequest) (*UpdateIntPointResponse, error) {
+	var err error
+	var res *IntPoint
 	db := m.DB
-
-	if custom, ok := interface{}(in).(IntPointServiceIntPointWithBeforeUpdateSet); ok {
+	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithBeforeUpdateB); ok {
 		var err error
-		if db, err = custom.BeforeUpdateSet(ctx, db); err != nil {
+		if db, err = custom.BeforeUpdateB(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-
-	res, err := DefaultPatchSetIntPoint(ctx, in.GetObjects(), in.GetMasks(), db)
+	if in.GetGerogeriGegege() == nil {
+		res, err = DefaultStrictUpdateIntPoint(ctx, in.GetPayload(), db)
+	} else {
+		res, err = DefaultPatchIntPoint(ctx, in.GetPayload(), in.GetGerogeriGegege(), db)
+	}
 	if err != nil {
+		var fmErr *errors.InvalidFieldMaskError
+		if errors1.As(err, &fmErr) {
+			err = status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, err
 	}
-
-	out := &UpdateSetIntPointResponse{Results: res}
-
-	if custom, ok := interface{}(in).(IntPointServiceIntPointWithAfterUpdateSet); ok {
+	out := &UpdateIntPointResponse{Result: res}
+	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithAfterUpdateB); ok {
 		var err error
-		if err = custom.AfterUpdateSet(ctx, out, db); err != nil {
+		if err = custom.AfterUpdateB(ctx, out, db); err != nil {
 			return nil, err
 		}
 	}
-
 	return out, nil
 }
 
-// IntPointServiceIntPointWithBeforeUpdateSet called before DefaultUpdateSetIntPoint in the default UpdateSet handler
-type IntPointServiceIntPointWithBeforeUpdateSet interface {
-	BeforeUpdateSet(context.Context, *gorm.DB) (*gorm.DB, error)
+// MultipleMethodsAutoGenIntPointWithBeforeUpdateB called before DefaultUpdateBIntPoint in the default UpdateB handler
+type MultipleMethodsAutoGenIntPointWithBeforeUpdateB interface {
+	BeforeUpdateB(context.Context, *gorm.DB) (*gorm.DB, error)
 }
 
-// IntPointServiceIntPointWithAfterUpdateSet called before DefaultUpdateSetIntPoint in the default UpdateSet handler
-type IntPointServiceIntPointWithAfterUpdateSet interface {
-	AfterUpdateSet(context.Context, *UpdateSetIntPointResponse, *gorm.DB) error
+// MultipleMethodsAutoGenIntPointWithAfterUpdateB called before DefaultUpdateBIntPoint in the default UpdateB handler
+type MultipleMethodsAutoGenIntPointWithAfterUpdateB interface {
+	AfterUpdateB(context.Context, *UpdateIntPointResponse, *gorm.DB) error
 }
 
-// List ...
-func (m *IntPointServiceDefaultServer) List(ctx context.Context, in *ListIntPointRequest) (*ListIntPointResponse, error) {
+// ListA ...
+func (m *MultipleMethodsAutoGenDefaultServer) ListA(ctx context.Context, in *ListIntPointRequest) (*ListIntPointResponse, error) {
 	db := m.DB
-	if custom, ok := interface{}(in).(IntPointServiceIntPointWithBeforeList); ok {
+	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithBeforeListA); ok {
 		var err error
-		if db, err = custom.BeforeList(ctx, db); err != nil {
+		if db, err = custom.BeforeListA(ctx, db); err != nil {
 			return nil, err
 		}
 	}
@@ -960,551 +13645,492 @@ func (m *IntPointServiceDefaultServer) List(ctx context.Context, in *ListIntPoin
 		resPaging = &query.PageInfo{Offset: offset}
 	}
 	out := &ListIntPointResponse{Results: res, PageInfo: resPaging}
-	if custom, ok := interface{}(in).(IntPointServiceIntPointWithAfterList); ok {
+	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithAfterListA); ok {
 		var err error
-		if err = custom.AfterList(ctx, out, db); err != nil {
+		if err = custom.AfterListA(ctx, out, db); err != nil {
 			return nil, err
 		}
 	}
 	return out, nil
 }
 
-// IntPointServiceIntPointWithBeforeList called before DefaultListIntPoint in the default List handler
-type IntPointServiceIntPointWithBeforeList interface {
-	BeforeList(context.Context, *gorm.DB) (*gorm.DB, error)
+// MultipleMethodsAutoGenIntPointWithBeforeListA called before DefaultListAIntPoint in the default ListA handler
+type MultipleMethodsAutoGenIntPointWithBeforeListA interface {
+	BeforeListA(context.Context, *gorm.DB) (*gorm.DB, error)
 }
 
-// IntPointServiceIntPointWithAfterList called before DefaultListIntPoint in the default List handler
-type IntPointServiceIntPointWithAfterList interface {
-	AfterList(context.Context, *ListIntPointResponse, *gorm.DB) error
+// MultipleMethodsAutoGenIntPointWithAfterListA called before DefaultListAIntPoint in the default ListA handler
+type MultipleMethodsAutoGenIntPointWithAfterListA interface {
+	AfterListA(context.Context, *ListIntPointResponse, *gorm.DB) error
 }
 
-// ListSomething ...
-func (m *IntPointServiceDefaultServer) ListSomething(ctx context.Context, in *emptypb.Empty) (*ListSomethingResponse, error) {
+// ListB ...
+func (m *MultipleMethodsAutoGenDefaultServer) ListB(ctx context.Context, in *ListIntPointRequest) (*ListIntPointResponse, error) {
 	db := m.DB
-	if custom, ok := interface{}(in).(IntPointServiceSomethingWithBeforeListSomething); ok {
+	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithBeforeListB); ok {
 		var err error
-		if db, err = custom.BeforeListSomething(ctx, db); err != nil {
+		if db, err = custom.BeforeListB(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	res, err := DefaultListSomething(ctx, db)
+	pagedRequest := false
+	if in.GetPaging().GetLimit() >= 1 {
+		in.Paging.Limit++
+		pagedRequest = true
+	}
+	res, err := DefaultListIntPoint(ctx, db, in.Filter, in.OrderBy, in.Paging, in.Fields)
 	if err != nil {
 		return nil, err
 	}
-	out := &ListSomethingResponse{Results: res}
-	if custom, ok := interface{}(in).(IntPointServiceSomethingWithAfterListSomething); ok {
-		var err error
-		if err = custom.AfterListSomething(ctx, out, db); err != nil {
-			return nil, err
-		}
-	}
-	return out, nil
-}
-
-// IntPointServiceSomethingWithBeforeListSomething called before DefaultListSomethingSomething in the default ListSomething handler
-type IntPointServiceSomethingWithBeforeListSomething interface {
-	BeforeListSomething(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-
-// IntPointServiceSomethingWithAfterListSomething called before DefaultListSomethingSomething in the default ListSomething handler
-type IntPointServiceSomethingWithAfterListSomething interface {
-	AfterListSomething(context.Context, *ListSomethingResponse, *gorm.DB) error
-}
-
-// Delete ...
-func (m *IntPointServiceDefaultServer) Delete(ctx context.Context, in *DeleteIntPointRequest) (*DeleteIntPointResponse, error) {
-	db := m.DB
-	if custom, ok := interface{}(in).(IntPointServiceIntPointWithBeforeDelete); ok {
-		var err error
-		if db, err = custom.BeforeDelete(ctx, db); err != nil {
-			return nil, err
+	var resPaging *query.PageInfo
+	if pagedRequest {
+		var offset int32
+		var size int32 = int32(len(res))
+		if size == in.GetPaging().GetLimit() {
+			size--
+			res = res[:size]
+			offset = in.GetPaging().GetOffset() + size
 		}
+		resPaging = &query.PageInfo{Offset: offset}
 	}
-	err := DefaultDeleteIntPoint(ctx, &IntPoint{Id: in.GetId()}, db)
-	if err != nil {
-		return nil, err
-	}
-	out := &DeleteIntPointResponse{}
-	if custom, ok := interface{}(in).(IntPointServiceIntPointWithAfterDelete); ok {
+	out := &ListIntPointResponse{Results: res, PageInfo: resPaging}
+	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithAfterListB); ok {
 		var err error
-		if err = custom.AfterDelete(ctx, out, db); err != nil {
+		if err = custom.AfterListB(ctx, out, db); err != nil {
 			return nil, err
 		}
 	}
 	return out, nil
 }
 
-// IntPointServiceIntPointWithBeforeDelete called before DefaultDeleteIntPoint in the default Delete handler
-type IntPointServiceIntPointWithBeforeDelete interface {
-	BeforeDelete(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-
-// IntPointServiceIntPointWithAfterDelete called before DefaultDeleteIntPoint in the default Delete handler
-type IntPointServiceIntPointWithAfterDelete interface {
-	AfterDelete(context.Context, *DeleteIntPointResponse, *gorm.DB) error
-}
-
-// CustomMethod ...
-func (m *IntPointServiceDefaultServer) CustomMethod(ctx context.Context, in *emptypb.Empty) (*emptypb.Empty, error) {
-	out := &emptypb.Empty{}
-	return out, nil
-}
-
-// CreateSomething ...
-func (m *IntPointServiceDefaultServer) CreateSomething(ctx context.Context, in *Something) (*Something, error) {
-	out := &Something{}
-	return out, nil
+// MultipleMethodsAutoGenIntPointWithBeforeListB called before DefaultListBIntPoint in the default ListB handler
+type MultipleMethodsAutoGenIntPointWithBeforeListB interface {
+	BeforeListB(context.Context, *gorm.DB) (*gorm.DB, error)
 }
 
-type IntPointServiceBDefaultServer struct {
-	DB *gorm.DB
+// MultipleMethodsAutoGenIntPointWithAfterListB called before DefaultListBIntPoint in the default ListB handler
+type MultipleMethodsAutoGenIntPointWithAfterListB interface {
+	AfterListB(context.Context, *ListIntPointResponse, *gorm.DB) error
 }
 
-// List ...
-func (m *IntPointServiceBDefaultServer) List(ctx context.Context, in *ListFooRequest) (*ListIntPointResponse, error) {
+// DeleteA ...
+func (m *MultipleMethodsAutoGenDefaultServer) DeleteA(ctx context.Context, in *DeleteIntPointRequest) (*DeleteIntPointResponse, error) {
 	db := m.DB
-	if custom, ok := interface{}(in).(IntPointServiceBIntPointWithBeforeList); ok {
+	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithBeforeDeleteA); ok {
 		var err error
-		if db, err = custom.BeforeList(ctx, db); err != nil {
+		if db, err = custom.BeforeDeleteA(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	res, err := DefaultListIntPoint(ctx, db, nil, nil, nil, nil)
+	err := DefaultDeleteIntPoint(ctx, &IntPoint{Id: in.GetId()}, db)
 	if err != nil {
 		return nil, err
 	}
-	out := &ListIntPointResponse{Results: res}
-	if custom, ok := interface{}(in).(IntPointServiceBIntPointWithAfterList); ok {
+	out := &DeleteIntPointResponse{}
+	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithAfterDeleteA); ok {
 		var err error
-		if err = custom.AfterList(ctx, out, db); err != nil {
+		if err = custom.AfterDeleteA(ctx, out, db); err != nil {
 			return nil, err
 		}
 	}
 	return out, nil
 }
 
-// IntPointServiceBIntPointWithBeforeList called before DefaultListIntPoint in the default List handler
-type IntPointServiceBIntPointWithBeforeList interface {
-	BeforeList(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-
-// IntPointServiceBIntPointWithAfterList called before DefaultListIntPoint in the default List handler
-type IntPointServiceBIntPointWithAfterList interface {
-	AfterList(context.Context, *ListIntPointResponse, *gorm.DB) error
-}
-
-// Create ...
-func (m *IntPointServiceBDefaultServer) Create(ctx context.Context, in *CreateFooRequest) (*ListIntPointResponse, error) {
-	out := &ListIntPointResponse{}
-	return out, nil
-}
-
-type IntPointTxnDefaultServer struct {
+// MultipleMethodsAutoGenIntPointWithBeforeDeleteA called before DefaultDeleteAIntPoint in the default DeleteA handler
+type MultipleMethodsAutoGenIntPointWithBeforeDeleteA interface {
+	BeforeDeleteA(context.Context, *gorm.DB) (*gorm.DB, error)
 }
 
-func (m *IntPointTxnDefaultServer) spanCreate(ctx context.Context, in interface{}, methodName string) (*trace.Span, error) {
-	_, span := trace.StartSpan(ctx, fmt.Sprint("IntPointTxnDefaultServer.", methodName))
-	raw, err := json.Marshal(in)
+// MultipleMethodsAutoGenIntPointWithAfterDeleteA called before DefaultDeleteAIntPoint in the default DeleteA handler
+type MultipleMethodsAutoGenIntPointWithAfterDeleteA interface {
+	AfterDeleteA(context.Context, *DeleteIntPointResponse, *gorm.DB) error
+}
+
+// DeleteB ...
+func (m *MultipleMethodsAutoGenDefaultServer) DeleteB(ctx context.Context, in *DeleteIntPointRequest) (*DeleteIntPointResponse, error) {
+	db := m.DB
+	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithBeforeDeleteB); ok {
+		var err error
+		if db, err = custom.BeforeDeleteB(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	err := DefaultDeleteIntPoint(ctx, &IntPoint{Id: in.GetId()}, db)
 	if err != nil {
 		return nil, err
 	}
-	span.Annotate([]trace.Attribute{trace.StringAttribute("in", string(raw))}, "in parameter")
-	return span, nil
+	out := &DeleteIntPointResponse{}
+	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithAfterDeleteB); ok {
+		var err error
+		if err = custom.AfterDeleteB(ctx, out, db); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
 }
 
-// spanError ...
-func (m *IntPointTxnDefaultServer) spanError(span *trace.Span, err error) error {
-	span.SetStatus(trace.Status{
-		Code:    trace.StatusCodeUnknown,
-		Message: err.Error(),
-	})
-	return err
+// MultipleMethodsAutoGenIntPointWithBeforeDeleteB called before DefaultDeleteBIntPoint in the default DeleteB handler
+type MultipleMethodsAutoGenIntPointWithBeforeDeleteB interface {
+	BeforeDeleteB(context.Context, *gorm.DB) (*gorm.DB, error)
 }
 
-// spanResult ...
-func (m *IntPointTxnDefaultServer) spanResult(span *trace.Span, out interface{}) error {
-	raw, err := json.Marshal(out)
-	if err != nil {
-		return err
-	}
-	span.Annotate([]trace.Attribute{trace.StringAttribute("out", string(raw))}, "out parameter")
-	return nil
+// MultipleMethodsAutoGenIntPointWithAfterDeleteB called before DefaultDeleteBIntPoint in the default DeleteB handler
+type MultipleMethodsAutoGenIntPointWithAfterDeleteB interface {
+	AfterDeleteB(context.Context, *DeleteIntPointResponse, *gorm.DB) error
 }
 
-// Create ...
-func (m *IntPointTxnDefaultServer) Create(ctx context.Context, in *CreateIntPointRequest) (*CreateIntPointResponse, error) {
-	span, errSpanCreate := m.spanCreate(ctx, in, "Create")
-	if errSpanCreate != nil {
-		return nil, errSpanCreate
-	}
-	defer span.End()
-	txn, ok := gorm1.FromContext(ctx)
-	if !ok {
-		return nil, errors.NoTransactionError
-	}
-	db := txn.Begin()
-	if db.Error != nil {
-		return nil, db.Error
+// DeleteSetA ...
+func (m *MultipleMethodsAutoGenDefaultServer) DeleteSetA(ctx context.Context, in *DeleteIntPointsRequest) (*DeleteIntPointResponse, error) {
+	db := m.DB
+	objs := []*IntPoint{}
+	for _, id := range in.Ids {
+		objs = append(objs, &IntPoint{Id: id})
 	}
-	if custom, ok := interface{}(in).(IntPointTxnIntPointWithBeforeCreate); ok {
+	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithBeforeDeleteSetA); ok {
 		var err error
-		if db, err = custom.BeforeCreate(ctx, db); err != nil {
-			return nil, m.spanError(span, err)
+		if db, err = custom.BeforeDeleteSetA(ctx, db); err != nil {
+			return nil, err
 		}
 	}
-	res, err := DefaultCreateIntPoint(ctx, in.GetPayload(), db)
-	if err != nil {
-		return nil, m.spanError(span, err)
-	}
-	out := &CreateIntPointResponse{Result: res}
-	err = gateway.SetCreated(ctx, "")
+	err := DefaultDeleteIntPointSet(ctx, objs, db)
 	if err != nil {
-		return nil, m.spanError(span, err)
+		return nil, err
 	}
-	if custom, ok := interface{}(in).(IntPointTxnIntPointWithAfterCreate); ok {
+	out := &DeleteIntPointResponse{}
+	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithAfterDeleteSetA); ok {
 		var err error
-		if err = custom.AfterCreate(ctx, out, db); err != nil {
-			return nil, m.spanError(span, err)
+		if err = custom.AfterDeleteSetA(ctx, out, db); err != nil {
+			return nil, err
 		}
 	}
-	errSpanResult := m.spanResult(span, out)
-	if errSpanResult != nil {
-		return nil, m.spanError(span, errSpanResult)
-	}
 	return out, nil
 }
 
-// IntPointTxnIntPointWithBeforeCreate called before DefaultCreateIntPoint in the default Create handler
-type IntPointTxnIntPointWithBeforeCreate interface {
-	BeforeCreate(context.Context, *gorm.DB) (*gorm.DB, error)
+// MultipleMethodsAutoGenIntPointWithBeforeDeleteSetA called before DefaultDeleteSetAIntPoint in the default DeleteSetA handler
+type MultipleMethodsAutoGenIntPointWithBeforeDeleteSetA interface {
+	BeforeDeleteSetA(context.Context, *gorm.DB) (*gorm.DB, error)
 }
 
-// IntPointTxnIntPointWithAfterCreate called before DefaultCreateIntPoint in the default Create handler
-type IntPointTxnIntPointWithAfterCreate interface {
-	AfterCreate(context.Context, *CreateIntPointResponse, *gorm.DB) error
+// MultipleMethodsAutoGenIntPointWithAfterDeleteSetA called before DefaultDeleteSetAIntPoint in the default DeleteSetA handler
+type MultipleMethodsAutoGenIntPointWithAfterDeleteSetA interface {
+	AfterDeleteSetA(context.Context, *DeleteIntPointResponse, *gorm.DB) error
 }
 
-// Read ...
-func (m *IntPointTxnDefaultServer) Read(ctx context.Context, in *ReadIntPointRequest) (*ReadIntPointResponse, error) {
-	span, errSpanCreate := m.spanCreate(ctx, in, "Read")
-	if errSpanCreate != nil {
-		return nil, errSpanCreate
-	}
-	defer span.End()
-	txn, ok := gorm1.FromContext(ctx)
-	if !ok {
-		return nil, errors.NoTransactionError
-	}
-	db := txn.Begin()
-	if db.Error != nil {
-		return nil, db.Error
+// DeleteSetB ...
+func (m *MultipleMethodsAutoGenDefaultServer) DeleteSetB(ctx context.Context, in *DeleteIntPointsRequest) (*DeleteIntPointResponse, error) {
+	db := m.DB
+	objs := []*IntPoint{}
+	for _, id := range in.Ids {
+		objs = append(objs, &IntPoint{Id: id})
 	}
-	if custom, ok := interface{}(in).(IntPointTxnIntPointWithBeforeRead); ok {
+	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithBeforeDeleteSetB); ok {
 		var err error
-		if db, err = custom.BeforeRead(ctx, db); err != nil {
-			return nil, m.spanError(span, err)
+		if db, err = custom.BeforeDeleteSetB(ctx, db); err != nil {
+			return nil, err
 		}
 	}
-	res, err := DefaultReadIntPoint(ctx, &IntPoint{Id: in.GetId()}, db, in.Fields)
+	err := DefaultDeleteIntPointSet(ctx, objs, db)
 	if err != nil {
-		return nil, m.spanError(span, err)
+		return nil, err
 	}
-	out := &ReadIntPointResponse{Result: res}
-	if custom, ok := interface{}(in).(IntPointTxnIntPointWithAfterRead); ok {
+	out := &DeleteIntPointResponse{}
+	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithAfterDeleteSetB); ok {
 		var err error
-		if err = custom.AfterRead(ctx, out, db); err != nil {
-			return nil, m.spanError(span, err)
+		if err = custom.AfterDeleteSetB(ctx, out, db); err != nil {
+			return nil, err
 		}
 	}
-	errSpanResult := m.spanResult(span, out)
-	if errSpanResult != nil {
-		return nil, m.spanError(span, errSpanResult)
-	}
 	return out, nil
 }
 
-// IntPointTxnIntPointWithBeforeRead called before DefaultReadIntPoint in the default Read handler
-type IntPointTxnIntPointWithBeforeRead interface {
-	BeforeRead(context.Context, *gorm.DB) (*gorm.DB, error)
+// MultipleMethodsAutoGenIntPointWithBeforeDeleteSetB called before DefaultDeleteSetBIntPoint in the default DeleteSetB handler
+type MultipleMethodsAutoGenIntPointWithBeforeDeleteSetB interface {
+	BeforeDeleteSetB(context.Context, *gorm.DB) (*gorm.DB, error)
 }
 
-// IntPointTxnIntPointWithAfterRead called before DefaultReadIntPoint in the default Read handler
-type IntPointTxnIntPointWithAfterRead interface {
-	AfterRead(context.Context, *ReadIntPointResponse, *gorm.DB) error
+// MultipleMethodsAutoGenIntPointWithAfterDeleteSetB called before DefaultDeleteSetBIntPoint in the default DeleteSetB handler
+type MultipleMethodsAutoGenIntPointWithAfterDeleteSetB interface {
+	AfterDeleteSetB(context.Context, *DeleteIntPointResponse, *gorm.DB) error
+}
+type TenantEventServiceDefaultServer struct {
+	DB *gorm.DB
 }
 
-// Update ...
-func (m *IntPointTxnDefaultServer) Update(ctx context.Context, in *UpdateIntPointRequest) (*UpdateIntPointResponse, error) {
-	span, errSpanCreate := m.spanCreate(ctx, in, "Update")
-	if errSpanCreate != nil {
-		return nil, errSpanCreate
-	}
-	defer span.End()
-	var err error
-	var res *IntPoint
-	txn, ok := gorm1.FromContext(ctx)
-	if !ok {
-		return nil, errors.NoTransactionError
-	}
-	db := txn.Begin()
-	if db.Error != nil {
-		return nil, db.Error
-	}
-	if custom, ok := interface{}(in).(IntPointTxnIntPointWithBeforeUpdate); ok {
+// Read ...
+func (m *TenantEventServiceDefaultServer) Read(ctx context.Context, in *ReadTenantEventRequest) (*ReadTenantEventResponse, error) {
+	db := m.DB
+	if custom, ok := interface{}(in).(TenantEventServiceTenantEventWithBeforeRead); ok {
 		var err error
-		if db, err = custom.BeforeUpdate(ctx, db); err != nil {
-			return nil, m.spanError(span, err)
+		if db, err = custom.BeforeRead(ctx, db); err != nil {
+			return nil, err
 		}
 	}
-	if in.GetGerogeriGegege() == nil {
-		res, err = DefaultStrictUpdateIntPoint(ctx, in.GetPayload(), db)
-	} else {
-		res, err = DefaultPatchIntPoint(ctx, in.GetPayload(), in.GetGerogeriGegege(), db)
-	}
+	res, err := DefaultReadTenantEvent(ctx, &TenantEvent{AccountId: in.GetAccountId(), Id: in.GetId()}, db)
 	if err != nil {
-		return nil, m.spanError(span, err)
+		return nil, err
 	}
-	out := &UpdateIntPointResponse{Result: res}
-	if custom, ok := interface{}(in).(IntPointTxnIntPointWithAfterUpdate); ok {
+	out := &ReadTenantEventResponse{Result: res}
+	if custom, ok := interface{}(in).(TenantEventServiceTenantEventWithAfterRead); ok {
 		var err error
-		if err = custom.AfterUpdate(ctx, out, db); err != nil {
-			return nil, m.spanError(span, err)
+		if err = custom.AfterRead(ctx, out, db); err != nil {
+			return nil, err
 		}
 	}
-	errSpanResult := m.spanResult(span, out)
-	if errSpanResult != nil {
-		return nil, m.spanError(span, errSpanResult)
-	}
 	return out, nil
 }
 
-// IntPointTxnIntPointWithBeforeUpdate called before DefaultUpdateIntPoint in the default Update handler
-type IntPointTxnIntPointWithBeforeUpdate interface {
-	BeforeUpdate(context.Context, *gorm.DB) (*gorm.DB, error)
+// TenantEventServiceTenantEventWithBeforeRead called before DefaultReadTenantEvent in the default Read handler
+type TenantEventServiceTenantEventWithBeforeRead interface {
+	BeforeRead(context.Context, *gorm.DB) (*gorm.DB, error)
 }
 
-// IntPointTxnIntPointWithAfterUpdate called before DefaultUpdateIntPoint in the default Update handler
-type IntPointTxnIntPointWithAfterUpdate interface {
-	AfterUpdate(context.Context, *UpdateIntPointResponse, *gorm.DB) error
+// TenantEventServiceTenantEventWithAfterRead called before DefaultReadTenantEvent in the default Read handler
+type TenantEventServiceTenantEventWithAfterRead interface {
+	AfterRead(context.Context, *ReadTenantEventResponse, *gorm.DB) error
 }
 
-// List ...
-func (m *IntPointTxnDefaultServer) List(ctx context.Context, in *ListIntPointRequest) (*ListIntPointResponse, error) {
-	span, errSpanCreate := m.spanCreate(ctx, in, "List")
-	if errSpanCreate != nil {
-		return nil, errSpanCreate
-	}
-	defer span.End()
-	txn, ok := gorm1.FromContext(ctx)
-	if !ok {
-		return nil, errors.NoTransactionError
-	}
-	db := txn.Begin()
-	if db.Error != nil {
-		return nil, db.Error
-	}
-	if custom, ok := interface{}(in).(IntPointTxnIntPointWithBeforeList); ok {
+// Update ...
+func (m *TenantEventServiceDefaultServer) Update(ctx context.Context, in *UpdateTenantEventRequest) (*UpdateTenantEventResponse, error) {
+	var err error
+	var res *TenantEvent
+	db := m.DB
+	if custom, ok := interface{}(in).(TenantEventServiceTenantEventWithBeforeUpdate); ok {
 		var err error
-		if db, err = custom.BeforeList(ctx, db); err != nil {
-			return nil, m.spanError(span, err)
+		if db, err = custom.BeforeUpdate(ctx, db); err != nil {
+			return nil, err
 		}
 	}
-	pagedRequest := false
-	if in.GetPaging().GetLimit() >= 1 {
-		in.Paging.Limit++
-		pagedRequest = true
-	}
-	res, err := DefaultListIntPoint(ctx, db, in.Filter, in.OrderBy, in.Paging, in.Fields)
-	if err != nil {
-		return nil, m.spanError(span, err)
-	}
-	var resPaging *query.PageInfo
-	if pagedRequest {
-		var offset int32
-		var size int32 = int32(len(res))
-		if size == in.GetPaging().GetLimit() {
-			size--
-			res = res[:size]
-			offset = in.GetPaging().GetOffset() + size
+	if in.GetUpdateMask() == nil {
+		res, err = DefaultStrictUpdateTenantEvent(ctx, in.GetPayload(), db)
+	} else {
+		res, err = DefaultPatchTenantEvent(ctx, in.GetPayload(), in.GetUpdateMask(), db)
+	}
+	if err != nil {
+		var fmErr *errors.InvalidFieldMaskError
+		if errors1.As(err, &fmErr) {
+			err = status.Error(codes.InvalidArgument, err.Error())
 		}
-		resPaging = &query.PageInfo{Offset: offset}
+		return nil, err
 	}
-	out := &ListIntPointResponse{Results: res, PageInfo: resPaging}
-	if custom, ok := interface{}(in).(IntPointTxnIntPointWithAfterList); ok {
+	out := &UpdateTenantEventResponse{Result: res}
+	if custom, ok := interface{}(in).(TenantEventServiceTenantEventWithAfterUpdate); ok {
 		var err error
-		if err = custom.AfterList(ctx, out, db); err != nil {
-			return nil, m.spanError(span, err)
+		if err = custom.AfterUpdate(ctx, out, db); err != nil {
+			return nil, err
 		}
 	}
-	errSpanResult := m.spanResult(span, out)
-	if errSpanResult != nil {
-		return nil, m.spanError(span, errSpanResult)
-	}
 	return out, nil
 }
 
-// IntPointTxnIntPointWithBeforeList called before DefaultListIntPoint in the default List handler
-type IntPointTxnIntPointWithBeforeList interface {
-	BeforeList(context.Context, *gorm.DB) (*gorm.DB, error)
+// TenantEventServiceTenantEventWithBeforeUpdate called before DefaultUpdateTenantEvent in the default Update handler
+type TenantEventServiceTenantEventWithBeforeUpdate interface {
+	BeforeUpdate(context.Context, *gorm.DB) (*gorm.DB, error)
 }
 
-// IntPointTxnIntPointWithAfterList called before DefaultListIntPoint in the default List handler
-type IntPointTxnIntPointWithAfterList interface {
-	AfterList(context.Context, *ListIntPointResponse, *gorm.DB) error
+// TenantEventServiceTenantEventWithAfterUpdate called before DefaultUpdateTenantEvent in the default Update handler
+type TenantEventServiceTenantEventWithAfterUpdate interface {
+	AfterUpdate(context.Context, *UpdateTenantEventResponse, *gorm.DB) error
 }
 
 // Delete ...
-func (m *IntPointTxnDefaultServer) Delete(ctx context.Context, in *DeleteIntPointRequest) (*DeleteIntPointResponse, error) {
-	span, errSpanCreate := m.spanCreate(ctx, in, "Delete")
-	if errSpanCreate != nil {
-		return nil, errSpanCreate
-	}
-	defer span.End()
-	txn, ok := gorm1.FromContext(ctx)
-	if !ok {
-		return nil, errors.NoTransactionError
-	}
-	db := txn.Begin()
-	if db.Error != nil {
-		return nil, db.Error
-	}
-	if custom, ok := interface{}(in).(IntPointTxnIntPointWithBeforeDelete); ok {
+func (m *TenantEventServiceDefaultServer) Delete(ctx context.Context, in *DeleteTenantEventRequest) (*DeleteTenantEventResponse, error) {
+	db := m.DB
+	if custom, ok := interface{}(in).(TenantEventServiceTenantEventWithBeforeDelete); ok {
 		var err error
 		if db, err = custom.BeforeDelete(ctx, db); err != nil {
-			return nil, m.spanError(span, err)
+			return nil, err
 		}
 	}
-	err := DefaultDeleteIntPoint(ctx, &IntPoint{Id: in.GetId()}, db)
+	err := DefaultDeleteTenantEvent(ctx, &TenantEvent{AccountId: in.GetAccountId(), Id: in.GetId()}, db)
 	if err != nil {
-		return nil, m.spanError(span, err)
+		return nil, err
 	}
-	out := &DeleteIntPointResponse{}
-	if custom, ok := interface{}(in).(IntPointTxnIntPointWithAfterDelete); ok {
+	out := &DeleteTenantEventResponse{}
+	if custom, ok := interface{}(in).(TenantEventServiceTenantEventWithAfterDelete); ok {
 		var err error
 		if err = custom.AfterDelete(ctx, out, db); err != nil {
-			return nil, m.spanError(span, err)
+			return nil, err
 		}
 	}
-	errSpanResult := m.spanResult(span, out)
-	if errSpanResult != nil {
-		return nil, m.spanError(span, errSpanResult)
-	}
 	return out, nil
 }
 
-// IntPointTxnIntPointWithBeforeDelete called before DefaultDeleteIntPoint in the default Delete handler
-type IntPointTxnIntPointWithBeforeDelete interface {
+// TenantEventServiceTenantEventWithBeforeDelete called before DefaultDeleteTenantEvent in the default Delete handler
+type TenantEventServiceTenantEventWithBeforeDelete interface {
 	BeforeDelete(context.Context, *gorm.DB) (*gorm.DB, error)
 }
 
-// IntPointTxnIntPointWithAfterDelete called before DefaultDeleteIntPoint in the default Delete handler
-type IntPointTxnIntPointWithAfterDelete interface {
-	AfterDelete(context.Context, *DeleteIntPointResponse, *gorm.DB) error
+// TenantEventServiceTenantEventWithAfterDelete called before DefaultDeleteTenantEvent in the default Delete handler
+type TenantEventServiceTenantEventWithAfterDelete interface {
+	AfterDelete(context.Context, *DeleteTenantEventResponse, *gorm.DB) error
 }
 
 // DeleteSet ...
-func (m *IntPointTxnDefaultServer) DeleteSet(ctx context.Context, in *DeleteIntPointsRequest) (*DeleteIntPointResponse, error) {
-	span, errSpanCreate := m.spanCreate(ctx, in, "DeleteSet")
-	if errSpanCreate != nil {
-		return nil, errSpanCreate
-	}
-	defer span.End()
-	txn, ok := gorm1.FromContext(ctx)
-	if !ok {
-		return nil, errors.NoTransactionError
-	}
-	db := txn.Begin()
-	if db.Error != nil {
-		return nil, db.Error
-	}
-	objs := []*IntPoint{}
-	for _, id := range in.Ids {
-		objs = append(objs, &IntPoint{Id: id})
-	}
-	if custom, ok := interface{}(in).(IntPointTxnIntPointWithBeforeDeleteSet); ok {
+func (m *TenantEventServiceDefaultServer) DeleteSet(ctx context.Context, in *DeleteTenantEventsRequest) (*DeleteTenantEventResponse, error) {
+	db := m.DB
+	objs := in.GetObjects()
+	if custom, ok := interface{}(in).(TenantEventServiceTenantEventWithBeforeDeleteSet); ok {
 		var err error
 		if db, err = custom.BeforeDeleteSet(ctx, db); err != nil {
-			return nil, m.spanError(span, err)
+			return nil, err
 		}
 	}
-	err := DefaultDeleteIntPointSet(ctx, objs, db)
+	err := DefaultDeleteTenantEventSet(ctx, objs, db)
 	if err != nil {
-		return nil, m.spanError(span, err)
+		return nil, err
 	}
-	out := &DeleteIntPointResponse{}
-	if custom, ok := interface{}(in).(IntPointTxnIntPointWithAfterDeleteSet); ok {
+	out := &DeleteTenantEventResponse{}
+	if custom, ok := interface{}(in).(TenantEventServiceTenantEventWithAfterDeleteSet); ok {
 		var err error
 		if err = custom.AfterDeleteSet(ctx, out, db); err != nil {
-			return nil, m.spanError(span, err)
+			return nil, err
 		}
 	}
-	errSpanResult := m.spanResult(span, out)
-	if errSpanResult != nil {
-		return nil, m.spanError(span, errSpanResult)
-	}
 	return out, nil
 }
 
-// IntPointTxnIntPointWithBeforeDeleteSet called before DefaultDeleteSetIntPoint in the default DeleteSet handler
-type IntPointTxnIntPointWithBeforeDeleteSet interface {
+// TenantEventServiceTenantEventWithBeforeDeleteSet called before DefaultDeleteSetTenantEvent in the default DeleteSet handler
+type TenantEventServiceTenantEventWithBeforeDeleteSet interface {
 	BeforeDeleteSet(context.Context, *gorm.DB) (*gorm.DB, error)
 }
 
-// IntPointTxnIntPointWithAfterDeleteSet called before DefaultDeleteSetIntPoint in the default DeleteSet handler
-type IntPointTxnIntPointWithAfterDeleteSet interface {
-	AfterDeleteSet(context.Context, *DeleteIntPointResponse, *gorm.DB) error
+// TenantEventServiceTenantEventWithAfterDeleteSet called before DefaultDeleteSetTenantEvent in the default DeleteSet handler
+type TenantEventServiceTenantEventWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, *DeleteTenantEventResponse, *gorm.DB) error
+}
+type SettingServiceDefaultServer struct {
+	DB *gorm.DB
 }
 
-// CustomMethod ...
-func (m *IntPointTxnDefaultServer) CustomMethod(ctx context.Context, in *emptypb.Empty) (*emptypb.Empty, error) {
-	span, errSpanCreate := m.spanCreate(ctx, in, "CustomMethod")
-	if errSpanCreate != nil {
-		return nil, errSpanCreate
+// Upsert ...
+func (m *SettingServiceDefaultServer) Upsert(ctx context.Context, in *UpsertSettingRequest) (*UpsertSettingResponse, error) {
+	db := m.DB
+	if custom, ok := interface{}(in).(SettingServiceSettingWithBeforeUpsert); ok {
+		var err error
+		if db, err = custom.BeforeUpsert(ctx, db); err != nil {
+			return nil, err
+		}
 	}
-	defer span.End()
-	out := &emptypb.Empty{}
-	errSpanResult := m.spanResult(span, out)
-	if errSpanResult != nil {
-		return nil, m.spanError(span, errSpanResult)
+	res, err := DefaultUpsertSetting(ctx, in.GetPayload(), "idx_setting_name", in.GetUpdateMask(), db)
+	if err != nil {
+		var fmErr *errors.InvalidFieldMaskError
+		if errors1.As(err, &fmErr) {
+			err = status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, err
+	}
+	out := &UpsertSettingResponse{Result: res}
+	if custom, ok := interface{}(in).(SettingServiceSettingWithAfterUpsert); ok {
+		var err error
+		if err = custom.AfterUpsert(ctx, out, db); err != nil {
+			return nil, err
+		}
 	}
 	return out, nil
 }
 
-// CreateSomething ...
-func (m *IntPointTxnDefaultServer) CreateSomething(ctx context.Context, in *Something) (*Something, error) {
-	span, errSpanCreate := m.spanCreate(ctx, in, "CreateSomething")
-	if errSpanCreate != nil {
-		return nil, errSpanCreate
+// SettingServiceSettingWithBeforeUpsert called before DefaultUpsertSetting in the default Upsert handler
+type SettingServiceSettingWithBeforeUpsert interface {
+	BeforeUpsert(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+
+// SettingServiceSettingWithAfterUpsert called before DefaultUpsertSetting in the default Upsert handler
+type SettingServiceSettingWithAfterUpsert interface {
+	AfterUpsert(context.Context, *UpsertSettingResponse, *gorm.DB) error
+}
+
+// ReadByName ...
+func (m *SettingServiceDefaultServer) ReadByName(ctx context.Context, in *ReadSettingByNameRequest) (*ReadSettingResponse, error) {
+	db := m.DB
+	if custom, ok := interface{}(in).(SettingServiceSettingWithBeforeReadByName); ok {
+		var err error
+		if db, err = custom.BeforeReadByName(ctx, db); err != nil {
+			return nil, err
+		}
 	}
-	defer span.End()
-	out := &Something{}
-	errSpanResult := m.spanResult(span, out)
-	if errSpanResult != nil {
-		return nil, m.spanError(span, errSpanResult)
+	res, err := DefaultReadByNameSetting(ctx, &Setting{Name: in.GetName()}, db)
+	if err != nil {
+		return nil, err
+	}
+	out := &ReadSettingResponse{Result: res}
+	if custom, ok := interface{}(in).(SettingServiceSettingWithAfterReadByName); ok {
+		var err error
+		if err = custom.AfterReadByName(ctx, out, db); err != nil {
+			return nil, err
+		}
 	}
 	return out, nil
 }
 
-type CircleServiceDefaultServer struct {
+// SettingServiceSettingWithBeforeReadByName called before DefaultReadByNameSetting in the default ReadByName handler
+type SettingServiceSettingWithBeforeReadByName interface {
+	BeforeReadByName(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+
+// SettingServiceSettingWithAfterReadByName called before DefaultReadByNameSetting in the default ReadByName handler
+type SettingServiceSettingWithAfterReadByName interface {
+	AfterReadByName(context.Context, *ReadSettingResponse, *gorm.DB) error
+}
+type BasketServiceDefaultServer struct {
 	DB *gorm.DB
 }
 
+// Read ...
+func (m *BasketServiceDefaultServer) Read(ctx context.Context, in *ReadBasketRequest) (*ReadBasketResponse, error) {
+	db := m.DB
+	if custom, ok := interface{}(in).(BasketServiceBasketWithBeforeRead); ok {
+		var err error
+		if db, err = custom.BeforeRead(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	var fs *query.FieldSelection
+	if in.GetView() != BasketView_BASKET_VIEW_FULL {
+		fs = &query.FieldSelection{Fields: map[string]*query.Field{}}
+	}
+	res, err := DefaultReadBasket(ctx, &Basket{Id: in.GetId()}, db, fs)
+	if err != nil {
+		return nil, err
+	}
+	out := &ReadBasketResponse{Result: res}
+	if custom, ok := interface{}(in).(BasketServiceBasketWithAfterRead); ok {
+		var err error
+		if err = custom.AfterRead(ctx, out, db); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// BasketServiceBasketWithBeforeRead called before DefaultReadBasket in the default Read handler
+type BasketServiceBasketWithBeforeRead interface {
+	BeforeRead(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+
+// BasketServiceBasketWithAfterRead called before DefaultReadBasket in the default Read handler
+type BasketServiceBasketWithAfterRead interface {
+	AfterRead(context.Context, *ReadBasketResponse, *gorm.DB) error
+}
+
 // List ...
-func (m *CircleServiceDefaultServer) List(ctx context.Context, in *ListCircleRequest) (*ListCircleResponse, error) {
+func (m *BasketServiceDefaultServer) List(ctx context.Context, in *ListBasketRequest) (*ListBasketResponse, error) {
 	db := m.DB
-	if custom, ok := interface{}(in).(CircleServiceCircleWithBeforeList); ok {
+	if custom, ok := interface{}(in).(BasketServiceBasketWithBeforeList); ok {
 		var err error
 		if db, err = custom.BeforeList(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	res, err := DefaultListCircle(ctx, db)
+	fs := in.GetFields()
+	if fs == nil && in.GetView() != BasketView_BASKET_VIEW_FULL {
+		fs = &query.FieldSelection{Fields: map[string]*query.Field{}}
+	}
+	res, err := DefaultListBasket(ctx, db, fs)
 	if err != nil {
 		return nil, err
 	}
-	out := &ListCircleResponse{Results: res}
-	if custom, ok := interface{}(in).(CircleServiceCircleWithAfterList); ok {
+	out := &ListBasketResponse{Results: res}
+	if custom, ok := interface{}(in).(BasketServiceBasketWithAfterList); ok {
 		var err error
 		if err = custom.AfterList(ctx, out, db); err != nil {
 			return nil, err
@@ -1513,471 +14139,556 @@ func (m *CircleServiceDefaultServer) List(ctx context.Context, in *ListCircleReq
 	return out, nil
 }
 
-// CircleServiceCircleWithBeforeList called before DefaultListCircle in the default List handler
-type CircleServiceCircleWithBeforeList interface {
+// BasketServiceBasketWithBeforeList called before DefaultListBasket in the default List handler
+type BasketServiceBasketWithBeforeList interface {
 	BeforeList(context.Context, *gorm.DB) (*gorm.DB, error)
 }
 
-// CircleServiceCircleWithAfterList called before DefaultListCircle in the default List handler
-type CircleServiceCircleWithAfterList interface {
-	AfterList(context.Context, *ListCircleResponse, *gorm.DB) error
-}
-type MultipleMethodsAutoGenDefaultServer struct {
-	DB *gorm.DB
+// BasketServiceBasketWithAfterList called before DefaultListBasket in the default List handler
+type BasketServiceBasketWithAfterList interface {
+	AfterList(context.Context, *ListBasketResponse, *gorm.DB) error
 }
 
-// CreateA ...
-func (m *MultipleMethodsAutoGenDefaultServer) CreateA(ctx context.Context, in *CreateIntPointRequest) (*CreateIntPointResponse, error) {
+// AddLabels ...
+func (m *BasketServiceDefaultServer) AddLabels(ctx context.Context, in *BasketLabelsRequest) (*BasketLabelsResponse, error) {
 	db := m.DB
-	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithBeforeCreateA); ok {
+	if custom, ok := interface{}(in).(BasketServiceBasketWithBeforeAddLabels); ok {
 		var err error
-		if db, err = custom.BeforeCreateA(ctx, db); err != nil {
+		if db, err = custom.BeforeAddLabels(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	res, err := DefaultCreateIntPoint(ctx, in.GetPayload(), db)
+	err := DefaultAddLabelsToBasket(ctx, &Basket{Id: in.GetId()}, in.GetLabels(), db)
 	if err != nil {
 		return nil, err
 	}
-	out := &CreateIntPointResponse{Result: res}
-	err = gateway.SetCreated(ctx, "")
+	out := &BasketLabelsResponse{}
+	if custom, ok := interface{}(in).(BasketServiceBasketWithAfterAddLabels); ok {
+		var err error
+		if err = custom.AfterAddLabels(ctx, out, db); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// BasketServiceBasketWithBeforeAddLabels called before DefaultAddLabelsBasket in the default AddLabels handler
+type BasketServiceBasketWithBeforeAddLabels interface {
+	BeforeAddLabels(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+
+// BasketServiceBasketWithAfterAddLabels called before DefaultAddLabelsBasket in the default AddLabels handler
+type BasketServiceBasketWithAfterAddLabels interface {
+	AfterAddLabels(context.Context, *BasketLabelsResponse, *gorm.DB) error
+}
+
+// RemoveLabels ...
+func (m *BasketServiceDefaultServer) RemoveLabels(ctx context.Context, in *BasketLabelsRequest) (*BasketLabelsResponse, error) {
+	db := m.DB
+	if custom, ok := interface{}(in).(BasketServiceBasketWithBeforeRemoveLabels); ok {
+		var err error
+		if db, err = custom.BeforeRemoveLabels(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	err := DefaultRemoveLabelsFromBasket(ctx, &Basket{Id: in.GetId()}, in.GetLabels(), db)
 	if err != nil {
 		return nil, err
 	}
-	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithAfterCreateA); ok {
+	out := &BasketLabelsResponse{}
+	if custom, ok := interface{}(in).(BasketServiceBasketWithAfterRemoveLabels); ok {
 		var err error
-		if err = custom.AfterCreateA(ctx, out, db); err != nil {
+		if err = custom.AfterRemoveLabels(ctx, out, db); err != nil {
 			return nil, err
 		}
 	}
 	return out, nil
 }
 
-// MultipleMethodsAutoGenIntPointWithBeforeCreateA called before DefaultCreateAIntPoint in the default CreateA handler
-type MultipleMethodsAutoGenIntPointWithBeforeCreateA interface {
-	BeforeCreateA(context.Context, *gorm.DB) (*gorm.DB, error)
+// BasketServiceBasketWithBeforeRemoveLabels called before DefaultRemoveLabelsBasket in the default RemoveLabels handler
+type BasketServiceBasketWithBeforeRemoveLabels interface {
+	BeforeRemoveLabels(context.Context, *gorm.DB) (*gorm.DB, error)
 }
 
-// MultipleMethodsAutoGenIntPointWithAfterCreateA called before DefaultCreateAIntPoint in the default CreateA handler
-type MultipleMethodsAutoGenIntPointWithAfterCreateA interface {
-	AfterCreateA(context.Context, *CreateIntPointResponse, *gorm.DB) error
+// BasketServiceBasketWithAfterRemoveLabels called before DefaultRemoveLabelsBasket in the default RemoveLabels handler
+type BasketServiceBasketWithAfterRemoveLabels interface {
+	AfterRemoveLabels(context.Context, *BasketLabelsResponse, *gorm.DB) error
+}
+type DocumentServiceDefaultServer struct {
+	DB *gorm.DB
 }
 
-// CreateB ...
-func (m *MultipleMethodsAutoGenDefaultServer) CreateB(ctx context.Context, in *CreateIntPointRequest) (*CreateIntPointResponse, error) {
+// Read ...
+func (m *DocumentServiceDefaultServer) Read(ctx context.Context, in *ReadDocumentRequest) (*ReadDocumentResponse, error) {
 	db := m.DB
-	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithBeforeCreateB); ok {
+	if custom, ok := interface{}(in).(DocumentServiceDocumentWithBeforeRead); ok {
 		var err error
-		if db, err = custom.BeforeCreateB(ctx, db); err != nil {
+		if db, err = custom.BeforeRead(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	res, err := DefaultCreateIntPoint(ctx, in.GetPayload(), db)
+	res, err := DefaultReadDocument(ctx, &Document{Id: in.GetId()}, db, in.GetShowDeleted())
 	if err != nil {
 		return nil, err
 	}
-	out := &CreateIntPointResponse{Result: res}
-	err = gateway.SetCreated(ctx, "")
+	out := &ReadDocumentResponse{Result: res}
+	if custom, ok := interface{}(in).(DocumentServiceDocumentWithAfterRead); ok {
+		var err error
+		if err = custom.AfterRead(ctx, out, db); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// DocumentServiceDocumentWithBeforeRead called before DefaultReadDocument in the default Read handler
+type DocumentServiceDocumentWithBeforeRead interface {
+	BeforeRead(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+
+// DocumentServiceDocumentWithAfterRead called before DefaultReadDocument in the default Read handler
+type DocumentServiceDocumentWithAfterRead interface {
+	AfterRead(context.Context, *ReadDocumentResponse, *gorm.DB) error
+}
+
+// List ...
+func (m *DocumentServiceDefaultServer) List(ctx context.Context, in *ListDocumentRequest) (*ListDocumentResponse, error) {
+	db := m.DB
+	if custom, ok := interface{}(in).(DocumentServiceDocumentWithBeforeList); ok {
+		var err error
+		if db, err = custom.BeforeList(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	res, err := DefaultListDocument(ctx, db, in.GetShowDeleted())
 	if err != nil {
 		return nil, err
 	}
-	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithAfterCreateB); ok {
+	out := &ListDocumentResponse{Results: res}
+	if custom, ok := interface{}(in).(DocumentServiceDocumentWithAfterList); ok {
 		var err error
-		if err = custom.AfterCreateB(ctx, out, db); err != nil {
+		if err = custom.AfterList(ctx, out, db); err != nil {
 			return nil, err
 		}
 	}
 	return out, nil
 }
 
-// MultipleMethodsAutoGenIntPointWithBeforeCreateB called before DefaultCreateBIntPoint in the default CreateB handler
-type MultipleMethodsAutoGenIntPointWithBeforeCreateB interface {
-	BeforeCreateB(context.Context, *gorm.DB) (*gorm.DB, error)
+// DocumentServiceDocumentWithBeforeList called before DefaultListDocument in the default List handler
+type DocumentServiceDocumentWithBeforeList interface {
+	BeforeList(context.Context, *gorm.DB) (*gorm.DB, error)
 }
 
-// MultipleMethodsAutoGenIntPointWithAfterCreateB called before DefaultCreateBIntPoint in the default CreateB handler
-type MultipleMethodsAutoGenIntPointWithAfterCreateB interface {
-	AfterCreateB(context.Context, *CreateIntPointResponse, *gorm.DB) error
+// DocumentServiceDocumentWithAfterList called before DefaultListDocument in the default List handler
+type DocumentServiceDocumentWithAfterList interface {
+	AfterList(context.Context, *ListDocumentResponse, *gorm.DB) error
 }
 
-// ReadA ...
-func (m *MultipleMethodsAutoGenDefaultServer) ReadA(ctx context.Context, in *ReadIntPointRequest) (*ReadIntPointResponse, error) {
+// Delete ...
+func (m *DocumentServiceDefaultServer) Delete(ctx context.Context, in *DeleteDocumentRequest) (*DeleteDocumentResponse, error) {
 	db := m.DB
-	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithBeforeReadA); ok {
+	if custom, ok := interface{}(in).(DocumentServiceDocumentWithBeforeDelete); ok {
 		var err error
-		if db, err = custom.BeforeReadA(ctx, db); err != nil {
+		if db, err = custom.BeforeDelete(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	res, err := DefaultReadIntPoint(ctx, &IntPoint{Id: in.GetId()}, db, in.Fields)
+	err := DefaultDeleteDocument(ctx, &Document{Id: in.GetId()}, db)
 	if err != nil {
 		return nil, err
 	}
-	out := &ReadIntPointResponse{Result: res}
-	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithAfterReadA); ok {
+	out := &DeleteDocumentResponse{}
+	if custom, ok := interface{}(in).(DocumentServiceDocumentWithAfterDelete); ok {
 		var err error
-		if err = custom.AfterReadA(ctx, out, db); err != nil {
+		if err = custom.AfterDelete(ctx, out, db); err != nil {
 			return nil, err
 		}
 	}
 	return out, nil
 }
 
-// MultipleMethodsAutoGenIntPointWithBeforeReadA called before DefaultReadAIntPoint in the default ReadA handler
-type MultipleMethodsAutoGenIntPointWithBeforeReadA interface {
-	BeforeReadA(context.Context, *gorm.DB) (*gorm.DB, error)
+// DocumentServiceDocumentWithBeforeDelete called before DefaultDeleteDocument in the default Delete handler
+type DocumentServiceDocumentWithBeforeDelete interface {
+	BeforeDelete(context.Context, *gorm.DB) (*gorm.DB, error)
 }
 
-// MultipleMethodsAutoGenIntPointWithAfterReadA called before DefaultReadAIntPoint in the default ReadA handler
-type MultipleMethodsAutoGenIntPointWithAfterReadA interface {
-	AfterReadA(context.Context, *ReadIntPointResponse, *gorm.DB) error
+// DocumentServiceDocumentWithAfterDelete called before DefaultDeleteDocument in the default Delete handler
+type DocumentServiceDocumentWithAfterDelete interface {
+	AfterDelete(context.Context, *DeleteDocumentResponse, *gorm.DB) error
 }
 
-// ReadB ...
-func (m *MultipleMethodsAutoGenDefaultServer) ReadB(ctx context.Context, in *ReadIntPointRequest) (*ReadIntPointResponse, error) {
+// Undelete ...
+func (m *DocumentServiceDefaultServer) Undelete(ctx context.Context, in *UndeleteDocumentRequest) (*UndeleteDocumentResponse, error) {
 	db := m.DB
-	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithBeforeReadB); ok {
+	if custom, ok := interface{}(in).(DocumentServiceDocumentWithBeforeUndelete); ok {
 		var err error
-		if db, err = custom.BeforeReadB(ctx, db); err != nil {
+		if db, err = custom.BeforeUndelete(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	res, err := DefaultReadIntPoint(ctx, &IntPoint{Id: in.GetId()}, db, in.Fields)
+	res, err := DefaultUndeleteDocument(ctx, &Document{Id: in.GetId()}, db)
 	if err != nil {
 		return nil, err
 	}
-	out := &ReadIntPointResponse{Result: res}
-	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithAfterReadB); ok {
+	out := &UndeleteDocumentResponse{Result: res}
+	if custom, ok := interface{}(in).(DocumentServiceDocumentWithAfterUndelete); ok {
 		var err error
-		if err = custom.AfterReadB(ctx, out, db); err != nil {
+		if err = custom.AfterUndelete(ctx, out, db); err != nil {
 			return nil, err
 		}
 	}
 	return out, nil
 }
 
-// MultipleMethodsAutoGenIntPointWithBeforeReadB called before DefaultReadBIntPoint in the default ReadB handler
-type MultipleMethodsAutoGenIntPointWithBeforeReadB interface {
-	BeforeReadB(context.Context, *gorm.DB) (*gorm.DB, error)
+// DocumentServiceDocumentWithBeforeUndelete called before DefaultUndeleteDocument in the default Undelete handler
+type DocumentServiceDocumentWithBeforeUndelete interface {
+	BeforeUndelete(context.Context, *gorm.DB) (*gorm.DB, error)
 }
 
-// MultipleMethodsAutoGenIntPointWithAfterReadB called before DefaultReadBIntPoint in the default ReadB handler
-type MultipleMethodsAutoGenIntPointWithAfterReadB interface {
-	AfterReadB(context.Context, *ReadIntPointResponse, *gorm.DB) error
+// DocumentServiceDocumentWithAfterUndelete called before DefaultUndeleteDocument in the default Undelete handler
+type DocumentServiceDocumentWithAfterUndelete interface {
+	AfterUndelete(context.Context, *UndeleteDocumentResponse, *gorm.DB) error
 }
 
-// UpdateA ...
-func (m *MultipleMethodsAutoGenDefaultServer) UpdateA(ctx context.Context, in *UpdateIntPointRequest) (*UpdateIntPointResponse, error) {
-	var err error
-	var res *IntPoint
+// Purge ...
+func (m *DocumentServiceDefaultServer) Purge(ctx context.Context, in *DeleteDocumentRequest) (*DeleteDocumentResponse, error) {
 	db := m.DB
-	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithBeforeUpdateA); ok {
+	if custom, ok := interface{}(in).(DocumentServiceDocumentWithBeforePurge); ok {
 		var err error
-		if db, err = custom.BeforeUpdateA(ctx, db); err != nil {
+		if db, err = custom.BeforePurge(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if in.GetGerogeriGegege() == nil {
-		res, err = DefaultStrictUpdateIntPoint(ctx, in.GetPayload(), db)
-	} else {
-		res, err = DefaultPatchIntPoint(ctx, in.GetPayload(), in.GetGerogeriGegege(), db)
-	}
+	err := DefaultHardDeleteDocument(ctx, &Document{Id: in.GetId()}, db)
 	if err != nil {
 		return nil, err
 	}
-	out := &UpdateIntPointResponse{Result: res}
-	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithAfterUpdateA); ok {
+	out := &DeleteDocumentResponse{}
+	if custom, ok := interface{}(in).(DocumentServiceDocumentWithAfterPurge); ok {
 		var err error
-		if err = custom.AfterUpdateA(ctx, out, db); err != nil {
+		if err = custom.AfterPurge(ctx, out, db); err != nil {
 			return nil, err
 		}
 	}
 	return out, nil
 }
 
-// MultipleMethodsAutoGenIntPointWithBeforeUpdateA called before DefaultUpdateAIntPoint in the default UpdateA handler
-type MultipleMethodsAutoGenIntPointWithBeforeUpdateA interface {
-	BeforeUpdateA(context.Context, *gorm.DB) (*gorm.DB, error)
+// DocumentServiceDocumentWithBeforePurge called before DefaultPurgeDocument in the default Purge handler
+type DocumentServiceDocumentWithBeforePurge interface {
+	BeforePurge(context.Context, *gorm.DB) (*gorm.DB, error)
 }
 
-// MultipleMethodsAutoGenIntPointWithAfterUpdateA called before DefaultUpdateAIntPoint in the default UpdateA handler
-type MultipleMethodsAutoGenIntPointWithAfterUpdateA interface {
-	AfterUpdateA(context.Context, *UpdateIntPointResponse, *gorm.DB) error
+// DocumentServiceDocumentWithAfterPurge called before DefaultPurgeDocument in the default Purge handler
+type DocumentServiceDocumentWithAfterPurge interface {
+	AfterPurge(context.Context, *DeleteDocumentResponse, *gorm.DB) error
+}
+type TicketServiceDefaultServer struct {
+	DB *gorm.DB
 }
 
-// UpdateB ...
-func (m *MultipleMethodsAutoGenDefaultServer) UpdateB(ctx context.Context, in *UpdateIntPointRequest) (*UpdateIntPointResponse, error) {
-	var err error
-	var res *IntPoint
+// Create ...
+func (m *TicketServiceDefaultServer) Create(ctx context.Context, in *CreateTicketRequest) (*CreateTicketResponse, error) {
 	db := m.DB
-	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithBeforeUpdateB); ok {
+	if custom, ok := interface{}(in).(TicketServiceTicketWithBeforeCreate); ok {
 		var err error
-		if db, err = custom.BeforeUpdateB(ctx, db); err != nil {
+		if db, err = custom.BeforeCreate(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if in.GetGerogeriGegege() == nil {
-		res, err = DefaultStrictUpdateIntPoint(ctx, in.GetPayload(), db)
-	} else {
-		res, err = DefaultPatchIntPoint(ctx, in.GetPayload(), in.GetGerogeriGegege(), db)
+	res, err := DefaultCreateTicket(ctx, in.GetPayload(), db)
+	if err != nil {
+		return nil, err
+	}
+	if err = grpc.SetHeader(ctx, metadata.Pairs("etag", strconv.Quote(fmt.Sprint(res.GetVersion())))); err != nil {
+		return nil, err
 	}
+	out := &CreateTicketResponse{Result: res}
+	err = gateway.SetCreated(ctx, "")
 	if err != nil {
 		return nil, err
 	}
-	out := &UpdateIntPointResponse{Result: res}
-	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithAfterUpdateB); ok {
+	if custom, ok := interface{}(in).(TicketServiceTicketWithAfterCreate); ok {
 		var err error
-		if err = custom.AfterUpdateB(ctx, out, db); err != nil {
+		if err = custom.AfterCreate(ctx, out, db); err != nil {
 			return nil, err
 		}
 	}
 	return out, nil
 }
 
-// MultipleMethodsAutoGenIntPointWithBeforeUpdateB called before DefaultUpdateBIntPoint in the default UpdateB handler
-type MultipleMethodsAutoGenIntPointWithBeforeUpdateB interface {
-	BeforeUpdateB(context.Context, *gorm.DB) (*gorm.DB, error)
+// TicketServiceTicketWithBeforeCreate called before DefaultCreateTicket in the default Create handler
+type TicketServiceTicketWithBeforeCreate interface {
+	BeforeCreate(context.Context, *gorm.DB) (*gorm.DB, error)
 }
 
-// MultipleMethodsAutoGenIntPointWithAfterUpdateB called before DefaultUpdateBIntPoint in the default UpdateB handler
-type MultipleMethodsAutoGenIntPointWithAfterUpdateB interface {
-	AfterUpdateB(context.Context, *UpdateIntPointResponse, *gorm.DB) error
+// TicketServiceTicketWithAfterCreate called before DefaultCreateTicket in the default Create handler
+type TicketServiceTicketWithAfterCreate interface {
+	AfterCreate(context.Context, *CreateTicketResponse, *gorm.DB) error
 }
 
-// ListA ...
-func (m *MultipleMethodsAutoGenDefaultServer) ListA(ctx context.Context, in *ListIntPointRequest) (*ListIntPointResponse, error) {
+// Read ...
+func (m *TicketServiceDefaultServer) Read(ctx context.Context, in *ReadTicketRequest) (*ReadTicketResponse, error) {
 	db := m.DB
-	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithBeforeListA); ok {
+	if custom, ok := interface{}(in).(TicketServiceTicketWithBeforeRead); ok {
 		var err error
-		if db, err = custom.BeforeListA(ctx, db); err != nil {
+		if db, err = custom.BeforeRead(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	pagedRequest := false
-	if in.GetPaging().GetLimit() >= 1 {
-		in.Paging.Limit++
-		pagedRequest = true
-	}
-	res, err := DefaultListIntPoint(ctx, db, in.Filter, in.OrderBy, in.Paging, in.Fields)
+	res, err := DefaultReadTicket(ctx, &Ticket{Id: in.GetId()}, db)
 	if err != nil {
 		return nil, err
 	}
-	var resPaging *query.PageInfo
-	if pagedRequest {
-		var offset int32
-		var size int32 = int32(len(res))
-		if size == in.GetPaging().GetLimit() {
-			size--
-			res = res[:size]
-			offset = in.GetPaging().GetOffset() + size
-		}
-		resPaging = &query.PageInfo{Offset: offset}
+	if err = grpc.SetHeader(ctx, metadata.Pairs("etag", strconv.Quote(fmt.Sprint(res.GetVersion())))); err != nil {
+		return nil, err
 	}
-	out := &ListIntPointResponse{Results: res, PageInfo: resPaging}
-	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithAfterListA); ok {
+	out := &ReadTicketResponse{Result: res}
+	if custom, ok := interface{}(in).(TicketServiceTicketWithAfterRead); ok {
 		var err error
-		if err = custom.AfterListA(ctx, out, db); err != nil {
+		if err = custom.AfterRead(ctx, out, db); err != nil {
 			return nil, err
 		}
 	}
 	return out, nil
 }
 
-// MultipleMethodsAutoGenIntPointWithBeforeListA called before DefaultListAIntPoint in the default ListA handler
-type MultipleMethodsAutoGenIntPointWithBeforeListA interface {
-	BeforeListA(context.Context, *gorm.DB) (*gorm.DB, error)
+// TicketServiceTicketWithBeforeRead called before DefaultReadTicket in the default Read handler
+type TicketServiceTicketWithBeforeRead interface {
+	BeforeRead(context.Context, *gorm.DB) (*gorm.DB, error)
 }
 
-// MultipleMethodsAutoGenIntPointWithAfterListA called before DefaultListAIntPoint in the default ListA handler
-type MultipleMethodsAutoGenIntPointWithAfterListA interface {
-	AfterListA(context.Context, *ListIntPointResponse, *gorm.DB) error
+// TicketServiceTicketWithAfterRead called before DefaultReadTicket in the default Read handler
+type TicketServiceTicketWithAfterRead interface {
+	AfterRead(context.Context, *ReadTicketResponse, *gorm.DB) error
 }
 
-// ListB ...
-func (m *MultipleMethodsAutoGenDefaultServer) ListB(ctx context.Context, in *ListIntPointRequest) (*ListIntPointResponse, error) {
+// Update ...
+func (m *TicketServiceDefaultServer) Update(ctx context.Context, in *UpdateTicketRequest) (*UpdateTicketResponse, error) {
+	var err error
+	var res *Ticket
 	db := m.DB
-	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithBeforeListB); ok {
+	if custom, ok := interface{}(in).(TicketServiceTicketWithBeforeUpdate); ok {
 		var err error
-		if db, err = custom.BeforeListB(ctx, db); err != nil {
+		if db, err = custom.BeforeUpdate(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	pagedRequest := false
-	if in.GetPaging().GetLimit() >= 1 {
-		in.Paging.Limit++
-		pagedRequest = true
+	if in.GetUpdateMask() == nil {
+		res, err = DefaultStrictUpdateTicket(ctx, in.GetPayload(), db)
+	} else {
+		res, err = DefaultPatchTicket(ctx, in.GetPayload(), in.GetUpdateMask(), db)
 	}
-	res, err := DefaultListIntPoint(ctx, db, in.Filter, in.OrderBy, in.Paging, in.Fields)
 	if err != nil {
+		var fmErr *errors.InvalidFieldMaskError
+		if errors1.As(err, &fmErr) {
+			err = status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors1.Is(err, errors.VersionConflictError) {
+			err = status.Error(codes.Aborted, err.Error())
+		}
 		return nil, err
 	}
-	var resPaging *query.PageInfo
-	if pagedRequest {
-		var offset int32
-		var size int32 = int32(len(res))
-		if size == in.GetPaging().GetLimit() {
-			size--
-			res = res[:size]
-			offset = in.GetPaging().GetOffset() + size
-		}
-		resPaging = &query.PageInfo{Offset: offset}
+	if err = grpc.SetHeader(ctx, metadata.Pairs("etag", strconv.Quote(fmt.Sprint(res.GetVersion())))); err != nil {
+		return nil, err
 	}
-	out := &ListIntPointResponse{Results: res, PageInfo: resPaging}
-	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithAfterListB); ok {
+	out := &UpdateTicketResponse{Result: res}
+	if custom, ok := interface{}(in).(TicketServiceTicketWithAfterUpdate); ok {
 		var err error
-		if err = custom.AfterListB(ctx, out, db); err != nil {
+		if err = custom.AfterUpdate(ctx, out, db); err != nil {
 			return nil, err
 		}
 	}
 	return out, nil
 }
 
-// MultipleMethodsAutoGenIntPointWithBeforeListB called before DefaultListBIntPoint in the default ListB handler
-type MultipleMethodsAutoGenIntPointWithBeforeListB interface {
-	BeforeListB(context.Context, *gorm.DB) (*gorm.DB, error)
+// TicketServiceTicketWithBeforeUpdate called before DefaultUpdateTicket in the default Update handler
+type TicketServiceTicketWithBeforeUpdate interface {
+	BeforeUpdate(context.Context, *gorm.DB) (*gorm.DB, error)
 }
 
-// MultipleMethodsAutoGenIntPointWithAfterListB called before DefaultListBIntPoint in the default ListB handler
-type MultipleMethodsAutoGenIntPointWithAfterListB interface {
-	AfterListB(context.Context, *ListIntPointResponse, *gorm.DB) error
+// TicketServiceTicketWithAfterUpdate called before DefaultUpdateTicket in the default Update handler
+type TicketServiceTicketWithAfterUpdate interface {
+	AfterUpdate(context.Context, *UpdateTicketResponse, *gorm.DB) error
 }
 
-// DeleteA ...
-func (m *MultipleMethodsAutoGenDefaultServer) DeleteA(ctx context.Context, in *DeleteIntPointRequest) (*DeleteIntPointResponse, error) {
+// UpdatePartial ...
+func (m *TicketServiceDefaultServer) UpdatePartial(ctx context.Context, in *UpdateTicketRequest) (*UpdateTicketResponse, error) {
+	var err error
+	var res *Ticket
 	db := m.DB
-	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithBeforeDeleteA); ok {
+	if custom, ok := interface{}(in).(TicketServiceTicketWithBeforeUpdatePartial); ok {
 		var err error
-		if db, err = custom.BeforeDeleteA(ctx, db); err != nil {
+		if db, err = custom.BeforeUpdatePartial(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	err := DefaultDeleteIntPoint(ctx, &IntPoint{Id: in.GetId()}, db)
+	updateMask := in.GetUpdateMask()
+	if len(updateMask.GetPaths()) == 0 {
+		updateMask = DefaultImpliedFieldMaskTicket(in.GetPayload())
+	}
+	res, err = DefaultPatchTicket(ctx, in.GetPayload(), updateMask, db)
 	if err != nil {
+		var fmErr *errors.InvalidFieldMaskError
+		if errors1.As(err, &fmErr) {
+			err = status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors1.Is(err, errors.VersionConflictError) {
+			err = status.Error(codes.Aborted, err.Error())
+		}
 		return nil, err
 	}
-	out := &DeleteIntPointResponse{}
-	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithAfterDeleteA); ok {
+	if err = grpc.SetHeader(ctx, metadata.Pairs("etag", strconv.Quote(fmt.Sprint(res.GetVersion())))); err != nil {
+		return nil, err
+	}
+	out := &UpdateTicketResponse{Result: res}
+	if custom, ok := interface{}(in).(TicketServiceTicketWithAfterUpdatePartial); ok {
 		var err error
-		if err = custom.AfterDeleteA(ctx, out, db); err != nil {
+		if err = custom.AfterUpdatePartial(ctx, out, db); err != nil {
 			return nil, err
 		}
 	}
 	return out, nil
 }
 
-// MultipleMethodsAutoGenIntPointWithBeforeDeleteA called before DefaultDeleteAIntPoint in the default DeleteA handler
-type MultipleMethodsAutoGenIntPointWithBeforeDeleteA interface {
-	BeforeDeleteA(context.Context, *gorm.DB) (*gorm.DB, error)
+// TicketServiceTicketWithBeforeUpdatePartial called before DefaultUpdatePartialTicket in the default UpdatePartial handler
+type TicketServiceTicketWithBeforeUpdatePartial interface {
+	BeforeUpdatePartial(context.Context, *gorm.DB) (*gorm.DB, error)
 }
 
-// MultipleMethodsAutoGenIntPointWithAfterDeleteA called before DefaultDeleteAIntPoint in the default DeleteA handler
-type MultipleMethodsAutoGenIntPointWithAfterDeleteA interface {
-	AfterDeleteA(context.Context, *DeleteIntPointResponse, *gorm.DB) error
+// TicketServiceTicketWithAfterUpdatePartial called before DefaultUpdatePartialTicket in the default UpdatePartial handler
+type TicketServiceTicketWithAfterUpdatePartial interface {
+	AfterUpdatePartial(context.Context, *UpdateTicketResponse, *gorm.DB) error
 }
 
-// DeleteB ...
-func (m *MultipleMethodsAutoGenDefaultServer) DeleteB(ctx context.Context, in *DeleteIntPointRequest) (*DeleteIntPointResponse, error) {
+// UpdateSet ...
+func (m *TicketServiceDefaultServer) UpdateSet(ctx context.Context, in *UpdateSetTicketRequest) (*UpdateSetTicketResponse, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+
 	db := m.DB
-	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithBeforeDeleteB); ok {
+
+	if custom, ok := interface{}(in).(TicketServiceTicketWithBeforeUpdateSet); ok {
 		var err error
-		if db, err = custom.BeforeDeleteB(ctx, db); err != nil {
+		if db, err = custom.BeforeUpdateSet(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	err := DefaultDeleteIntPoint(ctx, &IntPoint{Id: in.GetId()}, db)
+
+	res, err := DefaultPatchSetTicket(ctx, in.GetObjects(), in.GetMasks(), db)
 	if err != nil {
+		var fmErr *errors.InvalidFieldMaskError
+		if errors1.As(err, &fmErr) {
+			err = status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors1.Is(err, errors.VersionConflictError) {
+			err = status.Error(codes.Aborted, err.Error())
+		}
 		return nil, err
 	}
-	out := &DeleteIntPointResponse{}
-	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithAfterDeleteB); ok {
+
+	out := &UpdateSetTicketResponse{Results: res}
+
+	if custom, ok := interface{}(in).(TicketServiceTicketWithAfterUpdateSet); ok {
 		var err error
-		if err = custom.AfterDeleteB(ctx, out, db); err != nil {
+		if err = custom.AfterUpdateSet(ctx, out, db); err != nil {
 			return nil, err
 		}
 	}
+
 	return out, nil
 }
 
-// MultipleMethodsAutoGenIntPointWithBeforeDeleteB called before DefaultDeleteBIntPoint in the default DeleteB handler
-type MultipleMethodsAutoGenIntPointWithBeforeDeleteB interface {
-	BeforeDeleteB(context.Context, *gorm.DB) (*gorm.DB, error)
+// TicketServiceTicketWithBeforeUpdateSet called before DefaultUpdateSetTicket in the default UpdateSet handler
+type TicketServiceTicketWithBeforeUpdateSet interface {
+	BeforeUpdateSet(context.Context, *gorm.DB) (*gorm.DB, error)
 }
 
-// MultipleMethodsAutoGenIntPointWithAfterDeleteB called before DefaultDeleteBIntPoint in the default DeleteB handler
-type MultipleMethodsAutoGenIntPointWithAfterDeleteB interface {
-	AfterDeleteB(context.Context, *DeleteIntPointResponse, *gorm.DB) error
+// TicketServiceTicketWithAfterUpdateSet called before DefaultUpdateSetTicket in the default UpdateSet handler
+type TicketServiceTicketWithAfterUpdateSet interface {
+	AfterUpdateSet(context.Context, *UpdateSetTicketResponse, *gorm.DB) error
 }
 
-// DeleteSetA ...
-func (m *MultipleMethodsAutoGenDefaultServer) DeleteSetA(ctx context.Context, in *DeleteIntPointsRequest) (*DeleteIntPointResponse, error) {
+// List ...
+func (m *TicketServiceDefaultServer) List(ctx context.Context, in *ListTicketRequest) (*ListTicketResponse, error) {
 	db := m.DB
-	objs := []*IntPoint{}
-	for _, id := range in.Ids {
-		objs = append(objs, &IntPoint{Id: id})
-	}
-	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithBeforeDeleteSetA); ok {
+	if custom, ok := interface{}(in).(TicketServiceTicketWithBeforeList); ok {
 		var err error
-		if db, err = custom.BeforeDeleteSetA(ctx, db); err != nil {
+		if db, err = custom.BeforeList(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	err := DefaultDeleteIntPointSet(ctx, objs, db)
+	res, pageToken, total, err := DefaultListTicket(ctx, db, in.Filter, in.OrderBy, in.Paging)
 	if err != nil {
+		if errors1.Is(err, errors.InvalidPageTokenError) {
+			err = status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, err
 	}
-	out := &DeleteIntPointResponse{}
-	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithAfterDeleteSetA); ok {
+	var resPaging *query.PageInfo
+	if in.GetPaging().GetLimit() >= 1 {
+		resPaging = &query.PageInfo{PageToken: pageToken}
+	}
+	if resPaging == nil {
+		resPaging = &query.PageInfo{}
+	}
+	resPaging.Size = int32(total)
+	out := &ListTicketResponse{Results: res, PageInfo: resPaging}
+	if custom, ok := interface{}(in).(TicketServiceTicketWithAfterList); ok {
 		var err error
-		if err = custom.AfterDeleteSetA(ctx, out, db); err != nil {
+		if err = custom.AfterList(ctx, out, db); err != nil {
 			return nil, err
 		}
 	}
 	return out, nil
 }
 
-// MultipleMethodsAutoGenIntPointWithBeforeDeleteSetA called before DefaultDeleteSetAIntPoint in the default DeleteSetA handler
-type MultipleMethodsAutoGenIntPointWithBeforeDeleteSetA interface {
-	BeforeDeleteSetA(context.Context, *gorm.DB) (*gorm.DB, error)
+// TicketServiceTicketWithBeforeList called before DefaultListTicket in the default List handler
+type TicketServiceTicketWithBeforeList interface {
+	BeforeList(context.Context, *gorm.DB) (*gorm.DB, error)
 }
 
-// MultipleMethodsAutoGenIntPointWithAfterDeleteSetA called before DefaultDeleteSetAIntPoint in the default DeleteSetA handler
-type MultipleMethodsAutoGenIntPointWithAfterDeleteSetA interface {
-	AfterDeleteSetA(context.Context, *DeleteIntPointResponse, *gorm.DB) error
+// TicketServiceTicketWithAfterList called before DefaultListTicket in the default List handler
+type TicketServiceTicketWithAfterList interface {
+	AfterList(context.Context, *ListTicketResponse, *gorm.DB) error
 }
 
-// DeleteSetB ...
-func (m *MultipleMethodsAutoGenDefaultServer) DeleteSetB(ctx context.Context, in *DeleteIntPointsRequest) (*DeleteIntPointResponse, error) {
+// ListStream ...
+func (m *TicketServiceDefaultServer) ListStream(in *ListTicketRequest, stream TicketService_ListStreamServer) error {
+	ctx := stream.Context()
 	db := m.DB
-	objs := []*IntPoint{}
-	for _, id := range in.Ids {
-		objs = append(objs, &IntPoint{Id: id})
-	}
-	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithBeforeDeleteSetB); ok {
+	if custom, ok := interface{}(in).(TicketServiceTicketWithBeforeListStream); ok {
 		var err error
-		if db, err = custom.BeforeDeleteSetB(ctx, db); err != nil {
-			return nil, err
+		if db, err = custom.BeforeListStream(ctx, db); err != nil {
+			return err
 		}
 	}
-	err := DefaultDeleteIntPointSet(ctx, objs, db)
+	sent := 0
+	err := DefaultStreamListTicket(ctx, db, in.Filter, in.OrderBy, in.Paging, func(obj *Ticket) error {
+		sent++
+		return stream.Send(obj)
+	})
 	if err != nil {
-		return nil, err
+		if errors1.Is(err, errors.InvalidPageTokenError) {
+			err = status.Error(codes.InvalidArgument, err.Error())
+		}
+		return err
 	}
-	out := &DeleteIntPointResponse{}
-	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithAfterDeleteSetB); ok {
-		var err error
-		if err = custom.AfterDeleteSetB(ctx, out, db); err != nil {
-			return nil, err
+	if custom, ok := interface{}(in).(TicketServiceTicketWithAfterListStream); ok {
+		if err = custom.AfterListStream(ctx, sent, db); err != nil {
+			return err
 		}
 	}
-	return out, nil
+	return nil
 }
 
-// MultipleMethodsAutoGenIntPointWithBeforeDeleteSetB called before DefaultDeleteSetBIntPoint in the default DeleteSetB handler
-type MultipleMethodsAutoGenIntPointWithBeforeDeleteSetB interface {
-	BeforeDeleteSetB(context.Context, *gorm.DB) (*gorm.DB, error)
+// TicketServiceTicketWithBeforeListStream called before DefaultListStreamTicket in the default ListStream handler
+type TicketServiceTicketWithBeforeListStream interface {
+	BeforeListStream(context.Context, *gorm.DB) (*gorm.DB, error)
 }
 
-// MultipleMethodsAutoGenIntPointWithAfterDeleteSetB called before DefaultDeleteSetBIntPoint in the default DeleteSetB handler
-type MultipleMethodsAutoGenIntPointWithAfterDeleteSetB interface {
-	AfterDeleteSetB(context.Context, *DeleteIntPointResponse, *gorm.DB) error
+// TicketServiceTicketWithAfterListStream called after DefaultStreamListTicket in the default ListStream handler
+// with the count of the objects sent
+type TicketServiceTicketWithAfterListStream interface {
+	AfterListStream(context.Context, int, *gorm.DB) error
 }
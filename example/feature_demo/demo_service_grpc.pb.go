@@ -21,6 +21,7 @@ const _ = grpc.SupportPackageIsVersion7
 
 const (
 	IntPointService_Create_FullMethodName          = "/example.IntPointService/Create"
+	IntPointService_CreateSet_FullMethodName       = "/example.IntPointService/CreateSet"
 	IntPointService_Read_FullMethodName            = "/example.IntPointService/Read"
 	IntPointService_Update_FullMethodName          = "/example.IntPointService/Update"
 	IntPointService_UpdateSet_FullMethodName       = "/example.IntPointService/UpdateSet"
@@ -40,6 +41,7 @@ type IntPointServiceClient interface {
 	// so multiple objects can have CURDL handlers in the same service, provided
 	// they are given unique suffixes
 	Create(ctx context.Context, in *CreateIntPointRequest, opts ...grpc.CallOption) (*CreateIntPointResponse, error)
+	CreateSet(ctx context.Context, in *CreateSetIntPointRequest, opts ...grpc.CallOption) (*CreateSetIntPointResponse, error)
 	Read(ctx context.Context, in *ReadIntPointRequest, opts ...grpc.CallOption) (*ReadIntPointResponse, error)
 	Update(ctx context.Context, in *UpdateIntPointRequest, opts ...grpc.CallOption) (*UpdateIntPointResponse, error)
 	UpdateSet(ctx context.Context, in *UpdateSetIntPointRequest, opts ...grpc.CallOption) (*UpdateSetIntPointResponse, error)
@@ -70,6 +72,15 @@ func (c *intPointServiceClient) Create(ctx context.Context, in *CreateIntPointRe
 	return out, nil
 }
 
+func (c *intPointServiceClient) CreateSet(ctx context.Context, in *CreateSetIntPointRequest, opts ...grpc.CallOption) (*CreateSetIntPointResponse, error) {
+	out := new(CreateSetIntPointResponse)
+	err := c.cc.Invoke(ctx, IntPointService_CreateSet_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *intPointServiceClient) Read(ctx context.Context, in *ReadIntPointRequest, opts ...grpc.CallOption) (*ReadIntPointResponse, error) {
 	out := new(ReadIntPointResponse)
 	err := c.cc.Invoke(ctx, IntPointService_Read_FullMethodName, in, out, opts...)
@@ -151,6 +162,7 @@ type IntPointServiceServer interface {
 	// so multiple objects can have CURDL handlers in the same service, provided
 	// they are given unique suffixes
 	Create(context.Context, *CreateIntPointRequest) (*CreateIntPointResponse, error)
+	CreateSet(context.Context, *CreateSetIntPointRequest) (*CreateSetIntPointResponse, error)
 	Read(context.Context, *ReadIntPointRequest) (*ReadIntPointResponse, error)
 	Update(context.Context, *UpdateIntPointRequest) (*UpdateIntPointResponse, error)
 	UpdateSet(context.Context, *UpdateSetIntPointRequest) (*UpdateSetIntPointResponse, error)
@@ -172,6 +184,9 @@ type UnimplementedIntPointServiceServer struct {
 func (UnimplementedIntPointServiceServer) Create(context.Context, *CreateIntPointRequest) (*CreateIntPointResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
 }
+func (UnimplementedIntPointServiceServer) CreateSet(context.Context, *CreateSetIntPointRequest) (*CreateSetIntPointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSet not implemented")
+}
 func (UnimplementedIntPointServiceServer) Read(context.Context, *ReadIntPointRequest) (*ReadIntPointResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Read not implemented")
 }
@@ -227,6 +242,24 @@ func _IntPointService_Create_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IntPointService_CreateSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSetIntPointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IntPointServiceServer).CreateSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IntPointService_CreateSet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IntPointServiceServer).CreateSet(ctx, req.(*CreateSetIntPointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _IntPointService_Read_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ReadIntPointRequest)
 	if err := dec(in); err != nil {
@@ -382,6 +415,10 @@ var IntPointService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Create",
 			Handler:    _IntPointService_Create_Handler,
 		},
+		{
+			MethodName: "CreateSet",
+			Handler:    _IntPointService_CreateSet_Handler,
+		},
 		{
 			MethodName: "Read",
 			Handler:    _IntPointService_Read_Handler,
@@ -1496,3 +1533,1118 @@ var MultipleMethodsAutoGen_ServiceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "feature_demo/demo_service.proto",
 }
+
+const (
+	TenantEventService_Read_FullMethodName      = "/example.TenantEventService/Read"
+	TenantEventService_Update_FullMethodName    = "/example.TenantEventService/Update"
+	TenantEventService_Delete_FullMethodName    = "/example.TenantEventService/Delete"
+	TenantEventService_DeleteSet_FullMethodName = "/example.TenantEventService/DeleteSet"
+)
+
+// TenantEventServiceClient is the client API for TenantEventService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TenantEventServiceClient interface {
+	Read(ctx context.Context, in *ReadTenantEventRequest, opts ...grpc.CallOption) (*ReadTenantEventResponse, error)
+	Update(ctx context.Context, in *UpdateTenantEventRequest, opts ...grpc.CallOption) (*UpdateTenantEventResponse, error)
+	Delete(ctx context.Context, in *DeleteTenantEventRequest, opts ...grpc.CallOption) (*DeleteTenantEventResponse, error)
+	DeleteSet(ctx context.Context, in *DeleteTenantEventsRequest, opts ...grpc.CallOption) (*DeleteTenantEventResponse, error)
+}
+
+type tenantEventServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTenantEventServiceClient(cc grpc.ClientConnInterface) TenantEventServiceClient {
+	return &tenantEventServiceClient{cc}
+}
+
+func (c *tenantEventServiceClient) Read(ctx context.Context, in *ReadTenantEventRequest, opts ...grpc.CallOption) (*ReadTenantEventResponse, error) {
+	out := new(ReadTenantEventResponse)
+	err := c.cc.Invoke(ctx, TenantEventService_Read_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantEventServiceClient) Update(ctx context.Context, in *UpdateTenantEventRequest, opts ...grpc.CallOption) (*UpdateTenantEventResponse, error) {
+	out := new(UpdateTenantEventResponse)
+	err := c.cc.Invoke(ctx, TenantEventService_Update_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantEventServiceClient) Delete(ctx context.Context, in *DeleteTenantEventRequest, opts ...grpc.CallOption) (*DeleteTenantEventResponse, error) {
+	out := new(DeleteTenantEventResponse)
+	err := c.cc.Invoke(ctx, TenantEventService_Delete_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantEventServiceClient) DeleteSet(ctx context.Context, in *DeleteTenantEventsRequest, opts ...grpc.CallOption) (*DeleteTenantEventResponse, error) {
+	out := new(DeleteTenantEventResponse)
+	err := c.cc.Invoke(ctx, TenantEventService_DeleteSet_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TenantEventServiceServer is the server API for TenantEventService service.
+// All implementations must embed UnimplementedTenantEventServiceServer
+// for forward compatibility
+type TenantEventServiceServer interface {
+	Read(context.Context, *ReadTenantEventRequest) (*ReadTenantEventResponse, error)
+	Update(context.Context, *UpdateTenantEventRequest) (*UpdateTenantEventResponse, error)
+	Delete(context.Context, *DeleteTenantEventRequest) (*DeleteTenantEventResponse, error)
+	DeleteSet(context.Context, *DeleteTenantEventsRequest) (*DeleteTenantEventResponse, error)
+	mustEmbedUnimplementedTenantEventServiceServer()
+}
+
+// UnimplementedTenantEventServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedTenantEventServiceServer struct {
+}
+
+func (UnimplementedTenantEventServiceServer) Read(context.Context, *ReadTenantEventRequest) (*ReadTenantEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Read not implemented")
+}
+func (UnimplementedTenantEventServiceServer) Update(context.Context, *UpdateTenantEventRequest) (*UpdateTenantEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedTenantEventServiceServer) Delete(context.Context, *DeleteTenantEventRequest) (*DeleteTenantEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedTenantEventServiceServer) DeleteSet(context.Context, *DeleteTenantEventsRequest) (*DeleteTenantEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSet not implemented")
+}
+func (UnimplementedTenantEventServiceServer) mustEmbedUnimplementedTenantEventServiceServer() {}
+
+// UnsafeTenantEventServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TenantEventServiceServer will
+// result in compilation errors.
+type UnsafeTenantEventServiceServer interface {
+	mustEmbedUnimplementedTenantEventServiceServer()
+}
+
+func RegisterTenantEventServiceServer(s grpc.ServiceRegistrar, srv TenantEventServiceServer) {
+	s.RegisterService(&TenantEventService_ServiceDesc, srv)
+}
+
+func _TenantEventService_Read_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadTenantEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantEventServiceServer).Read(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantEventService_Read_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantEventServiceServer).Read(ctx, req.(*ReadTenantEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenantEventService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTenantEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantEventServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantEventService_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantEventServiceServer).Update(ctx, req.(*UpdateTenantEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenantEventService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTenantEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantEventServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantEventService_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantEventServiceServer).Delete(ctx, req.(*DeleteTenantEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenantEventService_DeleteSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTenantEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantEventServiceServer).DeleteSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantEventService_DeleteSet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantEventServiceServer).DeleteSet(ctx, req.(*DeleteTenantEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TenantEventService_ServiceDesc is the grpc.ServiceDesc for TenantEventService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TenantEventService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "example.TenantEventService",
+	HandlerType: (*TenantEventServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Read",
+			Handler:    _TenantEventService_Read_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _TenantEventService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _TenantEventService_Delete_Handler,
+		},
+		{
+			MethodName: "DeleteSet",
+			Handler:    _TenantEventService_DeleteSet_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "feature_demo/demo_service.proto",
+}
+
+const (
+	SettingService_Upsert_FullMethodName     = "/example.SettingService/Upsert"
+	SettingService_ReadByName_FullMethodName = "/example.SettingService/ReadByName"
+)
+
+// SettingServiceClient is the client API for SettingService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SettingServiceClient interface {
+	Upsert(ctx context.Context, in *UpsertSettingRequest, opts ...grpc.CallOption) (*UpsertSettingResponse, error)
+	ReadByName(ctx context.Context, in *ReadSettingByNameRequest, opts ...grpc.CallOption) (*ReadSettingResponse, error)
+}
+
+type settingServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSettingServiceClient(cc grpc.ClientConnInterface) SettingServiceClient {
+	return &settingServiceClient{cc}
+}
+
+func (c *settingServiceClient) Upsert(ctx context.Context, in *UpsertSettingRequest, opts ...grpc.CallOption) (*UpsertSettingResponse, error) {
+	out := new(UpsertSettingResponse)
+	err := c.cc.Invoke(ctx, SettingService_Upsert_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *settingServiceClient) ReadByName(ctx context.Context, in *ReadSettingByNameRequest, opts ...grpc.CallOption) (*ReadSettingResponse, error) {
+	out := new(ReadSettingResponse)
+	err := c.cc.Invoke(ctx, SettingService_ReadByName_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SettingServiceServer is the server API for SettingService service.
+// All implementations must embed UnimplementedSettingServiceServer
+// for forward compatibility
+type SettingServiceServer interface {
+	Upsert(context.Context, *UpsertSettingRequest) (*UpsertSettingResponse, error)
+	ReadByName(context.Context, *ReadSettingByNameRequest) (*ReadSettingResponse, error)
+	mustEmbedUnimplementedSettingServiceServer()
+}
+
+// UnimplementedSettingServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedSettingServiceServer struct {
+}
+
+func (UnimplementedSettingServiceServer) Upsert(context.Context, *UpsertSettingRequest) (*UpsertSettingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Upsert not implemented")
+}
+func (UnimplementedSettingServiceServer) ReadByName(context.Context, *ReadSettingByNameRequest) (*ReadSettingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadByName not implemented")
+}
+func (UnimplementedSettingServiceServer) mustEmbedUnimplementedSettingServiceServer() {}
+
+// UnsafeSettingServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SettingServiceServer will
+// result in compilation errors.
+type UnsafeSettingServiceServer interface {
+	mustEmbedUnimplementedSettingServiceServer()
+}
+
+func RegisterSettingServiceServer(s grpc.ServiceRegistrar, srv SettingServiceServer) {
+	s.RegisterService(&SettingService_ServiceDesc, srv)
+}
+
+func _SettingService_Upsert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertSettingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SettingServiceServer).Upsert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SettingService_Upsert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SettingServiceServer).Upsert(ctx, req.(*UpsertSettingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SettingService_ReadByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadSettingByNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SettingServiceServer).ReadByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SettingService_ReadByName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SettingServiceServer).ReadByName(ctx, req.(*ReadSettingByNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SettingService_ServiceDesc is the grpc.ServiceDesc for SettingService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SettingService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "example.SettingService",
+	HandlerType: (*SettingServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Upsert",
+			Handler:    _SettingService_Upsert_Handler,
+		},
+		{
+			MethodName: "ReadByName",
+			Handler:    _SettingService_ReadByName_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "feature_demo/demo_service.proto",
+}
+
+const (
+	BasketService_Read_FullMethodName         = "/example.BasketService/Read"
+	BasketService_List_FullMethodName         = "/example.BasketService/List"
+	BasketService_AddLabels_FullMethodName    = "/example.BasketService/AddLabels"
+	BasketService_RemoveLabels_FullMethodName = "/example.BasketService/RemoveLabels"
+)
+
+// BasketServiceClient is the client API for BasketService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type BasketServiceClient interface {
+	Read(ctx context.Context, in *ReadBasketRequest, opts ...grpc.CallOption) (*ReadBasketResponse, error)
+	List(ctx context.Context, in *ListBasketRequest, opts ...grpc.CallOption) (*ListBasketResponse, error)
+	// AddLabels adds stored labels to a basket, without updating the basket
+	AddLabels(ctx context.Context, in *BasketLabelsRequest, opts ...grpc.CallOption) (*BasketLabelsResponse, error)
+	RemoveLabels(ctx context.Context, in *BasketLabelsRequest, opts ...grpc.CallOption) (*BasketLabelsResponse, error)
+}
+
+type basketServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBasketServiceClient(cc grpc.ClientConnInterface) BasketServiceClient {
+	return &basketServiceClient{cc}
+}
+
+func (c *basketServiceClient) Read(ctx context.Context, in *ReadBasketRequest, opts ...grpc.CallOption) (*ReadBasketResponse, error) {
+	out := new(ReadBasketResponse)
+	err := c.cc.Invoke(ctx, BasketService_Read_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *basketServiceClient) List(ctx context.Context, in *ListBasketRequest, opts ...grpc.CallOption) (*ListBasketResponse, error) {
+	out := new(ListBasketResponse)
+	err := c.cc.Invoke(ctx, BasketService_List_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *basketServiceClient) AddLabels(ctx context.Context, in *BasketLabelsRequest, opts ...grpc.CallOption) (*BasketLabelsResponse, error) {
+	out := new(BasketLabelsResponse)
+	err := c.cc.Invoke(ctx, BasketService_AddLabels_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *basketServiceClient) RemoveLabels(ctx context.Context, in *BasketLabelsRequest, opts ...grpc.CallOption) (*BasketLabelsResponse, error) {
+	out := new(BasketLabelsResponse)
+	err := c.cc.Invoke(ctx, BasketService_RemoveLabels_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BasketServiceServer is the server API for BasketService service.
+// All implementations must embed UnimplementedBasketServiceServer
+// for forward compatibility
+type BasketServiceServer interface {
+	Read(context.Context, *ReadBasketRequest) (*ReadBasketResponse, error)
+	List(context.Context, *ListBasketRequest) (*ListBasketResponse, error)
+	// AddLabels adds stored labels to a basket, without updating the basket
+	AddLabels(context.Context, *BasketLabelsRequest) (*BasketLabelsResponse, error)
+	RemoveLabels(context.Context, *BasketLabelsRequest) (*BasketLabelsResponse, error)
+	mustEmbedUnimplementedBasketServiceServer()
+}
+
+// UnimplementedBasketServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedBasketServiceServer struct {
+}
+
+func (UnimplementedBasketServiceServer) Read(context.Context, *ReadBasketRequest) (*ReadBasketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Read not implemented")
+}
+func (UnimplementedBasketServiceServer) List(context.Context, *ListBasketRequest) (*ListBasketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedBasketServiceServer) AddLabels(context.Context, *BasketLabelsRequest) (*BasketLabelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddLabels not implemented")
+}
+func (UnimplementedBasketServiceServer) RemoveLabels(context.Context, *BasketLabelsRequest) (*BasketLabelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveLabels not implemented")
+}
+func (UnimplementedBasketServiceServer) mustEmbedUnimplementedBasketServiceServer() {}
+
+// UnsafeBasketServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BasketServiceServer will
+// result in compilation errors.
+type UnsafeBasketServiceServer interface {
+	mustEmbedUnimplementedBasketServiceServer()
+}
+
+func RegisterBasketServiceServer(s grpc.ServiceRegistrar, srv BasketServiceServer) {
+	s.RegisterService(&BasketService_ServiceDesc, srv)
+}
+
+func _BasketService_Read_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadBasketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BasketServiceServer).Read(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BasketService_Read_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BasketServiceServer).Read(ctx, req.(*ReadBasketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BasketService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBasketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BasketServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BasketService_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BasketServiceServer).List(ctx, req.(*ListBasketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BasketService_AddLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BasketLabelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BasketServiceServer).AddLabels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BasketService_AddLabels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BasketServiceServer).AddLabels(ctx, req.(*BasketLabelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BasketService_RemoveLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BasketLabelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BasketServiceServer).RemoveLabels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BasketService_RemoveLabels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BasketServiceServer).RemoveLabels(ctx, req.(*BasketLabelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BasketService_ServiceDesc is the grpc.ServiceDesc for BasketService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BasketService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "example.BasketService",
+	HandlerType: (*BasketServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Read",
+			Handler:    _BasketService_Read_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _BasketService_List_Handler,
+		},
+		{
+			MethodName: "AddLabels",
+			Handler:    _BasketService_AddLabels_Handler,
+		},
+		{
+			MethodName: "RemoveLabels",
+			Handler:    _BasketService_RemoveLabels_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "feature_demo/demo_service.proto",
+}
+
+const (
+	DocumentService_Read_FullMethodName     = "/example.DocumentService/Read"
+	DocumentService_List_FullMethodName     = "/example.DocumentService/List"
+	DocumentService_Delete_FullMethodName   = "/example.DocumentService/Delete"
+	DocumentService_Undelete_FullMethodName = "/example.DocumentService/Undelete"
+	DocumentService_Purge_FullMethodName    = "/example.DocumentService/Purge"
+)
+
+// DocumentServiceClient is the client API for DocumentService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DocumentServiceClient interface {
+	Read(ctx context.Context, in *ReadDocumentRequest, opts ...grpc.CallOption) (*ReadDocumentResponse, error)
+	List(ctx context.Context, in *ListDocumentRequest, opts ...grpc.CallOption) (*ListDocumentResponse, error)
+	Delete(ctx context.Context, in *DeleteDocumentRequest, opts ...grpc.CallOption) (*DeleteDocumentResponse, error)
+	// Undelete restores a soft deleted document
+	Undelete(ctx context.Context, in *UndeleteDocumentRequest, opts ...grpc.CallOption) (*UndeleteDocumentResponse, error)
+	// Purge removes a document for good
+	Purge(ctx context.Context, in *DeleteDocumentRequest, opts ...grpc.CallOption) (*DeleteDocumentResponse, error)
+}
+
+type documentServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDocumentServiceClient(cc grpc.ClientConnInterface) DocumentServiceClient {
+	return &documentServiceClient{cc}
+}
+
+func (c *documentServiceClient) Read(ctx context.Context, in *ReadDocumentRequest, opts ...grpc.CallOption) (*ReadDocumentResponse, error) {
+	out := new(ReadDocumentResponse)
+	err := c.cc.Invoke(ctx, DocumentService_Read_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentServiceClient) List(ctx context.Context, in *ListDocumentRequest, opts ...grpc.CallOption) (*ListDocumentResponse, error) {
+	out := new(ListDocumentResponse)
+	err := c.cc.Invoke(ctx, DocumentService_List_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentServiceClient) Delete(ctx context.Context, in *DeleteDocumentRequest, opts ...grpc.CallOption) (*DeleteDocumentResponse, error) {
+	out := new(DeleteDocumentResponse)
+	err := c.cc.Invoke(ctx, DocumentService_Delete_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentServiceClient) Undelete(ctx context.Context, in *UndeleteDocumentRequest, opts ...grpc.CallOption) (*UndeleteDocumentResponse, error) {
+	out := new(UndeleteDocumentResponse)
+	err := c.cc.Invoke(ctx, DocumentService_Undelete_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentServiceClient) Purge(ctx context.Context, in *DeleteDocumentRequest, opts ...grpc.CallOption) (*DeleteDocumentResponse, error) {
+	out := new(DeleteDocumentResponse)
+	err := c.cc.Invoke(ctx, DocumentService_Purge_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DocumentServiceServer is the server API for DocumentService service.
+// All implementations must embed UnimplementedDocumentServiceServer
+// for forward compatibility
+type DocumentServiceServer interface {
+	Read(context.Context, *ReadDocumentRequest) (*ReadDocumentResponse, error)
+	List(context.Context, *ListDocumentRequest) (*ListDocumentResponse, error)
+	Delete(context.Context, *DeleteDocumentRequest) (*DeleteDocumentResponse, error)
+	// Undelete restores a soft deleted document
+	Undelete(context.Context, *UndeleteDocumentRequest) (*UndeleteDocumentResponse, error)
+	// Purge removes a document for good
+	Purge(context.Context, *DeleteDocumentRequest) (*DeleteDocumentResponse, error)
+	mustEmbedUnimplementedDocumentServiceServer()
+}
+
+// UnimplementedDocumentServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedDocumentServiceServer struct {
+}
+
+func (UnimplementedDocumentServiceServer) Read(context.Context, *ReadDocumentRequest) (*ReadDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Read not implemented")
+}
+func (UnimplementedDocumentServiceServer) List(context.Context, *ListDocumentRequest) (*ListDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedDocumentServiceServer) Delete(context.Context, *DeleteDocumentRequest) (*DeleteDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedDocumentServiceServer) Undelete(context.Context, *UndeleteDocumentRequest) (*UndeleteDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Undelete not implemented")
+}
+func (UnimplementedDocumentServiceServer) Purge(context.Context, *DeleteDocumentRequest) (*DeleteDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Purge not implemented")
+}
+func (UnimplementedDocumentServiceServer) mustEmbedUnimplementedDocumentServiceServer() {}
+
+// UnsafeDocumentServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DocumentServiceServer will
+// result in compilation errors.
+type UnsafeDocumentServiceServer interface {
+	mustEmbedUnimplementedDocumentServiceServer()
+}
+
+func RegisterDocumentServiceServer(s grpc.ServiceRegistrar, srv DocumentServiceServer) {
+	s.RegisterService(&DocumentService_ServiceDesc, srv)
+}
+
+func _DocumentService_Read_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).Read(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DocumentService_Read_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).Read(ctx, req.(*ReadDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DocumentService_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).List(ctx, req.(*ListDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DocumentService_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).Delete(ctx, req.(*DeleteDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentService_Undelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndeleteDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).Undelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DocumentService_Undelete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).Undelete(ctx, req.(*UndeleteDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentService_Purge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).Purge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DocumentService_Purge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).Purge(ctx, req.(*DeleteDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DocumentService_ServiceDesc is the grpc.ServiceDesc for DocumentService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DocumentService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "example.DocumentService",
+	HandlerType: (*DocumentServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Read",
+			Handler:    _DocumentService_Read_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _DocumentService_List_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _DocumentService_Delete_Handler,
+		},
+		{
+			MethodName: "Undelete",
+			Handler:    _DocumentService_Undelete_Handler,
+		},
+		{
+			MethodName: "Purge",
+			Handler:    _DocumentService_Purge_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "feature_demo/demo_service.proto",
+}
+
+const (
+	TicketService_Create_FullMethodName        = "/example.TicketService/Create"
+	TicketService_Read_FullMethodName          = "/example.TicketService/Read"
+	TicketService_Update_FullMethodName        = "/example.TicketService/Update"
+	TicketService_UpdatePartial_FullMethodName = "/example.TicketService/UpdatePartial"
+	TicketService_UpdateSet_FullMethodName     = "/example.TicketService/UpdateSet"
+	TicketService_List_FullMethodName          = "/example.TicketService/List"
+	TicketService_ListStream_FullMethodName    = "/example.TicketService/ListStream"
+)
+
+// TicketServiceClient is the client API for TicketService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TicketServiceClient interface {
+	Create(ctx context.Context, in *CreateTicketRequest, opts ...grpc.CallOption) (*CreateTicketResponse, error)
+	Read(ctx context.Context, in *ReadTicketRequest, opts ...grpc.CallOption) (*ReadTicketResponse, error)
+	Update(ctx context.Context, in *UpdateTicketRequest, opts ...grpc.CallOption) (*UpdateTicketResponse, error)
+	UpdatePartial(ctx context.Context, in *UpdateTicketRequest, opts ...grpc.CallOption) (*UpdateTicketResponse, error)
+	UpdateSet(ctx context.Context, in *UpdateSetTicketRequest, opts ...grpc.CallOption) (*UpdateSetTicketResponse, error)
+	List(ctx context.Context, in *ListTicketRequest, opts ...grpc.CallOption) (*ListTicketResponse, error)
+	// ListStream sends the tickets one at a time as they are read from the db
+	ListStream(ctx context.Context, in *ListTicketRequest, opts ...grpc.CallOption) (TicketService_ListStreamClient, error)
+}
+
+type ticketServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTicketServiceClient(cc grpc.ClientConnInterface) TicketServiceClient {
+	return &ticketServiceClient{cc}
+}
+
+func (c *ticketServiceClient) Create(ctx context.Context, in *CreateTicketRequest, opts ...grpc.CallOption) (*CreateTicketResponse, error) {
+	out := new(CreateTicketResponse)
+	err := c.cc.Invoke(ctx, TicketService_Create_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) Read(ctx context.Context, in *ReadTicketRequest, opts ...grpc.CallOption) (*ReadTicketResponse, error) {
+	out := new(ReadTicketResponse)
+	err := c.cc.Invoke(ctx, TicketService_Read_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) Update(ctx context.Context, in *UpdateTicketRequest, opts ...grpc.CallOption) (*UpdateTicketResponse, error) {
+	out := new(UpdateTicketResponse)
+	err := c.cc.Invoke(ctx, TicketService_Update_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) UpdatePartial(ctx context.Context, in *UpdateTicketRequest, opts ...grpc.CallOption) (*UpdateTicketResponse, error) {
+	out := new(UpdateTicketResponse)
+	err := c.cc.Invoke(ctx, TicketService_UpdatePartial_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) UpdateSet(ctx context.Context, in *UpdateSetTicketRequest, opts ...grpc.CallOption) (*UpdateSetTicketResponse, error) {
+	out := new(UpdateSetTicketResponse)
+	err := c.cc.Invoke(ctx, TicketService_UpdateSet_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) List(ctx context.Context, in *ListTicketRequest, opts ...grpc.CallOption) (*ListTicketResponse, error) {
+	out := new(ListTicketResponse)
+	err := c.cc.Invoke(ctx, TicketService_List_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) ListStream(ctx context.Context, in *ListTicketRequest, opts ...grpc.CallOption) (TicketService_ListStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &TicketService_ServiceDesc.Streams[0], TicketService_ListStream_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &ticketServiceListStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TicketService_ListStreamClient interface {
+	Recv() (*Ticket, error)
+	grpc.ClientStream
+}
+
+type ticketServiceListStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *ticketServiceListStreamClient) Recv() (*Ticket, error) {
+	m := new(Ticket)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// TicketServiceServer is the server API for TicketService service.
+// All implementations must embed UnimplementedTicketServiceServer
+// for forward compatibility
+type TicketServiceServer interface {
+	Create(context.Context, *CreateTicketRequest) (*CreateTicketResponse, error)
+	Read(context.Context, *ReadTicketRequest) (*ReadTicketResponse, error)
+	Update(context.Context, *UpdateTicketRequest) (*UpdateTicketResponse, error)
+	UpdatePartial(context.Context, *UpdateTicketRequest) (*UpdateTicketResponse, error)
+	UpdateSet(context.Context, *UpdateSetTicketRequest) (*UpdateSetTicketResponse, error)
+	List(context.Context, *ListTicketRequest) (*ListTicketResponse, error)
+	// ListStream sends the tickets one at a time as they are read from the db
+	ListStream(*ListTicketRequest, TicketService_ListStreamServer) error
+	mustEmbedUnimplementedTicketServiceServer()
+}
+
+// UnimplementedTicketServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedTicketServiceServer struct {
+}
+
+func (UnimplementedTicketServiceServer) Create(context.Context, *CreateTicketRequest) (*CreateTicketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedTicketServiceServer) Read(context.Context, *ReadTicketRequest) (*ReadTicketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Read not implemented")
+}
+func (UnimplementedTicketServiceServer) Update(context.Context, *UpdateTicketRequest) (*UpdateTicketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedTicketServiceServer) UpdatePartial(context.Context, *UpdateTicketRequest) (*UpdateTicketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePartial not implemented")
+}
+func (UnimplementedTicketServiceServer) UpdateSet(context.Context, *UpdateSetTicketRequest) (*UpdateSetTicketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSet not implemented")
+}
+func (UnimplementedTicketServiceServer) List(context.Context, *ListTicketRequest) (*ListTicketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedTicketServiceServer) ListStream(*ListTicketRequest, TicketService_ListStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListStream not implemented")
+}
+func (UnimplementedTicketServiceServer) mustEmbedUnimplementedTicketServiceServer() {}
+
+// UnsafeTicketServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TicketServiceServer will
+// result in compilation errors.
+type UnsafeTicketServiceServer interface {
+	mustEmbedUnimplementedTicketServiceServer()
+}
+
+func RegisterTicketServiceServer(s grpc.ServiceRegistrar, srv TicketServiceServer) {
+	s.RegisterService(&TicketService_ServiceDesc, srv)
+}
+
+func _TicketService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTicketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).Create(ctx, req.(*CreateTicketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_Read_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadTicketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).Read(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_Read_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).Read(ctx, req.(*ReadTicketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTicketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).Update(ctx, req.(*UpdateTicketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_UpdatePartial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTicketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).UpdatePartial(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_UpdatePartial_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).UpdatePartial(ctx, req.(*UpdateTicketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_UpdateSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSetTicketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).UpdateSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_UpdateSet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).UpdateSet(ctx, req.(*UpdateSetTicketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTicketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).List(ctx, req.(*ListTicketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_ListStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListTicketRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TicketServiceServer).ListStream(m, &ticketServiceListStreamServer{stream})
+}
+
+type TicketService_ListStreamServer interface {
+	Send(*Ticket) error
+	grpc.ServerStream
+}
+
+type ticketServiceListStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *ticketServiceListStreamServer) Send(m *Ticket) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// TicketService_ServiceDesc is the grpc.ServiceDesc for TicketService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TicketService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "example.TicketService",
+	HandlerType: (*TicketServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _TicketService_Create_Handler,
+		},
+		{
+			MethodName: "Read",
+			Handler:    _TicketService_Read_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _TicketService_Update_Handler,
+		},
+		{
+			MethodName: "UpdatePartial",
+			Handler:    _TicketService_UpdatePartial_Handler,
+		},
+		{
+			MethodName: "UpdateSet",
+			Handler:    _TicketService_UpdateSet_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _TicketService_List_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListStream",
+			Handler:       _TicketService_ListStream_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "feature_demo/demo_service.proto",
+}
@@ -545,6 +545,63 @@ func (x *MultiaccountTypeWithoutID) GetSomeField() string {
 	return ""
 }
 
+// MultiaccountTypeWithName is a multi-account type identified by its name
+// instead of an "id" field, it is read and patched within the account
+type MultiaccountTypeWithName struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	SomeField string `protobuf:"bytes,2,opt,name=some_field,json=someField,proto3" json:"some_field,omitempty"`
+}
+
+func (x *MultiaccountTypeWithName) Reset() {
+	*x = MultiaccountTypeWithName{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feature_demo_demo_types_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MultiaccountTypeWithName) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiaccountTypeWithName) ProtoMessage() {}
+
+func (x *MultiaccountTypeWithName) ProtoReflect() protoreflect.Message {
+	mi := &file_feature_demo_demo_types_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiaccountTypeWithName.ProtoReflect.Descriptor instead.
+func (*MultiaccountTypeWithName) Descriptor() ([]byte, []int) {
+	return file_feature_demo_demo_types_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MultiaccountTypeWithName) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MultiaccountTypeWithName) GetSomeField() string {
+	if x != nil {
+		return x.SomeField
+	}
+	return ""
+}
+
 type APIOnlyType struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -560,7 +617,7 @@ type APIOnlyType struct {
 func (x *APIOnlyType) Reset() {
 	*x = APIOnlyType{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feature_demo_demo_types_proto_msgTypes[4]
+		mi := &file_feature_demo_demo_types_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -573,7 +630,7 @@ func (x *APIOnlyType) String() string {
 func (*APIOnlyType) ProtoMessage() {}
 
 func (x *APIOnlyType) ProtoReflect() protoreflect.Message {
-	mi := &file_feature_demo_demo_types_proto_msgTypes[4]
+	mi := &file_feature_demo_demo_types_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -586,7 +643,7 @@ func (x *APIOnlyType) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use APIOnlyType.ProtoReflect.Descriptor instead.
 func (*APIOnlyType) Descriptor() ([]byte, []int) {
-	return file_feature_demo_demo_types_proto_rawDescGZIP(), []int{4}
+	return file_feature_demo_demo_types_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *APIOnlyType) GetContents() string {
@@ -608,7 +665,7 @@ type PrimaryUUIDType struct {
 func (x *PrimaryUUIDType) Reset() {
 	*x = PrimaryUUIDType{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feature_demo_demo_types_proto_msgTypes[5]
+		mi := &file_feature_demo_demo_types_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -621,7 +678,7 @@ func (x *PrimaryUUIDType) String() string {
 func (*PrimaryUUIDType) ProtoMessage() {}
 
 func (x *PrimaryUUIDType) ProtoReflect() protoreflect.Message {
-	mi := &file_feature_demo_demo_types_proto_msgTypes[5]
+	mi := &file_feature_demo_demo_types_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -634,7 +691,7 @@ func (x *PrimaryUUIDType) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PrimaryUUIDType.ProtoReflect.Descriptor instead.
 func (*PrimaryUUIDType) Descriptor() ([]byte, []int) {
-	return file_feature_demo_demo_types_proto_rawDescGZIP(), []int{5}
+	return file_feature_demo_demo_types_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *PrimaryUUIDType) GetId() *types.UUIDValue {
@@ -663,7 +720,7 @@ type PrimaryStringType struct {
 func (x *PrimaryStringType) Reset() {
 	*x = PrimaryStringType{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feature_demo_demo_types_proto_msgTypes[6]
+		mi := &file_feature_demo_demo_types_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -676,7 +733,7 @@ func (x *PrimaryStringType) String() string {
 func (*PrimaryStringType) ProtoMessage() {}
 
 func (x *PrimaryStringType) ProtoReflect() protoreflect.Message {
-	mi := &file_feature_demo_demo_types_proto_msgTypes[6]
+	mi := &file_feature_demo_demo_types_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -689,7 +746,7 @@ func (x *PrimaryStringType) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PrimaryStringType.ProtoReflect.Descriptor instead.
 func (*PrimaryStringType) Descriptor() ([]byte, []int) {
-	return file_feature_demo_demo_types_proto_rawDescGZIP(), []int{6}
+	return file_feature_demo_demo_types_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *PrimaryStringType) GetId() string {
@@ -718,7 +775,7 @@ type TestTag struct {
 func (x *TestTag) Reset() {
 	*x = TestTag{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feature_demo_demo_types_proto_msgTypes[7]
+		mi := &file_feature_demo_demo_types_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -731,7 +788,7 @@ func (x *TestTag) String() string {
 func (*TestTag) ProtoMessage() {}
 
 func (x *TestTag) ProtoReflect() protoreflect.Message {
-	mi := &file_feature_demo_demo_types_proto_msgTypes[7]
+	mi := &file_feature_demo_demo_types_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -744,7 +801,7 @@ func (x *TestTag) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TestTag.ProtoReflect.Descriptor instead.
 func (*TestTag) Descriptor() ([]byte, []int) {
-	return file_feature_demo_demo_types_proto_rawDescGZIP(), []int{7}
+	return file_feature_demo_demo_types_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *TestTag) GetId() string {
@@ -774,7 +831,7 @@ type TestAssocHandlerDefault struct {
 func (x *TestAssocHandlerDefault) Reset() {
 	*x = TestAssocHandlerDefault{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feature_demo_demo_types_proto_msgTypes[8]
+		mi := &file_feature_demo_demo_types_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -787,7 +844,7 @@ func (x *TestAssocHandlerDefault) String() string {
 func (*TestAssocHandlerDefault) ProtoMessage() {}
 
 func (x *TestAssocHandlerDefault) ProtoReflect() protoreflect.Message {
-	mi := &file_feature_demo_demo_types_proto_msgTypes[8]
+	mi := &file_feature_demo_demo_types_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -800,7 +857,7 @@ func (x *TestAssocHandlerDefault) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TestAssocHandlerDefault.ProtoReflect.Descriptor instead.
 func (*TestAssocHandlerDefault) Descriptor() ([]byte, []int) {
-	return file_feature_demo_demo_types_proto_rawDescGZIP(), []int{8}
+	return file_feature_demo_demo_types_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *TestAssocHandlerDefault) GetId() string {
@@ -829,7 +886,7 @@ type TestAssocHandlerReplace struct {
 func (x *TestAssocHandlerReplace) Reset() {
 	*x = TestAssocHandlerReplace{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feature_demo_demo_types_proto_msgTypes[9]
+		mi := &file_feature_demo_demo_types_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -842,7 +899,7 @@ func (x *TestAssocHandlerReplace) String() string {
 func (*TestAssocHandlerReplace) ProtoMessage() {}
 
 func (x *TestAssocHandlerReplace) ProtoReflect() protoreflect.Message {
-	mi := &file_feature_demo_demo_types_proto_msgTypes[9]
+	mi := &file_feature_demo_demo_types_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -855,7 +912,7 @@ func (x *TestAssocHandlerReplace) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TestAssocHandlerReplace.ProtoReflect.Descriptor instead.
 func (*TestAssocHandlerReplace) Descriptor() ([]byte, []int) {
-	return file_feature_demo_demo_types_proto_rawDescGZIP(), []int{9}
+	return file_feature_demo_demo_types_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *TestAssocHandlerReplace) GetId() string {
@@ -884,7 +941,7 @@ type TestAssocHandlerClear struct {
 func (x *TestAssocHandlerClear) Reset() {
 	*x = TestAssocHandlerClear{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feature_demo_demo_types_proto_msgTypes[10]
+		mi := &file_feature_demo_demo_types_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -897,7 +954,7 @@ func (x *TestAssocHandlerClear) String() string {
 func (*TestAssocHandlerClear) ProtoMessage() {}
 
 func (x *TestAssocHandlerClear) ProtoReflect() protoreflect.Message {
-	mi := &file_feature_demo_demo_types_proto_msgTypes[10]
+	mi := &file_feature_demo_demo_types_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -910,7 +967,7 @@ func (x *TestAssocHandlerClear) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TestAssocHandlerClear.ProtoReflect.Descriptor instead.
 func (*TestAssocHandlerClear) Descriptor() ([]byte, []int) {
-	return file_feature_demo_demo_types_proto_rawDescGZIP(), []int{10}
+	return file_feature_demo_demo_types_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *TestAssocHandlerClear) GetId() string {
@@ -939,7 +996,7 @@ type TestAssocHandlerAppend struct {
 func (x *TestAssocHandlerAppend) Reset() {
 	*x = TestAssocHandlerAppend{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feature_demo_demo_types_proto_msgTypes[11]
+		mi := &file_feature_demo_demo_types_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -952,7 +1009,7 @@ func (x *TestAssocHandlerAppend) String() string {
 func (*TestAssocHandlerAppend) ProtoMessage() {}
 
 func (x *TestAssocHandlerAppend) ProtoReflect() protoreflect.Message {
-	mi := &file_feature_demo_demo_types_proto_msgTypes[11]
+	mi := &file_feature_demo_demo_types_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -965,7 +1022,7 @@ func (x *TestAssocHandlerAppend) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TestAssocHandlerAppend.ProtoReflect.Descriptor instead.
 func (*TestAssocHandlerAppend) Descriptor() ([]byte, []int) {
-	return file_feature_demo_demo_types_proto_rawDescGZIP(), []int{11}
+	return file_feature_demo_demo_types_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *TestAssocHandlerAppend) GetId() string {
@@ -993,7 +1050,7 @@ type TestTagAssociation struct {
 func (x *TestTagAssociation) Reset() {
 	*x = TestTagAssociation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feature_demo_demo_types_proto_msgTypes[12]
+		mi := &file_feature_demo_demo_types_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1006,7 +1063,7 @@ func (x *TestTagAssociation) String() string {
 func (*TestTagAssociation) ProtoMessage() {}
 
 func (x *TestTagAssociation) ProtoReflect() protoreflect.Message {
-	mi := &file_feature_demo_demo_types_proto_msgTypes[12]
+	mi := &file_feature_demo_demo_types_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1019,7 +1076,7 @@ func (x *TestTagAssociation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TestTagAssociation.ProtoReflect.Descriptor instead.
 func (*TestTagAssociation) Descriptor() ([]byte, []int) {
-	return file_feature_demo_demo_types_proto_rawDescGZIP(), []int{12}
+	return file_feature_demo_demo_types_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *TestTagAssociation) GetSomeField() string {
@@ -1040,7 +1097,7 @@ type PrimaryIncluded struct {
 func (x *PrimaryIncluded) Reset() {
 	*x = PrimaryIncluded{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feature_demo_demo_types_proto_msgTypes[13]
+		mi := &file_feature_demo_demo_types_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1053,7 +1110,7 @@ func (x *PrimaryIncluded) String() string {
 func (*PrimaryIncluded) ProtoMessage() {}
 
 func (x *PrimaryIncluded) ProtoReflect() protoreflect.Message {
-	mi := &file_feature_demo_demo_types_proto_msgTypes[13]
+	mi := &file_feature_demo_demo_types_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1066,7 +1123,7 @@ func (x *PrimaryIncluded) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PrimaryIncluded.ProtoReflect.Descriptor instead.
 func (*PrimaryIncluded) Descriptor() ([]byte, []int) {
-	return file_feature_demo_demo_types_proto_rawDescGZIP(), []int{13}
+	return file_feature_demo_demo_types_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *PrimaryIncluded) GetChild() *ExternalChild {
@@ -1076,6 +1133,278 @@ func (x *PrimaryIncluded) GetChild() *ExternalChild {
 	return nil
 }
 
+// TenantEventCount demonstrates a read-only type backed by a materialized
+// view, only the Read and List handlers are generated for it
+type TenantEventCount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountId string `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Events    int64  `protobuf:"varint,2,opt,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *TenantEventCount) Reset() {
+	*x = TenantEventCount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feature_demo_demo_types_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TenantEventCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantEventCount) ProtoMessage() {}
+
+func (x *TenantEventCount) ProtoReflect() protoreflect.Message {
+	mi := &file_feature_demo_demo_types_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantEventCount.ProtoReflect.Descriptor instead.
+func (*TenantEventCount) Descriptor() ([]byte, []int) {
+	return file_feature_demo_demo_types_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TenantEventCount) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *TenantEventCount) GetEvents() int64 {
+	if x != nil {
+		return x.Events
+	}
+	return 0
+}
+
+// Warehouse demonstrates associations to ormable types of the same name in
+// different proto packages, each resolves to the type of its own package
+type Warehouse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// a has-one association to example.Address
+	Address *Address `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// a belongs-to association to user.Address
+	BillingAddress *user.Address `protobuf:"bytes,3,opt,name=billing_address,json=billingAddress,proto3" json:"billing_address,omitempty"`
+	// an embedded message is stored as columns of the table, location_street,
+	// location_city and location_postal_code
+	Location *Location `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
+	// the embedded_prefix option overrides the prefix of the columns
+	ReturnLocation *Location `protobuf:"bytes,5,opt,name=return_location,json=returnLocation,proto3" json:"return_location,omitempty"`
+}
+
+func (x *Warehouse) Reset() {
+	*x = Warehouse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feature_demo_demo_types_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Warehouse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Warehouse) ProtoMessage() {}
+
+func (x *Warehouse) ProtoReflect() protoreflect.Message {
+	mi := &file_feature_demo_demo_types_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Warehouse.ProtoReflect.Descriptor instead.
+func (*Warehouse) Descriptor() ([]byte, []int) {
+	return file_feature_demo_demo_types_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Warehouse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Warehouse) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *Warehouse) GetBillingAddress() *user.Address {
+	if x != nil {
+		return x.BillingAddress
+	}
+	return nil
+}
+
+func (x *Warehouse) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *Warehouse) GetReturnLocation() *Location {
+	if x != nil {
+		return x.ReturnLocation
+	}
+	return nil
+}
+
+// Address shares its name with user.Address
+type Address struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Street string `protobuf:"bytes,2,opt,name=street,proto3" json:"street,omitempty"`
+	City   string `protobuf:"bytes,3,opt,name=city,proto3" json:"city,omitempty"`
+}
+
+func (x *Address) Reset() {
+	*x = Address{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feature_demo_demo_types_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Address) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Address) ProtoMessage() {}
+
+func (x *Address) ProtoReflect() protoreflect.Message {
+	mi := &file_feature_demo_demo_types_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Address.ProtoReflect.Descriptor instead.
+func (*Address) Descriptor() ([]byte, []int) {
+	return file_feature_demo_demo_types_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Address) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Address) GetStreet() string {
+	if x != nil {
+		return x.Street
+	}
+	return ""
+}
+
+func (x *Address) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+// Location is a value object without a table of its own, it is embedded into
+// the tables of the types using it
+type Location struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Street     string `protobuf:"bytes,1,opt,name=street,proto3" json:"street,omitempty"`
+	City       string `protobuf:"bytes,2,opt,name=city,proto3" json:"city,omitempty"`
+	PostalCode string `protobuf:"bytes,3,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
+}
+
+func (x *Location) Reset() {
+	*x = Location{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feature_demo_demo_types_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Location) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Location) ProtoMessage() {}
+
+func (x *Location) ProtoReflect() protoreflect.Message {
+	mi := &file_feature_demo_demo_types_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Location.ProtoReflect.Descriptor instead.
+func (*Location) Descriptor() ([]byte, []int) {
+	return file_feature_demo_demo_types_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Location) GetStreet() string {
+	if x != nil {
+		return x.Street
+	}
+	return ""
+}
+
+func (x *Location) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *Location) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
+}
+
 var File_feature_demo_demo_types_proto protoreflect.FileDescriptor
 
 var file_feature_demo_demo_types_proto_rawDesc = []byte{
@@ -1225,74 +1554,119 @@ var file_feature_demo_demo_types_proto_rawDesc = []byte{
 	0x75, 0x74, 0x49, 0x44, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x6f, 0x6d, 0x65, 0x5f, 0x66, 0x69, 0x65,
 	0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x6f, 0x6d, 0x65, 0x46, 0x69,
 	0x65, 0x6c, 0x64, 0x3a, 0x0a, 0xba, 0xb9, 0x19, 0x06, 0x08, 0x01, 0x20, 0x01, 0x28, 0x01, 0x22,
-	0x29, 0x0a, 0x0b, 0x41, 0x50, 0x49, 0x4f, 0x6e, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a,
-	0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x6e, 0x0a, 0x0f, 0x50, 0x72,
-	0x69, 0x6d, 0x61, 0x72, 0x79, 0x55, 0x55, 0x49, 0x44, 0x54, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x6f, 0x72, 0x6d,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x05, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x45, 0x78,
-	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x52, 0x05, 0x63, 0x68, 0x69,
-	0x6c, 0x64, 0x3a, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x08, 0x01, 0x22, 0x59, 0x0a, 0x11, 0x50, 0x72,
-	0x69, 0x6d, 0x61, 0x72, 0x79, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x61, 0x0a, 0x18, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x57, 0x69, 0x74, 0x68, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0xb9, 0x19, 0x04, 0x0a,
+	0x02, 0x28, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x6f, 0x6d,
+	0x65, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x6f, 0x6d, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x3a, 0x08, 0xba, 0xb9, 0x19, 0x04, 0x08, 0x01,
+	0x20, 0x01, 0x22, 0x29, 0x0a, 0x0b, 0x41, 0x50, 0x49, 0x4f, 0x6e, 0x6c, 0x79, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x6e, 0x0a,
+	0x0f, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x55, 0x55, 0x49, 0x44, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x25, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67,
+	0x6f, 0x72, 0x6d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x05, 0x63, 0x68, 0x69, 0x6c, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x52, 0x05,
+	0x63, 0x68, 0x69, 0x6c, 0x64, 0x3a, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x08, 0x01, 0x22, 0x59, 0x0a,
+	0x11, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x2c, 0x0a, 0x05, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x45, 0x78, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x52, 0x05, 0x63, 0x68, 0x69, 0x6c, 0x64,
+	0x3a, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x08, 0x01, 0x22, 0x6a, 0x0a, 0x07, 0x54, 0x65, 0x73, 0x74,
+	0x54, 0x61, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x47, 0x0a, 0x0c, 0x74, 0x65, 0x73, 0x74, 0x54, 0x61, 0x67, 0x41, 0x73,
+	0x73, 0x6f, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x78, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x54, 0x61, 0x67, 0x41, 0x73, 0x73, 0x6f, 0x63,
+	0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x1a, 0x00, 0x52, 0x0c,
+	0x74, 0x65, 0x73, 0x74, 0x54, 0x61, 0x67, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x3a, 0x06, 0xba, 0xb9,
+	0x19, 0x02, 0x08, 0x01, 0x22, 0x7a, 0x0a, 0x17, 0x54, 0x65, 0x73, 0x74, 0x41, 0x73, 0x73, 0x6f,
+	0x63, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12,
 	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x2c, 0x0a, 0x05, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
-	0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x52, 0x05, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x3a, 0x06, 0xba,
-	0xb9, 0x19, 0x02, 0x08, 0x01, 0x22, 0x6a, 0x0a, 0x07, 0x54, 0x65, 0x73, 0x74, 0x54, 0x61, 0x67,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x47, 0x0a, 0x0c, 0x74, 0x65, 0x73, 0x74, 0x54, 0x61, 0x67, 0x41, 0x73, 0x73, 0x6f, 0x63,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
-	0x2e, 0x54, 0x65, 0x73, 0x74, 0x54, 0x61, 0x67, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x42, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x1a, 0x00, 0x52, 0x0c, 0x74, 0x65, 0x73,
-	0x74, 0x54, 0x61, 0x67, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x3a, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x08,
-	0x01, 0x22, 0x7a, 0x0a, 0x17, 0x54, 0x65, 0x73, 0x74, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x48, 0x61,
-	0x6e, 0x64, 0x6c, 0x65, 0x72, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x47, 0x0a, 0x0c,
-	0x74, 0x65, 0x73, 0x74, 0x54, 0x61, 0x67, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x54, 0x65, 0x73,
-	0x74, 0x54, 0x61, 0x67, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42,
-	0x06, 0xba, 0xb9, 0x19, 0x02, 0x2a, 0x00, 0x52, 0x0c, 0x74, 0x65, 0x73, 0x74, 0x54, 0x61, 0x67,
-	0x41, 0x73, 0x73, 0x6f, 0x63, 0x3a, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x08, 0x01, 0x22, 0x7c, 0x0a,
-	0x17, 0x54, 0x65, 0x73, 0x74, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65,
-	0x72, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x49, 0x0a, 0x0c, 0x74, 0x65, 0x73, 0x74,
-	0x54, 0x61, 0x67, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
-	0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x54, 0x61, 0x67,
-	0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xba, 0xb9, 0x19,
-	0x04, 0x2a, 0x02, 0x50, 0x01, 0x52, 0x0c, 0x74, 0x65, 0x73, 0x74, 0x54, 0x61, 0x67, 0x41, 0x73,
-	0x73, 0x6f, 0x63, 0x3a, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x08, 0x01, 0x22, 0x7a, 0x0a, 0x15, 0x54,
-	0x65, 0x73, 0x74, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x43,
-	0x6c, 0x65, 0x61, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x47, 0x0a, 0x0c, 0x74, 0x65, 0x73, 0x74, 0x54, 0x61, 0x67, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
+	0x54, 0x65, 0x73, 0x74, 0x54, 0x61, 0x67, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x2a, 0x00, 0x52, 0x0c, 0x74, 0x65, 0x73, 0x74,
+	0x54, 0x61, 0x67, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x3a, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x08, 0x01,
+	0x22, 0x7c, 0x0a, 0x17, 0x54, 0x65, 0x73, 0x74, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x48, 0x61, 0x6e,
+	0x64, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x49, 0x0a, 0x0c, 0x74,
+	0x65, 0x73, 0x74, 0x54, 0x61, 0x67, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x54, 0x65, 0x73, 0x74,
+	0x54, 0x61, 0x67, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08,
+	0xba, 0xb9, 0x19, 0x04, 0x2a, 0x02, 0x50, 0x01, 0x52, 0x0c, 0x74, 0x65, 0x73, 0x74, 0x54, 0x61,
+	0x67, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x3a, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x08, 0x01, 0x22, 0x7a,
+	0x0a, 0x15, 0x54, 0x65, 0x73, 0x74, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x48, 0x61, 0x6e, 0x64, 0x6c,
+	0x65, 0x72, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x49, 0x0a, 0x0c, 0x74, 0x65, 0x73, 0x74, 0x54,
+	0x61, 0x67, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x54, 0x61, 0x67, 0x41,
+	0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xba, 0xb9, 0x19, 0x04,
+	0x2a, 0x02, 0x60, 0x01, 0x52, 0x0c, 0x74, 0x65, 0x73, 0x74, 0x54, 0x61, 0x67, 0x41, 0x73, 0x73,
+	0x6f, 0x63, 0x3a, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x08, 0x01, 0x22, 0x7b, 0x0a, 0x16, 0x54, 0x65,
+	0x73, 0x74, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x41, 0x70,
+	0x70, 0x65, 0x6e, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x02, 0x69, 0x64, 0x12, 0x49, 0x0a, 0x0c, 0x74, 0x65, 0x73, 0x74, 0x54, 0x61, 0x67, 0x41,
 	0x73, 0x73, 0x6f, 0x63, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x78, 0x61,
 	0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x54, 0x61, 0x67, 0x41, 0x73, 0x73, 0x6f,
-	0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xba, 0xb9, 0x19, 0x04, 0x2a, 0x02, 0x60,
+	0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xba, 0xb9, 0x19, 0x04, 0x2a, 0x02, 0x58,
 	0x01, 0x52, 0x0c, 0x74, 0x65, 0x73, 0x74, 0x54, 0x61, 0x67, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x3a,
-	0x06, 0xba, 0xb9, 0x19, 0x02, 0x08, 0x01, 0x22, 0x7b, 0x0a, 0x16, 0x54, 0x65, 0x73, 0x74, 0x41,
-	0x73, 0x73, 0x6f, 0x63, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x41, 0x70, 0x70, 0x65, 0x6e,
-	0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x49, 0x0a, 0x0c, 0x74, 0x65, 0x73, 0x74, 0x54, 0x61, 0x67, 0x41, 0x73, 0x73, 0x6f,
-	0x63, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
-	0x65, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x54, 0x61, 0x67, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xba, 0xb9, 0x19, 0x04, 0x2a, 0x02, 0x58, 0x01, 0x52, 0x0c,
-	0x74, 0x65, 0x73, 0x74, 0x54, 0x61, 0x67, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x3a, 0x06, 0xba, 0xb9,
-	0x19, 0x02, 0x08, 0x01, 0x22, 0x3b, 0x0a, 0x12, 0x54, 0x65, 0x73, 0x74, 0x54, 0x61, 0x67, 0x41,
-	0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x6f,
-	0x6d, 0x65, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x73, 0x6f, 0x6d, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x3a, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x08,
-	0x01, 0x22, 0x53, 0x0a, 0x0f, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x49, 0x6e, 0x63, 0x6c,
-	0x75, 0x64, 0x65, 0x64, 0x12, 0x2c, 0x0a, 0x05, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x45, 0x78,
-	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x52, 0x05, 0x63, 0x68, 0x69,
-	0x6c, 0x64, 0x3a, 0x12, 0xba, 0xb9, 0x19, 0x0e, 0x08, 0x01, 0x12, 0x0a, 0x0a, 0x04, 0x55, 0x55,
-	0x49, 0x44, 0x12, 0x02, 0x69, 0x64, 0x42, 0x46, 0x5a, 0x44, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x66, 0x6f, 0x62, 0x6c, 0x6f, 0x78, 0x6f, 0x70, 0x65,
-	0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x67, 0x6f, 0x72,
-	0x6d, 0x2f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2f, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72,
-	0x65, 0x5f, 0x64, 0x65, 0x6d, 0x6f, 0x3b, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x06, 0xba, 0xb9, 0x19, 0x02, 0x08, 0x01, 0x22, 0x3b, 0x0a, 0x12, 0x54, 0x65, 0x73, 0x74, 0x54,
+	0x61, 0x67, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x6f, 0x6d, 0x65, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x6f, 0x6d, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x3a, 0x06, 0xba, 0xb9,
+	0x19, 0x02, 0x08, 0x01, 0x22, 0x53, 0x0a, 0x0f, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x49,
+	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x12, 0x2c, 0x0a, 0x05, 0x63, 0x68, 0x69, 0x6c, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x52, 0x05,
+	0x63, 0x68, 0x69, 0x6c, 0x64, 0x3a, 0x12, 0xba, 0xb9, 0x19, 0x0e, 0x08, 0x01, 0x12, 0x0a, 0x0a,
+	0x04, 0x55, 0x55, 0x49, 0x44, 0x12, 0x02, 0x69, 0x64, 0x22, 0xad, 0x01, 0x0a, 0x10, 0x54, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27,
+	0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x08, 0xba, 0xb9, 0x19, 0x04, 0x0a, 0x02, 0x28, 0x01, 0x52, 0x09, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x3a,
+	0x58, 0xba, 0xb9, 0x19, 0x54, 0x08, 0x01, 0x3a, 0x50, 0x0a, 0x4c, 0x53, 0x45, 0x4c, 0x45, 0x43,
+	0x54, 0x20, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x2c, 0x20, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x28, 0x2a, 0x29, 0x20, 0x41, 0x53, 0x20, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x20, 0x46, 0x52, 0x4f, 0x4d, 0x20, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x20, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x20, 0x42, 0x59, 0x20, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x10, 0x01, 0x22, 0x98, 0x02, 0x0a, 0x09, 0x57, 0x61,
+	0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2a, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x3e, 0x0a, 0x0f, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x06, 0xba, 0xb9, 0x19,
+	0x02, 0x22, 0x00, 0x52, 0x0e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x37, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
+	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xba, 0xb9, 0x19, 0x04, 0x0a, 0x02,
+	0x60, 0x01, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4e, 0x0a, 0x0f,
+	0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
+	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x12, 0xba, 0xb9, 0x19, 0x0e, 0x0a, 0x0c,
+	0x60, 0x01, 0x6a, 0x08, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x5f, 0x52, 0x0e, 0x72, 0x65,
+	0x74, 0x75, 0x72, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x06, 0xba, 0xb9,
+	0x19, 0x02, 0x08, 0x01, 0x22, 0x4d, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x65, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x74, 0x72, 0x65, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x3a, 0x06, 0xba, 0xb9, 0x19,
+	0x02, 0x08, 0x01, 0x22, 0x57, 0x0a, 0x08, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x65, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x74, 0x72, 0x65, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x70,
+	0x6f, 0x73, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x70, 0x6f, 0x73, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x42, 0x46, 0x5a, 0x44,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x66, 0x6f, 0x62,
+	0x6c, 0x6f, 0x78, 0x6f, 0x70, 0x65, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67,
+	0x65, 0x6e, 0x2d, 0x67, 0x6f, 0x72, 0x6d, 0x2f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2f,
+	0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x64, 0x65, 0x6d, 0x6f, 0x3b, 0x65, 0x78, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1308,76 +1682,86 @@ func file_feature_demo_demo_types_proto_rawDescGZIP() []byte {
 }
 
 var file_feature_demo_demo_types_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_feature_demo_demo_types_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_feature_demo_demo_types_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_feature_demo_demo_types_proto_goTypes = []interface{}{
 	(TestTypesStatus)(0),              // 0: example.TestTypes.status
 	(*TestTypes)(nil),                 // 1: example.TestTypes
 	(*TypeWithID)(nil),                // 2: example.TypeWithID
 	(*MultiaccountTypeWithID)(nil),    // 3: example.MultiaccountTypeWithID
 	(*MultiaccountTypeWithoutID)(nil), // 4: example.MultiaccountTypeWithoutID
-	(*APIOnlyType)(nil),               // 5: example.APIOnlyType
-	(*PrimaryUUIDType)(nil),           // 6: example.PrimaryUUIDType
-	(*PrimaryStringType)(nil),         // 7: example.PrimaryStringType
-	(*TestTag)(nil),                   // 8: example.TestTag
-	(*TestAssocHandlerDefault)(nil),   // 9: example.TestAssocHandlerDefault
-	(*TestAssocHandlerReplace)(nil),   // 10: example.TestAssocHandlerReplace
-	(*TestAssocHandlerClear)(nil),     // 11: example.TestAssocHandlerClear
-	(*TestAssocHandlerAppend)(nil),    // 12: example.TestAssocHandlerAppend
-	(*TestTagAssociation)(nil),        // 13: example.TestTagAssociation
-	(*PrimaryIncluded)(nil),           // 14: example.PrimaryIncluded
-	(*wrapperspb.StringValue)(nil),    // 15: google.protobuf.StringValue
-	(*emptypb.Empty)(nil),             // 16: google.protobuf.Empty
-	(*types.UUID)(nil),                // 17: gorm.types.UUID
-	(*timestamppb.Timestamp)(nil),     // 18: google.protobuf.Timestamp
-	(*durationpb.Duration)(nil),       // 19: google.protobuf.Duration
-	(*types.JSONValue)(nil),           // 20: gorm.types.JSONValue
-	(*types.UUIDValue)(nil),           // 21: gorm.types.UUIDValue
-	(*types.TimeOnly)(nil),            // 22: gorm.types.TimeOnly
-	(*types.BigInt)(nil),              // 23: gorm.types.BigInt
-	(*IntPoint)(nil),                  // 24: example.IntPoint
-	(*user.User)(nil),                 // 25: user.User
-	(*types.InetValue)(nil),           // 26: gorm.types.InetValue
-	(*wrapperspb.FloatValue)(nil),     // 27: google.protobuf.FloatValue
-	(*wrapperspb.DoubleValue)(nil),    // 28: google.protobuf.DoubleValue
-	(*ExternalChild)(nil),             // 29: example.ExternalChild
+	(*MultiaccountTypeWithName)(nil),  // 5: example.MultiaccountTypeWithName
+	(*APIOnlyType)(nil),               // 6: example.APIOnlyType
+	(*PrimaryUUIDType)(nil),           // 7: example.PrimaryUUIDType
+	(*PrimaryStringType)(nil),         // 8: example.PrimaryStringType
+	(*TestTag)(nil),                   // 9: example.TestTag
+	(*TestAssocHandlerDefault)(nil),   // 10: example.TestAssocHandlerDefault
+	(*TestAssocHandlerReplace)(nil),   // 11: example.TestAssocHandlerReplace
+	(*TestAssocHandlerClear)(nil),     // 12: example.TestAssocHandlerClear
+	(*TestAssocHandlerAppend)(nil),    // 13: example.TestAssocHandlerAppend
+	(*TestTagAssociation)(nil),        // 14: example.TestTagAssociation
+	(*PrimaryIncluded)(nil),           // 15: example.PrimaryIncluded
+	(*TenantEventCount)(nil),          // 16: example.TenantEventCount
+	(*Warehouse)(nil),                 // 17: example.Warehouse
+	(*Address)(nil),                   // 18: example.Address
+	(*Location)(nil),                  // 19: example.Location
+	(*wrapperspb.StringValue)(nil),    // 20: google.protobuf.StringValue
+	(*emptypb.Empty)(nil),             // 21: google.protobuf.Empty
+	(*types.UUID)(nil),                // 22: gorm.types.UUID
+	(*timestamppb.Timestamp)(nil),     // 23: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),       // 24: google.protobuf.Duration
+	(*types.JSONValue)(nil),           // 25: gorm.types.JSONValue
+	(*types.UUIDValue)(nil),           // 26: gorm.types.UUIDValue
+	(*types.TimeOnly)(nil),            // 27: gorm.types.TimeOnly
+	(*types.BigInt)(nil),              // 28: gorm.types.BigInt
+	(*IntPoint)(nil),                  // 29: example.IntPoint
+	(*user.User)(nil),                 // 30: user.User
+	(*types.InetValue)(nil),           // 31: gorm.types.InetValue
+	(*wrapperspb.FloatValue)(nil),     // 32: google.protobuf.FloatValue
+	(*wrapperspb.DoubleValue)(nil),    // 33: google.protobuf.DoubleValue
+	(*ExternalChild)(nil),             // 34: example.ExternalChild
+	(*user.Address)(nil),              // 35: user.Address
 }
 var file_feature_demo_demo_types_proto_depIdxs = []int32{
-	15, // 0: example.TestTypes.optional_string:type_name -> google.protobuf.StringValue
+	20, // 0: example.TestTypes.optional_string:type_name -> google.protobuf.StringValue
 	0,  // 1: example.TestTypes.becomes_int:type_name -> example.TestTypes.status
-	16, // 2: example.TestTypes.nothingness:type_name -> google.protobuf.Empty
-	17, // 3: example.TestTypes.uuid:type_name -> gorm.types.UUID
-	18, // 4: example.TestTypes.created_at:type_name -> google.protobuf.Timestamp
-	19, // 5: example.TestTypes.duration:type_name -> google.protobuf.Duration
-	20, // 6: example.TestTypes.json_field:type_name -> gorm.types.JSONValue
-	21, // 7: example.TestTypes.nullable_uuid:type_name -> gorm.types.UUIDValue
-	22, // 8: example.TestTypes.time_only:type_name -> gorm.types.TimeOnly
-	23, // 9: example.TestTypes.bigint:type_name -> gorm.types.BigInt
-	20, // 10: example.TestTypes.several_values:type_name -> gorm.types.JSONValue
-	18, // 11: example.TestTypes.custom_deleted_at:type_name -> google.protobuf.Timestamp
+	21, // 2: example.TestTypes.nothingness:type_name -> google.protobuf.Empty
+	22, // 3: example.TestTypes.uuid:type_name -> gorm.types.UUID
+	23, // 4: example.TestTypes.created_at:type_name -> google.protobuf.Timestamp
+	24, // 5: example.TestTypes.duration:type_name -> google.protobuf.Duration
+	25, // 6: example.TestTypes.json_field:type_name -> gorm.types.JSONValue
+	26, // 7: example.TestTypes.nullable_uuid:type_name -> gorm.types.UUIDValue
+	27, // 8: example.TestTypes.time_only:type_name -> gorm.types.TimeOnly
+	28, // 9: example.TestTypes.bigint:type_name -> gorm.types.BigInt
+	25, // 10: example.TestTypes.several_values:type_name -> gorm.types.JSONValue
+	23, // 11: example.TestTypes.custom_deleted_at:type_name -> google.protobuf.Timestamp
 	1,  // 12: example.TypeWithID.things:type_name -> example.TestTypes
 	1,  // 13: example.TypeWithID.a_nested_object:type_name -> example.TestTypes
-	24, // 14: example.TypeWithID.point:type_name -> example.IntPoint
-	25, // 15: example.TypeWithID.user:type_name -> user.User
-	26, // 16: example.TypeWithID.address:type_name -> gorm.types.InetValue
-	5,  // 17: example.TypeWithID.synthetic_field:type_name -> example.APIOnlyType
-	27, // 18: example.TypeWithID.float_field:type_name -> google.protobuf.FloatValue
-	28, // 19: example.TypeWithID.double_field:type_name -> google.protobuf.DoubleValue
-	22, // 20: example.TypeWithID.time_only:type_name -> gorm.types.TimeOnly
-	18, // 21: example.TypeWithID.deleted_at:type_name -> google.protobuf.Timestamp
-	21, // 22: example.PrimaryUUIDType.id:type_name -> gorm.types.UUIDValue
-	29, // 23: example.PrimaryUUIDType.child:type_name -> example.ExternalChild
-	29, // 24: example.PrimaryStringType.child:type_name -> example.ExternalChild
-	13, // 25: example.TestTag.testTagAssoc:type_name -> example.TestTagAssociation
-	13, // 26: example.TestAssocHandlerDefault.testTagAssoc:type_name -> example.TestTagAssociation
-	13, // 27: example.TestAssocHandlerReplace.testTagAssoc:type_name -> example.TestTagAssociation
-	13, // 28: example.TestAssocHandlerClear.testTagAssoc:type_name -> example.TestTagAssociation
-	13, // 29: example.TestAssocHandlerAppend.testTagAssoc:type_name -> example.TestTagAssociation
-	29, // 30: example.PrimaryIncluded.child:type_name -> example.ExternalChild
-	31, // [31:31] is the sub-list for method output_type
-	31, // [31:31] is the sub-list for method input_type
-	31, // [31:31] is the sub-list for extension type_name
-	31, // [31:31] is the sub-list for extension extendee
-	0,  // [0:31] is the sub-list for field type_name
+	29, // 14: example.TypeWithID.point:type_name -> example.IntPoint
+	30, // 15: example.TypeWithID.user:type_name -> user.User
+	31, // 16: example.TypeWithID.address:type_name -> gorm.types.InetValue
+	6,  // 17: example.TypeWithID.synthetic_field:type_name -> example.APIOnlyType
+	32, // 18: example.TypeWithID.float_field:type_name -> google.protobuf.FloatValue
+	33, // 19: example.TypeWithID.double_field:type_name -> google.protobuf.DoubleValue
+	27, // 20: example.TypeWithID.time_only:type_name -> gorm.types.TimeOnly
+	23, // 21: example.TypeWithID.deleted_at:type_name -> google.protobuf.Timestamp
+	26, // 22: example.PrimaryUUIDType.id:type_name -> gorm.types.UUIDValue
+	34, // 23: example.PrimaryUUIDType.child:type_name -> example.ExternalChild
+	34, // 24: example.PrimaryStringType.child:type_name -> example.ExternalChild
+	14, // 25: example.TestTag.testTagAssoc:type_name -> example.TestTagAssociation
+	14, // 26: example.TestAssocHandlerDefault.testTagAssoc:type_name -> example.TestTagAssociation
+	14, // 27: example.TestAssocHandlerReplace.testTagAssoc:type_name -> example.TestTagAssociation
+	14, // 28: example.TestAssocHandlerClear.testTagAssoc:type_name -> example.TestTagAssociation
+	14, // 29: example.TestAssocHandlerAppend.testTagAssoc:type_name -> example.TestTagAssociation
+	34, // 30: example.PrimaryIncluded.child:type_name -> example.ExternalChild
+	18, // 31: example.Warehouse.address:type_name -> example.Address
+	35, // 32: example.Warehouse.billing_address:type_name -> user.Address
+	19, // 33: example.Warehouse.location:type_name -> example.Location
+	19, // 34: example.Warehouse.return_location:type_name -> example.Location
+	35, // [35:35] is the sub-list for method output_type
+	35, // [35:35] is the sub-list for method input_type
+	35, // [35:35] is the sub-list for extension type_name
+	35, // [35:35] is the sub-list for extension extendee
+	0,  // [0:35] is the sub-list for field type_name
 }
 
 func init() { file_feature_demo_demo_types_proto_init() }
@@ -1437,7 +1821,7 @@ func file_feature_demo_demo_types_proto_init() {
 			}
 		}
 		file_feature_demo_demo_types_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*APIOnlyType); i {
+			switch v := v.(*MultiaccountTypeWithName); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1449,7 +1833,7 @@ func file_feature_demo_demo_types_proto_init() {
 			}
 		}
 		file_feature_demo_demo_types_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PrimaryUUIDType); i {
+			switch v := v.(*APIOnlyType); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1461,7 +1845,7 @@ func file_feature_demo_demo_types_proto_init() {
 			}
 		}
 		file_feature_demo_demo_types_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PrimaryStringType); i {
+			switch v := v.(*PrimaryUUIDType); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1473,7 +1857,7 @@ func file_feature_demo_demo_types_proto_init() {
 			}
 		}
 		file_feature_demo_demo_types_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TestTag); i {
+			switch v := v.(*PrimaryStringType); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1485,7 +1869,7 @@ func file_feature_demo_demo_types_proto_init() {
 			}
 		}
 		file_feature_demo_demo_types_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TestAssocHandlerDefault); i {
+			switch v := v.(*TestTag); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1497,7 +1881,7 @@ func file_feature_demo_demo_types_proto_init() {
 			}
 		}
 		file_feature_demo_demo_types_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TestAssocHandlerReplace); i {
+			switch v := v.(*TestAssocHandlerDefault); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1509,7 +1893,7 @@ func file_feature_demo_demo_types_proto_init() {
 			}
 		}
 		file_feature_demo_demo_types_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TestAssocHandlerClear); i {
+			switch v := v.(*TestAssocHandlerReplace); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1521,7 +1905,7 @@ func file_feature_demo_demo_types_proto_init() {
 			}
 		}
 		file_feature_demo_demo_types_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TestAssocHandlerAppend); i {
+			switch v := v.(*TestAssocHandlerClear); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1533,7 +1917,7 @@ func file_feature_demo_demo_types_proto_init() {
 			}
 		}
 		file_feature_demo_demo_types_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TestTagAssociation); i {
+			switch v := v.(*TestAssocHandlerAppend); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1545,6 +1929,18 @@ func file_feature_demo_demo_types_proto_init() {
 			}
 		}
 		file_feature_demo_demo_types_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestTagAssociation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_feature_demo_demo_types_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PrimaryIncluded); i {
 			case 0:
 				return &v.state
@@ -1556,6 +1952,54 @@ func file_feature_demo_demo_types_proto_init() {
 				return nil
 			}
 		}
+		file_feature_demo_demo_types_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TenantEventCount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_feature_demo_demo_types_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Warehouse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_feature_demo_demo_types_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Address); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_feature_demo_demo_types_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Location); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1563,7 +2007,7 @@ func file_feature_demo_demo_types_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_feature_demo_demo_types_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   14,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -17,7 +17,9 @@ import (
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	gorm "gorm.io/gorm"
+	clause "gorm.io/gorm/clause"
 	big "math/big"
+	reflect "reflect"
 	strings "strings"
 	time "time"
 )
@@ -539,6 +541,81 @@ type MultiaccountTypeWithoutIDWithAfterToPB interface {
 	AfterToPB(context.Context, *MultiaccountTypeWithoutID) error
 }
 
+type MultiaccountTypeWithNameORM struct {
+	AccountID string
+	Name      string `gorm:"primaryKey"`
+	SomeField string
+}
+
+// TableName overrides the default tablename generated by GORM
+func (MultiaccountTypeWithNameORM) TableName() string {
+	return "multiaccount_type_with_names"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *MultiaccountTypeWithName) ToORM(ctx context.Context) (MultiaccountTypeWithNameORM, error) {
+	to := MultiaccountTypeWithNameORM{}
+	var err error
+	if prehook, ok := interface{}(m).(MultiaccountTypeWithNameWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Name = m.Name
+	to.SomeField = m.SomeField
+	accountID, err := auth.GetAccountID(ctx, nil)
+	if err != nil {
+		return to, err
+	}
+	to.AccountID = accountID
+	if posthook, ok := interface{}(m).(MultiaccountTypeWithNameWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *MultiaccountTypeWithNameORM) ToPB(ctx context.Context) (MultiaccountTypeWithName, error) {
+	to := MultiaccountTypeWithName{}
+	var err error
+	if prehook, ok := interface{}(m).(MultiaccountTypeWithNameWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Name = m.Name
+	to.SomeField = m.SomeField
+	if posthook, ok := interface{}(m).(MultiaccountTypeWithNameWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type MultiaccountTypeWithName the arg will be the target, the caller the one being converted from
+
+// MultiaccountTypeWithNameBeforeToORM called before default ToORM code
+type MultiaccountTypeWithNameWithBeforeToORM interface {
+	BeforeToORM(context.Context, *MultiaccountTypeWithNameORM) error
+}
+
+// MultiaccountTypeWithNameAfterToORM called after default ToORM code
+type MultiaccountTypeWithNameWithAfterToORM interface {
+	AfterToORM(context.Context, *MultiaccountTypeWithNameORM) error
+}
+
+// MultiaccountTypeWithNameBeforeToPB called before default ToPB code
+type MultiaccountTypeWithNameWithBeforeToPB interface {
+	BeforeToPB(context.Context, *MultiaccountTypeWithName) error
+}
+
+// MultiaccountTypeWithNameAfterToPB called after default ToPB code
+type MultiaccountTypeWithNameWithAfterToPB interface {
+	AfterToPB(context.Context, *MultiaccountTypeWithName) error
+}
+
 type PrimaryUUIDTypeORM struct {
 	Child *ExternalChildORM `gorm:"foreignKey:PrimaryUUIDTypeId;references:Id"`
 	Id    *go_uuid.UUID     `gorm:"type:uuid"`
@@ -1296,208 +1373,4622 @@ type PrimaryIncludedWithAfterToPB interface {
 	AfterToPB(context.Context, *PrimaryIncluded) error
 }
 
-// DefaultCreateTestTypes executes a basic gorm create call
-func DefaultCreateTestTypes(ctx context.Context, in *TestTypes, db *gorm.DB) (*TestTypes, error) {
-	if in == nil {
-		return nil, errors.NilArgumentError
-	}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(TestTypesORMWithBeforeCreate_); ok {
-		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
-			return nil, err
+type TenantEventCountORM struct {
+	AccountId string `gorm:"primaryKey"`
+	Events    int64
+}
+
+// TableName overrides the default tablename generated by GORM
+func (TenantEventCountORM) TableName() string {
+	return "tenant_event_counts"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *TenantEventCount) ToORM(ctx context.Context) (TenantEventCountORM, error) {
+	to := TenantEventCountORM{}
+	var err error
+	if prehook, ok := interface{}(m).(TenantEventCountWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
 		}
 	}
-	if err = db.Omit().Create(&ormObj).Error; err != nil {
-		return nil, err
+	to.AccountId = m.AccountId
+	to.Events = m.Events
+	if posthook, ok := interface{}(m).(TenantEventCountWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
 	}
-	if hook, ok := interface{}(&ormObj).(TestTypesORMWithAfterCreate_); ok {
-		if err = hook.AfterCreate_(ctx, db); err != nil {
-			return nil, err
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *TenantEventCountORM) ToPB(ctx context.Context) (TenantEventCount, error) {
+	to := TenantEventCount{}
+	var err error
+	if prehook, ok := interface{}(m).(TenantEventCountWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
 		}
 	}
-	pbResponse, err := ormObj.ToPB(ctx)
-	return &pbResponse, err
+	to.AccountId = m.AccountId
+	to.Events = m.Events
+	if posthook, ok := interface{}(m).(TenantEventCountWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
 }
 
-type TestTypesORMWithBeforeCreate_ interface {
-	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type TenantEventCount the arg will be the target, the caller the one being converted from
+
+// TenantEventCountBeforeToORM called before default ToORM code
+type TenantEventCountWithBeforeToORM interface {
+	BeforeToORM(context.Context, *TenantEventCountORM) error
 }
-type TestTypesORMWithAfterCreate_ interface {
-	AfterCreate_(context.Context, *gorm.DB) error
+
+// TenantEventCountAfterToORM called after default ToORM code
+type TenantEventCountWithAfterToORM interface {
+	AfterToORM(context.Context, *TenantEventCountORM) error
 }
 
-// DefaultApplyFieldMaskTestTypes patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskTestTypes(ctx context.Context, patchee *TestTypes, patcher *TestTypes, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*TestTypes, error) {
-	if patcher == nil {
-		return nil, nil
-	} else if patchee == nil {
-		return nil, errors.NilArgumentError
-	}
+// TenantEventCountBeforeToPB called before default ToPB code
+type TenantEventCountWithBeforeToPB interface {
+	BeforeToPB(context.Context, *TenantEventCount) error
+}
+
+// TenantEventCountAfterToPB called after default ToPB code
+type TenantEventCountWithAfterToPB interface {
+	AfterToPB(context.Context, *TenantEventCount) error
+}
+
+type WarehouseORM struct {
+	Address        *AddressORM `gorm:"foreignKey:WarehouseId;references:Id"`
+	AddressId      *int64
+	BillingAddress *user.AddressORM `gorm:"foreignKey:AddressId;references:Id"`
+	Id             uint64
+	Location       LocationORM `gorm:"embedded;embeddedPrefix:location_"`
+	ReturnLocation LocationORM `gorm:"embedded;embeddedPrefix:returns_"`
+}
+
+// TableName overrides the default tablename generated by GORM
+func (WarehouseORM) TableName() string {
+	return "warehouses"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *Warehouse) ToORM(ctx context.Context) (WarehouseORM, error) {
+	to := WarehouseORM{}
 	var err error
-	var updatedOptionalString bool
-	var updatedNothingness bool
-	var updatedCreatedAt bool
-	var updatedDuration bool
-	var updatedJsonField bool
-	var updatedCustomDeletedAt bool
-	for i, f := range updateMask.Paths {
-		if f == prefix+"ApiOnlyString" {
-			patchee.ApiOnlyString = patcher.ApiOnlyString
-			continue
-		}
-		if f == prefix+"Numbers" {
-			patchee.Numbers = patcher.Numbers
-			continue
-		}
-		if !updatedOptionalString && strings.HasPrefix(f, prefix+"OptionalString.") {
-			if patcher.OptionalString == nil {
-				patchee.OptionalString = nil
-				continue
-			}
-			if patchee.OptionalString == nil {
-				patchee.OptionalString = &wrapperspb.StringValue{}
-			}
-			childMask := &field_mask.FieldMask{}
-			for j := i; j < len(updateMask.Paths); j++ {
-				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"OptionalString."); trimPath != updateMask.Paths[j] {
-					childMask.Paths = append(childMask.Paths, trimPath)
-				}
-			}
-			if err := gorm1.MergeWithMask(patcher.OptionalString, patchee.OptionalString, childMask); err != nil {
-				return nil, nil
-			}
-		}
-		if f == prefix+"OptionalString" {
-			updatedOptionalString = true
-			patchee.OptionalString = patcher.OptionalString
-			continue
+	if prehook, ok := interface{}(m).(WarehouseWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
 		}
-		if f == prefix+"BecomesInt" {
-			patchee.BecomesInt = patcher.BecomesInt
-			continue
+	}
+	to.Id = m.Id
+	if m.Address != nil {
+		tempAddress, err := m.Address.ToORM(ctx)
+		if err != nil {
+			return to, err
 		}
-		if !updatedNothingness && strings.HasPrefix(f, prefix+"Nothingness.") {
-			if patcher.Nothingness == nil {
-				patchee.Nothingness = nil
-				continue
-			}
-			if patchee.Nothingness == nil {
-				patchee.Nothingness = &emptypb.Empty{}
-			}
-			childMask := &field_mask.FieldMask{}
-			for j := i; j < len(updateMask.Paths); j++ {
-				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"Nothingness."); trimPath != updateMask.Paths[j] {
-					childMask.Paths = append(childMask.Paths, trimPath)
-				}
-			}
-			if err := gorm1.MergeWithMask(patcher.Nothingness, patchee.Nothingness, childMask); err != nil {
-				return nil, nil
-			}
+		to.Address = &tempAddress
+	}
+	if m.BillingAddress != nil {
+		tempBillingAddress, err := m.BillingAddress.ToORM(ctx)
+		if err != nil {
+			return to, err
 		}
-		if f == prefix+"Nothingness" {
-			updatedNothingness = true
-			patchee.Nothingness = patcher.Nothingness
-			continue
+		to.BillingAddress = &tempBillingAddress
+	}
+	if m.Location != nil {
+		if to.Location, err = m.Location.ToORM(ctx); err != nil {
+			return to, err
 		}
-		if f == prefix+"Uuid" {
-			patchee.Uuid = patcher.Uuid
-			continue
+	}
+	if m.ReturnLocation != nil {
+		if to.ReturnLocation, err = m.ReturnLocation.ToORM(ctx); err != nil {
+			return to, err
 		}
-		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
-			if patcher.CreatedAt == nil {
-				patchee.CreatedAt = nil
-				continue
-			}
-			if patchee.CreatedAt == nil {
-				patchee.CreatedAt = &timestamppb.Timestamp{}
-			}
-			childMask := &field_mask.FieldMask{}
-			for j := i; j < len(updateMask.Paths); j++ {
-				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
-					childMask.Paths = append(childMask.Paths, trimPath)
-				}
-			}
-			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
-				return nil, nil
-			}
+	}
+	if posthook, ok := interface{}(m).(WarehouseWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *WarehouseORM) ToPB(ctx context.Context) (Warehouse, error) {
+	to := Warehouse{}
+	var err error
+	if prehook, ok := interface{}(m).(WarehouseWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
 		}
-		if f == prefix+"CreatedAt" {
-			updatedCreatedAt = true
-			patchee.CreatedAt = patcher.CreatedAt
-			continue
+	}
+	to.Id = m.Id
+	if m.Address != nil {
+		tempAddress, err := m.Address.ToPB(ctx)
+		if err != nil {
+			return to, err
 		}
-		if !updatedDuration && strings.HasPrefix(f, prefix+"Duration.") {
-			if patcher.Duration == nil {
-				patchee.Duration = nil
-				continue
-			}
-			if patchee.Duration == nil {
-				patchee.Duration = &durationpb.Duration{}
-			}
-			childMask := &field_mask.FieldMask{}
-			for j := i; j < len(updateMask.Paths); j++ {
-				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"Duration."); trimPath != updateMask.Paths[j] {
-					childMask.Paths = append(childMask.Paths, trimPath)
-				}
-			}
-			if err := gorm1.MergeWithMask(patcher.Duration, patchee.Duration, childMask); err != nil {
-				return nil, nil
-			}
+		to.Address = &tempAddress
+	}
+	if m.BillingAddress != nil {
+		tempBillingAddress, err := m.BillingAddress.ToPB(ctx)
+		if err != nil {
+			return to, err
 		}
-		if f == prefix+"Duration" {
-			updatedDuration = true
-			patchee.Duration = patcher.Duration
-			continue
+		to.BillingAddress = &tempBillingAddress
+	}
+	if !reflect.ValueOf(m.Location).IsZero() {
+		if to.Location, err = m.Location.ToPB(ctx); err != nil {
+			return to, err
 		}
-		if f == prefix+"TypeWithIdId" {
-			patchee.TypeWithIdId = patcher.TypeWithIdId
-			continue
+	}
+	if !reflect.ValueOf(m.ReturnLocation).IsZero() {
+		if to.ReturnLocation, err = m.ReturnLocation.ToPB(ctx); err != nil {
+			return to, err
 		}
-		if !updatedJsonField && strings.HasPrefix(f, prefix+"JsonField") {
-			patchee.JsonField = patcher.JsonField
-			updatedJsonField = true
+	}
+	if posthook, ok := interface{}(m).(WarehouseWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type Warehouse the arg will be the target, the caller the one being converted from
+
+// WarehouseBeforeToORM called before default ToORM code
+type WarehouseWithBeforeToORM interface {
+	BeforeToORM(context.Context, *WarehouseORM) error
+}
+
+// WarehouseAfterToORM called after default ToORM code
+type WarehouseWithAfterToORM interface {
+	AfterToORM(context.Context, *WarehouseORM) error
+}
+
+// WarehouseBeforeToPB called before default ToPB code
+type WarehouseWithBeforeToPB interface {
+	BeforeToPB(context.Context, *Warehouse) error
+}
+
+// WarehouseAfterToPB called after default ToPB code
+type WarehouseWithAfterToPB interface {
+	AfterToPB(context.Context, *Warehouse) error
+}
+
+type AddressORM struct {
+	City        string
+	Id          uint64
+	Street      string
+	WarehouseId *uint64
+}
+
+// TableName overrides the default tablename generated by GORM
+func (AddressORM) TableName() string {
+	return "addresses"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *Address) ToORM(ctx context.Context) (AddressORM, error) {
+	to := AddressORM{}
+	var err error
+	if prehook, ok := interface{}(m).(AddressWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Street = m.Street
+	to.City = m.City
+	if posthook, ok := interface{}(m).(AddressWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *AddressORM) ToPB(ctx context.Context) (Address, error) {
+	to := Address{}
+	var err error
+	if prehook, ok := interface{}(m).(AddressWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Street = m.Street
+	to.City = m.City
+	if posthook, ok := interface{}(m).(AddressWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type Address the arg will be the target, the caller the one being converted from
+
+// AddressBeforeToORM called before default ToORM code
+type AddressWithBeforeToORM interface {
+	BeforeToORM(context.Context, *AddressORM) error
+}
+
+// AddressAfterToORM called after default ToORM code
+type AddressWithAfterToORM interface {
+	AfterToORM(context.Context, *AddressORM) error
+}
+
+// AddressBeforeToPB called before default ToPB code
+type AddressWithBeforeToPB interface {
+	BeforeToPB(context.Context, *Address) error
+}
+
+// AddressAfterToPB called after default ToPB code
+type AddressWithAfterToPB interface {
+	AfterToPB(context.Context, *Address) error
+}
+
+type LocationORM struct {
+	City       string
+	PostalCode string
+	Street     string
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *Location) ToORM(ctx context.Context) (LocationORM, error) {
+	to := LocationORM{}
+	var err error
+	if prehook, ok := interface{}(m).(LocationWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Street = m.Street
+	to.City = m.City
+	to.PostalCode = m.PostalCode
+	if posthook, ok := interface{}(m).(LocationWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *LocationORM) ToPB(ctx context.Context) (*Location, error) {
+	to := &Location{}
+	var err error
+	if prehook, ok := interface{}(m).(LocationWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, to); err != nil {
+			return to, err
+		}
+	}
+	to.Street = m.Street
+	to.City = m.City
+	to.PostalCode = m.PostalCode
+	if posthook, ok := interface{}(m).(LocationWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type Location the arg will be the target, the caller the one being converted from
+
+// LocationBeforeToORM called before default ToORM code
+type LocationWithBeforeToORM interface {
+	BeforeToORM(context.Context, *LocationORM) error
+}
+
+// LocationAfterToORM called after default ToORM code
+type LocationWithAfterToORM interface {
+	AfterToORM(context.Context, *LocationORM) error
+}
+
+// LocationBeforeToPB called before default ToPB code
+type LocationWithBeforeToPB interface {
+	BeforeToPB(context.Context, *Location) error
+}
+
+// LocationAfterToPB called after default ToPB code
+type LocationWithAfterToPB interface {
+	AfterToPB(context.Context, *Location) error
+}
+
+// DefaultApplyFieldMaskLocation patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskLocation(ctx context.Context, patchee *Location, patcher *Location, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Location, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	if len(updateMask.GetPaths()) == 1 && updateMask.Paths[0] == prefix+"*" {
+		updateMask = &field_mask.FieldMask{Paths: []string{prefix + "Street", prefix + "City", prefix + "PostalCode"}}
+	}
+	for _, f := range updateMask.GetPaths() {
+		if strings.HasPrefix(f, prefix) && !DefaultHasFieldMaskPathLocation(strings.TrimPrefix(f, prefix)) {
+			return nil, &errors.InvalidFieldMaskError{Path: f}
+		}
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Street" {
+			patchee.Street = patcher.Street
 			continue
 		}
-		if f == prefix+"NullableUuid" {
-			patchee.NullableUuid = patcher.NullableUuid
+		if f == prefix+"City" {
+			patchee.City = patcher.City
 			continue
 		}
-		if f == prefix+"TimeOnly" {
-			patchee.TimeOnly = patcher.TimeOnly
+		if f == prefix+"PostalCode" {
+			patchee.PostalCode = patcher.PostalCode
 			continue
 		}
-		if f == prefix+"Bigint" {
-			patchee.Bigint = patcher.Bigint
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultHasFieldMaskPathLocation reports whether Location has the field of the field mask path
+func DefaultHasFieldMaskPathLocation(path string) bool {
+	parts := strings.SplitN(path, ".", 2)
+	switch parts[0] {
+	case "Street", "City", "PostalCode":
+		return len(parts) == 1
+	}
+	return false
+}
+
+// DefaultCreateTestTypes executes a basic gorm create call
+func DefaultCreateTestTypes(ctx context.Context, in *TestTypes, db *gorm.DB) (*TestTypes, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestTypesORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestTypesORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type TestTypesORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestTypesORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultCreateTestTypesSet executes gorm create calls in batches of 100 objects
+// in a transaction, either all of the objects are created or none of them. The BeforeCreate_ hook
+// of each object runs before the insert of its batch, which uses the handle the hooks of the
+// batch return
+func DefaultCreateTestTypesSet(ctx context.Context, in []*TestTypes, db *gorm.DB) ([]*TestTypes, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObjs := make([]TestTypesORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, ormObj)
+	}
+	batchSize := 100
+	err := db.Transaction(func(db *gorm.DB) error {
+		var err error
+		for start := 0; start < len(ormObjs); start += batchSize {
+			end := start + batchSize
+			if end > len(ormObjs) {
+				end = len(ormObjs)
+			}
+			batch := ormObjs[start:end]
+			// the scopes the hooks add only apply to the insert of their own batch
+			batchDB := db
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(TestTypesORMWithBeforeCreate_); ok {
+					if batchDB, err = hook.BeforeCreate_(ctx, batchDB); err != nil {
+						return err
+					}
+				}
+			}
+			if err = batchDB.Omit().CreateInBatches(&batch, batchSize).Error; err != nil {
+				return err
+			}
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(TestTypesORMWithAfterCreate_); ok {
+					if err = hook.AfterCreate_(ctx, db); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*TestTypes, 0, len(ormObjs))
+	for i := range ormObjs {
+		pbResponse, err := ormObjs[i].ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, &pbResponse)
+	}
+	return results, nil
+}
+
+// DefaultApplyFieldMaskTestTypes patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskTestTypes(ctx context.Context, patchee *TestTypes, patcher *TestTypes, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*TestTypes, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	if len(updateMask.GetPaths()) == 1 && updateMask.Paths[0] == prefix+"*" {
+		updateMask = &field_mask.FieldMask{Paths: []string{prefix + "ApiOnlyString", prefix + "Numbers", prefix + "OptionalString", prefix + "BecomesInt", prefix + "Nothingness", prefix + "Uuid", prefix + "CreatedAt", prefix + "Duration", prefix + "TypeWithIdId", prefix + "JsonField", prefix + "NullableUuid", prefix + "TimeOnly", prefix + "Bigint", prefix + "SeveralValues", prefix + "CustomDeletedAt"}}
+	}
+	for _, f := range updateMask.GetPaths() {
+		if strings.HasPrefix(f, prefix) && !DefaultHasFieldMaskPathTestTypes(strings.TrimPrefix(f, prefix)) {
+			return nil, &errors.InvalidFieldMaskError{Path: f}
+		}
+	}
+	var err error
+	var updatedOptionalString bool
+	var updatedNothingness bool
+	var updatedCreatedAt bool
+	var updatedDuration bool
+	var updatedJsonField bool
+	var updatedCustomDeletedAt bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"ApiOnlyString" {
+			patchee.ApiOnlyString = patcher.ApiOnlyString
+			continue
+		}
+		if f == prefix+"Numbers" {
+			patchee.Numbers = patcher.Numbers
+			continue
+		}
+		if !updatedOptionalString && strings.HasPrefix(f, prefix+"OptionalString.") {
+			if patcher.OptionalString == nil {
+				patchee.OptionalString = nil
+				continue
+			}
+			if patchee.OptionalString == nil {
+				patchee.OptionalString = &wrapperspb.StringValue{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"OptionalString."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.OptionalString, patchee.OptionalString, childMask); err != nil {
+				return nil, &errors.InvalidFieldMaskError{Path: f}
+			}
+		}
+		if f == prefix+"OptionalString" {
+			updatedOptionalString = true
+			patchee.OptionalString = patcher.OptionalString
 			continue
 		}
-		if f == prefix+"SeveralValues" {
-			patchee.SeveralValues = patcher.SeveralValues
+		if f == prefix+"BecomesInt" {
+			patchee.BecomesInt = patcher.BecomesInt
+			continue
+		}
+		if !updatedNothingness && strings.HasPrefix(f, prefix+"Nothingness.") {
+			if patcher.Nothingness == nil {
+				patchee.Nothingness = nil
+				continue
+			}
+			if patchee.Nothingness == nil {
+				patchee.Nothingness = &emptypb.Empty{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"Nothingness."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.Nothingness, patchee.Nothingness, childMask); err != nil {
+				return nil, &errors.InvalidFieldMaskError{Path: f}
+			}
+		}
+		if f == prefix+"Nothingness" {
+			updatedNothingness = true
+			patchee.Nothingness = patcher.Nothingness
+			continue
+		}
+		if f == prefix+"Uuid" {
+			patchee.Uuid = patcher.Uuid
+			continue
+		}
+		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
+			if patcher.CreatedAt == nil {
+				patchee.CreatedAt = nil
+				continue
+			}
+			if patchee.CreatedAt == nil {
+				patchee.CreatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
+				return nil, &errors.InvalidFieldMaskError{Path: f}
+			}
+		}
+		if f == prefix+"CreatedAt" {
+			updatedCreatedAt = true
+			patchee.CreatedAt = patcher.CreatedAt
+			continue
+		}
+		if !updatedDuration && strings.HasPrefix(f, prefix+"Duration.") {
+			if patcher.Duration == nil {
+				patchee.Duration = nil
+				continue
+			}
+			if patchee.Duration == nil {
+				patchee.Duration = &durationpb.Duration{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"Duration."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.Duration, patchee.Duration, childMask); err != nil {
+				return nil, &errors.InvalidFieldMaskError{Path: f}
+			}
+		}
+		if f == prefix+"Duration" {
+			updatedDuration = true
+			patchee.Duration = patcher.Duration
+			continue
+		}
+		if f == prefix+"TypeWithIdId" {
+			patchee.TypeWithIdId = patcher.TypeWithIdId
+			continue
+		}
+		if !updatedJsonField && strings.HasPrefix(f, prefix+"JsonField") {
+			patchee.JsonField = patcher.JsonField
+			updatedJsonField = true
+			continue
+		}
+		if f == prefix+"NullableUuid" {
+			patchee.NullableUuid = patcher.NullableUuid
+			continue
+		}
+		if f == prefix+"TimeOnly" {
+			patchee.TimeOnly = patcher.TimeOnly
+			continue
+		}
+		if f == prefix+"Bigint" {
+			patchee.Bigint = patcher.Bigint
+			continue
+		}
+		if f == prefix+"SeveralValues" {
+			patchee.SeveralValues = patcher.SeveralValues
+			continue
+		}
+		if !updatedCustomDeletedAt && strings.HasPrefix(f, prefix+"CustomDeletedAt.") {
+			if patcher.CustomDeletedAt == nil {
+				patchee.CustomDeletedAt = nil
+				continue
+			}
+			if patchee.CustomDeletedAt == nil {
+				patchee.CustomDeletedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CustomDeletedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CustomDeletedAt, patchee.CustomDeletedAt, childMask); err != nil {
+				return nil, &errors.InvalidFieldMaskError{Path: f}
+			}
+		}
+		if f == prefix+"CustomDeletedAt" {
+			updatedCustomDeletedAt = true
+			patchee.CustomDeletedAt = patcher.CustomDeletedAt
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultHasFieldMaskPathTestTypes reports whether TestTypes has the field of the field mask path
+func DefaultHasFieldMaskPathTestTypes(path string) bool {
+	parts := strings.SplitN(path, ".", 2)
+	switch parts[0] {
+	case "OptionalString":
+		if len(parts) == 1 {
+			return true
+		}
+		parts = strings.SplitN(parts[1], ".", 2)
+		switch parts[0] {
+		case "Value":
+			return len(parts) == 1
+		}
+		return false
+	case "Nothingness":
+		return len(parts) == 1
+	case "CreatedAt":
+		if len(parts) == 1 {
+			return true
+		}
+		parts = strings.SplitN(parts[1], ".", 2)
+		switch parts[0] {
+		case "Seconds", "Nanos":
+			return len(parts) == 1
+		}
+		return false
+	case "Duration":
+		if len(parts) == 1 {
+			return true
+		}
+		parts = strings.SplitN(parts[1], ".", 2)
+		switch parts[0] {
+		case "Seconds", "Nanos":
+			return len(parts) == 1
+		}
+		return false
+	case "CustomDeletedAt":
+		if len(parts) == 1 {
+			return true
+		}
+		parts = strings.SplitN(parts[1], ".", 2)
+		switch parts[0] {
+		case "Seconds", "Nanos":
+			return len(parts) == 1
+		}
+		return false
+	case "JsonField":
+		return true
+	case "ApiOnlyString", "Numbers", "BecomesInt", "Uuid", "TypeWithIdId", "NullableUuid", "TimeOnly", "Bigint", "SeveralValues":
+		return len(parts) == 1
+	}
+	return false
+}
+
+// DefaultListTestTypes executes a gorm list call
+func DefaultListTestTypes(ctx context.Context, db *gorm.DB) ([]*TestTypes, error) {
+	in := TestTypes{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestTypesORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(TestTypesORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	ormResponse := []TestTypesORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestTypesORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*TestTypes{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type TestTypesORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestTypesORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestTypesORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]TestTypesORM) error
+}
+
+// DefaultCreateTypeWithID executes a basic gorm create call
+func DefaultCreateTypeWithID(ctx context.Context, in *TypeWithID, db *gorm.DB) (*TypeWithID, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit("Emails").Preload("Emails").Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type TypeWithIDORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TypeWithIDORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultCreateTypeWithIDSet executes gorm create calls in batches of 100 objects
+// in a transaction, either all of the objects are created or none of them. The BeforeCreate_ hook
+// of each object runs before the insert of its batch, which uses the handle the hooks of the
+// batch return
+func DefaultCreateTypeWithIDSet(ctx context.Context, in []*TypeWithID, db *gorm.DB) ([]*TypeWithID, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObjs := make([]TypeWithIDORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, ormObj)
+	}
+	batchSize := 100
+	err := db.Transaction(func(db *gorm.DB) error {
+		var err error
+		for start := 0; start < len(ormObjs); start += batchSize {
+			end := start + batchSize
+			if end > len(ormObjs) {
+				end = len(ormObjs)
+			}
+			batch := ormObjs[start:end]
+			// the scopes the hooks add only apply to the insert of their own batch
+			batchDB := db
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(TypeWithIDORMWithBeforeCreate_); ok {
+					if batchDB, err = hook.BeforeCreate_(ctx, batchDB); err != nil {
+						return err
+					}
+				}
+			}
+			if err = batchDB.Omit("Emails").CreateInBatches(&batch, batchSize).Error; err != nil {
+				return err
+			}
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(TypeWithIDORMWithAfterCreate_); ok {
+					if err = hook.AfterCreate_(ctx, db); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*TypeWithID, 0, len(ormObjs))
+	for i := range ormObjs {
+		pbResponse, err := ormObjs[i].ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, &pbResponse)
+	}
+	return results, nil
+}
+
+func DefaultReadTypeWithID(ctx context.Context, in *TypeWithID, db *gorm.DB) (*TypeWithID, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := TypeWithIDORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(TypeWithIDORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type TypeWithIDORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TypeWithIDORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TypeWithIDORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteTypeWithID(ctx context.Context, in *TypeWithID, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&TypeWithIDORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type TypeWithIDORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TypeWithIDORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteTypeWithIDSet(ctx context.Context, in []*TypeWithID, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []uint32{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&TypeWithIDORM{})).(TypeWithIDORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&TypeWithIDORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&TypeWithIDORM{})).(TypeWithIDORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type TypeWithIDORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*TypeWithID, *gorm.DB) (*gorm.DB, error)
+}
+type TypeWithIDORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*TypeWithID, *gorm.DB) error
+}
+
+// DefaultStrictUpdateTypeWithID clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateTypeWithID(ctx context.Context, in *TypeWithID, db *gorm.DB) (*TypeWithID, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateTypeWithID")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var count int64
+	lockedRow := &TypeWithIDORM{}
+	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
+	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	filterANestedObject := TestTypesORM{}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	filterANestedObject.ANestedObjectTypeWithIDId = new(uint32)
+	*filterANestedObject.ANestedObjectTypeWithIDId = ormObj.Id
+	if err = db.Where(filterANestedObject).Delete(TestTypesORM{}).Error; err != nil {
+		return nil, err
+	}
+	filterThings := TestTypesORM{}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	filterThings.ThingsTypeWithIDId = new(uint32)
+	*filterThings.ThingsTypeWithIDId = ormObj.Id
+	if err = db.Where(filterThings).Delete(TestTypesORM{}).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit("Emails").Preload("Emails").Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		err = gateway.SetCreated(ctx, "")
+	}
+	return &pbResponse, err
+}
+
+type TypeWithIDORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TypeWithIDORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TypeWithIDORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultUpsertTypeWithID executes a gorm create call resolving conflicts on conflictTarget,
+// the name of a unique index or the primary key when empty, by updating the columns
+// in updateMask, all of them when updateMask is empty, and returns the stored object
+func DefaultUpsertTypeWithID(ctx context.Context, in *TypeWithID, conflictTarget string, updateMask *field_mask.FieldMask, db *gorm.DB) (*TypeWithID, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conflict := clause.OnConflict{}
+	var lookup func() map[string]interface{}
+	switch conflictTarget {
+	case "":
+		conflict.Columns = []clause.Column{{Name: "id"}}
+		lookup = func() map[string]interface{} { return map[string]interface{}{"id": ormObj.Id} }
+	default:
+		return nil, fmt.Errorf("unknown conflict target %q of TypeWithID", conflictTarget)
+	}
+	if len(updateMask.GetPaths()) == 0 {
+		conflict.UpdateAll = true
+	} else {
+		var columns []string
+		for _, path := range updateMask.GetPaths() {
+			var pathColumns []string
+			switch path {
+			case "Ip":
+				pathColumns = []string{"ip_addr"}
+			case "Address":
+				pathColumns = []string{"address"}
+			case "TagTest":
+				pathColumns = []string{"tag_test"}
+			case "TagSizeTest":
+				pathColumns = []string{"tag_size_test"}
+			case "FloatField":
+				pathColumns = []string{"float_field"}
+			case "DoubleField":
+				pathColumns = []string{"double_field"}
+			case "TimeOnly":
+				pathColumns = []string{"time_only"}
+			case "DeletedAt":
+				pathColumns = []string{"deleted_at"}
+			case "Metadata":
+				pathColumns = []string{"metadata"}
+			default:
+				return nil, &errors.InvalidFieldMaskError{Path: path}
+			}
+			for _, column := range pathColumns {
+				for _, target := range conflict.Columns {
+					if column == target.Name {
+						return nil, &errors.InvalidFieldMaskError{Path: path}
+					}
+				}
+			}
+			columns = append(columns, pathColumns...)
+		}
+		conflict.DoUpdates = clause.AssignmentColumns(columns)
+	}
+	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithBeforeUpsert); ok {
+		if db, err = hook.BeforeUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Clauses(conflict).Omit("Emails").Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if len(updateMask.GetPaths()) > 0 {
+		stored := TypeWithIDORM{}
+		if err = db.Where(lookup()).Preload("Emails").Take(&stored).Error; err != nil {
+			return nil, err
+		}
+		ormObj = stored
+	}
+	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithAfterUpsert); ok {
+		if err = hook.AfterUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type TypeWithIDORMWithBeforeUpsert interface {
+	BeforeUpsert(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TypeWithIDORMWithAfterUpsert interface {
+	AfterUpsert(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchTypeWithID executes a basic gorm update call with patch behavior
+func DefaultPatchTypeWithID(ctx context.Context, in *TypeWithID, updateMask *field_mask.FieldMask, db *gorm.DB) (*TypeWithID, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	pbObj := &TypeWithID{}
+	var err error
+	if hook, ok := interface{}(pbObj).(TypeWithIDWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if pbObj, err = DefaultReadTypeWithID(ctx, &TypeWithID{Id: in.GetId()}, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbObj).(TypeWithIDWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskTypeWithID(ctx, pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbObj).(TypeWithIDWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateTypeWithID(ctx, pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(TypeWithIDWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type TypeWithIDWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *TypeWithID, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type TypeWithIDWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *TypeWithID, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type TypeWithIDWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *TypeWithID, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type TypeWithIDWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *TypeWithID, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetTypeWithID patches the objects in a transaction: the masks are applied in memory to
+// the stored objects, read and locked with a single query, and the objects are written with an update
+// per batch of 100 objects. The objects are read and written with the handles their hooks
+// return, the objects of different handles don't share the queries. Only the associations in the
+// masks are updated, and objects sharing a primary key are rejected
+func DefaultPatchSetTypeWithID(ctx context.Context, objects []*TypeWithID, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*TypeWithID, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+	if len(objects) == 0 {
+		return []*TypeWithID{}, nil
+	}
+	var err error
+	objectKeys := make([]uint32, 0, len(objects))
+	seen := make(map[uint32]struct{}, len(objects))
+	for _, in := range objects {
+		if in == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := in.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if ormObj.Id == 0 {
+			return nil, errors.EmptyIdError
+		}
+		key := ormObj.Id
+		if _, ok := seen[key]; ok {
+			return nil, errors.DuplicateKeyError
+		}
+		seen[key] = struct{}{}
+		objectKeys = append(objectKeys, key)
+	}
+	batchSize := 100
+	results := make([]*TypeWithID, len(objects))
+	err = db.Transaction(func(db *gorm.DB) error {
+		var err error
+		// the objects sharing the handle their hooks return share the queries
+		dbs := make([]*gorm.DB, len(objects))
+		group := func() ([]*gorm.DB, map[*gorm.DB][]int) {
+			var handles []*gorm.DB
+			groups := make(map[*gorm.DB][]int)
+			for i, handle := range dbs {
+				if _, ok := groups[handle]; !ok {
+					handles = append(handles, handle)
+				}
+				groups[handle] = append(groups[handle], i)
+			}
+			return handles, groups
+		}
+		for i, in := range objects {
+			dbs[i] = db
+			if hook, ok := interface{}(&TypeWithID{}).(TypeWithIDWithBeforePatchRead); ok {
+				if dbs[i], err = hook.BeforePatchRead(ctx, in, updateMasks[i], db); err != nil {
+					return err
+				}
+			}
+		}
+		targets := make(map[uint32]TypeWithIDORM, len(objects))
+		handles, groups := group()
+		for _, handle := range handles {
+			keys := make([]uint32, 0, len(groups[handle]))
+			for _, i := range groups[handle] {
+				keys = append(keys, objectKeys[i])
+			}
+			query := handle.Session(&gorm.Session{}).Clauses(clause.Locking{Strength: "UPDATE"})
+			query = query.Where("id in (?)", keys)
+			rows := []TypeWithIDORM{}
+			if err = query.Find(&rows).Error; err != nil {
+				return err
+			}
+			for _, row := range rows {
+				targets[row.Id] = row
+			}
+		}
+		ormObjs := make([]TypeWithIDORM, len(objects))
+		prepare := func(i int) (*gorm.DB, error) {
+			in, key, updateMask, db := objects[i], objectKeys[i], updateMasks[i], dbs[i]
+			target, ok := targets[key]
+			if !ok {
+				return nil, gorm.ErrRecordNotFound
+			}
+			stored, err := target.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbObj := &stored
+			if hook, ok := interface{}(pbObj).(TypeWithIDWithBeforePatchApplyFieldMask); ok {
+				if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			if _, err := DefaultApplyFieldMaskTypeWithID(ctx, pbObj, in, updateMask, "", db); err != nil {
+				return nil, err
+			}
+			if hook, ok := interface{}(pbObj).(TypeWithIDWithBeforePatchSave); ok {
+				if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObj, err := pbObj.ToORM(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithBeforeStrictUpdateCleanup); ok {
+				if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			masked := make(map[string]bool, len(updateMask.GetPaths()))
+			for _, path := range updateMask.GetPaths() {
+				masked[strings.SplitN(path, ".", 2)[0]] = true
+			}
+			if masked["ANestedObject"] || masked["*"] {
+				filterANestedObject := TestTypesORM{}
+				if ormObj.Id == 0 {
+					return nil, errors.EmptyIdError
+				}
+				filterANestedObject.ANestedObjectTypeWithIDId = new(uint32)
+				*filterANestedObject.ANestedObjectTypeWithIDId = ormObj.Id
+				if err = db.Where(filterANestedObject).Delete(TestTypesORM{}).Error; err != nil {
+					return nil, err
+				}
+			}
+			if masked["Things"] || masked["*"] {
+				filterThings := TestTypesORM{}
+				if ormObj.Id == 0 {
+					return nil, errors.EmptyIdError
+				}
+				filterThings.ThingsTypeWithIDId = new(uint32)
+				*filterThings.ThingsTypeWithIDId = ormObj.Id
+				if err = db.Where(filterThings).Delete(TestTypesORM{}).Error; err != nil {
+					return nil, err
+				}
+			}
+			if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithBeforeStrictUpdateSave); ok {
+				if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObjs[i] = ormObj
+			return db, nil
+		}
+		for i := range objects {
+			if dbs[i], err = prepare(i); err != nil {
+				return err
+			}
+		}
+		write := func(db *gorm.DB, batch []int) error {
+			conds := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*2)
+			for _, i := range batch {
+				conds = append(conds, "(id = ?)")
+				args = append(args, objectKeys[i])
+			}
+			column := func(name string, value func(ormObj *TypeWithIDORM) interface{}) clause.Expr {
+				whens := make([]interface{}, 0, len(batch)*2)
+				for _, i := range batch {
+					whens = append(whens, objectKeys[i], value(&ormObjs[i]))
+				}
+				return gorm.Expr("CASE "+strings.Repeat("WHEN id = ? THEN ? ", len(batch))+"ELSE "+name+" END", whens...)
+			}
+			res := db.Session(&gorm.Session{}).Model(&TypeWithIDORM{}).Where(strings.Join(conds, " OR "), args...).Updates(map[string]interface{}{
+				"address":             column("address", func(ormObj *TypeWithIDORM) interface{} { return ormObj.Address }),
+				"deleted_at":          column("deleted_at", func(ormObj *TypeWithIDORM) interface{} { return ormObj.DeletedAt }),
+				"double_field":        column("double_field", func(ormObj *TypeWithIDORM) interface{} { return ormObj.DoubleField }),
+				"float_field":         column("float_field", func(ormObj *TypeWithIDORM) interface{} { return ormObj.FloatField }),
+				"int_point_id":        column("int_point_id", func(ormObj *TypeWithIDORM) interface{} { return ormObj.IntPointId }),
+				"ip_addr":             column("ip_addr", func(ormObj *TypeWithIDORM) interface{} { return ormObj.Ip }),
+				"metadata":            column("metadata", func(ormObj *TypeWithIDORM) interface{} { return ormObj.Metadata }),
+				"multi_account_types": column("multi_account_types", func(ormObj *TypeWithIDORM) interface{} { return ormObj.MultiAccountTypes }),
+				"tag_size_test":       column("tag_size_test", func(ormObj *TypeWithIDORM) interface{} { return ormObj.TagSizeTest }),
+				"tag_test":            column("tag_test", func(ormObj *TypeWithIDORM) interface{} { return ormObj.TagTest }),
+				"time_only":           column("time_only", func(ormObj *TypeWithIDORM) interface{} { return ormObj.TimeOnly }),
+				"user_id":             column("user_id", func(ormObj *TypeWithIDORM) interface{} { return ormObj.UserId }),
+			})
+			return res.Error
+		}
+		handles, groups = group()
+		for _, handle := range handles {
+			indexes := groups[handle]
+			for start := 0; start < len(indexes); start += batchSize {
+				end := start + batchSize
+				if end > len(indexes) {
+					end = len(indexes)
+				}
+				batch := indexes[start:end]
+				objs := make([]*TypeWithIDORM, 0, len(batch))
+				for _, i := range batch {
+					objs = append(objs, &ormObjs[i])
+				}
+				// the associations are saved first, the batch is then written with the
+				// foreign keys of its belongs-to associations
+				if err = handle.Session(&gorm.Session{}).Select("ANestedObject", "Point", "Things", "User").Omit("Emails").Model(&objs).Updates(map[string]interface{}{}).Error; err != nil {
+					return err
+				}
+				if err = write(handle, batch); err != nil {
+					return err
+				}
+			}
+		}
+		finish := func(i int) (*TypeWithID, error) {
+			in, updateMask, db, ormObj := objects[i], updateMasks[i], dbs[i], ormObjs[i]
+			var err error
+			if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithAfterStrictUpdateSave); ok {
+				if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			saved, err := ormObj.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbResponse := &saved
+			if hook, ok := interface{}(pbResponse).(TypeWithIDWithAfterPatchSave); ok {
+				if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			return pbResponse, nil
+		}
+		for i := range objects {
+			if results[i], err = finish(i); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// DefaultApplyFieldMaskTypeWithID patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskTypeWithID(ctx context.Context, patchee *TypeWithID, patcher *TypeWithID, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*TypeWithID, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	if len(updateMask.GetPaths()) == 1 && updateMask.Paths[0] == prefix+"*" {
+		updateMask = &field_mask.FieldMask{Paths: []string{prefix + "Id", prefix + "Ip", prefix + "Things", prefix + "ANestedObject", prefix + "Point", prefix + "User", prefix + "Address", prefix + "MultiaccountTypeIds", prefix + "SyntheticField", prefix + "TagTest", prefix + "TagSizeTest", prefix + "FloatField", prefix + "DoubleField", prefix + "TimeOnly", prefix + "DeletedAt", prefix + "Metadata"}}
+	}
+	for _, f := range updateMask.GetPaths() {
+		if strings.HasPrefix(f, prefix) && !DefaultHasFieldMaskPathTypeWithID(strings.TrimPrefix(f, prefix)) {
+			return nil, &errors.InvalidFieldMaskError{Path: f}
+		}
+	}
+	var err error
+	var updatedANestedObject bool
+	var updatedPoint bool
+	var updatedUser bool
+	var updatedSyntheticField bool
+	var updatedFloatField bool
+	var updatedDoubleField bool
+	var updatedDeletedAt bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"Ip" {
+			patchee.Ip = patcher.Ip
+			continue
+		}
+		if f == prefix+"Things" {
+			patchee.Things = patcher.Things
+			continue
+		}
+		if !updatedANestedObject && strings.HasPrefix(f, prefix+"ANestedObject.") {
+			updatedANestedObject = true
+			if patcher.ANestedObject == nil {
+				patchee.ANestedObject = nil
+				continue
+			}
+			if patchee.ANestedObject == nil {
+				patchee.ANestedObject = &TestTypes{}
+			}
+			if o, err := DefaultApplyFieldMaskTestTypes(ctx, patchee.ANestedObject, patcher.ANestedObject, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"ANestedObject.", db); err != nil {
+				return nil, err
+			} else {
+				patchee.ANestedObject = o
+			}
+			continue
+		}
+		if f == prefix+"ANestedObject" {
+			updatedANestedObject = true
+			patchee.ANestedObject = patcher.ANestedObject
+			continue
+		}
+		if !updatedPoint && strings.HasPrefix(f, prefix+"Point.") {
+			updatedPoint = true
+			if patcher.Point == nil {
+				patchee.Point = nil
+				continue
+			}
+			if patchee.Point == nil {
+				patchee.Point = &IntPoint{}
+			}
+			if o, err := DefaultApplyFieldMaskIntPoint(ctx, patchee.Point, patcher.Point, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"Point.", db); err != nil {
+				return nil, err
+			} else {
+				patchee.Point = o
+			}
+			continue
+		}
+		if f == prefix+"Point" {
+			updatedPoint = true
+			patchee.Point = patcher.Point
+			continue
+		}
+		if !updatedUser && strings.HasPrefix(f, prefix+"User.") {
+			updatedUser = true
+			if patcher.User == nil {
+				patchee.User = nil
+				continue
+			}
+			if patchee.User == nil {
+				patchee.User = &user.User{}
+			}
+			if o, err := user.DefaultApplyFieldMaskUser(ctx, patchee.User, patcher.User, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"User.", db); err != nil {
+				return nil, err
+			} else {
+				patchee.User = o
+			}
+			continue
+		}
+		if f == prefix+"User" {
+			updatedUser = true
+			patchee.User = patcher.User
+			continue
+		}
+		if f == prefix+"Address" {
+			patchee.Address = patcher.Address
+			continue
+		}
+		if f == prefix+"MultiaccountTypeIds" {
+			patchee.MultiaccountTypeIds = patcher.MultiaccountTypeIds
+			continue
+		}
+		if !updatedSyntheticField && strings.HasPrefix(f, prefix+"SyntheticField.") {
+			if patcher.SyntheticField == nil {
+				patchee.SyntheticField = nil
+				continue
+			}
+			if patchee.SyntheticField == nil {
+				patchee.SyntheticField = &APIOnlyType{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"SyntheticField."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.SyntheticField, patchee.SyntheticField, childMask); err != nil {
+				return nil, &errors.InvalidFieldMaskError{Path: f}
+			}
+		}
+		if f == prefix+"SyntheticField" {
+			updatedSyntheticField = true
+			patchee.SyntheticField = patcher.SyntheticField
+			continue
+		}
+		if f == prefix+"TagTest" {
+			patchee.TagTest = patcher.TagTest
+			continue
+		}
+		if f == prefix+"TagSizeTest" {
+			patchee.TagSizeTest = patcher.TagSizeTest
+			continue
+		}
+		if !updatedFloatField && strings.HasPrefix(f, prefix+"FloatField.") {
+			if patcher.FloatField == nil {
+				patchee.FloatField = nil
+				continue
+			}
+			if patchee.FloatField == nil {
+				patchee.FloatField = &wrapperspb.FloatValue{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"FloatField."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.FloatField, patchee.FloatField, childMask); err != nil {
+				return nil, &errors.InvalidFieldMaskError{Path: f}
+			}
+		}
+		if f == prefix+"FloatField" {
+			updatedFloatField = true
+			patchee.FloatField = patcher.FloatField
+			continue
+		}
+		if !updatedDoubleField && strings.HasPrefix(f, prefix+"DoubleField.") {
+			if patcher.DoubleField == nil {
+				patchee.DoubleField = nil
+				continue
+			}
+			if patchee.DoubleField == nil {
+				patchee.DoubleField = &wrapperspb.DoubleValue{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"DoubleField."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.DoubleField, patchee.DoubleField, childMask); err != nil {
+				return nil, &errors.InvalidFieldMaskError{Path: f}
+			}
+		}
+		if f == prefix+"DoubleField" {
+			updatedDoubleField = true
+			patchee.DoubleField = patcher.DoubleField
+			continue
+		}
+		if f == prefix+"TimeOnly" {
+			patchee.TimeOnly = patcher.TimeOnly
+			continue
+		}
+		if !updatedDeletedAt && strings.HasPrefix(f, prefix+"DeletedAt.") {
+			if patcher.DeletedAt == nil {
+				patchee.DeletedAt = nil
+				continue
+			}
+			if patchee.DeletedAt == nil {
+				patchee.DeletedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"DeletedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.DeletedAt, patchee.DeletedAt, childMask); err != nil {
+				return nil, &errors.InvalidFieldMaskError{Path: f}
+			}
+		}
+		if f == prefix+"DeletedAt" {
+			updatedDeletedAt = true
+			patchee.DeletedAt = patcher.DeletedAt
+			continue
+		}
+		if f == prefix+"Metadata" {
+			patchee.Metadata = patcher.Metadata
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultHasFieldMaskPathTypeWithID reports whether TypeWithID has the field of the field mask path
+func DefaultHasFieldMaskPathTypeWithID(path string) bool {
+	parts := strings.SplitN(path, ".", 2)
+	switch parts[0] {
+	case "ANestedObject":
+		return len(parts) == 1 || DefaultHasFieldMaskPathTestTypes(parts[1])
+	case "Point":
+		return len(parts) == 1 || DefaultHasFieldMaskPathIntPoint(parts[1])
+	case "User":
+		return len(parts) == 1 || user.DefaultHasFieldMaskPathUser(parts[1])
+	case "SyntheticField":
+		if len(parts) == 1 {
+			return true
+		}
+		parts = strings.SplitN(parts[1], ".", 2)
+		switch parts[0] {
+		case "Contents":
+			return len(parts) == 1
+		}
+		return false
+	case "FloatField":
+		if len(parts) == 1 {
+			return true
+		}
+		parts = strings.SplitN(parts[1], ".", 2)
+		switch parts[0] {
+		case "Value":
+			return len(parts) == 1
+		}
+		return false
+	case "DoubleField":
+		if len(parts) == 1 {
+			return true
+		}
+		parts = strings.SplitN(parts[1], ".", 2)
+		switch parts[0] {
+		case "Value":
+			return len(parts) == 1
+		}
+		return false
+	case "DeletedAt":
+		if len(parts) == 1 {
+			return true
+		}
+		parts = strings.SplitN(parts[1], ".", 2)
+		switch parts[0] {
+		case "Seconds", "Nanos":
+			return len(parts) == 1
+		}
+		return false
+	case "Id", "Ip", "Things", "Address", "MultiaccountTypeIds", "TagTest", "TagSizeTest", "TimeOnly", "Metadata":
+		return len(parts) == 1
+	}
+	return false
+}
+
+// DefaultListTypeWithID executes a gorm list call
+func DefaultListTypeWithID(ctx context.Context, db *gorm.DB) ([]*TypeWithID, error) {
+	in := TypeWithID{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []TypeWithIDORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*TypeWithID{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type TypeWithIDORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TypeWithIDORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TypeWithIDORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]TypeWithIDORM) error
+}
+
+// DefaultCreateMultiaccountTypeWithID executes a basic gorm create call
+func DefaultCreateMultiaccountTypeWithID(ctx context.Context, in *MultiaccountTypeWithID, db *gorm.DB) (*MultiaccountTypeWithID, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type MultiaccountTypeWithIDORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MultiaccountTypeWithIDORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultCreateMultiaccountTypeWithIDSet executes gorm create calls in batches of 100 objects
+// in a transaction, either all of the objects are created or none of them. The BeforeCreate_ hook
+// of each object runs before the insert of its batch, which uses the handle the hooks of the
+// batch return
+func DefaultCreateMultiaccountTypeWithIDSet(ctx context.Context, in []*MultiaccountTypeWithID, db *gorm.DB) ([]*MultiaccountTypeWithID, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObjs := make([]MultiaccountTypeWithIDORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, ormObj)
+	}
+	batchSize := 100
+	err := db.Transaction(func(db *gorm.DB) error {
+		var err error
+		for start := 0; start < len(ormObjs); start += batchSize {
+			end := start + batchSize
+			if end > len(ormObjs) {
+				end = len(ormObjs)
+			}
+			batch := ormObjs[start:end]
+			// the scopes the hooks add only apply to the insert of their own batch
+			batchDB := db
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(MultiaccountTypeWithIDORMWithBeforeCreate_); ok {
+					if batchDB, err = hook.BeforeCreate_(ctx, batchDB); err != nil {
+						return err
+					}
+				}
+			}
+			if err = batchDB.Omit().CreateInBatches(&batch, batchSize).Error; err != nil {
+				return err
+			}
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(MultiaccountTypeWithIDORMWithAfterCreate_); ok {
+					if err = hook.AfterCreate_(ctx, db); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*MultiaccountTypeWithID, 0, len(ormObjs))
+	for i := range ormObjs {
+		pbResponse, err := ormObjs[i].ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, &pbResponse)
+	}
+	return results, nil
+}
+
+func DefaultReadMultiaccountTypeWithID(ctx context.Context, in *MultiaccountTypeWithID, db *gorm.DB) (*MultiaccountTypeWithID, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := MultiaccountTypeWithIDORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(MultiaccountTypeWithIDORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type MultiaccountTypeWithIDORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MultiaccountTypeWithIDORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MultiaccountTypeWithIDORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteMultiaccountTypeWithID(ctx context.Context, in *MultiaccountTypeWithID, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&MultiaccountTypeWithIDORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type MultiaccountTypeWithIDORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MultiaccountTypeWithIDORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteMultiaccountTypeWithIDSet(ctx context.Context, in []*MultiaccountTypeWithID, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []uint64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&MultiaccountTypeWithIDORM{})).(MultiaccountTypeWithIDORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	accountId, err := auth.GetAccountID(ctx, nil)
+	if err != nil {
+		return err
+	}
+	compartmentId, err := auth.GetCompartmentID(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if compartmentId != "" {
+		err = db.Where("account_id = ? AND compartment_id like ?% AND id in (?)", accountId, compartmentId, keys).Delete(&MultiaccountTypeWithIDORM{}).Error
+		if err != nil {
+			return err
+		}
+	} else {
+		err = db.Where("account_id = ? AND id in (?)", accountId, keys).Delete(&MultiaccountTypeWithIDORM{}).Error
+		if err != nil {
+			return err
+		}
+	}
+	if hook, ok := (interface{}(&MultiaccountTypeWithIDORM{})).(MultiaccountTypeWithIDORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type MultiaccountTypeWithIDORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*MultiaccountTypeWithID, *gorm.DB) (*gorm.DB, error)
+}
+type MultiaccountTypeWithIDORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*MultiaccountTypeWithID, *gorm.DB) error
+}
+
+// DefaultStrictUpdateMultiaccountTypeWithID clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateMultiaccountTypeWithID(ctx context.Context, in *MultiaccountTypeWithID, db *gorm.DB) (*MultiaccountTypeWithID, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateMultiaccountTypeWithID")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	accountID, err := auth.GetAccountID(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	compartmentID, err := auth.GetCompartmentID(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	if compartmentID != "" {
+		db = db.Where(map[string]interface{}{"account_id": accountID, "compartment_id": compartmentID})
+	} else {
+		db = db.Where(map[string]interface{}{"account_id": accountID})
+	}
+	var count int64
+	lockedRow := &MultiaccountTypeWithIDORM{}
+	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		err = gateway.SetCreated(ctx, "")
+	}
+	return &pbResponse, err
+}
+
+type MultiaccountTypeWithIDORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MultiaccountTypeWithIDORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MultiaccountTypeWithIDORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultUpsertMultiaccountTypeWithID executes a gorm create call resolving conflicts on conflictTarget,
+// the name of a unique index or the primary key when empty, by updating the columns
+// in updateMask, all of them when updateMask is empty, and returns the stored object
+// gorm.ErrRecordNotFound is returned when the conflicting row belongs to another account
+func DefaultUpsertMultiaccountTypeWithID(ctx context.Context, in *MultiaccountTypeWithID, conflictTarget string, updateMask *field_mask.FieldMask, db *gorm.DB) (*MultiaccountTypeWithID, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conflict := clause.OnConflict{}
+	var lookup func() map[string]interface{}
+	switch conflictTarget {
+	case "":
+		conflict.Columns = []clause.Column{{Name: "id"}}
+		lookup = func() map[string]interface{} {
+			return map[string]interface{}{"id": ormObj.Id, "account_id": ormObj.AccountID}
+		}
+	default:
+		return nil, fmt.Errorf("unknown conflict target %q of MultiaccountTypeWithID", conflictTarget)
+	}
+	if len(updateMask.GetPaths()) == 0 {
+		conflict.UpdateAll = true
+	} else {
+		var columns []string
+		for _, path := range updateMask.GetPaths() {
+			var pathColumns []string
+			switch path {
+			case "SomeField":
+				pathColumns = []string{"some_field"}
+			default:
+				return nil, &errors.InvalidFieldMaskError{Path: path}
+			}
+			for _, column := range pathColumns {
+				for _, target := range conflict.Columns {
+					if column == target.Name {
+						return nil, &errors.InvalidFieldMaskError{Path: path}
+					}
+				}
+			}
+			columns = append(columns, pathColumns...)
+		}
+		conflict.DoUpdates = clause.AssignmentColumns(columns)
+	}
+	conflict.Where = clause.Where{Exprs: []clause.Expression{clause.Eq{Column: clause.Column{Table: "multiaccount_type_with_ids", Name: "account_id"}, Value: ormObj.AccountID}}}
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithBeforeUpsert); ok {
+		if db, err = hook.BeforeUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Clauses(conflict).Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	stored := MultiaccountTypeWithIDORM{}
+	if err = db.Where(lookup()).Take(&stored).Error; err != nil {
+		return nil, err
+	}
+	ormObj = stored
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithAfterUpsert); ok {
+		if err = hook.AfterUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type MultiaccountTypeWithIDORMWithBeforeUpsert interface {
+	BeforeUpsert(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MultiaccountTypeWithIDORMWithAfterUpsert interface {
+	AfterUpsert(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchMultiaccountTypeWithID executes a basic gorm update call with patch behavior
+func DefaultPatchMultiaccountTypeWithID(ctx context.Context, in *MultiaccountTypeWithID, updateMask *field_mask.FieldMask, db *gorm.DB) (*MultiaccountTypeWithID, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	pbObj := &MultiaccountTypeWithID{}
+	var err error
+	if hook, ok := interface{}(pbObj).(MultiaccountTypeWithIDWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if pbObj, err = DefaultReadMultiaccountTypeWithID(ctx, &MultiaccountTypeWithID{Id: in.GetId()}, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbObj).(MultiaccountTypeWithIDWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskMultiaccountTypeWithID(ctx, pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbObj).(MultiaccountTypeWithIDWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateMultiaccountTypeWithID(ctx, pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(MultiaccountTypeWithIDWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type MultiaccountTypeWithIDWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *MultiaccountTypeWithID, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type MultiaccountTypeWithIDWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *MultiaccountTypeWithID, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type MultiaccountTypeWithIDWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *MultiaccountTypeWithID, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type MultiaccountTypeWithIDWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *MultiaccountTypeWithID, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetMultiaccountTypeWithID patches the objects in a transaction: the masks are applied in memory to
+// the stored objects, read and locked with a single query, and the objects are written with an update
+// per batch of 100 objects. The objects are read and written with the handles their hooks
+// return, the objects of different handles don't share the queries. Only the associations in the
+// masks are updated, and objects sharing a primary key are rejected
+func DefaultPatchSetMultiaccountTypeWithID(ctx context.Context, objects []*MultiaccountTypeWithID, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*MultiaccountTypeWithID, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+	if len(objects) == 0 {
+		return []*MultiaccountTypeWithID{}, nil
+	}
+	var err error
+	accountID, err := auth.GetAccountID(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	compartmentID, err := auth.GetCompartmentID(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	if compartmentID != "" {
+		db = db.Where(map[string]interface{}{"account_id": accountID, "compartment_id": compartmentID})
+	} else {
+		db = db.Where(map[string]interface{}{"account_id": accountID})
+	}
+	objectKeys := make([]uint64, 0, len(objects))
+	seen := make(map[uint64]struct{}, len(objects))
+	for _, in := range objects {
+		if in == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := in.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if ormObj.Id == 0 {
+			return nil, errors.EmptyIdError
+		}
+		key := ormObj.Id
+		if _, ok := seen[key]; ok {
+			return nil, errors.DuplicateKeyError
+		}
+		seen[key] = struct{}{}
+		objectKeys = append(objectKeys, key)
+	}
+	batchSize := 100
+	results := make([]*MultiaccountTypeWithID, len(objects))
+	err = db.Transaction(func(db *gorm.DB) error {
+		var err error
+		// the objects sharing the handle their hooks return share the queries
+		dbs := make([]*gorm.DB, len(objects))
+		group := func() ([]*gorm.DB, map[*gorm.DB][]int) {
+			var handles []*gorm.DB
+			groups := make(map[*gorm.DB][]int)
+			for i, handle := range dbs {
+				if _, ok := groups[handle]; !ok {
+					handles = append(handles, handle)
+				}
+				groups[handle] = append(groups[handle], i)
+			}
+			return handles, groups
+		}
+		for i, in := range objects {
+			dbs[i] = db
+			if hook, ok := interface{}(&MultiaccountTypeWithID{}).(MultiaccountTypeWithIDWithBeforePatchRead); ok {
+				if dbs[i], err = hook.BeforePatchRead(ctx, in, updateMasks[i], db); err != nil {
+					return err
+				}
+			}
+		}
+		targets := make(map[uint64]MultiaccountTypeWithIDORM, len(objects))
+		handles, groups := group()
+		for _, handle := range handles {
+			keys := make([]uint64, 0, len(groups[handle]))
+			for _, i := range groups[handle] {
+				keys = append(keys, objectKeys[i])
+			}
+			query := handle.Session(&gorm.Session{}).Clauses(clause.Locking{Strength: "UPDATE"})
+			query = query.Where("id in (?)", keys)
+			rows := []MultiaccountTypeWithIDORM{}
+			if err = query.Find(&rows).Error; err != nil {
+				return err
+			}
+			for _, row := range rows {
+				targets[row.Id] = row
+			}
+		}
+		ormObjs := make([]MultiaccountTypeWithIDORM, len(objects))
+		prepare := func(i int) (*gorm.DB, error) {
+			in, key, updateMask, db := objects[i], objectKeys[i], updateMasks[i], dbs[i]
+			target, ok := targets[key]
+			if !ok {
+				return nil, gorm.ErrRecordNotFound
+			}
+			stored, err := target.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbObj := &stored
+			if hook, ok := interface{}(pbObj).(MultiaccountTypeWithIDWithBeforePatchApplyFieldMask); ok {
+				if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			if _, err := DefaultApplyFieldMaskMultiaccountTypeWithID(ctx, pbObj, in, updateMask, "", db); err != nil {
+				return nil, err
+			}
+			if hook, ok := interface{}(pbObj).(MultiaccountTypeWithIDWithBeforePatchSave); ok {
+				if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObj, err := pbObj.ToORM(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithBeforeStrictUpdateCleanup); ok {
+				if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithBeforeStrictUpdateSave); ok {
+				if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObjs[i] = ormObj
+			return db, nil
+		}
+		for i := range objects {
+			if dbs[i], err = prepare(i); err != nil {
+				return err
+			}
+		}
+		write := func(db *gorm.DB, batch []int) error {
+			conds := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*2)
+			for _, i := range batch {
+				conds = append(conds, "(id = ?)")
+				args = append(args, objectKeys[i])
+			}
+			column := func(name string, value func(ormObj *MultiaccountTypeWithIDORM) interface{}) clause.Expr {
+				whens := make([]interface{}, 0, len(batch)*2)
+				for _, i := range batch {
+					whens = append(whens, objectKeys[i], value(&ormObjs[i]))
+				}
+				return gorm.Expr("CASE "+strings.Repeat("WHEN id = ? THEN ? ", len(batch))+"ELSE "+name+" END", whens...)
+			}
+			res := db.Session(&gorm.Session{}).Model(&MultiaccountTypeWithIDORM{}).Where(strings.Join(conds, " OR "), args...).Updates(map[string]interface{}{
+				"account_id":     column("account_id", func(ormObj *MultiaccountTypeWithIDORM) interface{} { return ormObj.AccountID }),
+				"compartment_id": column("compartment_id", func(ormObj *MultiaccountTypeWithIDORM) interface{} { return ormObj.CompartmentID }),
+				"some_field":     column("some_field", func(ormObj *MultiaccountTypeWithIDORM) interface{} { return ormObj.SomeField }),
+			})
+			return res.Error
+		}
+		handles, groups = group()
+		for _, handle := range handles {
+			indexes := groups[handle]
+			for start := 0; start < len(indexes); start += batchSize {
+				end := start + batchSize
+				if end > len(indexes) {
+					end = len(indexes)
+				}
+				batch := indexes[start:end]
+				if err = write(handle, batch); err != nil {
+					return err
+				}
+			}
+		}
+		finish := func(i int) (*MultiaccountTypeWithID, error) {
+			in, updateMask, db, ormObj := objects[i], updateMasks[i], dbs[i], ormObjs[i]
+			var err error
+			if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithAfterStrictUpdateSave); ok {
+				if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			saved, err := ormObj.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbResponse := &saved
+			if hook, ok := interface{}(pbResponse).(MultiaccountTypeWithIDWithAfterPatchSave); ok {
+				if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			return pbResponse, nil
+		}
+		for i := range objects {
+			if results[i], err = finish(i); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// DefaultApplyFieldMaskMultiaccountTypeWithID patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskMultiaccountTypeWithID(ctx context.Context, patchee *MultiaccountTypeWithID, patcher *MultiaccountTypeWithID, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*MultiaccountTypeWithID, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	if len(updateMask.GetPaths()) == 1 && updateMask.Paths[0] == prefix+"*" {
+		updateMask = &field_mask.FieldMask{Paths: []string{prefix + "Id", prefix + "SomeField"}}
+	}
+	for _, f := range updateMask.GetPaths() {
+		if strings.HasPrefix(f, prefix) && !DefaultHasFieldMaskPathMultiaccountTypeWithID(strings.TrimPrefix(f, prefix)) {
+			return nil, &errors.InvalidFieldMaskError{Path: f}
+		}
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"SomeField" {
+			patchee.SomeField = patcher.SomeField
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultHasFieldMaskPathMultiaccountTypeWithID reports whether MultiaccountTypeWithID has the field of the field mask path
+func DefaultHasFieldMaskPathMultiaccountTypeWithID(path string) bool {
+	parts := strings.SplitN(path, ".", 2)
+	switch parts[0] {
+	case "Id", "SomeField":
+		return len(parts) == 1
+	}
+	return false
+}
+
+// DefaultListMultiaccountTypeWithID executes a gorm list call
+func DefaultListMultiaccountTypeWithID(ctx context.Context, db *gorm.DB) ([]*MultiaccountTypeWithID, error) {
+	in := MultiaccountTypeWithID{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []MultiaccountTypeWithIDORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*MultiaccountTypeWithID{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type MultiaccountTypeWithIDORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MultiaccountTypeWithIDORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MultiaccountTypeWithIDORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]MultiaccountTypeWithIDORM) error
+}
+
+// DefaultCreateMultiaccountTypeWithoutID executes a basic gorm create call
+func DefaultCreateMultiaccountTypeWithoutID(ctx context.Context, in *MultiaccountTypeWithoutID, db *gorm.DB) (*MultiaccountTypeWithoutID, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithoutIDORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithoutIDORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type MultiaccountTypeWithoutIDORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MultiaccountTypeWithoutIDORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultCreateMultiaccountTypeWithoutIDSet executes gorm create calls in batches of 100 objects
+// in a transaction, either all of the objects are created or none of them. The BeforeCreate_ hook
+// of each object runs before the insert of its batch, which uses the handle the hooks of the
+// batch return
+func DefaultCreateMultiaccountTypeWithoutIDSet(ctx context.Context, in []*MultiaccountTypeWithoutID, db *gorm.DB) ([]*MultiaccountTypeWithoutID, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObjs := make([]MultiaccountTypeWithoutIDORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, ormObj)
+	}
+	batchSize := 100
+	err := db.Transaction(func(db *gorm.DB) error {
+		var err error
+		for start := 0; start < len(ormObjs); start += batchSize {
+			end := start + batchSize
+			if end > len(ormObjs) {
+				end = len(ormObjs)
+			}
+			batch := ormObjs[start:end]
+			// the scopes the hooks add only apply to the insert of their own batch
+			batchDB := db
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(MultiaccountTypeWithoutIDORMWithBeforeCreate_); ok {
+					if batchDB, err = hook.BeforeCreate_(ctx, batchDB); err != nil {
+						return err
+					}
+				}
+			}
+			if err = batchDB.Omit().CreateInBatches(&batch, batchSize).Error; err != nil {
+				return err
+			}
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(MultiaccountTypeWithoutIDORMWithAfterCreate_); ok {
+					if err = hook.AfterCreate_(ctx, db); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*MultiaccountTypeWithoutID, 0, len(ormObjs))
+	for i := range ormObjs {
+		pbResponse, err := ormObjs[i].ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, &pbResponse)
+	}
+	return results, nil
+}
+
+// DefaultApplyFieldMaskMultiaccountTypeWithoutID patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskMultiaccountTypeWithoutID(ctx context.Context, patchee *MultiaccountTypeWithoutID, patcher *MultiaccountTypeWithoutID, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*MultiaccountTypeWithoutID, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	if len(updateMask.GetPaths()) == 1 && updateMask.Paths[0] == prefix+"*" {
+		updateMask = &field_mask.FieldMask{Paths: []string{prefix + "SomeField"}}
+	}
+	for _, f := range updateMask.GetPaths() {
+		if strings.HasPrefix(f, prefix) && !DefaultHasFieldMaskPathMultiaccountTypeWithoutID(strings.TrimPrefix(f, prefix)) {
+			return nil, &errors.InvalidFieldMaskError{Path: f}
+		}
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"SomeField" {
+			patchee.SomeField = patcher.SomeField
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultHasFieldMaskPathMultiaccountTypeWithoutID reports whether MultiaccountTypeWithoutID has the field of the field mask path
+func DefaultHasFieldMaskPathMultiaccountTypeWithoutID(path string) bool {
+	parts := strings.SplitN(path, ".", 2)
+	switch parts[0] {
+	case "SomeField":
+		return len(parts) == 1
+	}
+	return false
+}
+
+// DefaultListMultiaccountTypeWithoutID executes a gorm list call
+func DefaultListMultiaccountTypeWithoutID(ctx context.Context, db *gorm.DB) ([]*MultiaccountTypeWithoutID, error) {
+	in := MultiaccountTypeWithoutID{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithoutIDORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithoutIDORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	ormResponse := []MultiaccountTypeWithoutIDORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithoutIDORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*MultiaccountTypeWithoutID{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type MultiaccountTypeWithoutIDORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MultiaccountTypeWithoutIDORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MultiaccountTypeWithoutIDORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]MultiaccountTypeWithoutIDORM) error
+}
+
+// DefaultCreateMultiaccountTypeWithName executes a basic gorm create call
+func DefaultCreateMultiaccountTypeWithName(ctx context.Context, in *MultiaccountTypeWithName, db *gorm.DB) (*MultiaccountTypeWithName, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithNameORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithNameORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type MultiaccountTypeWithNameORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MultiaccountTypeWithNameORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultCreateMultiaccountTypeWithNameSet executes gorm create calls in batches of 100 objects
+// in a transaction, either all of the objects are created or none of them. The BeforeCreate_ hook
+// of each object runs before the insert of its batch, which uses the handle the hooks of the
+// batch return
+func DefaultCreateMultiaccountTypeWithNameSet(ctx context.Context, in []*MultiaccountTypeWithName, db *gorm.DB) ([]*MultiaccountTypeWithName, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObjs := make([]MultiaccountTypeWithNameORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, ormObj)
+	}
+	batchSize := 100
+	err := db.Transaction(func(db *gorm.DB) error {
+		var err error
+		for start := 0; start < len(ormObjs); start += batchSize {
+			end := start + batchSize
+			if end > len(ormObjs) {
+				end = len(ormObjs)
+			}
+			batch := ormObjs[start:end]
+			// the scopes the hooks add only apply to the insert of their own batch
+			batchDB := db
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(MultiaccountTypeWithNameORMWithBeforeCreate_); ok {
+					if batchDB, err = hook.BeforeCreate_(ctx, batchDB); err != nil {
+						return err
+					}
+				}
+			}
+			if err = batchDB.Omit().CreateInBatches(&batch, batchSize).Error; err != nil {
+				return err
+			}
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(MultiaccountTypeWithNameORMWithAfterCreate_); ok {
+					if err = hook.AfterCreate_(ctx, db); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*MultiaccountTypeWithName, 0, len(ormObjs))
+	for i := range ormObjs {
+		pbResponse, err := ormObjs[i].ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, &pbResponse)
+	}
+	return results, nil
+}
+
+func DefaultReadMultiaccountTypeWithName(ctx context.Context, in *MultiaccountTypeWithName, db *gorm.DB) (*MultiaccountTypeWithName, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Name == "" {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithNameORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithNameORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := MultiaccountTypeWithNameORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(MultiaccountTypeWithNameORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type MultiaccountTypeWithNameORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MultiaccountTypeWithNameORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MultiaccountTypeWithNameORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteMultiaccountTypeWithName(ctx context.Context, in *MultiaccountTypeWithName, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Name == "" {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithNameORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&MultiaccountTypeWithNameORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithNameORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type MultiaccountTypeWithNameORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MultiaccountTypeWithNameORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteMultiaccountTypeWithNameSet(ctx context.Context, in []*MultiaccountTypeWithName, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []string{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Name == "" {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Name)
+	}
+	if hook, ok := (interface{}(&MultiaccountTypeWithNameORM{})).(MultiaccountTypeWithNameORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	accountId, err := auth.GetAccountID(ctx, nil)
+	if err != nil {
+		return err
+	}
+	err = db.Where("account_id = ? AND name in (?)", accountId, keys).Delete(&MultiaccountTypeWithNameORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&MultiaccountTypeWithNameORM{})).(MultiaccountTypeWithNameORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type MultiaccountTypeWithNameORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*MultiaccountTypeWithName, *gorm.DB) (*gorm.DB, error)
+}
+type MultiaccountTypeWithNameORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*MultiaccountTypeWithName, *gorm.DB) error
+}
+
+// DefaultStrictUpdateMultiaccountTypeWithName clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateMultiaccountTypeWithName(ctx context.Context, in *MultiaccountTypeWithName, db *gorm.DB) (*MultiaccountTypeWithName, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateMultiaccountTypeWithName")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	accountID, err := auth.GetAccountID(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	db = db.Where(map[string]interface{}{"account_id": accountID})
+	var count int64
+	lockedRow := &MultiaccountTypeWithNameORM{}
+	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("name=?", ormObj.Name).First(lockedRow).RowsAffected
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithNameORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithNameORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithNameORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		err = gateway.SetCreated(ctx, "")
+	}
+	return &pbResponse, err
+}
+
+type MultiaccountTypeWithNameORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MultiaccountTypeWithNameORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MultiaccountTypeWithNameORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultUpsertMultiaccountTypeWithName executes a gorm create call resolving conflicts on conflictTarget,
+// the name of a unique index or the primary key when empty, by updating the columns
+// in updateMask, all of them when updateMask is empty, and returns the stored object
+// gorm.ErrRecordNotFound is returned when the conflicting row belongs to another account
+func DefaultUpsertMultiaccountTypeWithName(ctx context.Context, in *MultiaccountTypeWithName, conflictTarget string, updateMask *field_mask.FieldMask, db *gorm.DB) (*MultiaccountTypeWithName, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conflict := clause.OnConflict{}
+	var lookup func() map[string]interface{}
+	switch conflictTarget {
+	case "":
+		conflict.Columns = []clause.Column{{Name: "name"}}
+		lookup = func() map[string]interface{} {
+			return map[string]interface{}{"name": ormObj.Name, "account_id": ormObj.AccountID}
+		}
+	default:
+		return nil, fmt.Errorf("unknown conflict target %q of MultiaccountTypeWithName", conflictTarget)
+	}
+	if len(updateMask.GetPaths()) == 0 {
+		conflict.UpdateAll = true
+	} else {
+		var columns []string
+		for _, path := range updateMask.GetPaths() {
+			var pathColumns []string
+			switch path {
+			case "SomeField":
+				pathColumns = []string{"some_field"}
+			default:
+				return nil, &errors.InvalidFieldMaskError{Path: path}
+			}
+			for _, column := range pathColumns {
+				for _, target := range conflict.Columns {
+					if column == target.Name {
+						return nil, &errors.InvalidFieldMaskError{Path: path}
+					}
+				}
+			}
+			columns = append(columns, pathColumns...)
+		}
+		conflict.DoUpdates = clause.AssignmentColumns(columns)
+	}
+	conflict.Where = clause.Where{Exprs: []clause.Expression{clause.Eq{Column: clause.Column{Table: "multiaccount_type_with_names", Name: "account_id"}, Value: ormObj.AccountID}}}
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithNameORMWithBeforeUpsert); ok {
+		if db, err = hook.BeforeUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Clauses(conflict).Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	stored := MultiaccountTypeWithNameORM{}
+	if err = db.Where(lookup()).Take(&stored).Error; err != nil {
+		return nil, err
+	}
+	ormObj = stored
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithNameORMWithAfterUpsert); ok {
+		if err = hook.AfterUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type MultiaccountTypeWithNameORMWithBeforeUpsert interface {
+	BeforeUpsert(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MultiaccountTypeWithNameORMWithAfterUpsert interface {
+	AfterUpsert(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchMultiaccountTypeWithName executes a basic gorm update call with patch behavior
+func DefaultPatchMultiaccountTypeWithName(ctx context.Context, in *MultiaccountTypeWithName, updateMask *field_mask.FieldMask, db *gorm.DB) (*MultiaccountTypeWithName, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	pbObj := &MultiaccountTypeWithName{}
+	var err error
+	if hook, ok := interface{}(pbObj).(MultiaccountTypeWithNameWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if pbObj, err = DefaultReadMultiaccountTypeWithName(ctx, &MultiaccountTypeWithName{Name: in.GetName()}, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbObj).(MultiaccountTypeWithNameWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskMultiaccountTypeWithName(ctx, pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbObj).(MultiaccountTypeWithNameWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateMultiaccountTypeWithName(ctx, pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(MultiaccountTypeWithNameWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type MultiaccountTypeWithNameWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *MultiaccountTypeWithName, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type MultiaccountTypeWithNameWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *MultiaccountTypeWithName, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type MultiaccountTypeWithNameWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *MultiaccountTypeWithName, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type MultiaccountTypeWithNameWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *MultiaccountTypeWithName, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetMultiaccountTypeWithName patches the objects in a transaction: the masks are applied in memory to
+// the stored objects, read and locked with a single query, and the objects are written with an update
+// per batch of 100 objects. The objects are read and written with the handles their hooks
+// return, the objects of different handles don't share the queries. Only the associations in the
+// masks are updated, and objects sharing a primary key are rejected
+func DefaultPatchSetMultiaccountTypeWithName(ctx context.Context, objects []*MultiaccountTypeWithName, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*MultiaccountTypeWithName, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+	if len(objects) == 0 {
+		return []*MultiaccountTypeWithName{}, nil
+	}
+	var err error
+	accountID, err := auth.GetAccountID(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	db = db.Where(map[string]interface{}{"account_id": accountID})
+	objectKeys := make([]string, 0, len(objects))
+	seen := make(map[string]struct{}, len(objects))
+	for _, in := range objects {
+		if in == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := in.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if ormObj.Name == "" {
+			return nil, errors.EmptyIdError
+		}
+		key := ormObj.Name
+		if _, ok := seen[key]; ok {
+			return nil, errors.DuplicateKeyError
+		}
+		seen[key] = struct{}{}
+		objectKeys = append(objectKeys, key)
+	}
+	batchSize := 100
+	results := make([]*MultiaccountTypeWithName, len(objects))
+	err = db.Transaction(func(db *gorm.DB) error {
+		var err error
+		// the objects sharing the handle their hooks return share the queries
+		dbs := make([]*gorm.DB, len(objects))
+		group := func() ([]*gorm.DB, map[*gorm.DB][]int) {
+			var handles []*gorm.DB
+			groups := make(map[*gorm.DB][]int)
+			for i, handle := range dbs {
+				if _, ok := groups[handle]; !ok {
+					handles = append(handles, handle)
+				}
+				groups[handle] = append(groups[handle], i)
+			}
+			return handles, groups
+		}
+		for i, in := range objects {
+			dbs[i] = db
+			if hook, ok := interface{}(&MultiaccountTypeWithName{}).(MultiaccountTypeWithNameWithBeforePatchRead); ok {
+				if dbs[i], err = hook.BeforePatchRead(ctx, in, updateMasks[i], db); err != nil {
+					return err
+				}
+			}
+		}
+		targets := make(map[string]MultiaccountTypeWithNameORM, len(objects))
+		handles, groups := group()
+		for _, handle := range handles {
+			keys := make([]string, 0, len(groups[handle]))
+			for _, i := range groups[handle] {
+				keys = append(keys, objectKeys[i])
+			}
+			query := handle.Session(&gorm.Session{}).Clauses(clause.Locking{Strength: "UPDATE"})
+			query = query.Where("name in (?)", keys)
+			rows := []MultiaccountTypeWithNameORM{}
+			if err = query.Find(&rows).Error; err != nil {
+				return err
+			}
+			for _, row := range rows {
+				targets[row.Name] = row
+			}
+		}
+		ormObjs := make([]MultiaccountTypeWithNameORM, len(objects))
+		prepare := func(i int) (*gorm.DB, error) {
+			in, key, updateMask, db := objects[i], objectKeys[i], updateMasks[i], dbs[i]
+			target, ok := targets[key]
+			if !ok {
+				return nil, gorm.ErrRecordNotFound
+			}
+			stored, err := target.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbObj := &stored
+			if hook, ok := interface{}(pbObj).(MultiaccountTypeWithNameWithBeforePatchApplyFieldMask); ok {
+				if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			if _, err := DefaultApplyFieldMaskMultiaccountTypeWithName(ctx, pbObj, in, updateMask, "", db); err != nil {
+				return nil, err
+			}
+			if hook, ok := interface{}(pbObj).(MultiaccountTypeWithNameWithBeforePatchSave); ok {
+				if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObj, err := pbObj.ToORM(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithNameORMWithBeforeStrictUpdateCleanup); ok {
+				if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithNameORMWithBeforeStrictUpdateSave); ok {
+				if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObjs[i] = ormObj
+			return db, nil
+		}
+		for i := range objects {
+			if dbs[i], err = prepare(i); err != nil {
+				return err
+			}
+		}
+		write := func(db *gorm.DB, batch []int) error {
+			conds := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*2)
+			for _, i := range batch {
+				conds = append(conds, "(name = ?)")
+				args = append(args, objectKeys[i])
+			}
+			column := func(name string, value func(ormObj *MultiaccountTypeWithNameORM) interface{}) clause.Expr {
+				whens := make([]interface{}, 0, len(batch)*2)
+				for _, i := range batch {
+					whens = append(whens, objectKeys[i], value(&ormObjs[i]))
+				}
+				return gorm.Expr("CASE "+strings.Repeat("WHEN name = ? THEN ? ", len(batch))+"ELSE "+name+" END", whens...)
+			}
+			res := db.Session(&gorm.Session{}).Model(&MultiaccountTypeWithNameORM{}).Where(strings.Join(conds, " OR "), args...).Updates(map[string]interface{}{
+				"account_id": column("account_id", func(ormObj *MultiaccountTypeWithNameORM) interface{} { return ormObj.AccountID }),
+				"some_field": column("some_field", func(ormObj *MultiaccountTypeWithNameORM) interface{} { return ormObj.SomeField }),
+			})
+			return res.Error
+		}
+		handles, groups = group()
+		for _, handle := range handles {
+			indexes := groups[handle]
+			for start := 0; start < len(indexes); start += batchSize {
+				end := start + batchSize
+				if end > len(indexes) {
+					end = len(indexes)
+				}
+				batch := indexes[start:end]
+				if err = write(handle, batch); err != nil {
+					return err
+				}
+			}
+		}
+		finish := func(i int) (*MultiaccountTypeWithName, error) {
+			in, updateMask, db, ormObj := objects[i], updateMasks[i], dbs[i], ormObjs[i]
+			var err error
+			if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithNameORMWithAfterStrictUpdateSave); ok {
+				if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			saved, err := ormObj.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbResponse := &saved
+			if hook, ok := interface{}(pbResponse).(MultiaccountTypeWithNameWithAfterPatchSave); ok {
+				if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			return pbResponse, nil
+		}
+		for i := range objects {
+			if results[i], err = finish(i); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// DefaultApplyFieldMaskMultiaccountTypeWithName patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskMultiaccountTypeWithName(ctx context.Context, patchee *MultiaccountTypeWithName, patcher *MultiaccountTypeWithName, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*MultiaccountTypeWithName, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	if len(updateMask.GetPaths()) == 1 && updateMask.Paths[0] == prefix+"*" {
+		updateMask = &field_mask.FieldMask{Paths: []string{prefix + "Name", prefix + "SomeField"}}
+	}
+	for _, f := range updateMask.GetPaths() {
+		if strings.HasPrefix(f, prefix) && !DefaultHasFieldMaskPathMultiaccountTypeWithName(strings.TrimPrefix(f, prefix)) {
+			return nil, &errors.InvalidFieldMaskError{Path: f}
+		}
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Name" {
+			patchee.Name = patcher.Name
+			continue
+		}
+		if f == prefix+"SomeField" {
+			patchee.SomeField = patcher.SomeField
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultHasFieldMaskPathMultiaccountTypeWithName reports whether MultiaccountTypeWithName has the field of the field mask path
+func DefaultHasFieldMaskPathMultiaccountTypeWithName(path string) bool {
+	parts := strings.SplitN(path, ".", 2)
+	switch parts[0] {
+	case "Name", "SomeField":
+		return len(parts) == 1
+	}
+	return false
+}
+
+// DefaultListMultiaccountTypeWithName executes a gorm list call
+func DefaultListMultiaccountTypeWithName(ctx context.Context, db *gorm.DB) ([]*MultiaccountTypeWithName, error) {
+	in := MultiaccountTypeWithName{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithNameORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithNameORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("name")
+	ormResponse := []MultiaccountTypeWithNameORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithNameORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*MultiaccountTypeWithName{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type MultiaccountTypeWithNameORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MultiaccountTypeWithNameORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MultiaccountTypeWithNameORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]MultiaccountTypeWithNameORM) error
+}
+
+// DefaultCreatePrimaryUUIDType executes a basic gorm create call
+func DefaultCreatePrimaryUUIDType(ctx context.Context, in *PrimaryUUIDType, db *gorm.DB) (*PrimaryUUIDType, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type PrimaryUUIDTypeORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryUUIDTypeORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultCreatePrimaryUUIDTypeSet executes gorm create calls in batches of 100 objects
+// in a transaction, either all of the objects are created or none of them. The BeforeCreate_ hook
+// of each object runs before the insert of its batch, which uses the handle the hooks of the
+// batch return
+func DefaultCreatePrimaryUUIDTypeSet(ctx context.Context, in []*PrimaryUUIDType, db *gorm.DB) ([]*PrimaryUUIDType, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObjs := make([]PrimaryUUIDTypeORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, ormObj)
+	}
+	batchSize := 100
+	err := db.Transaction(func(db *gorm.DB) error {
+		var err error
+		for start := 0; start < len(ormObjs); start += batchSize {
+			end := start + batchSize
+			if end > len(ormObjs) {
+				end = len(ormObjs)
+			}
+			batch := ormObjs[start:end]
+			// the scopes the hooks add only apply to the insert of their own batch
+			batchDB := db
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(PrimaryUUIDTypeORMWithBeforeCreate_); ok {
+					if batchDB, err = hook.BeforeCreate_(ctx, batchDB); err != nil {
+						return err
+					}
+				}
+			}
+			if err = batchDB.Omit().CreateInBatches(&batch, batchSize).Error; err != nil {
+				return err
+			}
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(PrimaryUUIDTypeORMWithAfterCreate_); ok {
+					if err = hook.AfterCreate_(ctx, db); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*PrimaryUUIDType, 0, len(ormObjs))
+	for i := range ormObjs {
+		pbResponse, err := ormObjs[i].ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, &pbResponse)
+	}
+	return results, nil
+}
+
+func DefaultReadPrimaryUUIDType(ctx context.Context, in *PrimaryUUIDType, db *gorm.DB) (*PrimaryUUIDType, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == nil || *ormObj.Id == go_uuid.Nil {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := PrimaryUUIDTypeORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(PrimaryUUIDTypeORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type PrimaryUUIDTypeORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryUUIDTypeORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryUUIDTypeORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeletePrimaryUUIDType(ctx context.Context, in *PrimaryUUIDType, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == nil || *ormObj.Id == go_uuid.Nil {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&PrimaryUUIDTypeORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type PrimaryUUIDTypeORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryUUIDTypeORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeletePrimaryUUIDTypeSet(ctx context.Context, in []*PrimaryUUIDType, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []*go_uuid.UUID{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == nil || *ormObj.Id == go_uuid.Nil {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&PrimaryUUIDTypeORM{})).(PrimaryUUIDTypeORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&PrimaryUUIDTypeORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&PrimaryUUIDTypeORM{})).(PrimaryUUIDTypeORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type PrimaryUUIDTypeORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*PrimaryUUIDType, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryUUIDTypeORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*PrimaryUUIDType, *gorm.DB) error
+}
+
+// DefaultStrictUpdatePrimaryUUIDType clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdatePrimaryUUIDType(ctx context.Context, in *PrimaryUUIDType, db *gorm.DB) (*PrimaryUUIDType, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdatePrimaryUUIDType")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var count int64
+	lockedRow := &PrimaryUUIDTypeORM{}
+	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
+	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	filterChild := ExternalChildORM{}
+	if ormObj.Id == nil || *ormObj.Id == go_uuid.Nil {
+		return nil, errors.EmptyIdError
+	}
+	filterChild.PrimaryUUIDTypeId = new(go_uuid.UUID)
+	*filterChild.PrimaryUUIDTypeId = *ormObj.Id
+	if err = db.Where(filterChild).Delete(ExternalChildORM{}).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		err = gateway.SetCreated(ctx, "")
+	}
+	return &pbResponse, err
+}
+
+type PrimaryUUIDTypeORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryUUIDTypeORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryUUIDTypeORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultUpsertPrimaryUUIDType executes a gorm create call resolving conflicts on conflictTarget,
+// the name of a unique index or the primary key when empty, by updating the columns
+// in updateMask, all of them when updateMask is empty, and returns the stored object
+func DefaultUpsertPrimaryUUIDType(ctx context.Context, in *PrimaryUUIDType, conflictTarget string, updateMask *field_mask.FieldMask, db *gorm.DB) (*PrimaryUUIDType, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conflict := clause.OnConflict{}
+	var lookup func() map[string]interface{}
+	switch conflictTarget {
+	case "":
+		conflict.Columns = []clause.Column{{Name: "id"}}
+		lookup = func() map[string]interface{} { return map[string]interface{}{"id": ormObj.Id} }
+	default:
+		return nil, fmt.Errorf("unknown conflict target %q of PrimaryUUIDType", conflictTarget)
+	}
+	if len(updateMask.GetPaths()) == 0 {
+		conflict.UpdateAll = true
+	} else {
+		return nil, &errors.InvalidFieldMaskError{Path: updateMask.GetPaths()[0]}
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithBeforeUpsert); ok {
+		if db, err = hook.BeforeUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Clauses(conflict).Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if len(updateMask.GetPaths()) > 0 {
+		stored := PrimaryUUIDTypeORM{}
+		if err = db.Where(lookup()).Take(&stored).Error; err != nil {
+			return nil, err
+		}
+		ormObj = stored
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithAfterUpsert); ok {
+		if err = hook.AfterUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type PrimaryUUIDTypeORMWithBeforeUpsert interface {
+	BeforeUpsert(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryUUIDTypeORMWithAfterUpsert interface {
+	AfterUpsert(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchPrimaryUUIDType executes a basic gorm update call with patch behavior
+func DefaultPatchPrimaryUUIDType(ctx context.Context, in *PrimaryUUIDType, updateMask *field_mask.FieldMask, db *gorm.DB) (*PrimaryUUIDType, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	pbObj := &PrimaryUUIDType{}
+	var err error
+	if hook, ok := interface{}(pbObj).(PrimaryUUIDTypeWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if pbObj, err = DefaultReadPrimaryUUIDType(ctx, &PrimaryUUIDType{Id: in.GetId()}, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbObj).(PrimaryUUIDTypeWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskPrimaryUUIDType(ctx, pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbObj).(PrimaryUUIDTypeWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdatePrimaryUUIDType(ctx, pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(PrimaryUUIDTypeWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type PrimaryUUIDTypeWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *PrimaryUUIDType, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryUUIDTypeWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *PrimaryUUIDType, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryUUIDTypeWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *PrimaryUUIDType, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryUUIDTypeWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *PrimaryUUIDType, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetPrimaryUUIDType patches the objects in a transaction: the masks are applied in memory to
+// the stored objects, read and locked with a single query, and the objects are written with an update
+// per batch of 100 objects. The objects are read and written with the handles their hooks
+// return, the objects of different handles don't share the queries. Only the associations in the
+// masks are updated, and objects sharing a primary key are rejected
+func DefaultPatchSetPrimaryUUIDType(ctx context.Context, objects []*PrimaryUUIDType, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*PrimaryUUIDType, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+	if len(objects) == 0 {
+		return []*PrimaryUUIDType{}, nil
+	}
+	var err error
+	objectKeys := make([]go_uuid.UUID, 0, len(objects))
+	seen := make(map[go_uuid.UUID]struct{}, len(objects))
+	for _, in := range objects {
+		if in == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := in.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if ormObj.Id == nil || *ormObj.Id == go_uuid.Nil {
+			return nil, errors.EmptyIdError
+		}
+		key := *ormObj.Id
+		if _, ok := seen[key]; ok {
+			return nil, errors.DuplicateKeyError
+		}
+		seen[key] = struct{}{}
+		objectKeys = append(objectKeys, key)
+	}
+	batchSize := 100
+	results := make([]*PrimaryUUIDType, len(objects))
+	err = db.Transaction(func(db *gorm.DB) error {
+		var err error
+		// the objects sharing the handle their hooks return share the queries
+		dbs := make([]*gorm.DB, len(objects))
+		group := func() ([]*gorm.DB, map[*gorm.DB][]int) {
+			var handles []*gorm.DB
+			groups := make(map[*gorm.DB][]int)
+			for i, handle := range dbs {
+				if _, ok := groups[handle]; !ok {
+					handles = append(handles, handle)
+				}
+				groups[handle] = append(groups[handle], i)
+			}
+			return handles, groups
+		}
+		for i, in := range objects {
+			dbs[i] = db
+			if hook, ok := interface{}(&PrimaryUUIDType{}).(PrimaryUUIDTypeWithBeforePatchRead); ok {
+				if dbs[i], err = hook.BeforePatchRead(ctx, in, updateMasks[i], db); err != nil {
+					return err
+				}
+			}
+		}
+		targets := make(map[go_uuid.UUID]PrimaryUUIDTypeORM, len(objects))
+		handles, groups := group()
+		for _, handle := range handles {
+			keys := make([]go_uuid.UUID, 0, len(groups[handle]))
+			for _, i := range groups[handle] {
+				keys = append(keys, objectKeys[i])
+			}
+			query := handle.Session(&gorm.Session{}).Clauses(clause.Locking{Strength: "UPDATE"})
+			query = query.Where("id in (?)", keys)
+			rows := []PrimaryUUIDTypeORM{}
+			if err = query.Find(&rows).Error; err != nil {
+				return err
+			}
+			for _, row := range rows {
+				targets[*row.Id] = row
+			}
+		}
+		ormObjs := make([]PrimaryUUIDTypeORM, len(objects))
+		prepare := func(i int) (*gorm.DB, error) {
+			in, key, updateMask, db := objects[i], objectKeys[i], updateMasks[i], dbs[i]
+			target, ok := targets[key]
+			if !ok {
+				return nil, gorm.ErrRecordNotFound
+			}
+			stored, err := target.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbObj := &stored
+			if hook, ok := interface{}(pbObj).(PrimaryUUIDTypeWithBeforePatchApplyFieldMask); ok {
+				if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			if _, err := DefaultApplyFieldMaskPrimaryUUIDType(ctx, pbObj, in, updateMask, "", db); err != nil {
+				return nil, err
+			}
+			if hook, ok := interface{}(pbObj).(PrimaryUUIDTypeWithBeforePatchSave); ok {
+				if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObj, err := pbObj.ToORM(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithBeforeStrictUpdateCleanup); ok {
+				if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			masked := make(map[string]bool, len(updateMask.GetPaths()))
+			for _, path := range updateMask.GetPaths() {
+				masked[strings.SplitN(path, ".", 2)[0]] = true
+			}
+			if masked["Child"] || masked["*"] {
+				filterChild := ExternalChildORM{}
+				if ormObj.Id == nil || *ormObj.Id == go_uuid.Nil {
+					return nil, errors.EmptyIdError
+				}
+				filterChild.PrimaryUUIDTypeId = new(go_uuid.UUID)
+				*filterChild.PrimaryUUIDTypeId = *ormObj.Id
+				if err = db.Where(filterChild).Delete(ExternalChildORM{}).Error; err != nil {
+					return nil, err
+				}
+			}
+			if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithBeforeStrictUpdateSave); ok {
+				if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObjs[i] = ormObj
+			return db, nil
+		}
+		for i := range objects {
+			if dbs[i], err = prepare(i); err != nil {
+				return err
+			}
+		}
+		handles, groups = group()
+		for _, handle := range handles {
+			indexes := groups[handle]
+			for start := 0; start < len(indexes); start += batchSize {
+				end := start + batchSize
+				if end > len(indexes) {
+					end = len(indexes)
+				}
+				batch := indexes[start:end]
+				objs := make([]*PrimaryUUIDTypeORM, 0, len(batch))
+				for _, i := range batch {
+					objs = append(objs, &ormObjs[i])
+				}
+				// the associations are saved first, the batch is then written with the
+				// foreign keys of its belongs-to associations
+				if err = handle.Session(&gorm.Session{}).Select("Child").Model(&objs).Updates(map[string]interface{}{}).Error; err != nil {
+					return err
+				}
+			}
+		}
+		finish := func(i int) (*PrimaryUUIDType, error) {
+			in, updateMask, db, ormObj := objects[i], updateMasks[i], dbs[i], ormObjs[i]
+			var err error
+			if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithAfterStrictUpdateSave); ok {
+				if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			saved, err := ormObj.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbResponse := &saved
+			if hook, ok := interface{}(pbResponse).(PrimaryUUIDTypeWithAfterPatchSave); ok {
+				if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			return pbResponse, nil
+		}
+		for i := range objects {
+			if results[i], err = finish(i); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// DefaultApplyFieldMaskPrimaryUUIDType patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskPrimaryUUIDType(ctx context.Context, patchee *PrimaryUUIDType, patcher *PrimaryUUIDType, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*PrimaryUUIDType, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	if len(updateMask.GetPaths()) == 1 && updateMask.Paths[0] == prefix+"*" {
+		updateMask = &field_mask.FieldMask{Paths: []string{prefix + "Id", prefix + "Child"}}
+	}
+	for _, f := range updateMask.GetPaths() {
+		if strings.HasPrefix(f, prefix) && !DefaultHasFieldMaskPathPrimaryUUIDType(strings.TrimPrefix(f, prefix)) {
+			return nil, &errors.InvalidFieldMaskError{Path: f}
+		}
+	}
+	var err error
+	var updatedChild bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if !updatedChild && strings.HasPrefix(f, prefix+"Child.") {
+			updatedChild = true
+			if patcher.Child == nil {
+				patchee.Child = nil
+				continue
+			}
+			if patchee.Child == nil {
+				patchee.Child = &ExternalChild{}
+			}
+			if o, err := DefaultApplyFieldMaskExternalChild(ctx, patchee.Child, patcher.Child, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"Child.", db); err != nil {
+				return nil, err
+			} else {
+				patchee.Child = o
+			}
+			continue
+		}
+		if f == prefix+"Child" {
+			updatedChild = true
+			patchee.Child = patcher.Child
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultHasFieldMaskPathPrimaryUUIDType reports whether PrimaryUUIDType has the field of the field mask path
+func DefaultHasFieldMaskPathPrimaryUUIDType(path string) bool {
+	parts := strings.SplitN(path, ".", 2)
+	switch parts[0] {
+	case "Child":
+		return len(parts) == 1 || DefaultHasFieldMaskPathExternalChild(parts[1])
+	case "Id":
+		return len(parts) == 1
+	}
+	return false
+}
+
+// DefaultListPrimaryUUIDType executes a gorm list call
+func DefaultListPrimaryUUIDType(ctx context.Context, db *gorm.DB) ([]*PrimaryUUIDType, error) {
+	in := PrimaryUUIDType{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []PrimaryUUIDTypeORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*PrimaryUUIDType{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type PrimaryUUIDTypeORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryUUIDTypeORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryUUIDTypeORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]PrimaryUUIDTypeORM) error
+}
+
+// DefaultCreatePrimaryStringType executes a basic gorm create call
+func DefaultCreatePrimaryStringType(ctx context.Context, in *PrimaryStringType, db *gorm.DB) (*PrimaryStringType, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type PrimaryStringTypeORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryStringTypeORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultCreatePrimaryStringTypeSet executes gorm create calls in batches of 100 objects
+// in a transaction, either all of the objects are created or none of them. The BeforeCreate_ hook
+// of each object runs before the insert of its batch, which uses the handle the hooks of the
+// batch return
+func DefaultCreatePrimaryStringTypeSet(ctx context.Context, in []*PrimaryStringType, db *gorm.DB) ([]*PrimaryStringType, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObjs := make([]PrimaryStringTypeORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, ormObj)
+	}
+	batchSize := 100
+	err := db.Transaction(func(db *gorm.DB) error {
+		var err error
+		for start := 0; start < len(ormObjs); start += batchSize {
+			end := start + batchSize
+			if end > len(ormObjs) {
+				end = len(ormObjs)
+			}
+			batch := ormObjs[start:end]
+			// the scopes the hooks add only apply to the insert of their own batch
+			batchDB := db
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(PrimaryStringTypeORMWithBeforeCreate_); ok {
+					if batchDB, err = hook.BeforeCreate_(ctx, batchDB); err != nil {
+						return err
+					}
+				}
+			}
+			if err = batchDB.Omit().CreateInBatches(&batch, batchSize).Error; err != nil {
+				return err
+			}
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(PrimaryStringTypeORMWithAfterCreate_); ok {
+					if err = hook.AfterCreate_(ctx, db); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*PrimaryStringType, 0, len(ormObjs))
+	for i := range ormObjs {
+		pbResponse, err := ormObjs[i].ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, &pbResponse)
+	}
+	return results, nil
+}
+
+func DefaultReadPrimaryStringType(ctx context.Context, in *PrimaryStringType, db *gorm.DB) (*PrimaryStringType, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := PrimaryStringTypeORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(PrimaryStringTypeORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type PrimaryStringTypeORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryStringTypeORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryStringTypeORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeletePrimaryStringType(ctx context.Context, in *PrimaryStringType, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == "" {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&PrimaryStringTypeORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type PrimaryStringTypeORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryStringTypeORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeletePrimaryStringTypeSet(ctx context.Context, in []*PrimaryStringType, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []string{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == "" {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&PrimaryStringTypeORM{})).(PrimaryStringTypeORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&PrimaryStringTypeORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&PrimaryStringTypeORM{})).(PrimaryStringTypeORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type PrimaryStringTypeORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*PrimaryStringType, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryStringTypeORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*PrimaryStringType, *gorm.DB) error
+}
+
+// DefaultStrictUpdatePrimaryStringType clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdatePrimaryStringType(ctx context.Context, in *PrimaryStringType, db *gorm.DB) (*PrimaryStringType, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdatePrimaryStringType")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var count int64
+	lockedRow := &PrimaryStringTypeORM{}
+	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
+	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	filterChild := ExternalChildORM{}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	filterChild.PrimaryStringTypeId = new(string)
+	*filterChild.PrimaryStringTypeId = ormObj.Id
+	if err = db.Where(filterChild).Delete(ExternalChildORM{}).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		err = gateway.SetCreated(ctx, "")
+	}
+	return &pbResponse, err
+}
+
+type PrimaryStringTypeORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryStringTypeORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryStringTypeORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultUpsertPrimaryStringType executes a gorm create call resolving conflicts on conflictTarget,
+// the name of a unique index or the primary key when empty, by updating the columns
+// in updateMask, all of them when updateMask is empty, and returns the stored object
+func DefaultUpsertPrimaryStringType(ctx context.Context, in *PrimaryStringType, conflictTarget string, updateMask *field_mask.FieldMask, db *gorm.DB) (*PrimaryStringType, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conflict := clause.OnConflict{}
+	var lookup func() map[string]interface{}
+	switch conflictTarget {
+	case "":
+		conflict.Columns = []clause.Column{{Name: "id"}}
+		lookup = func() map[string]interface{} { return map[string]interface{}{"id": ormObj.Id} }
+	default:
+		return nil, fmt.Errorf("unknown conflict target %q of PrimaryStringType", conflictTarget)
+	}
+	if len(updateMask.GetPaths()) == 0 {
+		conflict.UpdateAll = true
+	} else {
+		return nil, &errors.InvalidFieldMaskError{Path: updateMask.GetPaths()[0]}
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithBeforeUpsert); ok {
+		if db, err = hook.BeforeUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Clauses(conflict).Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if len(updateMask.GetPaths()) > 0 {
+		stored := PrimaryStringTypeORM{}
+		if err = db.Where(lookup()).Take(&stored).Error; err != nil {
+			return nil, err
+		}
+		ormObj = stored
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithAfterUpsert); ok {
+		if err = hook.AfterUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type PrimaryStringTypeORMWithBeforeUpsert interface {
+	BeforeUpsert(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryStringTypeORMWithAfterUpsert interface {
+	AfterUpsert(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchPrimaryStringType executes a basic gorm update call with patch behavior
+func DefaultPatchPrimaryStringType(ctx context.Context, in *PrimaryStringType, updateMask *field_mask.FieldMask, db *gorm.DB) (*PrimaryStringType, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	pbObj := &PrimaryStringType{}
+	var err error
+	if hook, ok := interface{}(pbObj).(PrimaryStringTypeWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if pbObj, err = DefaultReadPrimaryStringType(ctx, &PrimaryStringType{Id: in.GetId()}, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbObj).(PrimaryStringTypeWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskPrimaryStringType(ctx, pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbObj).(PrimaryStringTypeWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdatePrimaryStringType(ctx, pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(PrimaryStringTypeWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type PrimaryStringTypeWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *PrimaryStringType, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryStringTypeWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *PrimaryStringType, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryStringTypeWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *PrimaryStringType, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryStringTypeWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *PrimaryStringType, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetPrimaryStringType patches the objects in a transaction: the masks are applied in memory to
+// the stored objects, read and locked with a single query, and the objects are written with an update
+// per batch of 100 objects. The objects are read and written with the handles their hooks
+// return, the objects of different handles don't share the queries. Only the associations in the
+// masks are updated, and objects sharing a primary key are rejected
+func DefaultPatchSetPrimaryStringType(ctx context.Context, objects []*PrimaryStringType, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*PrimaryStringType, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+	if len(objects) == 0 {
+		return []*PrimaryStringType{}, nil
+	}
+	var err error
+	objectKeys := make([]string, 0, len(objects))
+	seen := make(map[string]struct{}, len(objects))
+	for _, in := range objects {
+		if in == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := in.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if ormObj.Id == "" {
+			return nil, errors.EmptyIdError
+		}
+		key := ormObj.Id
+		if _, ok := seen[key]; ok {
+			return nil, errors.DuplicateKeyError
+		}
+		seen[key] = struct{}{}
+		objectKeys = append(objectKeys, key)
+	}
+	batchSize := 100
+	results := make([]*PrimaryStringType, len(objects))
+	err = db.Transaction(func(db *gorm.DB) error {
+		var err error
+		// the objects sharing the handle their hooks return share the queries
+		dbs := make([]*gorm.DB, len(objects))
+		group := func() ([]*gorm.DB, map[*gorm.DB][]int) {
+			var handles []*gorm.DB
+			groups := make(map[*gorm.DB][]int)
+			for i, handle := range dbs {
+				if _, ok := groups[handle]; !ok {
+					handles = append(handles, handle)
+				}
+				groups[handle] = append(groups[handle], i)
+			}
+			return handles, groups
+		}
+		for i, in := range objects {
+			dbs[i] = db
+			if hook, ok := interface{}(&PrimaryStringType{}).(PrimaryStringTypeWithBeforePatchRead); ok {
+				if dbs[i], err = hook.BeforePatchRead(ctx, in, updateMasks[i], db); err != nil {
+					return err
+				}
+			}
+		}
+		targets := make(map[string]PrimaryStringTypeORM, len(objects))
+		handles, groups := group()
+		for _, handle := range handles {
+			keys := make([]string, 0, len(groups[handle]))
+			for _, i := range groups[handle] {
+				keys = append(keys, objectKeys[i])
+			}
+			query := handle.Session(&gorm.Session{}).Clauses(clause.Locking{Strength: "UPDATE"})
+			query = query.Where("id in (?)", keys)
+			rows := []PrimaryStringTypeORM{}
+			if err = query.Find(&rows).Error; err != nil {
+				return err
+			}
+			for _, row := range rows {
+				targets[row.Id] = row
+			}
+		}
+		ormObjs := make([]PrimaryStringTypeORM, len(objects))
+		prepare := func(i int) (*gorm.DB, error) {
+			in, key, updateMask, db := objects[i], objectKeys[i], updateMasks[i], dbs[i]
+			target, ok := targets[key]
+			if !ok {
+				return nil, gorm.ErrRecordNotFound
+			}
+			stored, err := target.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbObj := &stored
+			if hook, ok := interface{}(pbObj).(PrimaryStringTypeWithBeforePatchApplyFieldMask); ok {
+				if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			if _, err := DefaultApplyFieldMaskPrimaryStringType(ctx, pbObj, in, updateMask, "", db); err != nil {
+				return nil, err
+			}
+			if hook, ok := interface{}(pbObj).(PrimaryStringTypeWithBeforePatchSave); ok {
+				if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObj, err := pbObj.ToORM(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithBeforeStrictUpdateCleanup); ok {
+				if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			masked := make(map[string]bool, len(updateMask.GetPaths()))
+			for _, path := range updateMask.GetPaths() {
+				masked[strings.SplitN(path, ".", 2)[0]] = true
+			}
+			if masked["Child"] || masked["*"] {
+				filterChild := ExternalChildORM{}
+				if ormObj.Id == "" {
+					return nil, errors.EmptyIdError
+				}
+				filterChild.PrimaryStringTypeId = new(string)
+				*filterChild.PrimaryStringTypeId = ormObj.Id
+				if err = db.Where(filterChild).Delete(ExternalChildORM{}).Error; err != nil {
+					return nil, err
+				}
+			}
+			if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithBeforeStrictUpdateSave); ok {
+				if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObjs[i] = ormObj
+			return db, nil
+		}
+		for i := range objects {
+			if dbs[i], err = prepare(i); err != nil {
+				return err
+			}
+		}
+		handles, groups = group()
+		for _, handle := range handles {
+			indexes := groups[handle]
+			for start := 0; start < len(indexes); start += batchSize {
+				end := start + batchSize
+				if end > len(indexes) {
+					end = len(indexes)
+				}
+				batch := indexes[start:end]
+				objs := make([]*PrimaryStringTypeORM, 0, len(batch))
+				for _, i := range batch {
+					objs = append(objs, &ormObjs[i])
+				}
+				// the associations are saved first, the batch is then written with the
+				// foreign keys of its belongs-to associations
+				if err = handle.Session(&gorm.Session{}).Select("Child").Model(&objs).Updates(map[string]interface{}{}).Error; err != nil {
+					return err
+				}
+			}
+		}
+		finish := func(i int) (*PrimaryStringType, error) {
+			in, updateMask, db, ormObj := objects[i], updateMasks[i], dbs[i], ormObjs[i]
+			var err error
+			if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithAfterStrictUpdateSave); ok {
+				if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			saved, err := ormObj.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbResponse := &saved
+			if hook, ok := interface{}(pbResponse).(PrimaryStringTypeWithAfterPatchSave); ok {
+				if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			return pbResponse, nil
+		}
+		for i := range objects {
+			if results[i], err = finish(i); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// DefaultApplyFieldMaskPrimaryStringType patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskPrimaryStringType(ctx context.Context, patchee *PrimaryStringType, patcher *PrimaryStringType, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*PrimaryStringType, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	if len(updateMask.GetPaths()) == 1 && updateMask.Paths[0] == prefix+"*" {
+		updateMask = &field_mask.FieldMask{Paths: []string{prefix + "Id", prefix + "Child"}}
+	}
+	for _, f := range updateMask.GetPaths() {
+		if strings.HasPrefix(f, prefix) && !DefaultHasFieldMaskPathPrimaryStringType(strings.TrimPrefix(f, prefix)) {
+			return nil, &errors.InvalidFieldMaskError{Path: f}
+		}
+	}
+	var err error
+	var updatedChild bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
 			continue
 		}
-		if !updatedCustomDeletedAt && strings.HasPrefix(f, prefix+"CustomDeletedAt.") {
-			if patcher.CustomDeletedAt == nil {
-				patchee.CustomDeletedAt = nil
+		if !updatedChild && strings.HasPrefix(f, prefix+"Child.") {
+			updatedChild = true
+			if patcher.Child == nil {
+				patchee.Child = nil
 				continue
 			}
-			if patchee.CustomDeletedAt == nil {
-				patchee.CustomDeletedAt = &timestamppb.Timestamp{}
-			}
-			childMask := &field_mask.FieldMask{}
-			for j := i; j < len(updateMask.Paths); j++ {
-				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CustomDeletedAt."); trimPath != updateMask.Paths[j] {
-					childMask.Paths = append(childMask.Paths, trimPath)
-				}
+			if patchee.Child == nil {
+				patchee.Child = &ExternalChild{}
 			}
-			if err := gorm1.MergeWithMask(patcher.CustomDeletedAt, patchee.CustomDeletedAt, childMask); err != nil {
-				return nil, nil
+			if o, err := DefaultApplyFieldMaskExternalChild(ctx, patchee.Child, patcher.Child, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"Child.", db); err != nil {
+				return nil, err
+			} else {
+				patchee.Child = o
 			}
+			continue
 		}
-		if f == prefix+"CustomDeletedAt" {
-			updatedCustomDeletedAt = true
-			patchee.CustomDeletedAt = patcher.CustomDeletedAt
+		if f == prefix+"Child" {
+			updatedChild = true
+			patchee.Child = patcher.Child
 			continue
 		}
 	}
@@ -1507,34 +5998,47 @@ func DefaultApplyFieldMaskTestTypes(ctx context.Context, patchee *TestTypes, pat
 	return patchee, nil
 }
 
-// DefaultListTestTypes executes a gorm list call
-func DefaultListTestTypes(ctx context.Context, db *gorm.DB) ([]*TestTypes, error) {
-	in := TestTypes{}
+// DefaultHasFieldMaskPathPrimaryStringType reports whether PrimaryStringType has the field of the field mask path
+func DefaultHasFieldMaskPathPrimaryStringType(path string) bool {
+	parts := strings.SplitN(path, ".", 2)
+	switch parts[0] {
+	case "Child":
+		return len(parts) == 1 || DefaultHasFieldMaskPathExternalChild(parts[1])
+	case "Id":
+		return len(parts) == 1
+	}
+	return false
+}
+
+// DefaultListPrimaryStringType executes a gorm list call
+func DefaultListPrimaryStringType(ctx context.Context, db *gorm.DB) ([]*PrimaryStringType, error) {
+	in := PrimaryStringType{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestTypesORMWithBeforeListApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithBeforeListApplyQuery); ok {
 		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if hook, ok := interface{}(&ormObj).(TestTypesORMWithBeforeListFind); ok {
+	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithBeforeListFind); ok {
 		if db, err = hook.BeforeListFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
 	db = db.Where(&ormObj)
-	ormResponse := []TestTypesORM{}
+	db = db.Order("id")
+	ormResponse := []PrimaryStringTypeORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestTypesORMWithAfterListFind); ok {
+	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithAfterListFind); ok {
 		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse := []*TestTypes{}
+	pbResponse := []*PrimaryStringType{}
 	for _, responseEntry := range ormResponse {
 		temp, err := responseEntry.ToPB(ctx)
 		if err != nil {
@@ -1545,18 +6049,18 @@ func DefaultListTestTypes(ctx context.Context, db *gorm.DB) ([]*TestTypes, error
 	return pbResponse, nil
 }
 
-type TestTypesORMWithBeforeListApplyQuery interface {
+type PrimaryStringTypeORMWithBeforeListApplyQuery interface {
 	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestTypesORMWithBeforeListFind interface {
+type PrimaryStringTypeORMWithBeforeListFind interface {
 	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestTypesORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]TestTypesORM) error
+type PrimaryStringTypeORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]PrimaryStringTypeORM) error
 }
 
-// DefaultCreateTypeWithID executes a basic gorm create call
-func DefaultCreateTypeWithID(ctx context.Context, in *TypeWithID, db *gorm.DB) (*TypeWithID, error) {
+// DefaultCreateTestTag executes a basic gorm create call
+func DefaultCreateTestTag(ctx context.Context, in *TestTag, db *gorm.DB) (*TestTag, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -1564,15 +6068,15 @@ func DefaultCreateTypeWithID(ctx context.Context, in *TypeWithID, db *gorm.DB) (
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithBeforeCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(TestTagORMWithBeforeCreate_); ok {
 		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if err = db.Omit("Emails").Preload("Emails").Create(&ormObj).Error; err != nil {
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithAfterCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(TestTagORMWithAfterCreate_); ok {
 		if err = hook.AfterCreate_(ctx, db); err != nil {
 			return nil, err
 		}
@@ -1581,14 +6085,78 @@ func DefaultCreateTypeWithID(ctx context.Context, in *TypeWithID, db *gorm.DB) (
 	return &pbResponse, err
 }
 
-type TypeWithIDORMWithBeforeCreate_ interface {
+type TestTagORMWithBeforeCreate_ interface {
 	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TypeWithIDORMWithAfterCreate_ interface {
+type TestTagORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm.DB) error
 }
 
-func DefaultReadTypeWithID(ctx context.Context, in *TypeWithID, db *gorm.DB) (*TypeWithID, error) {
+// DefaultCreateTestTagSet executes gorm create calls in batches of 100 objects
+// in a transaction, either all of the objects are created or none of them. The BeforeCreate_ hook
+// of each object runs before the insert of its batch, which uses the handle the hooks of the
+// batch return
+func DefaultCreateTestTagSet(ctx context.Context, in []*TestTag, db *gorm.DB) ([]*TestTag, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObjs := make([]TestTagORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, ormObj)
+	}
+	batchSize := 100
+	err := db.Transaction(func(db *gorm.DB) error {
+		var err error
+		for start := 0; start < len(ormObjs); start += batchSize {
+			end := start + batchSize
+			if end > len(ormObjs) {
+				end = len(ormObjs)
+			}
+			batch := ormObjs[start:end]
+			// the scopes the hooks add only apply to the insert of their own batch
+			batchDB := db
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(TestTagORMWithBeforeCreate_); ok {
+					if batchDB, err = hook.BeforeCreate_(ctx, batchDB); err != nil {
+						return err
+					}
+				}
+			}
+			if err = batchDB.Omit().CreateInBatches(&batch, batchSize).Error; err != nil {
+				return err
+			}
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(TestTagORMWithAfterCreate_); ok {
+					if err = hook.AfterCreate_(ctx, db); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*TestTag, 0, len(ormObjs))
+	for i := range ormObjs {
+		pbResponse, err := ormObjs[i].ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, &pbResponse)
+	}
+	return results, nil
+}
+
+func DefaultReadTestTag(ctx context.Context, in *TestTag, db *gorm.DB) (*TestTag, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -1596,24 +6164,24 @@ func DefaultReadTypeWithID(ctx context.Context, in *TypeWithID, db *gorm.DB) (*T
 	if err != nil {
 		return nil, err
 	}
-	if ormObj.Id == 0 {
+	if ormObj.Id == "" {
 		return nil, errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithBeforeReadApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(TestTagORMWithBeforeReadApplyQuery); ok {
 		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithBeforeReadFind); ok {
+	if hook, ok := interface{}(&ormObj).(TestTagORMWithBeforeReadFind); ok {
 		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	ormResponse := TypeWithIDORM{}
+	ormResponse := TestTagORM{}
 	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormResponse).(TypeWithIDORMWithAfterReadFind); ok {
+	if hook, ok := interface{}(&ormResponse).(TestTagORMWithAfterReadFind); ok {
 		if err = hook.AfterReadFind(ctx, db); err != nil {
 			return nil, err
 		}
@@ -1622,17 +6190,17 @@ func DefaultReadTypeWithID(ctx context.Context, in *TypeWithID, db *gorm.DB) (*T
 	return &pbResponse, err
 }
 
-type TypeWithIDORMWithBeforeReadApplyQuery interface {
+type TestTagORMWithBeforeReadApplyQuery interface {
 	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TypeWithIDORMWithBeforeReadFind interface {
+type TestTagORMWithBeforeReadFind interface {
 	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TypeWithIDORMWithAfterReadFind interface {
+type TestTagORMWithAfterReadFind interface {
 	AfterReadFind(context.Context, *gorm.DB) error
 }
 
-func DefaultDeleteTypeWithID(ctx context.Context, in *TypeWithID, db *gorm.DB) error {
+func DefaultDeleteTestTag(ctx context.Context, in *TestTag, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
@@ -1640,113 +6208,104 @@ func DefaultDeleteTypeWithID(ctx context.Context, in *TypeWithID, db *gorm.DB) e
 	if err != nil {
 		return err
 	}
-	if ormObj.Id == 0 {
+	if ormObj.Id == "" {
 		return errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithBeforeDelete_); ok {
+	if hook, ok := interface{}(&ormObj).(TestTagORMWithBeforeDelete_); ok {
 		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where(&ormObj).Delete(&TypeWithIDORM{}).Error
+	err = db.Where(&ormObj).Delete(&TestTagORM{}).Error
 	if err != nil {
 		return err
 	}
-	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithAfterDelete_); ok {
+	if hook, ok := interface{}(&ormObj).(TestTagORMWithAfterDelete_); ok {
 		err = hook.AfterDelete_(ctx, db)
 	}
 	return err
 }
 
-type TypeWithIDORMWithBeforeDelete_ interface {
+type TestTagORMWithBeforeDelete_ interface {
 	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TypeWithIDORMWithAfterDelete_ interface {
+type TestTagORMWithAfterDelete_ interface {
 	AfterDelete_(context.Context, *gorm.DB) error
 }
 
-func DefaultDeleteTypeWithIDSet(ctx context.Context, in []*TypeWithID, db *gorm.DB) error {
+func DefaultDeleteTestTagSet(ctx context.Context, in []*TestTag, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
 	var err error
-	keys := []uint32{}
+	keys := []string{}
 	for _, obj := range in {
 		ormObj, err := obj.ToORM(ctx)
 		if err != nil {
 			return err
 		}
-		if ormObj.Id == 0 {
+		if ormObj.Id == "" {
 			return errors.EmptyIdError
 		}
 		keys = append(keys, ormObj.Id)
 	}
-	if hook, ok := (interface{}(&TypeWithIDORM{})).(TypeWithIDORMWithBeforeDeleteSet); ok {
+	if hook, ok := (interface{}(&TestTagORM{})).(TestTagORMWithBeforeDeleteSet); ok {
 		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where("id in (?)", keys).Delete(&TypeWithIDORM{}).Error
+	err = db.Where("id in (?)", keys).Delete(&TestTagORM{}).Error
 	if err != nil {
 		return err
 	}
-	if hook, ok := (interface{}(&TypeWithIDORM{})).(TypeWithIDORMWithAfterDeleteSet); ok {
+	if hook, ok := (interface{}(&TestTagORM{})).(TestTagORMWithAfterDeleteSet); ok {
 		err = hook.AfterDeleteSet(ctx, in, db)
 	}
 	return err
 }
 
-type TypeWithIDORMWithBeforeDeleteSet interface {
-	BeforeDeleteSet(context.Context, []*TypeWithID, *gorm.DB) (*gorm.DB, error)
+type TestTagORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*TestTag, *gorm.DB) (*gorm.DB, error)
 }
-type TypeWithIDORMWithAfterDeleteSet interface {
-	AfterDeleteSet(context.Context, []*TypeWithID, *gorm.DB) error
+type TestTagORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*TestTag, *gorm.DB) error
 }
 
-// DefaultStrictUpdateTypeWithID clears / replaces / appends first level 1:many children and then executes a gorm update call
-func DefaultStrictUpdateTypeWithID(ctx context.Context, in *TypeWithID, db *gorm.DB) (*TypeWithID, error) {
+// DefaultStrictUpdateTestTag clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateTestTag(ctx context.Context, in *TestTag, db *gorm.DB) (*TestTag, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateTypeWithID")
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateTestTag")
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
 	var count int64
-	lockedRow := &TypeWithIDORM{}
+	lockedRow := &TestTagORM{}
 	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
-	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithBeforeStrictUpdateCleanup); ok {
+	if hook, ok := interface{}(&ormObj).(TestTagORMWithBeforeStrictUpdateCleanup); ok {
 		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	filterANestedObject := TestTypesORM{}
-	if ormObj.Id == 0 {
-		return nil, errors.EmptyIdError
-	}
-	filterANestedObject.ANestedObjectTypeWithIDId = new(uint32)
-	*filterANestedObject.ANestedObjectTypeWithIDId = ormObj.Id
-	if err = db.Where(filterANestedObject).Delete(TestTypesORM{}).Error; err != nil {
-		return nil, err
-	}
-	filterThings := TestTypesORM{}
-	if ormObj.Id == 0 {
+	filterTestTagAssoc := TestTagAssociationORM{}
+	if ormObj.Id == "" {
 		return nil, errors.EmptyIdError
 	}
-	filterThings.ThingsTypeWithIDId = new(uint32)
-	*filterThings.ThingsTypeWithIDId = ormObj.Id
-	if err = db.Where(filterThings).Delete(TestTypesORM{}).Error; err != nil {
+	filterTestTagAssoc.TestTagId = new(string)
+	*filterTestTagAssoc.TestTagId = ormObj.Id
+	if err = db.Where(filterTestTagAssoc).Delete(TestTagAssociationORM{}).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithBeforeStrictUpdateSave); ok {
+	if hook, ok := interface{}(&ormObj).(TestTagORMWithBeforeStrictUpdateSave); ok {
 		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if err = db.Omit("Emails").Preload("Emails").Save(&ormObj).Error; err != nil {
+	if err = db.Omit().Save(&ormObj).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithAfterStrictUpdateSave); ok {
+	if hook, ok := interface{}(&ormObj).(TestTagORMWithAfterStrictUpdateSave); ok {
 		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
@@ -1761,51 +6320,105 @@ func DefaultStrictUpdateTypeWithID(ctx context.Context, in *TypeWithID, db *gorm
 	return &pbResponse, err
 }
 
-type TypeWithIDORMWithBeforeStrictUpdateCleanup interface {
+type TestTagORMWithBeforeStrictUpdateCleanup interface {
 	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TypeWithIDORMWithBeforeStrictUpdateSave interface {
+type TestTagORMWithBeforeStrictUpdateSave interface {
 	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TypeWithIDORMWithAfterStrictUpdateSave interface {
+type TestTagORMWithAfterStrictUpdateSave interface {
 	AfterStrictUpdateSave(context.Context, *gorm.DB) error
 }
 
-// DefaultPatchTypeWithID executes a basic gorm update call with patch behavior
-func DefaultPatchTypeWithID(ctx context.Context, in *TypeWithID, updateMask *field_mask.FieldMask, db *gorm.DB) (*TypeWithID, error) {
+// DefaultUpsertTestTag executes a gorm create call resolving conflicts on conflictTarget,
+// the name of a unique index or the primary key when empty, by updating the columns
+// in updateMask, all of them when updateMask is empty, and returns the stored object
+func DefaultUpsertTestTag(ctx context.Context, in *TestTag, conflictTarget string, updateMask *field_mask.FieldMask, db *gorm.DB) (*TestTag, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conflict := clause.OnConflict{}
+	var lookup func() map[string]interface{}
+	switch conflictTarget {
+	case "":
+		conflict.Columns = []clause.Column{{Name: "id"}}
+		lookup = func() map[string]interface{} { return map[string]interface{}{"id": ormObj.Id} }
+	default:
+		return nil, fmt.Errorf("unknown conflict target %q of TestTag", conflictTarget)
+	}
+	if len(updateMask.GetPaths()) == 0 {
+		conflict.UpdateAll = true
+	} else {
+		return nil, &errors.InvalidFieldMaskError{Path: updateMask.GetPaths()[0]}
+	}
+	if hook, ok := interface{}(&ormObj).(TestTagORMWithBeforeUpsert); ok {
+		if db, err = hook.BeforeUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Clauses(conflict).Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if len(updateMask.GetPaths()) > 0 {
+		stored := TestTagORM{}
+		if err = db.Where(lookup()).Take(&stored).Error; err != nil {
+			return nil, err
+		}
+		ormObj = stored
+	}
+	if hook, ok := interface{}(&ormObj).(TestTagORMWithAfterUpsert); ok {
+		if err = hook.AfterUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type TestTagORMWithBeforeUpsert interface {
+	BeforeUpsert(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestTagORMWithAfterUpsert interface {
+	AfterUpsert(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchTestTag executes a basic gorm update call with patch behavior
+func DefaultPatchTestTag(ctx context.Context, in *TestTag, updateMask *field_mask.FieldMask, db *gorm.DB) (*TestTag, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	var pbObj TypeWithID
+	pbObj := &TestTag{}
 	var err error
-	if hook, ok := interface{}(&pbObj).(TypeWithIDWithBeforePatchRead); ok {
+	if hook, ok := interface{}(pbObj).(TestTagWithBeforePatchRead); ok {
 		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbReadRes, err := DefaultReadTypeWithID(ctx, &TypeWithID{Id: in.GetId()}, db)
-	if err != nil {
+	if pbObj, err = DefaultReadTestTag(ctx, &TestTag{Id: in.GetId()}, db); err != nil {
 		return nil, err
 	}
-	pbObj = *pbReadRes
-	if hook, ok := interface{}(&pbObj).(TypeWithIDWithBeforePatchApplyFieldMask); ok {
+	if hook, ok := interface{}(pbObj).(TestTagWithBeforePatchApplyFieldMask); ok {
 		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	if _, err := DefaultApplyFieldMaskTypeWithID(ctx, &pbObj, in, updateMask, "", db); err != nil {
+	if _, err := DefaultApplyFieldMaskTestTag(ctx, pbObj, in, updateMask, "", db); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&pbObj).(TypeWithIDWithBeforePatchSave); ok {
+	if hook, ok := interface{}(pbObj).(TestTagWithBeforePatchSave); ok {
 		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse, err := DefaultStrictUpdateTypeWithID(ctx, &pbObj, db)
+	pbResponse, err := DefaultStrictUpdateTestTag(ctx, pbObj, db)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(pbResponse).(TypeWithIDWithAfterPatchSave); ok {
+	if hook, ok := interface{}(pbResponse).(TestTagWithAfterPatchSave); ok {
 		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
@@ -1813,243 +6426,250 @@ func DefaultPatchTypeWithID(ctx context.Context, in *TypeWithID, updateMask *fie
 	return pbResponse, nil
 }
 
-type TypeWithIDWithBeforePatchRead interface {
-	BeforePatchRead(context.Context, *TypeWithID, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type TestTagWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *TestTag, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type TypeWithIDWithBeforePatchApplyFieldMask interface {
-	BeforePatchApplyFieldMask(context.Context, *TypeWithID, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type TestTagWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *TestTag, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type TypeWithIDWithBeforePatchSave interface {
-	BeforePatchSave(context.Context, *TypeWithID, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type TestTagWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *TestTag, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type TypeWithIDWithAfterPatchSave interface {
-	AfterPatchSave(context.Context, *TypeWithID, *field_mask.FieldMask, *gorm.DB) error
+type TestTagWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *TestTag, *field_mask.FieldMask, *gorm.DB) error
 }
 
-// DefaultPatchSetTypeWithID executes a bulk gorm update call with patch behavior
-func DefaultPatchSetTypeWithID(ctx context.Context, objects []*TypeWithID, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*TypeWithID, error) {
+// DefaultPatchSetTestTag patches the objects in a transaction: the masks are applied in memory to
+// the stored objects, read and locked with a single query, and the objects are written with an update
+// per batch of 100 objects. The objects are read and written with the handles their hooks
+// return, the objects of different handles don't share the queries. Only the associations in the
+// masks are updated, and objects sharing a primary key are rejected
+func DefaultPatchSetTestTag(ctx context.Context, objects []*TestTag, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*TestTag, error) {
 	if len(objects) != len(updateMasks) {
 		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
 	}
-
-	results := make([]*TypeWithID, 0, len(objects))
-	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchTypeWithID(ctx, patcher, updateMasks[i], db)
-		if err != nil {
-			return nil, err
-		}
-
-		results = append(results, pbResponse)
-	}
-
-	return results, nil
-}
-
-// DefaultApplyFieldMaskTypeWithID patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskTypeWithID(ctx context.Context, patchee *TypeWithID, patcher *TypeWithID, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*TypeWithID, error) {
-	if patcher == nil {
-		return nil, nil
-	} else if patchee == nil {
-		return nil, errors.NilArgumentError
+	if len(objects) == 0 {
+		return []*TestTag{}, nil
 	}
 	var err error
-	var updatedANestedObject bool
-	var updatedPoint bool
-	var updatedUser bool
-	var updatedSyntheticField bool
-	var updatedFloatField bool
-	var updatedDoubleField bool
-	var updatedDeletedAt bool
-	for i, f := range updateMask.Paths {
-		if f == prefix+"Id" {
-			patchee.Id = patcher.Id
-			continue
-		}
-		if f == prefix+"Ip" {
-			patchee.Ip = patcher.Ip
-			continue
+	objectKeys := make([]string, 0, len(objects))
+	seen := make(map[string]struct{}, len(objects))
+	for _, in := range objects {
+		if in == nil {
+			return nil, errors.NilArgumentError
 		}
-		if f == prefix+"Things" {
-			patchee.Things = patcher.Things
-			continue
+		ormObj, err := in.ToORM(ctx)
+		if err != nil {
+			return nil, err
 		}
-		if !updatedANestedObject && strings.HasPrefix(f, prefix+"ANestedObject.") {
-			updatedANestedObject = true
-			if patcher.ANestedObject == nil {
-				patchee.ANestedObject = nil
-				continue
-			}
-			if patchee.ANestedObject == nil {
-				patchee.ANestedObject = &TestTypes{}
-			}
-			if o, err := DefaultApplyFieldMaskTestTypes(ctx, patchee.ANestedObject, patcher.ANestedObject, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"ANestedObject.", db); err != nil {
-				return nil, err
-			} else {
-				patchee.ANestedObject = o
+		if ormObj.Id == "" {
+			return nil, errors.EmptyIdError
+		}
+		key := ormObj.Id
+		if _, ok := seen[key]; ok {
+			return nil, errors.DuplicateKeyError
+		}
+		seen[key] = struct{}{}
+		objectKeys = append(objectKeys, key)
+	}
+	batchSize := 100
+	results := make([]*TestTag, len(objects))
+	err = db.Transaction(func(db *gorm.DB) error {
+		var err error
+		// the objects sharing the handle their hooks return share the queries
+		dbs := make([]*gorm.DB, len(objects))
+		group := func() ([]*gorm.DB, map[*gorm.DB][]int) {
+			var handles []*gorm.DB
+			groups := make(map[*gorm.DB][]int)
+			for i, handle := range dbs {
+				if _, ok := groups[handle]; !ok {
+					handles = append(handles, handle)
+				}
+				groups[handle] = append(groups[handle], i)
 			}
-			continue
+			return handles, groups
 		}
-		if f == prefix+"ANestedObject" {
-			updatedANestedObject = true
-			patchee.ANestedObject = patcher.ANestedObject
-			continue
+		for i, in := range objects {
+			dbs[i] = db
+			if hook, ok := interface{}(&TestTag{}).(TestTagWithBeforePatchRead); ok {
+				if dbs[i], err = hook.BeforePatchRead(ctx, in, updateMasks[i], db); err != nil {
+					return err
+				}
+			}
 		}
-		if !updatedPoint && strings.HasPrefix(f, prefix+"Point.") {
-			updatedPoint = true
-			if patcher.Point == nil {
-				patchee.Point = nil
-				continue
+		targets := make(map[string]TestTagORM, len(objects))
+		handles, groups := group()
+		for _, handle := range handles {
+			keys := make([]string, 0, len(groups[handle]))
+			for _, i := range groups[handle] {
+				keys = append(keys, objectKeys[i])
 			}
-			if patchee.Point == nil {
-				patchee.Point = &IntPoint{}
+			query := handle.Session(&gorm.Session{}).Clauses(clause.Locking{Strength: "UPDATE"})
+			query = query.Where("id in (?)", keys)
+			rows := []TestTagORM{}
+			if err = query.Find(&rows).Error; err != nil {
+				return err
 			}
-			if o, err := DefaultApplyFieldMaskIntPoint(ctx, patchee.Point, patcher.Point, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"Point.", db); err != nil {
-				return nil, err
-			} else {
-				patchee.Point = o
+			for _, row := range rows {
+				targets[row.Id] = row
 			}
-			continue
-		}
-		if f == prefix+"Point" {
-			updatedPoint = true
-			patchee.Point = patcher.Point
-			continue
 		}
-		if !updatedUser && strings.HasPrefix(f, prefix+"User.") {
-			updatedUser = true
-			if patcher.User == nil {
-				patchee.User = nil
-				continue
-			}
-			if patchee.User == nil {
-				patchee.User = &user.User{}
+		ormObjs := make([]TestTagORM, len(objects))
+		prepare := func(i int) (*gorm.DB, error) {
+			in, key, updateMask, db := objects[i], objectKeys[i], updateMasks[i], dbs[i]
+			target, ok := targets[key]
+			if !ok {
+				return nil, gorm.ErrRecordNotFound
 			}
-			if o, err := user.DefaultApplyFieldMaskUser(ctx, patchee.User, patcher.User, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"User.", db); err != nil {
+			stored, err := target.ToPB(ctx)
+			if err != nil {
 				return nil, err
-			} else {
-				patchee.User = o
 			}
-			continue
-		}
-		if f == prefix+"User" {
-			updatedUser = true
-			patchee.User = patcher.User
-			continue
-		}
-		if f == prefix+"Address" {
-			patchee.Address = patcher.Address
-			continue
-		}
-		if f == prefix+"MultiaccountTypeIds" {
-			patchee.MultiaccountTypeIds = patcher.MultiaccountTypeIds
-			continue
-		}
-		if !updatedSyntheticField && strings.HasPrefix(f, prefix+"SyntheticField.") {
-			if patcher.SyntheticField == nil {
-				patchee.SyntheticField = nil
-				continue
+			pbObj := &stored
+			if hook, ok := interface{}(pbObj).(TestTagWithBeforePatchApplyFieldMask); ok {
+				if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
 			}
-			if patchee.SyntheticField == nil {
-				patchee.SyntheticField = &APIOnlyType{}
+			if _, err := DefaultApplyFieldMaskTestTag(ctx, pbObj, in, updateMask, "", db); err != nil {
+				return nil, err
 			}
-			childMask := &field_mask.FieldMask{}
-			for j := i; j < len(updateMask.Paths); j++ {
-				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"SyntheticField."); trimPath != updateMask.Paths[j] {
-					childMask.Paths = append(childMask.Paths, trimPath)
+			if hook, ok := interface{}(pbObj).(TestTagWithBeforePatchSave); ok {
+				if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
 				}
 			}
-			if err := gorm1.MergeWithMask(patcher.SyntheticField, patchee.SyntheticField, childMask); err != nil {
-				return nil, nil
+			ormObj, err := pbObj.ToORM(ctx)
+			if err != nil {
+				return nil, err
 			}
-		}
-		if f == prefix+"SyntheticField" {
-			updatedSyntheticField = true
-			patchee.SyntheticField = patcher.SyntheticField
-			continue
-		}
-		if f == prefix+"TagTest" {
-			patchee.TagTest = patcher.TagTest
-			continue
-		}
-		if f == prefix+"TagSizeTest" {
-			patchee.TagSizeTest = patcher.TagSizeTest
-			continue
-		}
-		if !updatedFloatField && strings.HasPrefix(f, prefix+"FloatField.") {
-			if patcher.FloatField == nil {
-				patchee.FloatField = nil
-				continue
+			if hook, ok := interface{}(&ormObj).(TestTagORMWithBeforeStrictUpdateCleanup); ok {
+				if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+					return nil, err
+				}
 			}
-			if patchee.FloatField == nil {
-				patchee.FloatField = &wrapperspb.FloatValue{}
+			masked := make(map[string]bool, len(updateMask.GetPaths()))
+			for _, path := range updateMask.GetPaths() {
+				masked[strings.SplitN(path, ".", 2)[0]] = true
 			}
-			childMask := &field_mask.FieldMask{}
-			for j := i; j < len(updateMask.Paths); j++ {
-				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"FloatField."); trimPath != updateMask.Paths[j] {
-					childMask.Paths = append(childMask.Paths, trimPath)
+			if masked["TestTagAssoc"] || masked["*"] {
+				filterTestTagAssoc := TestTagAssociationORM{}
+				if ormObj.Id == "" {
+					return nil, errors.EmptyIdError
+				}
+				filterTestTagAssoc.TestTagId = new(string)
+				*filterTestTagAssoc.TestTagId = ormObj.Id
+				if err = db.Where(filterTestTagAssoc).Delete(TestTagAssociationORM{}).Error; err != nil {
+					return nil, err
 				}
 			}
-			if err := gorm1.MergeWithMask(patcher.FloatField, patchee.FloatField, childMask); err != nil {
-				return nil, nil
+			if hook, ok := interface{}(&ormObj).(TestTagORMWithBeforeStrictUpdateSave); ok {
+				if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
 			}
+			ormObjs[i] = ormObj
+			return db, nil
 		}
-		if f == prefix+"FloatField" {
-			updatedFloatField = true
-			patchee.FloatField = patcher.FloatField
-			continue
+		for i := range objects {
+			if dbs[i], err = prepare(i); err != nil {
+				return err
+			}
 		}
-		if !updatedDoubleField && strings.HasPrefix(f, prefix+"DoubleField.") {
-			if patcher.DoubleField == nil {
-				patchee.DoubleField = nil
-				continue
+		handles, groups = group()
+		for _, handle := range handles {
+			indexes := groups[handle]
+			for start := 0; start < len(indexes); start += batchSize {
+				end := start + batchSize
+				if end > len(indexes) {
+					end = len(indexes)
+				}
+				batch := indexes[start:end]
+				objs := make([]*TestTagORM, 0, len(batch))
+				for _, i := range batch {
+					objs = append(objs, &ormObjs[i])
+				}
+				// the associations are saved first, the batch is then written with the
+				// foreign keys of its belongs-to associations
+				if err = handle.Session(&gorm.Session{}).Select("TestTagAssoc").Model(&objs).Updates(map[string]interface{}{}).Error; err != nil {
+					return err
+				}
 			}
-			if patchee.DoubleField == nil {
-				patchee.DoubleField = &wrapperspb.DoubleValue{}
+		}
+		finish := func(i int) (*TestTag, error) {
+			in, updateMask, db, ormObj := objects[i], updateMasks[i], dbs[i], ormObjs[i]
+			var err error
+			if hook, ok := interface{}(&ormObj).(TestTagORMWithAfterStrictUpdateSave); ok {
+				if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
 			}
-			childMask := &field_mask.FieldMask{}
-			for j := i; j < len(updateMask.Paths); j++ {
-				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"DoubleField."); trimPath != updateMask.Paths[j] {
-					childMask.Paths = append(childMask.Paths, trimPath)
+			saved, err := ormObj.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbResponse := &saved
+			if hook, ok := interface{}(pbResponse).(TestTagWithAfterPatchSave); ok {
+				if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
 				}
 			}
-			if err := gorm1.MergeWithMask(patcher.DoubleField, patchee.DoubleField, childMask); err != nil {
-				return nil, nil
+			return pbResponse, nil
+		}
+		for i := range objects {
+			if results[i], err = finish(i); err != nil {
+				return err
 			}
 		}
-		if f == prefix+"DoubleField" {
-			updatedDoubleField = true
-			patchee.DoubleField = patcher.DoubleField
-			continue
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// DefaultApplyFieldMaskTestTag patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskTestTag(ctx context.Context, patchee *TestTag, patcher *TestTag, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*TestTag, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	if len(updateMask.GetPaths()) == 1 && updateMask.Paths[0] == prefix+"*" {
+		updateMask = &field_mask.FieldMask{Paths: []string{prefix + "Id", prefix + "TestTagAssoc"}}
+	}
+	for _, f := range updateMask.GetPaths() {
+		if strings.HasPrefix(f, prefix) && !DefaultHasFieldMaskPathTestTag(strings.TrimPrefix(f, prefix)) {
+			return nil, &errors.InvalidFieldMaskError{Path: f}
 		}
-		if f == prefix+"TimeOnly" {
-			patchee.TimeOnly = patcher.TimeOnly
+	}
+	var err error
+	var updatedTestTagAssoc bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
 			continue
 		}
-		if !updatedDeletedAt && strings.HasPrefix(f, prefix+"DeletedAt.") {
-			if patcher.DeletedAt == nil {
-				patchee.DeletedAt = nil
+		if !updatedTestTagAssoc && strings.HasPrefix(f, prefix+"TestTagAssoc.") {
+			updatedTestTagAssoc = true
+			if patcher.TestTagAssoc == nil {
+				patchee.TestTagAssoc = nil
 				continue
 			}
-			if patchee.DeletedAt == nil {
-				patchee.DeletedAt = &timestamppb.Timestamp{}
-			}
-			childMask := &field_mask.FieldMask{}
-			for j := i; j < len(updateMask.Paths); j++ {
-				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"DeletedAt."); trimPath != updateMask.Paths[j] {
-					childMask.Paths = append(childMask.Paths, trimPath)
-				}
+			if patchee.TestTagAssoc == nil {
+				patchee.TestTagAssoc = &TestTagAssociation{}
 			}
-			if err := gorm1.MergeWithMask(patcher.DeletedAt, patchee.DeletedAt, childMask); err != nil {
-				return nil, nil
+			if o, err := DefaultApplyFieldMaskTestTagAssociation(ctx, patchee.TestTagAssoc, patcher.TestTagAssoc, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"TestTagAssoc.", db); err != nil {
+				return nil, err
+			} else {
+				patchee.TestTagAssoc = o
 			}
-		}
-		if f == prefix+"DeletedAt" {
-			updatedDeletedAt = true
-			patchee.DeletedAt = patcher.DeletedAt
 			continue
 		}
-		if f == prefix+"Metadata" {
-			patchee.Metadata = patcher.Metadata
+		if f == prefix+"TestTagAssoc" {
+			updatedTestTagAssoc = true
+			patchee.TestTagAssoc = patcher.TestTagAssoc
 			continue
 		}
 	}
@@ -2059,35 +6679,47 @@ func DefaultApplyFieldMaskTypeWithID(ctx context.Context, patchee *TypeWithID, p
 	return patchee, nil
 }
 
-// DefaultListTypeWithID executes a gorm list call
-func DefaultListTypeWithID(ctx context.Context, db *gorm.DB) ([]*TypeWithID, error) {
-	in := TypeWithID{}
+// DefaultHasFieldMaskPathTestTag reports whether TestTag has the field of the field mask path
+func DefaultHasFieldMaskPathTestTag(path string) bool {
+	parts := strings.SplitN(path, ".", 2)
+	switch parts[0] {
+	case "TestTagAssoc":
+		return len(parts) == 1 || DefaultHasFieldMaskPathTestTagAssociation(parts[1])
+	case "Id":
+		return len(parts) == 1
+	}
+	return false
+}
+
+// DefaultListTestTag executes a gorm list call
+func DefaultListTestTag(ctx context.Context, db *gorm.DB) ([]*TestTag, error) {
+	in := TestTag{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithBeforeListApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(TestTagORMWithBeforeListApplyQuery); ok {
 		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithBeforeListFind); ok {
+	if hook, ok := interface{}(&ormObj).(TestTagORMWithBeforeListFind); ok {
 		if db, err = hook.BeforeListFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
 	db = db.Where(&ormObj)
 	db = db.Order("id")
-	ormResponse := []TypeWithIDORM{}
+	ormResponse := []TestTagORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithAfterListFind); ok {
+	if hook, ok := interface{}(&ormObj).(TestTagORMWithAfterListFind); ok {
 		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse := []*TypeWithID{}
+	pbResponse := []*TestTag{}
 	for _, responseEntry := range ormResponse {
 		temp, err := responseEntry.ToPB(ctx)
 		if err != nil {
@@ -2098,18 +6730,18 @@ func DefaultListTypeWithID(ctx context.Context, db *gorm.DB) ([]*TypeWithID, err
 	return pbResponse, nil
 }
 
-type TypeWithIDORMWithBeforeListApplyQuery interface {
+type TestTagORMWithBeforeListApplyQuery interface {
 	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TypeWithIDORMWithBeforeListFind interface {
+type TestTagORMWithBeforeListFind interface {
 	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TypeWithIDORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]TypeWithIDORM) error
+type TestTagORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]TestTagORM) error
 }
 
-// DefaultCreateMultiaccountTypeWithID executes a basic gorm create call
-func DefaultCreateMultiaccountTypeWithID(ctx context.Context, in *MultiaccountTypeWithID, db *gorm.DB) (*MultiaccountTypeWithID, error) {
+// DefaultCreateTestAssocHandlerDefault executes a basic gorm create call
+func DefaultCreateTestAssocHandlerDefault(ctx context.Context, in *TestAssocHandlerDefault, db *gorm.DB) (*TestAssocHandlerDefault, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -2117,7 +6749,7 @@ func DefaultCreateMultiaccountTypeWithID(ctx context.Context, in *MultiaccountTy
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithBeforeCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithBeforeCreate_); ok {
 		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
 			return nil, err
 		}
@@ -2125,7 +6757,7 @@ func DefaultCreateMultiaccountTypeWithID(ctx context.Context, in *MultiaccountTy
 	if err = db.Omit().Create(&ormObj).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithAfterCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithAfterCreate_); ok {
 		if err = hook.AfterCreate_(ctx, db); err != nil {
 			return nil, err
 		}
@@ -2134,14 +6766,78 @@ func DefaultCreateMultiaccountTypeWithID(ctx context.Context, in *MultiaccountTy
 	return &pbResponse, err
 }
 
-type MultiaccountTypeWithIDORMWithBeforeCreate_ interface {
+type TestAssocHandlerDefaultORMWithBeforeCreate_ interface {
 	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type MultiaccountTypeWithIDORMWithAfterCreate_ interface {
+type TestAssocHandlerDefaultORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm.DB) error
 }
 
-func DefaultReadMultiaccountTypeWithID(ctx context.Context, in *MultiaccountTypeWithID, db *gorm.DB) (*MultiaccountTypeWithID, error) {
+// DefaultCreateTestAssocHandlerDefaultSet executes gorm create calls in batches of 100 objects
+// in a transaction, either all of the objects are created or none of them. The BeforeCreate_ hook
+// of each object runs before the insert of its batch, which uses the handle the hooks of the
+// batch return
+func DefaultCreateTestAssocHandlerDefaultSet(ctx context.Context, in []*TestAssocHandlerDefault, db *gorm.DB) ([]*TestAssocHandlerDefault, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObjs := make([]TestAssocHandlerDefaultORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, ormObj)
+	}
+	batchSize := 100
+	err := db.Transaction(func(db *gorm.DB) error {
+		var err error
+		for start := 0; start < len(ormObjs); start += batchSize {
+			end := start + batchSize
+			if end > len(ormObjs) {
+				end = len(ormObjs)
+			}
+			batch := ormObjs[start:end]
+			// the scopes the hooks add only apply to the insert of their own batch
+			batchDB := db
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(TestAssocHandlerDefaultORMWithBeforeCreate_); ok {
+					if batchDB, err = hook.BeforeCreate_(ctx, batchDB); err != nil {
+						return err
+					}
+				}
+			}
+			if err = batchDB.Omit().CreateInBatches(&batch, batchSize).Error; err != nil {
+				return err
+			}
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(TestAssocHandlerDefaultORMWithAfterCreate_); ok {
+					if err = hook.AfterCreate_(ctx, db); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*TestAssocHandlerDefault, 0, len(ormObjs))
+	for i := range ormObjs {
+		pbResponse, err := ormObjs[i].ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, &pbResponse)
+	}
+	return results, nil
+}
+
+func DefaultReadTestAssocHandlerDefault(ctx context.Context, in *TestAssocHandlerDefault, db *gorm.DB) (*TestAssocHandlerDefault, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -2149,24 +6845,24 @@ func DefaultReadMultiaccountTypeWithID(ctx context.Context, in *MultiaccountType
 	if err != nil {
 		return nil, err
 	}
-	if ormObj.Id == 0 {
+	if ormObj.Id == "" {
 		return nil, errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithBeforeReadApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithBeforeReadApplyQuery); ok {
 		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithBeforeReadFind); ok {
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithBeforeReadFind); ok {
 		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	ormResponse := MultiaccountTypeWithIDORM{}
+	ormResponse := TestAssocHandlerDefaultORM{}
 	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormResponse).(MultiaccountTypeWithIDORMWithAfterReadFind); ok {
+	if hook, ok := interface{}(&ormResponse).(TestAssocHandlerDefaultORMWithAfterReadFind); ok {
 		if err = hook.AfterReadFind(ctx, db); err != nil {
 			return nil, err
 		}
@@ -2175,17 +6871,17 @@ func DefaultReadMultiaccountTypeWithID(ctx context.Context, in *MultiaccountType
 	return &pbResponse, err
 }
 
-type MultiaccountTypeWithIDORMWithBeforeReadApplyQuery interface {
+type TestAssocHandlerDefaultORMWithBeforeReadApplyQuery interface {
 	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type MultiaccountTypeWithIDORMWithBeforeReadFind interface {
+type TestAssocHandlerDefaultORMWithBeforeReadFind interface {
 	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type MultiaccountTypeWithIDORMWithAfterReadFind interface {
+type TestAssocHandlerDefaultORMWithAfterReadFind interface {
 	AfterReadFind(context.Context, *gorm.DB) error
 }
 
-func DefaultDeleteMultiaccountTypeWithID(ctx context.Context, in *MultiaccountTypeWithID, db *gorm.DB) error {
+func DefaultDeleteTestAssocHandlerDefault(ctx context.Context, in *TestAssocHandlerDefault, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
@@ -2193,115 +6889,96 @@ func DefaultDeleteMultiaccountTypeWithID(ctx context.Context, in *MultiaccountTy
 	if err != nil {
 		return err
 	}
-	if ormObj.Id == 0 {
+	if ormObj.Id == "" {
 		return errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithBeforeDelete_); ok {
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithBeforeDelete_); ok {
 		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where(&ormObj).Delete(&MultiaccountTypeWithIDORM{}).Error
+	err = db.Where(&ormObj).Delete(&TestAssocHandlerDefaultORM{}).Error
 	if err != nil {
 		return err
 	}
-	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithAfterDelete_); ok {
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithAfterDelete_); ok {
 		err = hook.AfterDelete_(ctx, db)
 	}
 	return err
 }
 
-type MultiaccountTypeWithIDORMWithBeforeDelete_ interface {
+type TestAssocHandlerDefaultORMWithBeforeDelete_ interface {
 	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type MultiaccountTypeWithIDORMWithAfterDelete_ interface {
+type TestAssocHandlerDefaultORMWithAfterDelete_ interface {
 	AfterDelete_(context.Context, *gorm.DB) error
 }
 
-func DefaultDeleteMultiaccountTypeWithIDSet(ctx context.Context, in []*MultiaccountTypeWithID, db *gorm.DB) error {
+func DefaultDeleteTestAssocHandlerDefaultSet(ctx context.Context, in []*TestAssocHandlerDefault, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
 	var err error
-	keys := []uint64{}
+	keys := []string{}
 	for _, obj := range in {
 		ormObj, err := obj.ToORM(ctx)
 		if err != nil {
 			return err
 		}
-		if ormObj.Id == 0 {
+		if ormObj.Id == "" {
 			return errors.EmptyIdError
 		}
 		keys = append(keys, ormObj.Id)
 	}
-	if hook, ok := (interface{}(&MultiaccountTypeWithIDORM{})).(MultiaccountTypeWithIDORMWithBeforeDeleteSet); ok {
+	if hook, ok := (interface{}(&TestAssocHandlerDefaultORM{})).(TestAssocHandlerDefaultORMWithBeforeDeleteSet); ok {
 		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
 			return err
 		}
 	}
-	accountId, err := auth.GetAccountID(ctx, nil)
-	if err != nil {
-		return err
-	}
-	compartmentId, err := auth.GetCompartmentID(ctx, nil)
+	err = db.Where("id in (?)", keys).Delete(&TestAssocHandlerDefaultORM{}).Error
 	if err != nil {
 		return err
 	}
-	if compartmentId != "" {
-		err = db.Where("account_id = ? AND compartment_id like ?% AND id in (?)", accountId, compartmentId, keys).Delete(&MultiaccountTypeWithIDORM{}).Error
-		if err != nil {
-			return err
-		}
-	} else {
-		err = db.Where("account_id = ? AND id in (?)", accountId, keys).Delete(&MultiaccountTypeWithIDORM{}).Error
-		if err != nil {
-			return err
-		}
-	}
-	if hook, ok := (interface{}(&MultiaccountTypeWithIDORM{})).(MultiaccountTypeWithIDORMWithAfterDeleteSet); ok {
+	if hook, ok := (interface{}(&TestAssocHandlerDefaultORM{})).(TestAssocHandlerDefaultORMWithAfterDeleteSet); ok {
 		err = hook.AfterDeleteSet(ctx, in, db)
 	}
 	return err
 }
 
-type MultiaccountTypeWithIDORMWithBeforeDeleteSet interface {
-	BeforeDeleteSet(context.Context, []*MultiaccountTypeWithID, *gorm.DB) (*gorm.DB, error)
+type TestAssocHandlerDefaultORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*TestAssocHandlerDefault, *gorm.DB) (*gorm.DB, error)
 }
-type MultiaccountTypeWithIDORMWithAfterDeleteSet interface {
-	AfterDeleteSet(context.Context, []*MultiaccountTypeWithID, *gorm.DB) error
+type TestAssocHandlerDefaultORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*TestAssocHandlerDefault, *gorm.DB) error
 }
 
-// DefaultStrictUpdateMultiaccountTypeWithID clears / replaces / appends first level 1:many children and then executes a gorm update call
-func DefaultStrictUpdateMultiaccountTypeWithID(ctx context.Context, in *MultiaccountTypeWithID, db *gorm.DB) (*MultiaccountTypeWithID, error) {
+// DefaultStrictUpdateTestAssocHandlerDefault clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateTestAssocHandlerDefault(ctx context.Context, in *TestAssocHandlerDefault, db *gorm.DB) (*TestAssocHandlerDefault, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateMultiaccountTypeWithID")
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateTestAssocHandlerDefault")
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	accountID, err := auth.GetAccountID(ctx, nil)
-	if err != nil {
-		return nil, err
-	}
-	compartmentID, err := auth.GetCompartmentID(ctx, nil)
-	if err != nil {
-		return nil, err
-	}
-	if compartmentID != "" {
-		db = db.Where(map[string]interface{}{"account_id": accountID, "compartment_id": compartmentID})
-	} else {
-		db = db.Where(map[string]interface{}{"account_id": accountID})
-	}
 	var count int64
-	lockedRow := &MultiaccountTypeWithIDORM{}
+	lockedRow := &TestAssocHandlerDefaultORM{}
 	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
-	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithBeforeStrictUpdateCleanup); ok {
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithBeforeStrictUpdateCleanup); ok {
 		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithBeforeStrictUpdateSave); ok {
+	filterTestTagAssoc := TestTagAssociationORM{}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	filterTestTagAssoc.TestAssocHandlerDefaultId = new(string)
+	*filterTestTagAssoc.TestAssocHandlerDefaultId = ormObj.Id
+	if err = db.Where(filterTestTagAssoc).Delete(TestTagAssociationORM{}).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithBeforeStrictUpdateSave); ok {
 		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
@@ -2309,7 +6986,7 @@ func DefaultStrictUpdateMultiaccountTypeWithID(ctx context.Context, in *Multiacc
 	if err = db.Omit().Save(&ormObj).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithAfterStrictUpdateSave); ok {
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithAfterStrictUpdateSave); ok {
 		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
@@ -2324,105 +7001,338 @@ func DefaultStrictUpdateMultiaccountTypeWithID(ctx context.Context, in *Multiacc
 	return &pbResponse, err
 }
 
-type MultiaccountTypeWithIDORMWithBeforeStrictUpdateCleanup interface {
+type TestAssocHandlerDefaultORMWithBeforeStrictUpdateCleanup interface {
 	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type MultiaccountTypeWithIDORMWithBeforeStrictUpdateSave interface {
+type TestAssocHandlerDefaultORMWithBeforeStrictUpdateSave interface {
 	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type MultiaccountTypeWithIDORMWithAfterStrictUpdateSave interface {
+type TestAssocHandlerDefaultORMWithAfterStrictUpdateSave interface {
 	AfterStrictUpdateSave(context.Context, *gorm.DB) error
 }
 
-// DefaultPatchMultiaccountTypeWithID executes a basic gorm update call with patch behavior
-func DefaultPatchMultiaccountTypeWithID(ctx context.Context, in *MultiaccountTypeWithID, updateMask *field_mask.FieldMask, db *gorm.DB) (*MultiaccountTypeWithID, error) {
+// DefaultUpsertTestAssocHandlerDefault executes a gorm create call resolving conflicts on conflictTarget,
+// the name of a unique index or the primary key when empty, by updating the columns
+// in updateMask, all of them when updateMask is empty, and returns the stored object
+func DefaultUpsertTestAssocHandlerDefault(ctx context.Context, in *TestAssocHandlerDefault, conflictTarget string, updateMask *field_mask.FieldMask, db *gorm.DB) (*TestAssocHandlerDefault, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conflict := clause.OnConflict{}
+	var lookup func() map[string]interface{}
+	switch conflictTarget {
+	case "":
+		conflict.Columns = []clause.Column{{Name: "id"}}
+		lookup = func() map[string]interface{} { return map[string]interface{}{"id": ormObj.Id} }
+	default:
+		return nil, fmt.Errorf("unknown conflict target %q of TestAssocHandlerDefault", conflictTarget)
+	}
+	if len(updateMask.GetPaths()) == 0 {
+		conflict.UpdateAll = true
+	} else {
+		return nil, &errors.InvalidFieldMaskError{Path: updateMask.GetPaths()[0]}
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithBeforeUpsert); ok {
+		if db, err = hook.BeforeUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Clauses(conflict).Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if len(updateMask.GetPaths()) > 0 {
+		stored := TestAssocHandlerDefaultORM{}
+		if err = db.Where(lookup()).Take(&stored).Error; err != nil {
+			return nil, err
+		}
+		ormObj = stored
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithAfterUpsert); ok {
+		if err = hook.AfterUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type TestAssocHandlerDefaultORMWithBeforeUpsert interface {
+	BeforeUpsert(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerDefaultORMWithAfterUpsert interface {
+	AfterUpsert(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchTestAssocHandlerDefault executes a basic gorm update call with patch behavior
+func DefaultPatchTestAssocHandlerDefault(ctx context.Context, in *TestAssocHandlerDefault, updateMask *field_mask.FieldMask, db *gorm.DB) (*TestAssocHandlerDefault, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	var pbObj MultiaccountTypeWithID
+	pbObj := &TestAssocHandlerDefault{}
 	var err error
-	if hook, ok := interface{}(&pbObj).(MultiaccountTypeWithIDWithBeforePatchRead); ok {
+	if hook, ok := interface{}(pbObj).(TestAssocHandlerDefaultWithBeforePatchRead); ok {
 		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbReadRes, err := DefaultReadMultiaccountTypeWithID(ctx, &MultiaccountTypeWithID{Id: in.GetId()}, db)
-	if err != nil {
+	if pbObj, err = DefaultReadTestAssocHandlerDefault(ctx, &TestAssocHandlerDefault{Id: in.GetId()}, db); err != nil {
 		return nil, err
 	}
-	pbObj = *pbReadRes
-	if hook, ok := interface{}(&pbObj).(MultiaccountTypeWithIDWithBeforePatchApplyFieldMask); ok {
+	if hook, ok := interface{}(pbObj).(TestAssocHandlerDefaultWithBeforePatchApplyFieldMask); ok {
 		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	if _, err := DefaultApplyFieldMaskMultiaccountTypeWithID(ctx, &pbObj, in, updateMask, "", db); err != nil {
+	if _, err := DefaultApplyFieldMaskTestAssocHandlerDefault(ctx, pbObj, in, updateMask, "", db); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&pbObj).(MultiaccountTypeWithIDWithBeforePatchSave); ok {
+	if hook, ok := interface{}(pbObj).(TestAssocHandlerDefaultWithBeforePatchSave); ok {
 		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
-	}
-	pbResponse, err := DefaultStrictUpdateMultiaccountTypeWithID(ctx, &pbObj, db)
+	}
+	pbResponse, err := DefaultStrictUpdateTestAssocHandlerDefault(ctx, pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(TestAssocHandlerDefaultWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type TestAssocHandlerDefaultWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *TestAssocHandlerDefault, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerDefaultWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *TestAssocHandlerDefault, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerDefaultWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *TestAssocHandlerDefault, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerDefaultWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *TestAssocHandlerDefault, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetTestAssocHandlerDefault patches the objects in a transaction: the masks are applied in memory to
+// the stored objects, read and locked with a single query, and the objects are written with an update
+// per batch of 100 objects. The objects are read and written with the handles their hooks
+// return, the objects of different handles don't share the queries. Only the associations in the
+// masks are updated, and objects sharing a primary key are rejected
+func DefaultPatchSetTestAssocHandlerDefault(ctx context.Context, objects []*TestAssocHandlerDefault, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*TestAssocHandlerDefault, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+	if len(objects) == 0 {
+		return []*TestAssocHandlerDefault{}, nil
+	}
+	var err error
+	objectKeys := make([]string, 0, len(objects))
+	seen := make(map[string]struct{}, len(objects))
+	for _, in := range objects {
+		if in == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := in.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if ormObj.Id == "" {
+			return nil, errors.EmptyIdError
+		}
+		key := ormObj.Id
+		if _, ok := seen[key]; ok {
+			return nil, errors.DuplicateKeyError
+		}
+		seen[key] = struct{}{}
+		objectKeys = append(objectKeys, key)
+	}
+	batchSize := 100
+	results := make([]*TestAssocHandlerDefault, len(objects))
+	err = db.Transaction(func(db *gorm.DB) error {
+		var err error
+		// the objects sharing the handle their hooks return share the queries
+		dbs := make([]*gorm.DB, len(objects))
+		group := func() ([]*gorm.DB, map[*gorm.DB][]int) {
+			var handles []*gorm.DB
+			groups := make(map[*gorm.DB][]int)
+			for i, handle := range dbs {
+				if _, ok := groups[handle]; !ok {
+					handles = append(handles, handle)
+				}
+				groups[handle] = append(groups[handle], i)
+			}
+			return handles, groups
+		}
+		for i, in := range objects {
+			dbs[i] = db
+			if hook, ok := interface{}(&TestAssocHandlerDefault{}).(TestAssocHandlerDefaultWithBeforePatchRead); ok {
+				if dbs[i], err = hook.BeforePatchRead(ctx, in, updateMasks[i], db); err != nil {
+					return err
+				}
+			}
+		}
+		targets := make(map[string]TestAssocHandlerDefaultORM, len(objects))
+		handles, groups := group()
+		for _, handle := range handles {
+			keys := make([]string, 0, len(groups[handle]))
+			for _, i := range groups[handle] {
+				keys = append(keys, objectKeys[i])
+			}
+			query := handle.Session(&gorm.Session{}).Clauses(clause.Locking{Strength: "UPDATE"})
+			query = query.Where("id in (?)", keys)
+			rows := []TestAssocHandlerDefaultORM{}
+			if err = query.Find(&rows).Error; err != nil {
+				return err
+			}
+			for _, row := range rows {
+				targets[row.Id] = row
+			}
+		}
+		ormObjs := make([]TestAssocHandlerDefaultORM, len(objects))
+		prepare := func(i int) (*gorm.DB, error) {
+			in, key, updateMask, db := objects[i], objectKeys[i], updateMasks[i], dbs[i]
+			target, ok := targets[key]
+			if !ok {
+				return nil, gorm.ErrRecordNotFound
+			}
+			stored, err := target.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbObj := &stored
+			if hook, ok := interface{}(pbObj).(TestAssocHandlerDefaultWithBeforePatchApplyFieldMask); ok {
+				if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			if _, err := DefaultApplyFieldMaskTestAssocHandlerDefault(ctx, pbObj, in, updateMask, "", db); err != nil {
+				return nil, err
+			}
+			if hook, ok := interface{}(pbObj).(TestAssocHandlerDefaultWithBeforePatchSave); ok {
+				if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObj, err := pbObj.ToORM(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithBeforeStrictUpdateCleanup); ok {
+				if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			masked := make(map[string]bool, len(updateMask.GetPaths()))
+			for _, path := range updateMask.GetPaths() {
+				masked[strings.SplitN(path, ".", 2)[0]] = true
+			}
+			if masked["TestTagAssoc"] || masked["*"] {
+				filterTestTagAssoc := TestTagAssociationORM{}
+				if ormObj.Id == "" {
+					return nil, errors.EmptyIdError
+				}
+				filterTestTagAssoc.TestAssocHandlerDefaultId = new(string)
+				*filterTestTagAssoc.TestAssocHandlerDefaultId = ormObj.Id
+				if err = db.Where(filterTestTagAssoc).Delete(TestTagAssociationORM{}).Error; err != nil {
+					return nil, err
+				}
+			}
+			if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithBeforeStrictUpdateSave); ok {
+				if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObjs[i] = ormObj
+			return db, nil
+		}
+		for i := range objects {
+			if dbs[i], err = prepare(i); err != nil {
+				return err
+			}
+		}
+		handles, groups = group()
+		for _, handle := range handles {
+			indexes := groups[handle]
+			for start := 0; start < len(indexes); start += batchSize {
+				end := start + batchSize
+				if end > len(indexes) {
+					end = len(indexes)
+				}
+				batch := indexes[start:end]
+				objs := make([]*TestAssocHandlerDefaultORM, 0, len(batch))
+				for _, i := range batch {
+					objs = append(objs, &ormObjs[i])
+				}
+				// the associations are saved first, the batch is then written with the
+				// foreign keys of its belongs-to associations
+				if err = handle.Session(&gorm.Session{}).Select("TestTagAssoc").Model(&objs).Updates(map[string]interface{}{}).Error; err != nil {
+					return err
+				}
+			}
+		}
+		finish := func(i int) (*TestAssocHandlerDefault, error) {
+			in, updateMask, db, ormObj := objects[i], updateMasks[i], dbs[i], ormObjs[i]
+			var err error
+			if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithAfterStrictUpdateSave); ok {
+				if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			saved, err := ormObj.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbResponse := &saved
+			if hook, ok := interface{}(pbResponse).(TestAssocHandlerDefaultWithAfterPatchSave); ok {
+				if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			return pbResponse, nil
+		}
+		for i := range objects {
+			if results[i], err = finish(i); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(pbResponse).(MultiaccountTypeWithIDWithAfterPatchSave); ok {
-		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
-			return nil, err
-		}
-	}
-	return pbResponse, nil
-}
-
-type MultiaccountTypeWithIDWithBeforePatchRead interface {
-	BeforePatchRead(context.Context, *MultiaccountTypeWithID, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
-}
-type MultiaccountTypeWithIDWithBeforePatchApplyFieldMask interface {
-	BeforePatchApplyFieldMask(context.Context, *MultiaccountTypeWithID, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
-}
-type MultiaccountTypeWithIDWithBeforePatchSave interface {
-	BeforePatchSave(context.Context, *MultiaccountTypeWithID, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
-}
-type MultiaccountTypeWithIDWithAfterPatchSave interface {
-	AfterPatchSave(context.Context, *MultiaccountTypeWithID, *field_mask.FieldMask, *gorm.DB) error
-}
-
-// DefaultPatchSetMultiaccountTypeWithID executes a bulk gorm update call with patch behavior
-func DefaultPatchSetMultiaccountTypeWithID(ctx context.Context, objects []*MultiaccountTypeWithID, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*MultiaccountTypeWithID, error) {
-	if len(objects) != len(updateMasks) {
-		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
-	}
-
-	results := make([]*MultiaccountTypeWithID, 0, len(objects))
-	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchMultiaccountTypeWithID(ctx, patcher, updateMasks[i], db)
-		if err != nil {
-			return nil, err
-		}
-
-		results = append(results, pbResponse)
-	}
-
 	return results, nil
 }
 
-// DefaultApplyFieldMaskMultiaccountTypeWithID patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskMultiaccountTypeWithID(ctx context.Context, patchee *MultiaccountTypeWithID, patcher *MultiaccountTypeWithID, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*MultiaccountTypeWithID, error) {
+// DefaultApplyFieldMaskTestAssocHandlerDefault patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskTestAssocHandlerDefault(ctx context.Context, patchee *TestAssocHandlerDefault, patcher *TestAssocHandlerDefault, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*TestAssocHandlerDefault, error) {
 	if patcher == nil {
 		return nil, nil
 	} else if patchee == nil {
 		return nil, errors.NilArgumentError
 	}
+	if len(updateMask.GetPaths()) == 1 && updateMask.Paths[0] == prefix+"*" {
+		updateMask = &field_mask.FieldMask{Paths: []string{prefix + "Id", prefix + "TestTagAssoc"}}
+	}
+	for _, f := range updateMask.GetPaths() {
+		if strings.HasPrefix(f, prefix) && !DefaultHasFieldMaskPathTestAssocHandlerDefault(strings.TrimPrefix(f, prefix)) {
+			return nil, &errors.InvalidFieldMaskError{Path: f}
+		}
+	}
 	var err error
 	for _, f := range updateMask.Paths {
 		if f == prefix+"Id" {
 			patchee.Id = patcher.Id
 			continue
 		}
-		if f == prefix+"SomeField" {
-			patchee.SomeField = patcher.SomeField
+		if f == prefix+"TestTagAssoc" {
+			patchee.TestTagAssoc = patcher.TestTagAssoc
 			continue
 		}
 	}
@@ -2432,35 +7342,45 @@ func DefaultApplyFieldMaskMultiaccountTypeWithID(ctx context.Context, patchee *M
 	return patchee, nil
 }
 
-// DefaultListMultiaccountTypeWithID executes a gorm list call
-func DefaultListMultiaccountTypeWithID(ctx context.Context, db *gorm.DB) ([]*MultiaccountTypeWithID, error) {
-	in := MultiaccountTypeWithID{}
+// DefaultHasFieldMaskPathTestAssocHandlerDefault reports whether TestAssocHandlerDefault has the field of the field mask path
+func DefaultHasFieldMaskPathTestAssocHandlerDefault(path string) bool {
+	parts := strings.SplitN(path, ".", 2)
+	switch parts[0] {
+	case "Id", "TestTagAssoc":
+		return len(parts) == 1
+	}
+	return false
+}
+
+// DefaultListTestAssocHandlerDefault executes a gorm list call
+func DefaultListTestAssocHandlerDefault(ctx context.Context, db *gorm.DB) ([]*TestAssocHandlerDefault, error) {
+	in := TestAssocHandlerDefault{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithBeforeListApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithBeforeListApplyQuery); ok {
 		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithBeforeListFind); ok {
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithBeforeListFind); ok {
 		if db, err = hook.BeforeListFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
 	db = db.Where(&ormObj)
 	db = db.Order("id")
-	ormResponse := []MultiaccountTypeWithIDORM{}
+	ormResponse := []TestAssocHandlerDefaultORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithAfterListFind); ok {
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithAfterListFind); ok {
 		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse := []*MultiaccountTypeWithID{}
+	pbResponse := []*TestAssocHandlerDefault{}
 	for _, responseEntry := range ormResponse {
 		temp, err := responseEntry.ToPB(ctx)
 		if err != nil {
@@ -2471,18 +7391,18 @@ func DefaultListMultiaccountTypeWithID(ctx context.Context, db *gorm.DB) ([]*Mul
 	return pbResponse, nil
 }
 
-type MultiaccountTypeWithIDORMWithBeforeListApplyQuery interface {
+type TestAssocHandlerDefaultORMWithBeforeListApplyQuery interface {
 	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type MultiaccountTypeWithIDORMWithBeforeListFind interface {
+type TestAssocHandlerDefaultORMWithBeforeListFind interface {
 	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type MultiaccountTypeWithIDORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]MultiaccountTypeWithIDORM) error
+type TestAssocHandlerDefaultORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]TestAssocHandlerDefaultORM) error
 }
 
-// DefaultCreateMultiaccountTypeWithoutID executes a basic gorm create call
-func DefaultCreateMultiaccountTypeWithoutID(ctx context.Context, in *MultiaccountTypeWithoutID, db *gorm.DB) (*MultiaccountTypeWithoutID, error) {
+// DefaultCreateTestAssocHandlerReplace executes a basic gorm create call
+func DefaultCreateTestAssocHandlerReplace(ctx context.Context, in *TestAssocHandlerReplace, db *gorm.DB) (*TestAssocHandlerReplace, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -2490,7 +7410,7 @@ func DefaultCreateMultiaccountTypeWithoutID(ctx context.Context, in *Multiaccoun
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithoutIDORMWithBeforeCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithBeforeCreate_); ok {
 		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
 			return nil, err
 		}
@@ -2498,7 +7418,7 @@ func DefaultCreateMultiaccountTypeWithoutID(ctx context.Context, in *Multiaccoun
 	if err = db.Omit().Create(&ormObj).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithoutIDORMWithAfterCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithAfterCreate_); ok {
 		if err = hook.AfterCreate_(ctx, db); err != nil {
 			return nil, err
 		}
@@ -2507,115 +7427,78 @@ func DefaultCreateMultiaccountTypeWithoutID(ctx context.Context, in *Multiaccoun
 	return &pbResponse, err
 }
 
-type MultiaccountTypeWithoutIDORMWithBeforeCreate_ interface {
+type TestAssocHandlerReplaceORMWithBeforeCreate_ interface {
 	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type MultiaccountTypeWithoutIDORMWithAfterCreate_ interface {
+type TestAssocHandlerReplaceORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm.DB) error
 }
 
-// DefaultApplyFieldMaskMultiaccountTypeWithoutID patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskMultiaccountTypeWithoutID(ctx context.Context, patchee *MultiaccountTypeWithoutID, patcher *MultiaccountTypeWithoutID, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*MultiaccountTypeWithoutID, error) {
-	if patcher == nil {
-		return nil, nil
-	} else if patchee == nil {
+// DefaultCreateTestAssocHandlerReplaceSet executes gorm create calls in batches of 100 objects
+// in a transaction, either all of the objects are created or none of them. The BeforeCreate_ hook
+// of each object runs before the insert of its batch, which uses the handle the hooks of the
+// batch return
+func DefaultCreateTestAssocHandlerReplaceSet(ctx context.Context, in []*TestAssocHandlerReplace, db *gorm.DB) ([]*TestAssocHandlerReplace, error) {
+	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	var err error
-	for _, f := range updateMask.Paths {
-		if f == prefix+"SomeField" {
-			patchee.SomeField = patcher.SomeField
-			continue
-		}
-	}
-	if err != nil {
-		return nil, err
-	}
-	return patchee, nil
-}
-
-// DefaultListMultiaccountTypeWithoutID executes a gorm list call
-func DefaultListMultiaccountTypeWithoutID(ctx context.Context, db *gorm.DB) ([]*MultiaccountTypeWithoutID, error) {
-	in := MultiaccountTypeWithoutID{}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithoutIDORMWithBeforeListApplyQuery); ok {
-		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
-			return nil, err
-		}
-	}
-	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithoutIDORMWithBeforeListFind); ok {
-		if db, err = hook.BeforeListFind(ctx, db); err != nil {
-			return nil, err
-		}
-	}
-	db = db.Where(&ormObj)
-	ormResponse := []MultiaccountTypeWithoutIDORM{}
-	if err := db.Find(&ormResponse).Error; err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithoutIDORMWithAfterListFind); ok {
-		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
-			return nil, err
+	ormObjs := make([]TestAssocHandlerReplaceORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
 		}
-	}
-	pbResponse := []*MultiaccountTypeWithoutID{}
-	for _, responseEntry := range ormResponse {
-		temp, err := responseEntry.ToPB(ctx)
+		ormObj, err := obj.ToORM(ctx)
 		if err != nil {
 			return nil, err
 		}
-		pbResponse = append(pbResponse, &temp)
-	}
-	return pbResponse, nil
-}
-
-type MultiaccountTypeWithoutIDORMWithBeforeListApplyQuery interface {
-	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type MultiaccountTypeWithoutIDORMWithBeforeListFind interface {
-	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type MultiaccountTypeWithoutIDORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]MultiaccountTypeWithoutIDORM) error
-}
-
-// DefaultCreatePrimaryUUIDType executes a basic gorm create call
-func DefaultCreatePrimaryUUIDType(ctx context.Context, in *PrimaryUUIDType, db *gorm.DB) (*PrimaryUUIDType, error) {
-	if in == nil {
-		return nil, errors.NilArgumentError
-	}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return nil, err
+		ormObjs = append(ormObjs, ormObj)
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithBeforeCreate_); ok {
-		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
-			return nil, err
+	batchSize := 100
+	err := db.Transaction(func(db *gorm.DB) error {
+		var err error
+		for start := 0; start < len(ormObjs); start += batchSize {
+			end := start + batchSize
+			if end > len(ormObjs) {
+				end = len(ormObjs)
+			}
+			batch := ormObjs[start:end]
+			// the scopes the hooks add only apply to the insert of their own batch
+			batchDB := db
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(TestAssocHandlerReplaceORMWithBeforeCreate_); ok {
+					if batchDB, err = hook.BeforeCreate_(ctx, batchDB); err != nil {
+						return err
+					}
+				}
+			}
+			if err = batchDB.Omit().CreateInBatches(&batch, batchSize).Error; err != nil {
+				return err
+			}
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(TestAssocHandlerReplaceORMWithAfterCreate_); ok {
+					if err = hook.AfterCreate_(ctx, db); err != nil {
+						return err
+					}
+				}
+			}
 		}
-	}
-	if err = db.Omit().Create(&ormObj).Error; err != nil {
+		return nil
+	})
+	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithAfterCreate_); ok {
-		if err = hook.AfterCreate_(ctx, db); err != nil {
+	results := make([]*TestAssocHandlerReplace, 0, len(ormObjs))
+	for i := range ormObjs {
+		pbResponse, err := ormObjs[i].ToPB(ctx)
+		if err != nil {
 			return nil, err
 		}
+		results = append(results, &pbResponse)
 	}
-	pbResponse, err := ormObj.ToPB(ctx)
-	return &pbResponse, err
-}
-
-type PrimaryUUIDTypeORMWithBeforeCreate_ interface {
-	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type PrimaryUUIDTypeORMWithAfterCreate_ interface {
-	AfterCreate_(context.Context, *gorm.DB) error
+	return results, nil
 }
 
-func DefaultReadPrimaryUUIDType(ctx context.Context, in *PrimaryUUIDType, db *gorm.DB) (*PrimaryUUIDType, error) {
+func DefaultReadTestAssocHandlerReplace(ctx context.Context, in *TestAssocHandlerReplace, db *gorm.DB) (*TestAssocHandlerReplace, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -2623,24 +7506,24 @@ func DefaultReadPrimaryUUIDType(ctx context.Context, in *PrimaryUUIDType, db *go
 	if err != nil {
 		return nil, err
 	}
-	if ormObj.Id == nil || *ormObj.Id == go_uuid.Nil {
+	if ormObj.Id == "" {
 		return nil, errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithBeforeReadApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithBeforeReadApplyQuery); ok {
 		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithBeforeReadFind); ok {
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithBeforeReadFind); ok {
 		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	ormResponse := PrimaryUUIDTypeORM{}
+	ormResponse := TestAssocHandlerReplaceORM{}
 	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormResponse).(PrimaryUUIDTypeORMWithAfterReadFind); ok {
+	if hook, ok := interface{}(&ormResponse).(TestAssocHandlerReplaceORMWithAfterReadFind); ok {
 		if err = hook.AfterReadFind(ctx, db); err != nil {
 			return nil, err
 		}
@@ -2649,17 +7532,17 @@ func DefaultReadPrimaryUUIDType(ctx context.Context, in *PrimaryUUIDType, db *go
 	return &pbResponse, err
 }
 
-type PrimaryUUIDTypeORMWithBeforeReadApplyQuery interface {
+type TestAssocHandlerReplaceORMWithBeforeReadApplyQuery interface {
 	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryUUIDTypeORMWithBeforeReadFind interface {
+type TestAssocHandlerReplaceORMWithBeforeReadFind interface {
 	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryUUIDTypeORMWithAfterReadFind interface {
+type TestAssocHandlerReplaceORMWithAfterReadFind interface {
 	AfterReadFind(context.Context, *gorm.DB) error
 }
 
-func DefaultDeletePrimaryUUIDType(ctx context.Context, in *PrimaryUUIDType, db *gorm.DB) error {
+func DefaultDeleteTestAssocHandlerReplace(ctx context.Context, in *TestAssocHandlerReplace, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
@@ -2667,96 +7550,91 @@ func DefaultDeletePrimaryUUIDType(ctx context.Context, in *PrimaryUUIDType, db *
 	if err != nil {
 		return err
 	}
-	if ormObj.Id == nil || *ormObj.Id == go_uuid.Nil {
+	if ormObj.Id == "" {
 		return errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithBeforeDelete_); ok {
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithBeforeDelete_); ok {
 		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where(&ormObj).Delete(&PrimaryUUIDTypeORM{}).Error
+	err = db.Where(&ormObj).Delete(&TestAssocHandlerReplaceORM{}).Error
 	if err != nil {
 		return err
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithAfterDelete_); ok {
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithAfterDelete_); ok {
 		err = hook.AfterDelete_(ctx, db)
 	}
 	return err
 }
 
-type PrimaryUUIDTypeORMWithBeforeDelete_ interface {
+type TestAssocHandlerReplaceORMWithBeforeDelete_ interface {
 	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryUUIDTypeORMWithAfterDelete_ interface {
+type TestAssocHandlerReplaceORMWithAfterDelete_ interface {
 	AfterDelete_(context.Context, *gorm.DB) error
 }
 
-func DefaultDeletePrimaryUUIDTypeSet(ctx context.Context, in []*PrimaryUUIDType, db *gorm.DB) error {
+func DefaultDeleteTestAssocHandlerReplaceSet(ctx context.Context, in []*TestAssocHandlerReplace, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
 	var err error
-	keys := []*go_uuid.UUID{}
+	keys := []string{}
 	for _, obj := range in {
 		ormObj, err := obj.ToORM(ctx)
 		if err != nil {
 			return err
 		}
-		if ormObj.Id == nil || *ormObj.Id == go_uuid.Nil {
+		if ormObj.Id == "" {
 			return errors.EmptyIdError
 		}
 		keys = append(keys, ormObj.Id)
 	}
-	if hook, ok := (interface{}(&PrimaryUUIDTypeORM{})).(PrimaryUUIDTypeORMWithBeforeDeleteSet); ok {
+	if hook, ok := (interface{}(&TestAssocHandlerReplaceORM{})).(TestAssocHandlerReplaceORMWithBeforeDeleteSet); ok {
 		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where("id in (?)", keys).Delete(&PrimaryUUIDTypeORM{}).Error
+	err = db.Where("id in (?)", keys).Delete(&TestAssocHandlerReplaceORM{}).Error
 	if err != nil {
 		return err
 	}
-	if hook, ok := (interface{}(&PrimaryUUIDTypeORM{})).(PrimaryUUIDTypeORMWithAfterDeleteSet); ok {
+	if hook, ok := (interface{}(&TestAssocHandlerReplaceORM{})).(TestAssocHandlerReplaceORMWithAfterDeleteSet); ok {
 		err = hook.AfterDeleteSet(ctx, in, db)
 	}
 	return err
 }
 
-type PrimaryUUIDTypeORMWithBeforeDeleteSet interface {
-	BeforeDeleteSet(context.Context, []*PrimaryUUIDType, *gorm.DB) (*gorm.DB, error)
+type TestAssocHandlerReplaceORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*TestAssocHandlerReplace, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryUUIDTypeORMWithAfterDeleteSet interface {
-	AfterDeleteSet(context.Context, []*PrimaryUUIDType, *gorm.DB) error
+type TestAssocHandlerReplaceORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*TestAssocHandlerReplace, *gorm.DB) error
 }
 
-// DefaultStrictUpdatePrimaryUUIDType clears / replaces / appends first level 1:many children and then executes a gorm update call
-func DefaultStrictUpdatePrimaryUUIDType(ctx context.Context, in *PrimaryUUIDType, db *gorm.DB) (*PrimaryUUIDType, error) {
+// DefaultStrictUpdateTestAssocHandlerReplace clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateTestAssocHandlerReplace(ctx context.Context, in *TestAssocHandlerReplace, db *gorm.DB) (*TestAssocHandlerReplace, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdatePrimaryUUIDType")
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateTestAssocHandlerReplace")
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
 	var count int64
-	lockedRow := &PrimaryUUIDTypeORM{}
+	lockedRow := &TestAssocHandlerReplaceORM{}
 	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
-	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithBeforeStrictUpdateCleanup); ok {
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithBeforeStrictUpdateCleanup); ok {
 		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	filterChild := ExternalChildORM{}
-	if ormObj.Id == nil || *ormObj.Id == go_uuid.Nil {
-		return nil, errors.EmptyIdError
-	}
-	filterChild.PrimaryUUIDTypeId = new(go_uuid.UUID)
-	*filterChild.PrimaryUUIDTypeId = *ormObj.Id
-	if err = db.Where(filterChild).Delete(ExternalChildORM{}).Error; err != nil {
+	if err = db.Model(&ormObj).Association("TestTagAssoc").Replace(ormObj.TestTagAssoc); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithBeforeStrictUpdateSave); ok {
+	ormObj.TestTagAssoc = nil
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithBeforeStrictUpdateSave); ok {
 		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
@@ -2764,7 +7642,7 @@ func DefaultStrictUpdatePrimaryUUIDType(ctx context.Context, in *PrimaryUUIDType
 	if err = db.Omit().Save(&ormObj).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithAfterStrictUpdateSave); ok {
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithAfterStrictUpdateSave); ok {
 		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
@@ -2779,51 +7657,105 @@ func DefaultStrictUpdatePrimaryUUIDType(ctx context.Context, in *PrimaryUUIDType
 	return &pbResponse, err
 }
 
-type PrimaryUUIDTypeORMWithBeforeStrictUpdateCleanup interface {
+type TestAssocHandlerReplaceORMWithBeforeStrictUpdateCleanup interface {
 	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryUUIDTypeORMWithBeforeStrictUpdateSave interface {
+type TestAssocHandlerReplaceORMWithBeforeStrictUpdateSave interface {
 	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryUUIDTypeORMWithAfterStrictUpdateSave interface {
+type TestAssocHandlerReplaceORMWithAfterStrictUpdateSave interface {
 	AfterStrictUpdateSave(context.Context, *gorm.DB) error
 }
 
-// DefaultPatchPrimaryUUIDType executes a basic gorm update call with patch behavior
-func DefaultPatchPrimaryUUIDType(ctx context.Context, in *PrimaryUUIDType, updateMask *field_mask.FieldMask, db *gorm.DB) (*PrimaryUUIDType, error) {
+// DefaultUpsertTestAssocHandlerReplace executes a gorm create call resolving conflicts on conflictTarget,
+// the name of a unique index or the primary key when empty, by updating the columns
+// in updateMask, all of them when updateMask is empty, and returns the stored object
+func DefaultUpsertTestAssocHandlerReplace(ctx context.Context, in *TestAssocHandlerReplace, conflictTarget string, updateMask *field_mask.FieldMask, db *gorm.DB) (*TestAssocHandlerReplace, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conflict := clause.OnConflict{}
+	var lookup func() map[string]interface{}
+	switch conflictTarget {
+	case "":
+		conflict.Columns = []clause.Column{{Name: "id"}}
+		lookup = func() map[string]interface{} { return map[string]interface{}{"id": ormObj.Id} }
+	default:
+		return nil, fmt.Errorf("unknown conflict target %q of TestAssocHandlerReplace", conflictTarget)
+	}
+	if len(updateMask.GetPaths()) == 0 {
+		conflict.UpdateAll = true
+	} else {
+		return nil, &errors.InvalidFieldMaskError{Path: updateMask.GetPaths()[0]}
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithBeforeUpsert); ok {
+		if db, err = hook.BeforeUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Clauses(conflict).Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if len(updateMask.GetPaths()) > 0 {
+		stored := TestAssocHandlerReplaceORM{}
+		if err = db.Where(lookup()).Take(&stored).Error; err != nil {
+			return nil, err
+		}
+		ormObj = stored
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithAfterUpsert); ok {
+		if err = hook.AfterUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type TestAssocHandlerReplaceORMWithBeforeUpsert interface {
+	BeforeUpsert(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerReplaceORMWithAfterUpsert interface {
+	AfterUpsert(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchTestAssocHandlerReplace executes a basic gorm update call with patch behavior
+func DefaultPatchTestAssocHandlerReplace(ctx context.Context, in *TestAssocHandlerReplace, updateMask *field_mask.FieldMask, db *gorm.DB) (*TestAssocHandlerReplace, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	var pbObj PrimaryUUIDType
+	pbObj := &TestAssocHandlerReplace{}
 	var err error
-	if hook, ok := interface{}(&pbObj).(PrimaryUUIDTypeWithBeforePatchRead); ok {
+	if hook, ok := interface{}(pbObj).(TestAssocHandlerReplaceWithBeforePatchRead); ok {
 		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbReadRes, err := DefaultReadPrimaryUUIDType(ctx, &PrimaryUUIDType{Id: in.GetId()}, db)
-	if err != nil {
+	if pbObj, err = DefaultReadTestAssocHandlerReplace(ctx, &TestAssocHandlerReplace{Id: in.GetId()}, db); err != nil {
 		return nil, err
 	}
-	pbObj = *pbReadRes
-	if hook, ok := interface{}(&pbObj).(PrimaryUUIDTypeWithBeforePatchApplyFieldMask); ok {
+	if hook, ok := interface{}(pbObj).(TestAssocHandlerReplaceWithBeforePatchApplyFieldMask); ok {
 		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	if _, err := DefaultApplyFieldMaskPrimaryUUIDType(ctx, &pbObj, in, updateMask, "", db); err != nil {
+	if _, err := DefaultApplyFieldMaskTestAssocHandlerReplace(ctx, pbObj, in, updateMask, "", db); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&pbObj).(PrimaryUUIDTypeWithBeforePatchSave); ok {
+	if hook, ok := interface{}(pbObj).(TestAssocHandlerReplaceWithBeforePatchSave); ok {
 		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse, err := DefaultStrictUpdatePrimaryUUIDType(ctx, &pbObj, db)
+	pbResponse, err := DefaultStrictUpdateTestAssocHandlerReplace(ctx, pbObj, db)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(pbResponse).(PrimaryUUIDTypeWithAfterPatchSave); ok {
+	if hook, ok := interface{}(pbResponse).(TestAssocHandlerReplaceWithAfterPatchSave); ok {
 		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
@@ -2831,71 +7763,227 @@ func DefaultPatchPrimaryUUIDType(ctx context.Context, in *PrimaryUUIDType, updat
 	return pbResponse, nil
 }
 
-type PrimaryUUIDTypeWithBeforePatchRead interface {
-	BeforePatchRead(context.Context, *PrimaryUUIDType, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type TestAssocHandlerReplaceWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *TestAssocHandlerReplace, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryUUIDTypeWithBeforePatchApplyFieldMask interface {
-	BeforePatchApplyFieldMask(context.Context, *PrimaryUUIDType, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type TestAssocHandlerReplaceWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *TestAssocHandlerReplace, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryUUIDTypeWithBeforePatchSave interface {
-	BeforePatchSave(context.Context, *PrimaryUUIDType, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type TestAssocHandlerReplaceWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *TestAssocHandlerReplace, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryUUIDTypeWithAfterPatchSave interface {
-	AfterPatchSave(context.Context, *PrimaryUUIDType, *field_mask.FieldMask, *gorm.DB) error
+type TestAssocHandlerReplaceWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *TestAssocHandlerReplace, *field_mask.FieldMask, *gorm.DB) error
 }
 
-// DefaultPatchSetPrimaryUUIDType executes a bulk gorm update call with patch behavior
-func DefaultPatchSetPrimaryUUIDType(ctx context.Context, objects []*PrimaryUUIDType, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*PrimaryUUIDType, error) {
+// DefaultPatchSetTestAssocHandlerReplace patches the objects in a transaction: the masks are applied in memory to
+// the stored objects, read and locked with a single query, and the objects are written with an update
+// per batch of 100 objects. The objects are read and written with the handles their hooks
+// return, the objects of different handles don't share the queries. Only the associations in the
+// masks are updated, and objects sharing a primary key are rejected
+func DefaultPatchSetTestAssocHandlerReplace(ctx context.Context, objects []*TestAssocHandlerReplace, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*TestAssocHandlerReplace, error) {
 	if len(objects) != len(updateMasks) {
 		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
 	}
-
-	results := make([]*PrimaryUUIDType, 0, len(objects))
-	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchPrimaryUUIDType(ctx, patcher, updateMasks[i], db)
+	if len(objects) == 0 {
+		return []*TestAssocHandlerReplace{}, nil
+	}
+	var err error
+	objectKeys := make([]string, 0, len(objects))
+	seen := make(map[string]struct{}, len(objects))
+	for _, in := range objects {
+		if in == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := in.ToORM(ctx)
 		if err != nil {
 			return nil, err
 		}
-
-		results = append(results, pbResponse)
+		if ormObj.Id == "" {
+			return nil, errors.EmptyIdError
+		}
+		key := ormObj.Id
+		if _, ok := seen[key]; ok {
+			return nil, errors.DuplicateKeyError
+		}
+		seen[key] = struct{}{}
+		objectKeys = append(objectKeys, key)
+	}
+	batchSize := 100
+	results := make([]*TestAssocHandlerReplace, len(objects))
+	err = db.Transaction(func(db *gorm.DB) error {
+		var err error
+		// the objects sharing the handle their hooks return share the queries
+		dbs := make([]*gorm.DB, len(objects))
+		group := func() ([]*gorm.DB, map[*gorm.DB][]int) {
+			var handles []*gorm.DB
+			groups := make(map[*gorm.DB][]int)
+			for i, handle := range dbs {
+				if _, ok := groups[handle]; !ok {
+					handles = append(handles, handle)
+				}
+				groups[handle] = append(groups[handle], i)
+			}
+			return handles, groups
+		}
+		for i, in := range objects {
+			dbs[i] = db
+			if hook, ok := interface{}(&TestAssocHandlerReplace{}).(TestAssocHandlerReplaceWithBeforePatchRead); ok {
+				if dbs[i], err = hook.BeforePatchRead(ctx, in, updateMasks[i], db); err != nil {
+					return err
+				}
+			}
+		}
+		targets := make(map[string]TestAssocHandlerReplaceORM, len(objects))
+		handles, groups := group()
+		for _, handle := range handles {
+			keys := make([]string, 0, len(groups[handle]))
+			for _, i := range groups[handle] {
+				keys = append(keys, objectKeys[i])
+			}
+			query := handle.Session(&gorm.Session{}).Clauses(clause.Locking{Strength: "UPDATE"})
+			query = query.Where("id in (?)", keys)
+			rows := []TestAssocHandlerReplaceORM{}
+			if err = query.Find(&rows).Error; err != nil {
+				return err
+			}
+			for _, row := range rows {
+				targets[row.Id] = row
+			}
+		}
+		ormObjs := make([]TestAssocHandlerReplaceORM, len(objects))
+		prepare := func(i int) (*gorm.DB, error) {
+			in, key, updateMask, db := objects[i], objectKeys[i], updateMasks[i], dbs[i]
+			target, ok := targets[key]
+			if !ok {
+				return nil, gorm.ErrRecordNotFound
+			}
+			stored, err := target.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbObj := &stored
+			if hook, ok := interface{}(pbObj).(TestAssocHandlerReplaceWithBeforePatchApplyFieldMask); ok {
+				if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			if _, err := DefaultApplyFieldMaskTestAssocHandlerReplace(ctx, pbObj, in, updateMask, "", db); err != nil {
+				return nil, err
+			}
+			if hook, ok := interface{}(pbObj).(TestAssocHandlerReplaceWithBeforePatchSave); ok {
+				if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObj, err := pbObj.ToORM(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithBeforeStrictUpdateCleanup); ok {
+				if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			masked := make(map[string]bool, len(updateMask.GetPaths()))
+			for _, path := range updateMask.GetPaths() {
+				masked[strings.SplitN(path, ".", 2)[0]] = true
+			}
+			if masked["TestTagAssoc"] || masked["*"] {
+				if err = db.Model(&ormObj).Association("TestTagAssoc").Replace(ormObj.TestTagAssoc); err != nil {
+					return nil, err
+				}
+				ormObj.TestTagAssoc = nil
+			}
+			if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithBeforeStrictUpdateSave); ok {
+				if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObjs[i] = ormObj
+			return db, nil
+		}
+		for i := range objects {
+			if dbs[i], err = prepare(i); err != nil {
+				return err
+			}
+		}
+		handles, groups = group()
+		for _, handle := range handles {
+			indexes := groups[handle]
+			for start := 0; start < len(indexes); start += batchSize {
+				end := start + batchSize
+				if end > len(indexes) {
+					end = len(indexes)
+				}
+				batch := indexes[start:end]
+				objs := make([]*TestAssocHandlerReplaceORM, 0, len(batch))
+				for _, i := range batch {
+					objs = append(objs, &ormObjs[i])
+				}
+				// the associations are saved first, the batch is then written with the
+				// foreign keys of its belongs-to associations
+				if err = handle.Session(&gorm.Session{}).Select("TestTagAssoc").Model(&objs).Updates(map[string]interface{}{}).Error; err != nil {
+					return err
+				}
+			}
+		}
+		finish := func(i int) (*TestAssocHandlerReplace, error) {
+			in, updateMask, db, ormObj := objects[i], updateMasks[i], dbs[i], ormObjs[i]
+			var err error
+			if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithAfterStrictUpdateSave); ok {
+				if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			saved, err := ormObj.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbResponse := &saved
+			if hook, ok := interface{}(pbResponse).(TestAssocHandlerReplaceWithAfterPatchSave); ok {
+				if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			return pbResponse, nil
+		}
+		for i := range objects {
+			if results[i], err = finish(i); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
-
 	return results, nil
 }
 
-// DefaultApplyFieldMaskPrimaryUUIDType patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskPrimaryUUIDType(ctx context.Context, patchee *PrimaryUUIDType, patcher *PrimaryUUIDType, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*PrimaryUUIDType, error) {
+// DefaultApplyFieldMaskTestAssocHandlerReplace patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskTestAssocHandlerReplace(ctx context.Context, patchee *TestAssocHandlerReplace, patcher *TestAssocHandlerReplace, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*TestAssocHandlerReplace, error) {
 	if patcher == nil {
 		return nil, nil
 	} else if patchee == nil {
 		return nil, errors.NilArgumentError
 	}
+	if len(updateMask.GetPaths()) == 1 && updateMask.Paths[0] == prefix+"*" {
+		updateMask = &field_mask.FieldMask{Paths: []string{prefix + "Id", prefix + "TestTagAssoc"}}
+	}
+	for _, f := range updateMask.GetPaths() {
+		if strings.HasPrefix(f, prefix) && !DefaultHasFieldMaskPathTestAssocHandlerReplace(strings.TrimPrefix(f, prefix)) {
+			return nil, &errors.InvalidFieldMaskError{Path: f}
+		}
+	}
 	var err error
-	var updatedChild bool
-	for i, f := range updateMask.Paths {
+	for _, f := range updateMask.Paths {
 		if f == prefix+"Id" {
 			patchee.Id = patcher.Id
 			continue
 		}
-		if !updatedChild && strings.HasPrefix(f, prefix+"Child.") {
-			updatedChild = true
-			if patcher.Child == nil {
-				patchee.Child = nil
-				continue
-			}
-			if patchee.Child == nil {
-				patchee.Child = &ExternalChild{}
-			}
-			if o, err := DefaultApplyFieldMaskExternalChild(ctx, patchee.Child, patcher.Child, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"Child.", db); err != nil {
-				return nil, err
-			} else {
-				patchee.Child = o
-			}
-			continue
-		}
-		if f == prefix+"Child" {
-			updatedChild = true
-			patchee.Child = patcher.Child
+		if f == prefix+"TestTagAssoc" {
+			patchee.TestTagAssoc = patcher.TestTagAssoc
 			continue
 		}
 	}
@@ -2905,35 +7993,45 @@ func DefaultApplyFieldMaskPrimaryUUIDType(ctx context.Context, patchee *PrimaryU
 	return patchee, nil
 }
 
-// DefaultListPrimaryUUIDType executes a gorm list call
-func DefaultListPrimaryUUIDType(ctx context.Context, db *gorm.DB) ([]*PrimaryUUIDType, error) {
-	in := PrimaryUUIDType{}
+// DefaultHasFieldMaskPathTestAssocHandlerReplace reports whether TestAssocHandlerReplace has the field of the field mask path
+func DefaultHasFieldMaskPathTestAssocHandlerReplace(path string) bool {
+	parts := strings.SplitN(path, ".", 2)
+	switch parts[0] {
+	case "Id", "TestTagAssoc":
+		return len(parts) == 1
+	}
+	return false
+}
+
+// DefaultListTestAssocHandlerReplace executes a gorm list call
+func DefaultListTestAssocHandlerReplace(ctx context.Context, db *gorm.DB) ([]*TestAssocHandlerReplace, error) {
+	in := TestAssocHandlerReplace{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithBeforeListApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithBeforeListApplyQuery); ok {
 		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithBeforeListFind); ok {
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithBeforeListFind); ok {
 		if db, err = hook.BeforeListFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
 	db = db.Where(&ormObj)
 	db = db.Order("id")
-	ormResponse := []PrimaryUUIDTypeORM{}
+	ormResponse := []TestAssocHandlerReplaceORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithAfterListFind); ok {
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithAfterListFind); ok {
 		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse := []*PrimaryUUIDType{}
+	pbResponse := []*TestAssocHandlerReplace{}
 	for _, responseEntry := range ormResponse {
 		temp, err := responseEntry.ToPB(ctx)
 		if err != nil {
@@ -2944,18 +8042,18 @@ func DefaultListPrimaryUUIDType(ctx context.Context, db *gorm.DB) ([]*PrimaryUUI
 	return pbResponse, nil
 }
 
-type PrimaryUUIDTypeORMWithBeforeListApplyQuery interface {
+type TestAssocHandlerReplaceORMWithBeforeListApplyQuery interface {
 	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryUUIDTypeORMWithBeforeListFind interface {
+type TestAssocHandlerReplaceORMWithBeforeListFind interface {
 	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryUUIDTypeORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]PrimaryUUIDTypeORM) error
+type TestAssocHandlerReplaceORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]TestAssocHandlerReplaceORM) error
 }
 
-// DefaultCreatePrimaryStringType executes a basic gorm create call
-func DefaultCreatePrimaryStringType(ctx context.Context, in *PrimaryStringType, db *gorm.DB) (*PrimaryStringType, error) {
+// DefaultCreateTestAssocHandlerClear executes a basic gorm create call
+func DefaultCreateTestAssocHandlerClear(ctx context.Context, in *TestAssocHandlerClear, db *gorm.DB) (*TestAssocHandlerClear, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -2963,7 +8061,7 @@ func DefaultCreatePrimaryStringType(ctx context.Context, in *PrimaryStringType,
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithBeforeCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithBeforeCreate_); ok {
 		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
 			return nil, err
 		}
@@ -2971,7 +8069,7 @@ func DefaultCreatePrimaryStringType(ctx context.Context, in *PrimaryStringType,
 	if err = db.Omit().Create(&ormObj).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithAfterCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithAfterCreate_); ok {
 		if err = hook.AfterCreate_(ctx, db); err != nil {
 			return nil, err
 		}
@@ -2980,14 +8078,78 @@ func DefaultCreatePrimaryStringType(ctx context.Context, in *PrimaryStringType,
 	return &pbResponse, err
 }
 
-type PrimaryStringTypeORMWithBeforeCreate_ interface {
+type TestAssocHandlerClearORMWithBeforeCreate_ interface {
 	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryStringTypeORMWithAfterCreate_ interface {
+type TestAssocHandlerClearORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm.DB) error
 }
 
-func DefaultReadPrimaryStringType(ctx context.Context, in *PrimaryStringType, db *gorm.DB) (*PrimaryStringType, error) {
+// DefaultCreateTestAssocHandlerClearSet executes gorm create calls in batches of 100 objects
+// in a transaction, either all of the objects are created or none of them. The BeforeCreate_ hook
+// of each object runs before the insert of its batch, which uses the handle the hooks of the
+// batch return
+func DefaultCreateTestAssocHandlerClearSet(ctx context.Context, in []*TestAssocHandlerClear, db *gorm.DB) ([]*TestAssocHandlerClear, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObjs := make([]TestAssocHandlerClearORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, ormObj)
+	}
+	batchSize := 100
+	err := db.Transaction(func(db *gorm.DB) error {
+		var err error
+		for start := 0; start < len(ormObjs); start += batchSize {
+			end := start + batchSize
+			if end > len(ormObjs) {
+				end = len(ormObjs)
+			}
+			batch := ormObjs[start:end]
+			// the scopes the hooks add only apply to the insert of their own batch
+			batchDB := db
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(TestAssocHandlerClearORMWithBeforeCreate_); ok {
+					if batchDB, err = hook.BeforeCreate_(ctx, batchDB); err != nil {
+						return err
+					}
+				}
+			}
+			if err = batchDB.Omit().CreateInBatches(&batch, batchSize).Error; err != nil {
+				return err
+			}
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(TestAssocHandlerClearORMWithAfterCreate_); ok {
+					if err = hook.AfterCreate_(ctx, db); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*TestAssocHandlerClear, 0, len(ormObjs))
+	for i := range ormObjs {
+		pbResponse, err := ormObjs[i].ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, &pbResponse)
+	}
+	return results, nil
+}
+
+func DefaultReadTestAssocHandlerClear(ctx context.Context, in *TestAssocHandlerClear, db *gorm.DB) (*TestAssocHandlerClear, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -2998,21 +8160,21 @@ func DefaultReadPrimaryStringType(ctx context.Context, in *PrimaryStringType, db
 	if ormObj.Id == "" {
 		return nil, errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithBeforeReadApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithBeforeReadApplyQuery); ok {
 		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithBeforeReadFind); ok {
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithBeforeReadFind); ok {
 		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	ormResponse := PrimaryStringTypeORM{}
+	ormResponse := TestAssocHandlerClearORM{}
 	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormResponse).(PrimaryStringTypeORMWithAfterReadFind); ok {
+	if hook, ok := interface{}(&ormResponse).(TestAssocHandlerClearORMWithAfterReadFind); ok {
 		if err = hook.AfterReadFind(ctx, db); err != nil {
 			return nil, err
 		}
@@ -3021,17 +8183,17 @@ func DefaultReadPrimaryStringType(ctx context.Context, in *PrimaryStringType, db
 	return &pbResponse, err
 }
 
-type PrimaryStringTypeORMWithBeforeReadApplyQuery interface {
+type TestAssocHandlerClearORMWithBeforeReadApplyQuery interface {
 	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryStringTypeORMWithBeforeReadFind interface {
+type TestAssocHandlerClearORMWithBeforeReadFind interface {
 	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryStringTypeORMWithAfterReadFind interface {
+type TestAssocHandlerClearORMWithAfterReadFind interface {
 	AfterReadFind(context.Context, *gorm.DB) error
 }
 
-func DefaultDeletePrimaryStringType(ctx context.Context, in *PrimaryStringType, db *gorm.DB) error {
+func DefaultDeleteTestAssocHandlerClear(ctx context.Context, in *TestAssocHandlerClear, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
@@ -3042,29 +8204,29 @@ func DefaultDeletePrimaryStringType(ctx context.Context, in *PrimaryStringType,
 	if ormObj.Id == "" {
 		return errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithBeforeDelete_); ok {
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithBeforeDelete_); ok {
 		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where(&ormObj).Delete(&PrimaryStringTypeORM{}).Error
+	err = db.Where(&ormObj).Delete(&TestAssocHandlerClearORM{}).Error
 	if err != nil {
 		return err
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithAfterDelete_); ok {
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithAfterDelete_); ok {
 		err = hook.AfterDelete_(ctx, db)
 	}
 	return err
 }
 
-type PrimaryStringTypeORMWithBeforeDelete_ interface {
+type TestAssocHandlerClearORMWithBeforeDelete_ interface {
 	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryStringTypeORMWithAfterDelete_ interface {
+type TestAssocHandlerClearORMWithAfterDelete_ interface {
 	AfterDelete_(context.Context, *gorm.DB) error
 }
 
-func DefaultDeletePrimaryStringTypeSet(ctx context.Context, in []*PrimaryStringType, db *gorm.DB) error {
+func DefaultDeleteTestAssocHandlerClearSet(ctx context.Context, in []*TestAssocHandlerClear, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
@@ -3080,55 +8242,50 @@ func DefaultDeletePrimaryStringTypeSet(ctx context.Context, in []*PrimaryStringT
 		}
 		keys = append(keys, ormObj.Id)
 	}
-	if hook, ok := (interface{}(&PrimaryStringTypeORM{})).(PrimaryStringTypeORMWithBeforeDeleteSet); ok {
+	if hook, ok := (interface{}(&TestAssocHandlerClearORM{})).(TestAssocHandlerClearORMWithBeforeDeleteSet); ok {
 		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where("id in (?)", keys).Delete(&PrimaryStringTypeORM{}).Error
+	err = db.Where("id in (?)", keys).Delete(&TestAssocHandlerClearORM{}).Error
 	if err != nil {
 		return err
 	}
-	if hook, ok := (interface{}(&PrimaryStringTypeORM{})).(PrimaryStringTypeORMWithAfterDeleteSet); ok {
+	if hook, ok := (interface{}(&TestAssocHandlerClearORM{})).(TestAssocHandlerClearORMWithAfterDeleteSet); ok {
 		err = hook.AfterDeleteSet(ctx, in, db)
 	}
 	return err
 }
 
-type PrimaryStringTypeORMWithBeforeDeleteSet interface {
-	BeforeDeleteSet(context.Context, []*PrimaryStringType, *gorm.DB) (*gorm.DB, error)
-}
-type PrimaryStringTypeORMWithAfterDeleteSet interface {
-	AfterDeleteSet(context.Context, []*PrimaryStringType, *gorm.DB) error
+type TestAssocHandlerClearORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*TestAssocHandlerClear, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerClearORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*TestAssocHandlerClear, *gorm.DB) error
 }
 
-// DefaultStrictUpdatePrimaryStringType clears / replaces / appends first level 1:many children and then executes a gorm update call
-func DefaultStrictUpdatePrimaryStringType(ctx context.Context, in *PrimaryStringType, db *gorm.DB) (*PrimaryStringType, error) {
+// DefaultStrictUpdateTestAssocHandlerClear clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateTestAssocHandlerClear(ctx context.Context, in *TestAssocHandlerClear, db *gorm.DB) (*TestAssocHandlerClear, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdatePrimaryStringType")
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateTestAssocHandlerClear")
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
 	var count int64
-	lockedRow := &PrimaryStringTypeORM{}
+	lockedRow := &TestAssocHandlerClearORM{}
 	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
-	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithBeforeStrictUpdateCleanup); ok {
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithBeforeStrictUpdateCleanup); ok {
 		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	filterChild := ExternalChildORM{}
-	if ormObj.Id == "" {
-		return nil, errors.EmptyIdError
-	}
-	filterChild.PrimaryStringTypeId = new(string)
-	*filterChild.PrimaryStringTypeId = ormObj.Id
-	if err = db.Where(filterChild).Delete(ExternalChildORM{}).Error; err != nil {
+	if err = db.Model(&ormObj).Association("TestTagAssoc").Clear(); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithBeforeStrictUpdateSave); ok {
+	ormObj.TestTagAssoc = nil
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithBeforeStrictUpdateSave); ok {
 		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
@@ -3136,7 +8293,7 @@ func DefaultStrictUpdatePrimaryStringType(ctx context.Context, in *PrimaryString
 	if err = db.Omit().Save(&ormObj).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithAfterStrictUpdateSave); ok {
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithAfterStrictUpdateSave); ok {
 		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
@@ -3151,51 +8308,105 @@ func DefaultStrictUpdatePrimaryStringType(ctx context.Context, in *PrimaryString
 	return &pbResponse, err
 }
 
-type PrimaryStringTypeORMWithBeforeStrictUpdateCleanup interface {
+type TestAssocHandlerClearORMWithBeforeStrictUpdateCleanup interface {
 	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryStringTypeORMWithBeforeStrictUpdateSave interface {
+type TestAssocHandlerClearORMWithBeforeStrictUpdateSave interface {
 	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryStringTypeORMWithAfterStrictUpdateSave interface {
+type TestAssocHandlerClearORMWithAfterStrictUpdateSave interface {
 	AfterStrictUpdateSave(context.Context, *gorm.DB) error
 }
 
-// DefaultPatchPrimaryStringType executes a basic gorm update call with patch behavior
-func DefaultPatchPrimaryStringType(ctx context.Context, in *PrimaryStringType, updateMask *field_mask.FieldMask, db *gorm.DB) (*PrimaryStringType, error) {
+// DefaultUpsertTestAssocHandlerClear executes a gorm create call resolving conflicts on conflictTarget,
+// the name of a unique index or the primary key when empty, by updating the columns
+// in updateMask, all of them when updateMask is empty, and returns the stored object
+func DefaultUpsertTestAssocHandlerClear(ctx context.Context, in *TestAssocHandlerClear, conflictTarget string, updateMask *field_mask.FieldMask, db *gorm.DB) (*TestAssocHandlerClear, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conflict := clause.OnConflict{}
+	var lookup func() map[string]interface{}
+	switch conflictTarget {
+	case "":
+		conflict.Columns = []clause.Column{{Name: "id"}}
+		lookup = func() map[string]interface{} { return map[string]interface{}{"id": ormObj.Id} }
+	default:
+		return nil, fmt.Errorf("unknown conflict target %q of TestAssocHandlerClear", conflictTarget)
+	}
+	if len(updateMask.GetPaths()) == 0 {
+		conflict.UpdateAll = true
+	} else {
+		return nil, &errors.InvalidFieldMaskError{Path: updateMask.GetPaths()[0]}
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithBeforeUpsert); ok {
+		if db, err = hook.BeforeUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Clauses(conflict).Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if len(updateMask.GetPaths()) > 0 {
+		stored := TestAssocHandlerClearORM{}
+		if err = db.Where(lookup()).Take(&stored).Error; err != nil {
+			return nil, err
+		}
+		ormObj = stored
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithAfterUpsert); ok {
+		if err = hook.AfterUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type TestAssocHandlerClearORMWithBeforeUpsert interface {
+	BeforeUpsert(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerClearORMWithAfterUpsert interface {
+	AfterUpsert(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchTestAssocHandlerClear executes a basic gorm update call with patch behavior
+func DefaultPatchTestAssocHandlerClear(ctx context.Context, in *TestAssocHandlerClear, updateMask *field_mask.FieldMask, db *gorm.DB) (*TestAssocHandlerClear, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	var pbObj PrimaryStringType
+	pbObj := &TestAssocHandlerClear{}
 	var err error
-	if hook, ok := interface{}(&pbObj).(PrimaryStringTypeWithBeforePatchRead); ok {
+	if hook, ok := interface{}(pbObj).(TestAssocHandlerClearWithBeforePatchRead); ok {
 		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbReadRes, err := DefaultReadPrimaryStringType(ctx, &PrimaryStringType{Id: in.GetId()}, db)
-	if err != nil {
+	if pbObj, err = DefaultReadTestAssocHandlerClear(ctx, &TestAssocHandlerClear{Id: in.GetId()}, db); err != nil {
 		return nil, err
 	}
-	pbObj = *pbReadRes
-	if hook, ok := interface{}(&pbObj).(PrimaryStringTypeWithBeforePatchApplyFieldMask); ok {
+	if hook, ok := interface{}(pbObj).(TestAssocHandlerClearWithBeforePatchApplyFieldMask); ok {
 		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	if _, err := DefaultApplyFieldMaskPrimaryStringType(ctx, &pbObj, in, updateMask, "", db); err != nil {
+	if _, err := DefaultApplyFieldMaskTestAssocHandlerClear(ctx, pbObj, in, updateMask, "", db); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&pbObj).(PrimaryStringTypeWithBeforePatchSave); ok {
+	if hook, ok := interface{}(pbObj).(TestAssocHandlerClearWithBeforePatchSave); ok {
 		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse, err := DefaultStrictUpdatePrimaryStringType(ctx, &pbObj, db)
+	pbResponse, err := DefaultStrictUpdateTestAssocHandlerClear(ctx, pbObj, db)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(pbResponse).(PrimaryStringTypeWithAfterPatchSave); ok {
+	if hook, ok := interface{}(pbResponse).(TestAssocHandlerClearWithAfterPatchSave); ok {
 		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
@@ -3203,71 +8414,227 @@ func DefaultPatchPrimaryStringType(ctx context.Context, in *PrimaryStringType, u
 	return pbResponse, nil
 }
 
-type PrimaryStringTypeWithBeforePatchRead interface {
-	BeforePatchRead(context.Context, *PrimaryStringType, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type TestAssocHandlerClearWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *TestAssocHandlerClear, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryStringTypeWithBeforePatchApplyFieldMask interface {
-	BeforePatchApplyFieldMask(context.Context, *PrimaryStringType, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type TestAssocHandlerClearWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *TestAssocHandlerClear, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryStringTypeWithBeforePatchSave interface {
-	BeforePatchSave(context.Context, *PrimaryStringType, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type TestAssocHandlerClearWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *TestAssocHandlerClear, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryStringTypeWithAfterPatchSave interface {
-	AfterPatchSave(context.Context, *PrimaryStringType, *field_mask.FieldMask, *gorm.DB) error
+type TestAssocHandlerClearWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *TestAssocHandlerClear, *field_mask.FieldMask, *gorm.DB) error
 }
 
-// DefaultPatchSetPrimaryStringType executes a bulk gorm update call with patch behavior
-func DefaultPatchSetPrimaryStringType(ctx context.Context, objects []*PrimaryStringType, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*PrimaryStringType, error) {
+// DefaultPatchSetTestAssocHandlerClear patches the objects in a transaction: the masks are applied in memory to
+// the stored objects, read and locked with a single query, and the objects are written with an update
+// per batch of 100 objects. The objects are read and written with the handles their hooks
+// return, the objects of different handles don't share the queries. Only the associations in the
+// masks are updated, and objects sharing a primary key are rejected
+func DefaultPatchSetTestAssocHandlerClear(ctx context.Context, objects []*TestAssocHandlerClear, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*TestAssocHandlerClear, error) {
 	if len(objects) != len(updateMasks) {
 		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
 	}
-
-	results := make([]*PrimaryStringType, 0, len(objects))
-	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchPrimaryStringType(ctx, patcher, updateMasks[i], db)
+	if len(objects) == 0 {
+		return []*TestAssocHandlerClear{}, nil
+	}
+	var err error
+	objectKeys := make([]string, 0, len(objects))
+	seen := make(map[string]struct{}, len(objects))
+	for _, in := range objects {
+		if in == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := in.ToORM(ctx)
 		if err != nil {
 			return nil, err
 		}
-
-		results = append(results, pbResponse)
+		if ormObj.Id == "" {
+			return nil, errors.EmptyIdError
+		}
+		key := ormObj.Id
+		if _, ok := seen[key]; ok {
+			return nil, errors.DuplicateKeyError
+		}
+		seen[key] = struct{}{}
+		objectKeys = append(objectKeys, key)
+	}
+	batchSize := 100
+	results := make([]*TestAssocHandlerClear, len(objects))
+	err = db.Transaction(func(db *gorm.DB) error {
+		var err error
+		// the objects sharing the handle their hooks return share the queries
+		dbs := make([]*gorm.DB, len(objects))
+		group := func() ([]*gorm.DB, map[*gorm.DB][]int) {
+			var handles []*gorm.DB
+			groups := make(map[*gorm.DB][]int)
+			for i, handle := range dbs {
+				if _, ok := groups[handle]; !ok {
+					handles = append(handles, handle)
+				}
+				groups[handle] = append(groups[handle], i)
+			}
+			return handles, groups
+		}
+		for i, in := range objects {
+			dbs[i] = db
+			if hook, ok := interface{}(&TestAssocHandlerClear{}).(TestAssocHandlerClearWithBeforePatchRead); ok {
+				if dbs[i], err = hook.BeforePatchRead(ctx, in, updateMasks[i], db); err != nil {
+					return err
+				}
+			}
+		}
+		targets := make(map[string]TestAssocHandlerClearORM, len(objects))
+		handles, groups := group()
+		for _, handle := range handles {
+			keys := make([]string, 0, len(groups[handle]))
+			for _, i := range groups[handle] {
+				keys = append(keys, objectKeys[i])
+			}
+			query := handle.Session(&gorm.Session{}).Clauses(clause.Locking{Strength: "UPDATE"})
+			query = query.Where("id in (?)", keys)
+			rows := []TestAssocHandlerClearORM{}
+			if err = query.Find(&rows).Error; err != nil {
+				return err
+			}
+			for _, row := range rows {
+				targets[row.Id] = row
+			}
+		}
+		ormObjs := make([]TestAssocHandlerClearORM, len(objects))
+		prepare := func(i int) (*gorm.DB, error) {
+			in, key, updateMask, db := objects[i], objectKeys[i], updateMasks[i], dbs[i]
+			target, ok := targets[key]
+			if !ok {
+				return nil, gorm.ErrRecordNotFound
+			}
+			stored, err := target.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbObj := &stored
+			if hook, ok := interface{}(pbObj).(TestAssocHandlerClearWithBeforePatchApplyFieldMask); ok {
+				if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			if _, err := DefaultApplyFieldMaskTestAssocHandlerClear(ctx, pbObj, in, updateMask, "", db); err != nil {
+				return nil, err
+			}
+			if hook, ok := interface{}(pbObj).(TestAssocHandlerClearWithBeforePatchSave); ok {
+				if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObj, err := pbObj.ToORM(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithBeforeStrictUpdateCleanup); ok {
+				if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			masked := make(map[string]bool, len(updateMask.GetPaths()))
+			for _, path := range updateMask.GetPaths() {
+				masked[strings.SplitN(path, ".", 2)[0]] = true
+			}
+			if masked["TestTagAssoc"] || masked["*"] {
+				if err = db.Model(&ormObj).Association("TestTagAssoc").Clear(); err != nil {
+					return nil, err
+				}
+				ormObj.TestTagAssoc = nil
+			}
+			if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithBeforeStrictUpdateSave); ok {
+				if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObjs[i] = ormObj
+			return db, nil
+		}
+		for i := range objects {
+			if dbs[i], err = prepare(i); err != nil {
+				return err
+			}
+		}
+		handles, groups = group()
+		for _, handle := range handles {
+			indexes := groups[handle]
+			for start := 0; start < len(indexes); start += batchSize {
+				end := start + batchSize
+				if end > len(indexes) {
+					end = len(indexes)
+				}
+				batch := indexes[start:end]
+				objs := make([]*TestAssocHandlerClearORM, 0, len(batch))
+				for _, i := range batch {
+					objs = append(objs, &ormObjs[i])
+				}
+				// the associations are saved first, the batch is then written with the
+				// foreign keys of its belongs-to associations
+				if err = handle.Session(&gorm.Session{}).Select("TestTagAssoc").Model(&objs).Updates(map[string]interface{}{}).Error; err != nil {
+					return err
+				}
+			}
+		}
+		finish := func(i int) (*TestAssocHandlerClear, error) {
+			in, updateMask, db, ormObj := objects[i], updateMasks[i], dbs[i], ormObjs[i]
+			var err error
+			if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithAfterStrictUpdateSave); ok {
+				if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			saved, err := ormObj.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbResponse := &saved
+			if hook, ok := interface{}(pbResponse).(TestAssocHandlerClearWithAfterPatchSave); ok {
+				if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			return pbResponse, nil
+		}
+		for i := range objects {
+			if results[i], err = finish(i); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
-
 	return results, nil
 }
 
-// DefaultApplyFieldMaskPrimaryStringType patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskPrimaryStringType(ctx context.Context, patchee *PrimaryStringType, patcher *PrimaryStringType, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*PrimaryStringType, error) {
+// DefaultApplyFieldMaskTestAssocHandlerClear patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskTestAssocHandlerClear(ctx context.Context, patchee *TestAssocHandlerClear, patcher *TestAssocHandlerClear, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*TestAssocHandlerClear, error) {
 	if patcher == nil {
 		return nil, nil
 	} else if patchee == nil {
 		return nil, errors.NilArgumentError
 	}
+	if len(updateMask.GetPaths()) == 1 && updateMask.Paths[0] == prefix+"*" {
+		updateMask = &field_mask.FieldMask{Paths: []string{prefix + "Id", prefix + "TestTagAssoc"}}
+	}
+	for _, f := range updateMask.GetPaths() {
+		if strings.HasPrefix(f, prefix) && !DefaultHasFieldMaskPathTestAssocHandlerClear(strings.TrimPrefix(f, prefix)) {
+			return nil, &errors.InvalidFieldMaskError{Path: f}
+		}
+	}
 	var err error
-	var updatedChild bool
-	for i, f := range updateMask.Paths {
+	for _, f := range updateMask.Paths {
 		if f == prefix+"Id" {
 			patchee.Id = patcher.Id
 			continue
 		}
-		if !updatedChild && strings.HasPrefix(f, prefix+"Child.") {
-			updatedChild = true
-			if patcher.Child == nil {
-				patchee.Child = nil
-				continue
-			}
-			if patchee.Child == nil {
-				patchee.Child = &ExternalChild{}
-			}
-			if o, err := DefaultApplyFieldMaskExternalChild(ctx, patchee.Child, patcher.Child, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"Child.", db); err != nil {
-				return nil, err
-			} else {
-				patchee.Child = o
-			}
-			continue
-		}
-		if f == prefix+"Child" {
-			updatedChild = true
-			patchee.Child = patcher.Child
+		if f == prefix+"TestTagAssoc" {
+			patchee.TestTagAssoc = patcher.TestTagAssoc
 			continue
 		}
 	}
@@ -3277,35 +8644,45 @@ func DefaultApplyFieldMaskPrimaryStringType(ctx context.Context, patchee *Primar
 	return patchee, nil
 }
 
-// DefaultListPrimaryStringType executes a gorm list call
-func DefaultListPrimaryStringType(ctx context.Context, db *gorm.DB) ([]*PrimaryStringType, error) {
-	in := PrimaryStringType{}
+// DefaultHasFieldMaskPathTestAssocHandlerClear reports whether TestAssocHandlerClear has the field of the field mask path
+func DefaultHasFieldMaskPathTestAssocHandlerClear(path string) bool {
+	parts := strings.SplitN(path, ".", 2)
+	switch parts[0] {
+	case "Id", "TestTagAssoc":
+		return len(parts) == 1
+	}
+	return false
+}
+
+// DefaultListTestAssocHandlerClear executes a gorm list call
+func DefaultListTestAssocHandlerClear(ctx context.Context, db *gorm.DB) ([]*TestAssocHandlerClear, error) {
+	in := TestAssocHandlerClear{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithBeforeListApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithBeforeListApplyQuery); ok {
 		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithBeforeListFind); ok {
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithBeforeListFind); ok {
 		if db, err = hook.BeforeListFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
 	db = db.Where(&ormObj)
 	db = db.Order("id")
-	ormResponse := []PrimaryStringTypeORM{}
+	ormResponse := []TestAssocHandlerClearORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithAfterListFind); ok {
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithAfterListFind); ok {
 		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse := []*PrimaryStringType{}
+	pbResponse := []*TestAssocHandlerClear{}
 	for _, responseEntry := range ormResponse {
 		temp, err := responseEntry.ToPB(ctx)
 		if err != nil {
@@ -3316,18 +8693,18 @@ func DefaultListPrimaryStringType(ctx context.Context, db *gorm.DB) ([]*PrimaryS
 	return pbResponse, nil
 }
 
-type PrimaryStringTypeORMWithBeforeListApplyQuery interface {
+type TestAssocHandlerClearORMWithBeforeListApplyQuery interface {
 	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryStringTypeORMWithBeforeListFind interface {
+type TestAssocHandlerClearORMWithBeforeListFind interface {
 	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryStringTypeORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]PrimaryStringTypeORM) error
+type TestAssocHandlerClearORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]TestAssocHandlerClearORM) error
 }
 
-// DefaultCreateTestTag executes a basic gorm create call
-func DefaultCreateTestTag(ctx context.Context, in *TestTag, db *gorm.DB) (*TestTag, error) {
+// DefaultCreateTestAssocHandlerAppend executes a basic gorm create call
+func DefaultCreateTestAssocHandlerAppend(ctx context.Context, in *TestAssocHandlerAppend, db *gorm.DB) (*TestAssocHandlerAppend, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -3335,7 +8712,7 @@ func DefaultCreateTestTag(ctx context.Context, in *TestTag, db *gorm.DB) (*TestT
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestTagORMWithBeforeCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithBeforeCreate_); ok {
 		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
 			return nil, err
 		}
@@ -3343,7 +8720,7 @@ func DefaultCreateTestTag(ctx context.Context, in *TestTag, db *gorm.DB) (*TestT
 	if err = db.Omit().Create(&ormObj).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestTagORMWithAfterCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithAfterCreate_); ok {
 		if err = hook.AfterCreate_(ctx, db); err != nil {
 			return nil, err
 		}
@@ -3352,14 +8729,78 @@ func DefaultCreateTestTag(ctx context.Context, in *TestTag, db *gorm.DB) (*TestT
 	return &pbResponse, err
 }
 
-type TestTagORMWithBeforeCreate_ interface {
+type TestAssocHandlerAppendORMWithBeforeCreate_ interface {
 	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestTagORMWithAfterCreate_ interface {
+type TestAssocHandlerAppendORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm.DB) error
 }
 
-func DefaultReadTestTag(ctx context.Context, in *TestTag, db *gorm.DB) (*TestTag, error) {
+// DefaultCreateTestAssocHandlerAppendSet executes gorm create calls in batches of 100 objects
+// in a transaction, either all of the objects are created or none of them. The BeforeCreate_ hook
+// of each object runs before the insert of its batch, which uses the handle the hooks of the
+// batch return
+func DefaultCreateTestAssocHandlerAppendSet(ctx context.Context, in []*TestAssocHandlerAppend, db *gorm.DB) ([]*TestAssocHandlerAppend, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObjs := make([]TestAssocHandlerAppendORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, ormObj)
+	}
+	batchSize := 100
+	err := db.Transaction(func(db *gorm.DB) error {
+		var err error
+		for start := 0; start < len(ormObjs); start += batchSize {
+			end := start + batchSize
+			if end > len(ormObjs) {
+				end = len(ormObjs)
+			}
+			batch := ormObjs[start:end]
+			// the scopes the hooks add only apply to the insert of their own batch
+			batchDB := db
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(TestAssocHandlerAppendORMWithBeforeCreate_); ok {
+					if batchDB, err = hook.BeforeCreate_(ctx, batchDB); err != nil {
+						return err
+					}
+				}
+			}
+			if err = batchDB.Omit().CreateInBatches(&batch, batchSize).Error; err != nil {
+				return err
+			}
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(TestAssocHandlerAppendORMWithAfterCreate_); ok {
+					if err = hook.AfterCreate_(ctx, db); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*TestAssocHandlerAppend, 0, len(ormObjs))
+	for i := range ormObjs {
+		pbResponse, err := ormObjs[i].ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, &pbResponse)
+	}
+	return results, nil
+}
+
+func DefaultReadTestAssocHandlerAppend(ctx context.Context, in *TestAssocHandlerAppend, db *gorm.DB) (*TestAssocHandlerAppend, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -3370,21 +8811,21 @@ func DefaultReadTestTag(ctx context.Context, in *TestTag, db *gorm.DB) (*TestTag
 	if ormObj.Id == "" {
 		return nil, errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(TestTagORMWithBeforeReadApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithBeforeReadApplyQuery); ok {
 		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if hook, ok := interface{}(&ormObj).(TestTagORMWithBeforeReadFind); ok {
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithBeforeReadFind); ok {
 		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	ormResponse := TestTagORM{}
+	ormResponse := TestAssocHandlerAppendORM{}
 	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormResponse).(TestTagORMWithAfterReadFind); ok {
+	if hook, ok := interface{}(&ormResponse).(TestAssocHandlerAppendORMWithAfterReadFind); ok {
 		if err = hook.AfterReadFind(ctx, db); err != nil {
 			return nil, err
 		}
@@ -3393,17 +8834,17 @@ func DefaultReadTestTag(ctx context.Context, in *TestTag, db *gorm.DB) (*TestTag
 	return &pbResponse, err
 }
 
-type TestTagORMWithBeforeReadApplyQuery interface {
+type TestAssocHandlerAppendORMWithBeforeReadApplyQuery interface {
 	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestTagORMWithBeforeReadFind interface {
+type TestAssocHandlerAppendORMWithBeforeReadFind interface {
 	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestTagORMWithAfterReadFind interface {
+type TestAssocHandlerAppendORMWithAfterReadFind interface {
 	AfterReadFind(context.Context, *gorm.DB) error
 }
 
-func DefaultDeleteTestTag(ctx context.Context, in *TestTag, db *gorm.DB) error {
+func DefaultDeleteTestAssocHandlerAppend(ctx context.Context, in *TestAssocHandlerAppend, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
@@ -3414,29 +8855,29 @@ func DefaultDeleteTestTag(ctx context.Context, in *TestTag, db *gorm.DB) error {
 	if ormObj.Id == "" {
 		return errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(TestTagORMWithBeforeDelete_); ok {
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithBeforeDelete_); ok {
 		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where(&ormObj).Delete(&TestTagORM{}).Error
+	err = db.Where(&ormObj).Delete(&TestAssocHandlerAppendORM{}).Error
 	if err != nil {
 		return err
 	}
-	if hook, ok := interface{}(&ormObj).(TestTagORMWithAfterDelete_); ok {
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithAfterDelete_); ok {
 		err = hook.AfterDelete_(ctx, db)
 	}
 	return err
 }
 
-type TestTagORMWithBeforeDelete_ interface {
+type TestAssocHandlerAppendORMWithBeforeDelete_ interface {
 	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestTagORMWithAfterDelete_ interface {
+type TestAssocHandlerAppendORMWithAfterDelete_ interface {
 	AfterDelete_(context.Context, *gorm.DB) error
 }
 
-func DefaultDeleteTestTagSet(ctx context.Context, in []*TestTag, db *gorm.DB) error {
+func DefaultDeleteTestAssocHandlerAppendSet(ctx context.Context, in []*TestAssocHandlerAppend, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
@@ -3452,55 +8893,50 @@ func DefaultDeleteTestTagSet(ctx context.Context, in []*TestTag, db *gorm.DB) er
 		}
 		keys = append(keys, ormObj.Id)
 	}
-	if hook, ok := (interface{}(&TestTagORM{})).(TestTagORMWithBeforeDeleteSet); ok {
+	if hook, ok := (interface{}(&TestAssocHandlerAppendORM{})).(TestAssocHandlerAppendORMWithBeforeDeleteSet); ok {
 		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where("id in (?)", keys).Delete(&TestTagORM{}).Error
+	err = db.Where("id in (?)", keys).Delete(&TestAssocHandlerAppendORM{}).Error
 	if err != nil {
 		return err
 	}
-	if hook, ok := (interface{}(&TestTagORM{})).(TestTagORMWithAfterDeleteSet); ok {
+	if hook, ok := (interface{}(&TestAssocHandlerAppendORM{})).(TestAssocHandlerAppendORMWithAfterDeleteSet); ok {
 		err = hook.AfterDeleteSet(ctx, in, db)
 	}
 	return err
 }
 
-type TestTagORMWithBeforeDeleteSet interface {
-	BeforeDeleteSet(context.Context, []*TestTag, *gorm.DB) (*gorm.DB, error)
+type TestAssocHandlerAppendORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*TestAssocHandlerAppend, *gorm.DB) (*gorm.DB, error)
 }
-type TestTagORMWithAfterDeleteSet interface {
-	AfterDeleteSet(context.Context, []*TestTag, *gorm.DB) error
+type TestAssocHandlerAppendORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*TestAssocHandlerAppend, *gorm.DB) error
 }
 
-// DefaultStrictUpdateTestTag clears / replaces / appends first level 1:many children and then executes a gorm update call
-func DefaultStrictUpdateTestTag(ctx context.Context, in *TestTag, db *gorm.DB) (*TestTag, error) {
+// DefaultStrictUpdateTestAssocHandlerAppend clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateTestAssocHandlerAppend(ctx context.Context, in *TestAssocHandlerAppend, db *gorm.DB) (*TestAssocHandlerAppend, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateTestTag")
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateTestAssocHandlerAppend")
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
 	var count int64
-	lockedRow := &TestTagORM{}
+	lockedRow := &TestAssocHandlerAppendORM{}
 	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
-	if hook, ok := interface{}(&ormObj).(TestTagORMWithBeforeStrictUpdateCleanup); ok {
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithBeforeStrictUpdateCleanup); ok {
 		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	filterTestTagAssoc := TestTagAssociationORM{}
-	if ormObj.Id == "" {
-		return nil, errors.EmptyIdError
-	}
-	filterTestTagAssoc.TestTagId = new(string)
-	*filterTestTagAssoc.TestTagId = ormObj.Id
-	if err = db.Where(filterTestTagAssoc).Delete(TestTagAssociationORM{}).Error; err != nil {
+	if err = db.Model(&ormObj).Association("TestTagAssoc").Append(ormObj.TestTagAssoc); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestTagORMWithBeforeStrictUpdateSave); ok {
+	ormObj.TestTagAssoc = nil
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithBeforeStrictUpdateSave); ok {
 		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
@@ -3508,7 +8944,7 @@ func DefaultStrictUpdateTestTag(ctx context.Context, in *TestTag, db *gorm.DB) (
 	if err = db.Omit().Save(&ormObj).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestTagORMWithAfterStrictUpdateSave); ok {
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithAfterStrictUpdateSave); ok {
 		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
@@ -3523,51 +8959,105 @@ func DefaultStrictUpdateTestTag(ctx context.Context, in *TestTag, db *gorm.DB) (
 	return &pbResponse, err
 }
 
-type TestTagORMWithBeforeStrictUpdateCleanup interface {
+type TestAssocHandlerAppendORMWithBeforeStrictUpdateCleanup interface {
 	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestTagORMWithBeforeStrictUpdateSave interface {
+type TestAssocHandlerAppendORMWithBeforeStrictUpdateSave interface {
 	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestTagORMWithAfterStrictUpdateSave interface {
+type TestAssocHandlerAppendORMWithAfterStrictUpdateSave interface {
 	AfterStrictUpdateSave(context.Context, *gorm.DB) error
 }
 
-// DefaultPatchTestTag executes a basic gorm update call with patch behavior
-func DefaultPatchTestTag(ctx context.Context, in *TestTag, updateMask *field_mask.FieldMask, db *gorm.DB) (*TestTag, error) {
+// DefaultUpsertTestAssocHandlerAppend executes a gorm create call resolving conflicts on conflictTarget,
+// the name of a unique index or the primary key when empty, by updating the columns
+// in updateMask, all of them when updateMask is empty, and returns the stored object
+func DefaultUpsertTestAssocHandlerAppend(ctx context.Context, in *TestAssocHandlerAppend, conflictTarget string, updateMask *field_mask.FieldMask, db *gorm.DB) (*TestAssocHandlerAppend, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conflict := clause.OnConflict{}
+	var lookup func() map[string]interface{}
+	switch conflictTarget {
+	case "":
+		conflict.Columns = []clause.Column{{Name: "id"}}
+		lookup = func() map[string]interface{} { return map[string]interface{}{"id": ormObj.Id} }
+	default:
+		return nil, fmt.Errorf("unknown conflict target %q of TestAssocHandlerAppend", conflictTarget)
+	}
+	if len(updateMask.GetPaths()) == 0 {
+		conflict.UpdateAll = true
+	} else {
+		return nil, &errors.InvalidFieldMaskError{Path: updateMask.GetPaths()[0]}
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithBeforeUpsert); ok {
+		if db, err = hook.BeforeUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Clauses(conflict).Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if len(updateMask.GetPaths()) > 0 {
+		stored := TestAssocHandlerAppendORM{}
+		if err = db.Where(lookup()).Take(&stored).Error; err != nil {
+			return nil, err
+		}
+		ormObj = stored
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithAfterUpsert); ok {
+		if err = hook.AfterUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type TestAssocHandlerAppendORMWithBeforeUpsert interface {
+	BeforeUpsert(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerAppendORMWithAfterUpsert interface {
+	AfterUpsert(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchTestAssocHandlerAppend executes a basic gorm update call with patch behavior
+func DefaultPatchTestAssocHandlerAppend(ctx context.Context, in *TestAssocHandlerAppend, updateMask *field_mask.FieldMask, db *gorm.DB) (*TestAssocHandlerAppend, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	var pbObj TestTag
+	pbObj := &TestAssocHandlerAppend{}
 	var err error
-	if hook, ok := interface{}(&pbObj).(TestTagWithBeforePatchRead); ok {
+	if hook, ok := interface{}(pbObj).(TestAssocHandlerAppendWithBeforePatchRead); ok {
 		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbReadRes, err := DefaultReadTestTag(ctx, &TestTag{Id: in.GetId()}, db)
-	if err != nil {
+	if pbObj, err = DefaultReadTestAssocHandlerAppend(ctx, &TestAssocHandlerAppend{Id: in.GetId()}, db); err != nil {
 		return nil, err
 	}
-	pbObj = *pbReadRes
-	if hook, ok := interface{}(&pbObj).(TestTagWithBeforePatchApplyFieldMask); ok {
+	if hook, ok := interface{}(pbObj).(TestAssocHandlerAppendWithBeforePatchApplyFieldMask); ok {
 		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	if _, err := DefaultApplyFieldMaskTestTag(ctx, &pbObj, in, updateMask, "", db); err != nil {
+	if _, err := DefaultApplyFieldMaskTestAssocHandlerAppend(ctx, pbObj, in, updateMask, "", db); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&pbObj).(TestTagWithBeforePatchSave); ok {
+	if hook, ok := interface{}(pbObj).(TestAssocHandlerAppendWithBeforePatchSave); ok {
 		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse, err := DefaultStrictUpdateTestTag(ctx, &pbObj, db)
+	pbResponse, err := DefaultStrictUpdateTestAssocHandlerAppend(ctx, pbObj, db)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(pbResponse).(TestTagWithAfterPatchSave); ok {
+	if hook, ok := interface{}(pbResponse).(TestAssocHandlerAppendWithAfterPatchSave); ok {
 		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
@@ -3575,425 +9065,411 @@ func DefaultPatchTestTag(ctx context.Context, in *TestTag, updateMask *field_mas
 	return pbResponse, nil
 }
 
-type TestTagWithBeforePatchRead interface {
-	BeforePatchRead(context.Context, *TestTag, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type TestAssocHandlerAppendWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *TestAssocHandlerAppend, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type TestTagWithBeforePatchApplyFieldMask interface {
-	BeforePatchApplyFieldMask(context.Context, *TestTag, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type TestAssocHandlerAppendWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *TestAssocHandlerAppend, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type TestTagWithBeforePatchSave interface {
-	BeforePatchSave(context.Context, *TestTag, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type TestAssocHandlerAppendWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *TestAssocHandlerAppend, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type TestTagWithAfterPatchSave interface {
-	AfterPatchSave(context.Context, *TestTag, *field_mask.FieldMask, *gorm.DB) error
+type TestAssocHandlerAppendWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *TestAssocHandlerAppend, *field_mask.FieldMask, *gorm.DB) error
 }
 
-// DefaultPatchSetTestTag executes a bulk gorm update call with patch behavior
-func DefaultPatchSetTestTag(ctx context.Context, objects []*TestTag, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*TestTag, error) {
+// DefaultPatchSetTestAssocHandlerAppend patches the objects in a transaction: the masks are applied in memory to
+// the stored objects, read and locked with a single query, and the objects are written with an update
+// per batch of 100 objects. The objects are read and written with the handles their hooks
+// return, the objects of different handles don't share the queries. Only the associations in the
+// masks are updated, and objects sharing a primary key are rejected
+func DefaultPatchSetTestAssocHandlerAppend(ctx context.Context, objects []*TestAssocHandlerAppend, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*TestAssocHandlerAppend, error) {
 	if len(objects) != len(updateMasks) {
 		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
 	}
-
-	results := make([]*TestTag, 0, len(objects))
-	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchTestTag(ctx, patcher, updateMasks[i], db)
+	if len(objects) == 0 {
+		return []*TestAssocHandlerAppend{}, nil
+	}
+	var err error
+	objectKeys := make([]string, 0, len(objects))
+	seen := make(map[string]struct{}, len(objects))
+	for _, in := range objects {
+		if in == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := in.ToORM(ctx)
 		if err != nil {
 			return nil, err
 		}
-
-		results = append(results, pbResponse)
-	}
-
-	return results, nil
-}
-
-// DefaultApplyFieldMaskTestTag patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskTestTag(ctx context.Context, patchee *TestTag, patcher *TestTag, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*TestTag, error) {
-	if patcher == nil {
-		return nil, nil
-	} else if patchee == nil {
-		return nil, errors.NilArgumentError
-	}
-	var err error
-	var updatedTestTagAssoc bool
-	for i, f := range updateMask.Paths {
-		if f == prefix+"Id" {
-			patchee.Id = patcher.Id
-			continue
+		if ormObj.Id == "" {
+			return nil, errors.EmptyIdError
+		}
+		key := ormObj.Id
+		if _, ok := seen[key]; ok {
+			return nil, errors.DuplicateKeyError
+		}
+		seen[key] = struct{}{}
+		objectKeys = append(objectKeys, key)
+	}
+	batchSize := 100
+	results := make([]*TestAssocHandlerAppend, len(objects))
+	err = db.Transaction(func(db *gorm.DB) error {
+		var err error
+		// the objects sharing the handle their hooks return share the queries
+		dbs := make([]*gorm.DB, len(objects))
+		group := func() ([]*gorm.DB, map[*gorm.DB][]int) {
+			var handles []*gorm.DB
+			groups := make(map[*gorm.DB][]int)
+			for i, handle := range dbs {
+				if _, ok := groups[handle]; !ok {
+					handles = append(handles, handle)
+				}
+				groups[handle] = append(groups[handle], i)
+			}
+			return handles, groups
 		}
-		if !updatedTestTagAssoc && strings.HasPrefix(f, prefix+"TestTagAssoc.") {
-			updatedTestTagAssoc = true
-			if patcher.TestTagAssoc == nil {
-				patchee.TestTagAssoc = nil
-				continue
+		for i, in := range objects {
+			dbs[i] = db
+			if hook, ok := interface{}(&TestAssocHandlerAppend{}).(TestAssocHandlerAppendWithBeforePatchRead); ok {
+				if dbs[i], err = hook.BeforePatchRead(ctx, in, updateMasks[i], db); err != nil {
+					return err
+				}
 			}
-			if patchee.TestTagAssoc == nil {
-				patchee.TestTagAssoc = &TestTagAssociation{}
+		}
+		targets := make(map[string]TestAssocHandlerAppendORM, len(objects))
+		handles, groups := group()
+		for _, handle := range handles {
+			keys := make([]string, 0, len(groups[handle]))
+			for _, i := range groups[handle] {
+				keys = append(keys, objectKeys[i])
 			}
-			if o, err := DefaultApplyFieldMaskTestTagAssociation(ctx, patchee.TestTagAssoc, patcher.TestTagAssoc, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"TestTagAssoc.", db); err != nil {
+			query := handle.Session(&gorm.Session{}).Clauses(clause.Locking{Strength: "UPDATE"})
+			query = query.Where("id in (?)", keys)
+			rows := []TestAssocHandlerAppendORM{}
+			if err = query.Find(&rows).Error; err != nil {
+				return err
+			}
+			for _, row := range rows {
+				targets[row.Id] = row
+			}
+		}
+		ormObjs := make([]TestAssocHandlerAppendORM, len(objects))
+		prepare := func(i int) (*gorm.DB, error) {
+			in, key, updateMask, db := objects[i], objectKeys[i], updateMasks[i], dbs[i]
+			target, ok := targets[key]
+			if !ok {
+				return nil, gorm.ErrRecordNotFound
+			}
+			stored, err := target.ToPB(ctx)
+			if err != nil {
 				return nil, err
-			} else {
-				patchee.TestTagAssoc = o
 			}
-			continue
+			pbObj := &stored
+			if hook, ok := interface{}(pbObj).(TestAssocHandlerAppendWithBeforePatchApplyFieldMask); ok {
+				if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			if _, err := DefaultApplyFieldMaskTestAssocHandlerAppend(ctx, pbObj, in, updateMask, "", db); err != nil {
+				return nil, err
+			}
+			if hook, ok := interface{}(pbObj).(TestAssocHandlerAppendWithBeforePatchSave); ok {
+				if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObj, err := pbObj.ToORM(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithBeforeStrictUpdateCleanup); ok {
+				if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			masked := make(map[string]bool, len(updateMask.GetPaths()))
+			for _, path := range updateMask.GetPaths() {
+				masked[strings.SplitN(path, ".", 2)[0]] = true
+			}
+			if masked["TestTagAssoc"] || masked["*"] {
+				if err = db.Model(&ormObj).Association("TestTagAssoc").Append(ormObj.TestTagAssoc); err != nil {
+					return nil, err
+				}
+				ormObj.TestTagAssoc = nil
+			}
+			if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithBeforeStrictUpdateSave); ok {
+				if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObjs[i] = ormObj
+			return db, nil
 		}
-		if f == prefix+"TestTagAssoc" {
-			updatedTestTagAssoc = true
-			patchee.TestTagAssoc = patcher.TestTagAssoc
-			continue
+		for i := range objects {
+			if dbs[i], err = prepare(i); err != nil {
+				return err
+			}
 		}
-	}
+		handles, groups = group()
+		for _, handle := range handles {
+			indexes := groups[handle]
+			for start := 0; start < len(indexes); start += batchSize {
+				end := start + batchSize
+				if end > len(indexes) {
+					end = len(indexes)
+				}
+				batch := indexes[start:end]
+				objs := make([]*TestAssocHandlerAppendORM, 0, len(batch))
+				for _, i := range batch {
+					objs = append(objs, &ormObjs[i])
+				}
+				// the associations are saved first, the batch is then written with the
+				// foreign keys of its belongs-to associations
+				if err = handle.Session(&gorm.Session{}).Select("TestTagAssoc").Model(&objs).Updates(map[string]interface{}{}).Error; err != nil {
+					return err
+				}
+			}
+		}
+		finish := func(i int) (*TestAssocHandlerAppend, error) {
+			in, updateMask, db, ormObj := objects[i], updateMasks[i], dbs[i], ormObjs[i]
+			var err error
+			if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithAfterStrictUpdateSave); ok {
+				if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			saved, err := ormObj.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbResponse := &saved
+			if hook, ok := interface{}(pbResponse).(TestAssocHandlerAppendWithAfterPatchSave); ok {
+				if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			return pbResponse, nil
+		}
+		for i := range objects {
+			if results[i], err = finish(i); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
-	return patchee, nil
+	return results, nil
 }
 
-// DefaultListTestTag executes a gorm list call
-func DefaultListTestTag(ctx context.Context, db *gorm.DB) ([]*TestTag, error) {
-	in := TestTag{}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(TestTagORMWithBeforeListApplyQuery); ok {
-		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
-			return nil, err
-		}
-	}
-	if hook, ok := interface{}(&ormObj).(TestTagORMWithBeforeListFind); ok {
-		if db, err = hook.BeforeListFind(ctx, db); err != nil {
-			return nil, err
-		}
+// DefaultApplyFieldMaskTestAssocHandlerAppend patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskTestAssocHandlerAppend(ctx context.Context, patchee *TestAssocHandlerAppend, patcher *TestAssocHandlerAppend, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*TestAssocHandlerAppend, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
 	}
-	db = db.Where(&ormObj)
-	db = db.Order("id")
-	ormResponse := []TestTagORM{}
-	if err := db.Find(&ormResponse).Error; err != nil {
-		return nil, err
+	if len(updateMask.GetPaths()) == 1 && updateMask.Paths[0] == prefix+"*" {
+		updateMask = &field_mask.FieldMask{Paths: []string{prefix + "Id", prefix + "TestTagAssoc"}}
 	}
-	if hook, ok := interface{}(&ormObj).(TestTagORMWithAfterListFind); ok {
-		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
-			return nil, err
+	for _, f := range updateMask.GetPaths() {
+		if strings.HasPrefix(f, prefix) && !DefaultHasFieldMaskPathTestAssocHandlerAppend(strings.TrimPrefix(f, prefix)) {
+			return nil, &errors.InvalidFieldMaskError{Path: f}
 		}
 	}
-	pbResponse := []*TestTag{}
-	for _, responseEntry := range ormResponse {
-		temp, err := responseEntry.ToPB(ctx)
-		if err != nil {
-			return nil, err
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
 		}
-		pbResponse = append(pbResponse, &temp)
-	}
-	return pbResponse, nil
-}
-
-type TestTagORMWithBeforeListApplyQuery interface {
-	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type TestTagORMWithBeforeListFind interface {
-	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type TestTagORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]TestTagORM) error
-}
-
-// DefaultCreateTestAssocHandlerDefault executes a basic gorm create call
-func DefaultCreateTestAssocHandlerDefault(ctx context.Context, in *TestAssocHandlerDefault, db *gorm.DB) (*TestAssocHandlerDefault, error) {
-	if in == nil {
-		return nil, errors.NilArgumentError
-	}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithBeforeCreate_); ok {
-		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
-			return nil, err
+		if f == prefix+"TestTagAssoc" {
+			patchee.TestTagAssoc = patcher.TestTagAssoc
+			continue
 		}
 	}
-	if err = db.Omit().Create(&ormObj).Error; err != nil {
+	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithAfterCreate_); ok {
-		if err = hook.AfterCreate_(ctx, db); err != nil {
-			return nil, err
-		}
-	}
-	pbResponse, err := ormObj.ToPB(ctx)
-	return &pbResponse, err
+	return patchee, nil
 }
 
-type TestAssocHandlerDefaultORMWithBeforeCreate_ interface {
-	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type TestAssocHandlerDefaultORMWithAfterCreate_ interface {
-	AfterCreate_(context.Context, *gorm.DB) error
+// DefaultHasFieldMaskPathTestAssocHandlerAppend reports whether TestAssocHandlerAppend has the field of the field mask path
+func DefaultHasFieldMaskPathTestAssocHandlerAppend(path string) bool {
+	parts := strings.SplitN(path, ".", 2)
+	switch parts[0] {
+	case "Id", "TestTagAssoc":
+		return len(parts) == 1
+	}
+	return false
 }
 
-func DefaultReadTestAssocHandlerDefault(ctx context.Context, in *TestAssocHandlerDefault, db *gorm.DB) (*TestAssocHandlerDefault, error) {
-	if in == nil {
-		return nil, errors.NilArgumentError
-	}
+// DefaultListTestAssocHandlerAppend executes a gorm list call
+func DefaultListTestAssocHandlerAppend(ctx context.Context, db *gorm.DB) ([]*TestAssocHandlerAppend, error) {
+	in := TestAssocHandlerAppend{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if ormObj.Id == "" {
-		return nil, errors.EmptyIdError
-	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithBeforeReadApplyQuery); ok {
-		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithBeforeReadFind); ok {
-		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	ormResponse := TestAssocHandlerDefaultORM{}
-	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []TestAssocHandlerAppendORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormResponse).(TestAssocHandlerDefaultORMWithAfterReadFind); ok {
-		if err = hook.AfterReadFind(ctx, db); err != nil {
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse, err := ormResponse.ToPB(ctx)
-	return &pbResponse, err
-}
-
-type TestAssocHandlerDefaultORMWithBeforeReadApplyQuery interface {
-	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type TestAssocHandlerDefaultORMWithBeforeReadFind interface {
-	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type TestAssocHandlerDefaultORMWithAfterReadFind interface {
-	AfterReadFind(context.Context, *gorm.DB) error
-}
-
-func DefaultDeleteTestAssocHandlerDefault(ctx context.Context, in *TestAssocHandlerDefault, db *gorm.DB) error {
-	if in == nil {
-		return errors.NilArgumentError
-	}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return err
-	}
-	if ormObj.Id == "" {
-		return errors.EmptyIdError
-	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithBeforeDelete_); ok {
-		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
-			return err
-		}
-	}
-	err = db.Where(&ormObj).Delete(&TestAssocHandlerDefaultORM{}).Error
-	if err != nil {
-		return err
-	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithAfterDelete_); ok {
-		err = hook.AfterDelete_(ctx, db)
-	}
-	return err
-}
-
-type TestAssocHandlerDefaultORMWithBeforeDelete_ interface {
-	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type TestAssocHandlerDefaultORMWithAfterDelete_ interface {
-	AfterDelete_(context.Context, *gorm.DB) error
-}
-
-func DefaultDeleteTestAssocHandlerDefaultSet(ctx context.Context, in []*TestAssocHandlerDefault, db *gorm.DB) error {
-	if in == nil {
-		return errors.NilArgumentError
-	}
-	var err error
-	keys := []string{}
-	for _, obj := range in {
-		ormObj, err := obj.ToORM(ctx)
+	pbResponse := []*TestAssocHandlerAppend{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
 		if err != nil {
-			return err
-		}
-		if ormObj.Id == "" {
-			return errors.EmptyIdError
-		}
-		keys = append(keys, ormObj.Id)
-	}
-	if hook, ok := (interface{}(&TestAssocHandlerDefaultORM{})).(TestAssocHandlerDefaultORMWithBeforeDeleteSet); ok {
-		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
-			return err
+			return nil, err
 		}
+		pbResponse = append(pbResponse, &temp)
 	}
-	err = db.Where("id in (?)", keys).Delete(&TestAssocHandlerDefaultORM{}).Error
-	if err != nil {
-		return err
-	}
-	if hook, ok := (interface{}(&TestAssocHandlerDefaultORM{})).(TestAssocHandlerDefaultORMWithAfterDeleteSet); ok {
-		err = hook.AfterDeleteSet(ctx, in, db)
-	}
-	return err
+	return pbResponse, nil
 }
 
-type TestAssocHandlerDefaultORMWithBeforeDeleteSet interface {
-	BeforeDeleteSet(context.Context, []*TestAssocHandlerDefault, *gorm.DB) (*gorm.DB, error)
+type TestAssocHandlerAppendORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerDefaultORMWithAfterDeleteSet interface {
-	AfterDeleteSet(context.Context, []*TestAssocHandlerDefault, *gorm.DB) error
+type TestAssocHandlerAppendORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerAppendORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]TestAssocHandlerAppendORM) error
 }
 
-// DefaultStrictUpdateTestAssocHandlerDefault clears / replaces / appends first level 1:many children and then executes a gorm update call
-func DefaultStrictUpdateTestAssocHandlerDefault(ctx context.Context, in *TestAssocHandlerDefault, db *gorm.DB) (*TestAssocHandlerDefault, error) {
+// DefaultCreateTestTagAssociation executes a basic gorm create call
+func DefaultCreateTestTagAssociation(ctx context.Context, in *TestTagAssociation, db *gorm.DB) (*TestTagAssociation, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateTestAssocHandlerDefault")
+		return nil, errors.NilArgumentError
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	var count int64
-	lockedRow := &TestAssocHandlerDefaultORM{}
-	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithBeforeStrictUpdateCleanup); ok {
-		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
-			return nil, err
-		}
-	}
-	filterTestTagAssoc := TestTagAssociationORM{}
-	if ormObj.Id == "" {
-		return nil, errors.EmptyIdError
-	}
-	filterTestTagAssoc.TestAssocHandlerDefaultId = new(string)
-	*filterTestTagAssoc.TestAssocHandlerDefaultId = ormObj.Id
-	if err = db.Where(filterTestTagAssoc).Delete(TestTagAssociationORM{}).Error; err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithBeforeStrictUpdateSave); ok {
-		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+	if hook, ok := interface{}(&ormObj).(TestTagAssociationORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if err = db.Omit().Save(&ormObj).Error; err != nil {
+	if err = db.Omit().Create(&ormObj).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithAfterStrictUpdateSave); ok {
-		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+	if hook, ok := interface{}(&ormObj).(TestTagAssociationORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
 			return nil, err
 		}
 	}
 	pbResponse, err := ormObj.ToPB(ctx)
-	if err != nil {
-		return nil, err
-	}
-	if count == 0 {
-		err = gateway.SetCreated(ctx, "")
-	}
 	return &pbResponse, err
 }
 
-type TestAssocHandlerDefaultORMWithBeforeStrictUpdateCleanup interface {
-	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type TestAssocHandlerDefaultORMWithBeforeStrictUpdateSave interface {
-	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+type TestTagAssociationORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerDefaultORMWithAfterStrictUpdateSave interface {
-	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+type TestTagAssociationORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
 }
 
-// DefaultPatchTestAssocHandlerDefault executes a basic gorm update call with patch behavior
-func DefaultPatchTestAssocHandlerDefault(ctx context.Context, in *TestAssocHandlerDefault, updateMask *field_mask.FieldMask, db *gorm.DB) (*TestAssocHandlerDefault, error) {
+// DefaultCreateTestTagAssociationSet executes gorm create calls in batches of 100 objects
+// in a transaction, either all of the objects are created or none of them. The BeforeCreate_ hook
+// of each object runs before the insert of its batch, which uses the handle the hooks of the
+// batch return
+func DefaultCreateTestTagAssociationSet(ctx context.Context, in []*TestTagAssociation, db *gorm.DB) ([]*TestTagAssociation, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	var pbObj TestAssocHandlerDefault
-	var err error
-	if hook, ok := interface{}(&pbObj).(TestAssocHandlerDefaultWithBeforePatchRead); ok {
-		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
-			return nil, err
+	ormObjs := make([]TestTagAssociationORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
 		}
-	}
-	pbReadRes, err := DefaultReadTestAssocHandlerDefault(ctx, &TestAssocHandlerDefault{Id: in.GetId()}, db)
-	if err != nil {
-		return nil, err
-	}
-	pbObj = *pbReadRes
-	if hook, ok := interface{}(&pbObj).(TestAssocHandlerDefaultWithBeforePatchApplyFieldMask); ok {
-		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
 			return nil, err
 		}
+		ormObjs = append(ormObjs, ormObj)
 	}
-	if _, err := DefaultApplyFieldMaskTestAssocHandlerDefault(ctx, &pbObj, in, updateMask, "", db); err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&pbObj).(TestAssocHandlerDefaultWithBeforePatchSave); ok {
-		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
-			return nil, err
+	batchSize := 100
+	err := db.Transaction(func(db *gorm.DB) error {
+		var err error
+		for start := 0; start < len(ormObjs); start += batchSize {
+			end := start + batchSize
+			if end > len(ormObjs) {
+				end = len(ormObjs)
+			}
+			batch := ormObjs[start:end]
+			// the scopes the hooks add only apply to the insert of their own batch
+			batchDB := db
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(TestTagAssociationORMWithBeforeCreate_); ok {
+					if batchDB, err = hook.BeforeCreate_(ctx, batchDB); err != nil {
+						return err
+					}
+				}
+			}
+			if err = batchDB.Omit().CreateInBatches(&batch, batchSize).Error; err != nil {
+				return err
+			}
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(TestTagAssociationORMWithAfterCreate_); ok {
+					if err = hook.AfterCreate_(ctx, db); err != nil {
+						return err
+					}
+				}
+			}
 		}
-	}
-	pbResponse, err := DefaultStrictUpdateTestAssocHandlerDefault(ctx, &pbObj, db)
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(pbResponse).(TestAssocHandlerDefaultWithAfterPatchSave); ok {
-		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
-			return nil, err
-		}
-	}
-	return pbResponse, nil
-}
-
-type TestAssocHandlerDefaultWithBeforePatchRead interface {
-	BeforePatchRead(context.Context, *TestAssocHandlerDefault, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
-}
-type TestAssocHandlerDefaultWithBeforePatchApplyFieldMask interface {
-	BeforePatchApplyFieldMask(context.Context, *TestAssocHandlerDefault, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
-}
-type TestAssocHandlerDefaultWithBeforePatchSave interface {
-	BeforePatchSave(context.Context, *TestAssocHandlerDefault, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
-}
-type TestAssocHandlerDefaultWithAfterPatchSave interface {
-	AfterPatchSave(context.Context, *TestAssocHandlerDefault, *field_mask.FieldMask, *gorm.DB) error
-}
-
-// DefaultPatchSetTestAssocHandlerDefault executes a bulk gorm update call with patch behavior
-func DefaultPatchSetTestAssocHandlerDefault(ctx context.Context, objects []*TestAssocHandlerDefault, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*TestAssocHandlerDefault, error) {
-	if len(objects) != len(updateMasks) {
-		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
-	}
-
-	results := make([]*TestAssocHandlerDefault, 0, len(objects))
-	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchTestAssocHandlerDefault(ctx, patcher, updateMasks[i], db)
+	results := make([]*TestTagAssociation, 0, len(ormObjs))
+	for i := range ormObjs {
+		pbResponse, err := ormObjs[i].ToPB(ctx)
 		if err != nil {
 			return nil, err
 		}
-
-		results = append(results, pbResponse)
+		results = append(results, &pbResponse)
 	}
-
 	return results, nil
 }
 
-// DefaultApplyFieldMaskTestAssocHandlerDefault patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskTestAssocHandlerDefault(ctx context.Context, patchee *TestAssocHandlerDefault, patcher *TestAssocHandlerDefault, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*TestAssocHandlerDefault, error) {
+// DefaultApplyFieldMaskTestTagAssociation patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskTestTagAssociation(ctx context.Context, patchee *TestTagAssociation, patcher *TestTagAssociation, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*TestTagAssociation, error) {
 	if patcher == nil {
 		return nil, nil
 	} else if patchee == nil {
 		return nil, errors.NilArgumentError
 	}
+	if len(updateMask.GetPaths()) == 1 && updateMask.Paths[0] == prefix+"*" {
+		updateMask = &field_mask.FieldMask{Paths: []string{prefix + "SomeField"}}
+	}
+	for _, f := range updateMask.GetPaths() {
+		if strings.HasPrefix(f, prefix) && !DefaultHasFieldMaskPathTestTagAssociation(strings.TrimPrefix(f, prefix)) {
+			return nil, &errors.InvalidFieldMaskError{Path: f}
+		}
+	}
 	var err error
 	for _, f := range updateMask.Paths {
-		if f == prefix+"Id" {
-			patchee.Id = patcher.Id
-			continue
-		}
-		if f == prefix+"TestTagAssoc" {
-			patchee.TestTagAssoc = patcher.TestTagAssoc
+		if f == prefix+"SomeField" {
+			patchee.SomeField = patcher.SomeField
 			continue
 		}
 	}
@@ -4003,35 +9479,44 @@ func DefaultApplyFieldMaskTestAssocHandlerDefault(ctx context.Context, patchee *
 	return patchee, nil
 }
 
-// DefaultListTestAssocHandlerDefault executes a gorm list call
-func DefaultListTestAssocHandlerDefault(ctx context.Context, db *gorm.DB) ([]*TestAssocHandlerDefault, error) {
-	in := TestAssocHandlerDefault{}
+// DefaultHasFieldMaskPathTestTagAssociation reports whether TestTagAssociation has the field of the field mask path
+func DefaultHasFieldMaskPathTestTagAssociation(path string) bool {
+	parts := strings.SplitN(path, ".", 2)
+	switch parts[0] {
+	case "SomeField":
+		return len(parts) == 1
+	}
+	return false
+}
+
+// DefaultListTestTagAssociation executes a gorm list call
+func DefaultListTestTagAssociation(ctx context.Context, db *gorm.DB) ([]*TestTagAssociation, error) {
+	in := TestTagAssociation{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithBeforeListApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(TestTagAssociationORMWithBeforeListApplyQuery); ok {
 		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithBeforeListFind); ok {
+	if hook, ok := interface{}(&ormObj).(TestTagAssociationORMWithBeforeListFind); ok {
 		if db, err = hook.BeforeListFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
 	db = db.Where(&ormObj)
-	db = db.Order("id")
-	ormResponse := []TestAssocHandlerDefaultORM{}
+	ormResponse := []TestTagAssociationORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithAfterListFind); ok {
+	if hook, ok := interface{}(&ormObj).(TestTagAssociationORMWithAfterListFind); ok {
 		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse := []*TestAssocHandlerDefault{}
+	pbResponse := []*TestTagAssociation{}
 	for _, responseEntry := range ormResponse {
 		temp, err := responseEntry.ToPB(ctx)
 		if err != nil {
@@ -4042,18 +9527,18 @@ func DefaultListTestAssocHandlerDefault(ctx context.Context, db *gorm.DB) ([]*Te
 	return pbResponse, nil
 }
 
-type TestAssocHandlerDefaultORMWithBeforeListApplyQuery interface {
+type TestTagAssociationORMWithBeforeListApplyQuery interface {
 	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerDefaultORMWithBeforeListFind interface {
+type TestTagAssociationORMWithBeforeListFind interface {
 	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerDefaultORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]TestAssocHandlerDefaultORM) error
+type TestTagAssociationORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]TestTagAssociationORM) error
 }
 
-// DefaultCreateTestAssocHandlerReplace executes a basic gorm create call
-func DefaultCreateTestAssocHandlerReplace(ctx context.Context, in *TestAssocHandlerReplace, db *gorm.DB) (*TestAssocHandlerReplace, error) {
+// DefaultCreatePrimaryIncluded executes a basic gorm create call
+func DefaultCreatePrimaryIncluded(ctx context.Context, in *PrimaryIncluded, db *gorm.DB) (*PrimaryIncluded, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -4061,7 +9546,7 @@ func DefaultCreateTestAssocHandlerReplace(ctx context.Context, in *TestAssocHand
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithBeforeCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithBeforeCreate_); ok {
 		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
 			return nil, err
 		}
@@ -4069,7 +9554,7 @@ func DefaultCreateTestAssocHandlerReplace(ctx context.Context, in *TestAssocHand
 	if err = db.Omit().Create(&ormObj).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithAfterCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithAfterCreate_); ok {
 		if err = hook.AfterCreate_(ctx, db); err != nil {
 			return nil, err
 		}
@@ -4078,14 +9563,78 @@ func DefaultCreateTestAssocHandlerReplace(ctx context.Context, in *TestAssocHand
 	return &pbResponse, err
 }
 
-type TestAssocHandlerReplaceORMWithBeforeCreate_ interface {
+type PrimaryIncludedORMWithBeforeCreate_ interface {
 	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerReplaceORMWithAfterCreate_ interface {
+type PrimaryIncludedORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm.DB) error
 }
 
-func DefaultReadTestAssocHandlerReplace(ctx context.Context, in *TestAssocHandlerReplace, db *gorm.DB) (*TestAssocHandlerReplace, error) {
+// DefaultCreatePrimaryIncludedSet executes gorm create calls in batches of 100 objects
+// in a transaction, either all of the objects are created or none of them. The BeforeCreate_ hook
+// of each object runs before the insert of its batch, which uses the handle the hooks of the
+// batch return
+func DefaultCreatePrimaryIncludedSet(ctx context.Context, in []*PrimaryIncluded, db *gorm.DB) ([]*PrimaryIncluded, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObjs := make([]PrimaryIncludedORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, ormObj)
+	}
+	batchSize := 100
+	err := db.Transaction(func(db *gorm.DB) error {
+		var err error
+		for start := 0; start < len(ormObjs); start += batchSize {
+			end := start + batchSize
+			if end > len(ormObjs) {
+				end = len(ormObjs)
+			}
+			batch := ormObjs[start:end]
+			// the scopes the hooks add only apply to the insert of their own batch
+			batchDB := db
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(PrimaryIncludedORMWithBeforeCreate_); ok {
+					if batchDB, err = hook.BeforeCreate_(ctx, batchDB); err != nil {
+						return err
+					}
+				}
+			}
+			if err = batchDB.Omit().CreateInBatches(&batch, batchSize).Error; err != nil {
+				return err
+			}
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(PrimaryIncludedORMWithAfterCreate_); ok {
+					if err = hook.AfterCreate_(ctx, db); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*PrimaryIncluded, 0, len(ormObjs))
+	for i := range ormObjs {
+		pbResponse, err := ormObjs[i].ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, &pbResponse)
+	}
+	return results, nil
+}
+
+func DefaultReadPrimaryIncluded(ctx context.Context, in *PrimaryIncluded, db *gorm.DB) (*PrimaryIncluded, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -4093,24 +9642,24 @@ func DefaultReadTestAssocHandlerReplace(ctx context.Context, in *TestAssocHandle
 	if err != nil {
 		return nil, err
 	}
-	if ormObj.Id == "" {
+	if ormObj.Id == go_uuid.Nil {
 		return nil, errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithBeforeReadApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithBeforeReadApplyQuery); ok {
 		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithBeforeReadFind); ok {
+	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithBeforeReadFind); ok {
 		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	ormResponse := TestAssocHandlerReplaceORM{}
+	ormResponse := PrimaryIncludedORM{}
 	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormResponse).(TestAssocHandlerReplaceORMWithAfterReadFind); ok {
+	if hook, ok := interface{}(&ormResponse).(PrimaryIncludedORMWithAfterReadFind); ok {
 		if err = hook.AfterReadFind(ctx, db); err != nil {
 			return nil, err
 		}
@@ -4119,17 +9668,17 @@ func DefaultReadTestAssocHandlerReplace(ctx context.Context, in *TestAssocHandle
 	return &pbResponse, err
 }
 
-type TestAssocHandlerReplaceORMWithBeforeReadApplyQuery interface {
+type PrimaryIncludedORMWithBeforeReadApplyQuery interface {
 	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerReplaceORMWithBeforeReadFind interface {
+type PrimaryIncludedORMWithBeforeReadFind interface {
 	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerReplaceORMWithAfterReadFind interface {
+type PrimaryIncludedORMWithAfterReadFind interface {
 	AfterReadFind(context.Context, *gorm.DB) error
 }
 
-func DefaultDeleteTestAssocHandlerReplace(ctx context.Context, in *TestAssocHandlerReplace, db *gorm.DB) error {
+func DefaultDeletePrimaryIncluded(ctx context.Context, in *PrimaryIncluded, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
@@ -4137,272 +9686,132 @@ func DefaultDeleteTestAssocHandlerReplace(ctx context.Context, in *TestAssocHand
 	if err != nil {
 		return err
 	}
-	if ormObj.Id == "" {
+	if ormObj.Id == go_uuid.Nil {
 		return errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithBeforeDelete_); ok {
+	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithBeforeDelete_); ok {
 		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where(&ormObj).Delete(&TestAssocHandlerReplaceORM{}).Error
+	err = db.Where(&ormObj).Delete(&PrimaryIncludedORM{}).Error
 	if err != nil {
 		return err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithAfterDelete_); ok {
+	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithAfterDelete_); ok {
 		err = hook.AfterDelete_(ctx, db)
 	}
 	return err
 }
 
-type TestAssocHandlerReplaceORMWithBeforeDelete_ interface {
+type PrimaryIncludedORMWithBeforeDelete_ interface {
 	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type TestAssocHandlerReplaceORMWithAfterDelete_ interface {
-	AfterDelete_(context.Context, *gorm.DB) error
-}
-
-func DefaultDeleteTestAssocHandlerReplaceSet(ctx context.Context, in []*TestAssocHandlerReplace, db *gorm.DB) error {
-	if in == nil {
-		return errors.NilArgumentError
-	}
-	var err error
-	keys := []string{}
-	for _, obj := range in {
-		ormObj, err := obj.ToORM(ctx)
-		if err != nil {
-			return err
-		}
-		if ormObj.Id == "" {
-			return errors.EmptyIdError
-		}
-		keys = append(keys, ormObj.Id)
-	}
-	if hook, ok := (interface{}(&TestAssocHandlerReplaceORM{})).(TestAssocHandlerReplaceORMWithBeforeDeleteSet); ok {
-		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
-			return err
-		}
-	}
-	err = db.Where("id in (?)", keys).Delete(&TestAssocHandlerReplaceORM{}).Error
-	if err != nil {
-		return err
-	}
-	if hook, ok := (interface{}(&TestAssocHandlerReplaceORM{})).(TestAssocHandlerReplaceORMWithAfterDeleteSet); ok {
-		err = hook.AfterDeleteSet(ctx, in, db)
-	}
-	return err
-}
-
-type TestAssocHandlerReplaceORMWithBeforeDeleteSet interface {
-	BeforeDeleteSet(context.Context, []*TestAssocHandlerReplace, *gorm.DB) (*gorm.DB, error)
-}
-type TestAssocHandlerReplaceORMWithAfterDeleteSet interface {
-	AfterDeleteSet(context.Context, []*TestAssocHandlerReplace, *gorm.DB) error
-}
-
-// DefaultStrictUpdateTestAssocHandlerReplace clears / replaces / appends first level 1:many children and then executes a gorm update call
-func DefaultStrictUpdateTestAssocHandlerReplace(ctx context.Context, in *TestAssocHandlerReplace, db *gorm.DB) (*TestAssocHandlerReplace, error) {
-	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateTestAssocHandlerReplace")
-	}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return nil, err
-	}
-	var count int64
-	lockedRow := &TestAssocHandlerReplaceORM{}
-	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithBeforeStrictUpdateCleanup); ok {
-		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
-			return nil, err
-		}
-	}
-	if err = db.Model(&ormObj).Association("TestTagAssoc").Replace(ormObj.TestTagAssoc); err != nil {
-		return nil, err
-	}
-	ormObj.TestTagAssoc = nil
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithBeforeStrictUpdateSave); ok {
-		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
-			return nil, err
-		}
-	}
-	if err = db.Omit().Save(&ormObj).Error; err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithAfterStrictUpdateSave); ok {
-		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
-			return nil, err
-		}
-	}
-	pbResponse, err := ormObj.ToPB(ctx)
-	if err != nil {
-		return nil, err
-	}
-	if count == 0 {
-		err = gateway.SetCreated(ctx, "")
-	}
-	return &pbResponse, err
-}
-
-type TestAssocHandlerReplaceORMWithBeforeStrictUpdateCleanup interface {
-	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type TestAssocHandlerReplaceORMWithBeforeStrictUpdateSave interface {
-	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type TestAssocHandlerReplaceORMWithAfterStrictUpdateSave interface {
-	AfterStrictUpdateSave(context.Context, *gorm.DB) error
-}
-
-// DefaultPatchTestAssocHandlerReplace executes a basic gorm update call with patch behavior
-func DefaultPatchTestAssocHandlerReplace(ctx context.Context, in *TestAssocHandlerReplace, updateMask *field_mask.FieldMask, db *gorm.DB) (*TestAssocHandlerReplace, error) {
-	if in == nil {
-		return nil, errors.NilArgumentError
-	}
-	var pbObj TestAssocHandlerReplace
-	var err error
-	if hook, ok := interface{}(&pbObj).(TestAssocHandlerReplaceWithBeforePatchRead); ok {
-		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
-			return nil, err
-		}
-	}
-	pbReadRes, err := DefaultReadTestAssocHandlerReplace(ctx, &TestAssocHandlerReplace{Id: in.GetId()}, db)
-	if err != nil {
-		return nil, err
-	}
-	pbObj = *pbReadRes
-	if hook, ok := interface{}(&pbObj).(TestAssocHandlerReplaceWithBeforePatchApplyFieldMask); ok {
-		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
-			return nil, err
-		}
-	}
-	if _, err := DefaultApplyFieldMaskTestAssocHandlerReplace(ctx, &pbObj, in, updateMask, "", db); err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&pbObj).(TestAssocHandlerReplaceWithBeforePatchSave); ok {
-		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
-			return nil, err
-		}
-	}
-	pbResponse, err := DefaultStrictUpdateTestAssocHandlerReplace(ctx, &pbObj, db)
-	if err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(pbResponse).(TestAssocHandlerReplaceWithAfterPatchSave); ok {
-		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
-			return nil, err
-		}
-	}
-	return pbResponse, nil
-}
-
-type TestAssocHandlerReplaceWithBeforePatchRead interface {
-	BeforePatchRead(context.Context, *TestAssocHandlerReplace, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
-}
-type TestAssocHandlerReplaceWithBeforePatchApplyFieldMask interface {
-	BeforePatchApplyFieldMask(context.Context, *TestAssocHandlerReplace, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
-}
-type TestAssocHandlerReplaceWithBeforePatchSave interface {
-	BeforePatchSave(context.Context, *TestAssocHandlerReplace, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
-}
-type TestAssocHandlerReplaceWithAfterPatchSave interface {
-	AfterPatchSave(context.Context, *TestAssocHandlerReplace, *field_mask.FieldMask, *gorm.DB) error
-}
-
-// DefaultPatchSetTestAssocHandlerReplace executes a bulk gorm update call with patch behavior
-func DefaultPatchSetTestAssocHandlerReplace(ctx context.Context, objects []*TestAssocHandlerReplace, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*TestAssocHandlerReplace, error) {
-	if len(objects) != len(updateMasks) {
-		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
-	}
-
-	results := make([]*TestAssocHandlerReplace, 0, len(objects))
-	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchTestAssocHandlerReplace(ctx, patcher, updateMasks[i], db)
-		if err != nil {
-			return nil, err
-		}
-
-		results = append(results, pbResponse)
-	}
-
-	return results, nil
-}
-
-// DefaultApplyFieldMaskTestAssocHandlerReplace patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskTestAssocHandlerReplace(ctx context.Context, patchee *TestAssocHandlerReplace, patcher *TestAssocHandlerReplace, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*TestAssocHandlerReplace, error) {
-	if patcher == nil {
-		return nil, nil
-	} else if patchee == nil {
-		return nil, errors.NilArgumentError
+}
+type PrimaryIncludedORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeletePrimaryIncludedSet(ctx context.Context, in []*PrimaryIncluded, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
 	}
 	var err error
-	for _, f := range updateMask.Paths {
-		if f == prefix+"Id" {
-			patchee.Id = patcher.Id
-			continue
+	keys := []go_uuid.UUID{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
 		}
-		if f == prefix+"TestTagAssoc" {
-			patchee.TestTagAssoc = patcher.TestTagAssoc
-			continue
+		if ormObj.Id == go_uuid.Nil {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&PrimaryIncludedORM{})).(PrimaryIncludedORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
 		}
 	}
+	err = db.Where("id in (?)", keys).Delete(&PrimaryIncludedORM{}).Error
 	if err != nil {
-		return nil, err
+		return err
 	}
-	return patchee, nil
+	if hook, ok := (interface{}(&PrimaryIncludedORM{})).(PrimaryIncludedORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
 }
 
-// DefaultListTestAssocHandlerReplace executes a gorm list call
-func DefaultListTestAssocHandlerReplace(ctx context.Context, db *gorm.DB) ([]*TestAssocHandlerReplace, error) {
-	in := TestAssocHandlerReplace{}
+type PrimaryIncludedORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*PrimaryIncluded, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryIncludedORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*PrimaryIncluded, *gorm.DB) error
+}
+
+// DefaultStrictUpdatePrimaryIncluded clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdatePrimaryIncluded(ctx context.Context, in *PrimaryIncluded, db *gorm.DB) (*PrimaryIncluded, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdatePrimaryIncluded")
+	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithBeforeListApplyQuery); ok {
-		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+	var count int64
+	lockedRow := &PrimaryIncludedORM{}
+	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
+	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithBeforeListFind); ok {
-		if db, err = hook.BeforeListFind(ctx, db); err != nil {
-			return nil, err
-		}
+	filterChild := ExternalChildORM{}
+	if ormObj.Id == go_uuid.Nil {
+		return nil, errors.EmptyIdError
 	}
-	db = db.Where(&ormObj)
-	db = db.Order("id")
-	ormResponse := []TestAssocHandlerReplaceORM{}
-	if err := db.Find(&ormResponse).Error; err != nil {
+	filterChild.PrimaryIncludedId = new(go_uuid.UUID)
+	*filterChild.PrimaryIncludedId = ormObj.Id
+	if err = db.Where(filterChild).Delete(ExternalChildORM{}).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithAfterListFind); ok {
-		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse := []*TestAssocHandlerReplace{}
-	for _, responseEntry := range ormResponse {
-		temp, err := responseEntry.ToPB(ctx)
-		if err != nil {
+	if err = db.Omit().Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
-		pbResponse = append(pbResponse, &temp)
 	}
-	return pbResponse, nil
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		err = gateway.SetCreated(ctx, "")
+	}
+	return &pbResponse, err
 }
 
-type TestAssocHandlerReplaceORMWithBeforeListApplyQuery interface {
-	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+type PrimaryIncludedORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerReplaceORMWithBeforeListFind interface {
-	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+type PrimaryIncludedORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerReplaceORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]TestAssocHandlerReplaceORM) error
+type PrimaryIncludedORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
 }
 
-// DefaultCreateTestAssocHandlerClear executes a basic gorm create call
-func DefaultCreateTestAssocHandlerClear(ctx context.Context, in *TestAssocHandlerClear, db *gorm.DB) (*TestAssocHandlerClear, error) {
+// DefaultUpsertPrimaryIncluded executes a gorm create call resolving conflicts on conflictTarget,
+// the name of a unique index or the primary key when empty, by updating the columns
+// in updateMask, all of them when updateMask is empty, and returns the stored object
+func DefaultUpsertPrimaryIncluded(ctx context.Context, in *PrimaryIncluded, conflictTarget string, updateMask *field_mask.FieldMask, db *gorm.DB) (*PrimaryIncluded, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -4410,16 +9819,37 @@ func DefaultCreateTestAssocHandlerClear(ctx context.Context, in *TestAssocHandle
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithBeforeCreate_); ok {
-		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+	conflict := clause.OnConflict{}
+	var lookup func() map[string]interface{}
+	switch conflictTarget {
+	case "":
+		conflict.Columns = []clause.Column{{Name: "id"}}
+		lookup = func() map[string]interface{} { return map[string]interface{}{"id": ormObj.Id} }
+	default:
+		return nil, fmt.Errorf("unknown conflict target %q of PrimaryIncluded", conflictTarget)
+	}
+	if len(updateMask.GetPaths()) == 0 {
+		conflict.UpdateAll = true
+	} else {
+		return nil, &errors.InvalidFieldMaskError{Path: updateMask.GetPaths()[0]}
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithBeforeUpsert); ok {
+		if db, err = hook.BeforeUpsert(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if err = db.Omit().Create(&ormObj).Error; err != nil {
+	if err = db.Clauses(conflict).Omit().Create(&ormObj).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithAfterCreate_); ok {
-		if err = hook.AfterCreate_(ctx, db); err != nil {
+	if len(updateMask.GetPaths()) > 0 {
+		stored := PrimaryIncludedORM{}
+		if err = db.Where(lookup()).Take(&stored).Error; err != nil {
+			return nil, err
+		}
+		ormObj = stored
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithAfterUpsert); ok {
+		if err = hook.AfterUpsert(ctx, db); err != nil {
 			return nil, err
 		}
 	}
@@ -4427,309 +9857,431 @@ func DefaultCreateTestAssocHandlerClear(ctx context.Context, in *TestAssocHandle
 	return &pbResponse, err
 }
 
-type TestAssocHandlerClearORMWithBeforeCreate_ interface {
-	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+type PrimaryIncludedORMWithBeforeUpsert interface {
+	BeforeUpsert(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerClearORMWithAfterCreate_ interface {
-	AfterCreate_(context.Context, *gorm.DB) error
+type PrimaryIncludedORMWithAfterUpsert interface {
+	AfterUpsert(context.Context, *gorm.DB) error
 }
 
-func DefaultReadTestAssocHandlerClear(ctx context.Context, in *TestAssocHandlerClear, db *gorm.DB) (*TestAssocHandlerClear, error) {
+// DefaultPatchPrimaryIncluded executes a basic gorm update call with patch behavior
+func DefaultPatchPrimaryIncluded(ctx context.Context, in *PrimaryIncluded, updateMask *field_mask.FieldMask, db *gorm.DB) (*PrimaryIncluded, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return nil, err
-	}
-	if ormObj.Id == "" {
-		return nil, errors.EmptyIdError
+	pbObj := &PrimaryIncluded{}
+	var err error
+	if hook, ok := interface{}(pbObj).(PrimaryIncludedWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithBeforeReadApplyQuery); ok {
-		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+	if hook, ok := interface{}(pbObj).(PrimaryIncludedWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithBeforeReadFind); ok {
-		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+	if _, err := DefaultApplyFieldMaskPrimaryIncluded(ctx, pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbObj).(PrimaryIncludedWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	ormResponse := TestAssocHandlerClearORM{}
-	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+	pbResponse, err := DefaultStrictUpdatePrimaryIncluded(ctx, pbObj, db)
+	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormResponse).(TestAssocHandlerClearORMWithAfterReadFind); ok {
-		if err = hook.AfterReadFind(ctx, db); err != nil {
+	if hook, ok := interface{}(pbResponse).(PrimaryIncludedWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse, err := ormResponse.ToPB(ctx)
-	return &pbResponse, err
+	return pbResponse, nil
 }
 
-type TestAssocHandlerClearORMWithBeforeReadApplyQuery interface {
-	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+type PrimaryIncludedWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *PrimaryIncluded, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerClearORMWithBeforeReadFind interface {
-	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+type PrimaryIncludedWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *PrimaryIncluded, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerClearORMWithAfterReadFind interface {
-	AfterReadFind(context.Context, *gorm.DB) error
+type PrimaryIncludedWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *PrimaryIncluded, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryIncludedWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *PrimaryIncluded, *field_mask.FieldMask, *gorm.DB) error
 }
 
-func DefaultDeleteTestAssocHandlerClear(ctx context.Context, in *TestAssocHandlerClear, db *gorm.DB) error {
-	if in == nil {
-		return errors.NilArgumentError
-	}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return err
+// DefaultPatchSetPrimaryIncluded patches the objects in a transaction: the masks are applied in memory to
+// the stored objects, read and locked with a single query, and the objects are written with an update
+// per batch of 100 objects. The objects are read and written with the handles their hooks
+// return, the objects of different handles don't share the queries. Only the associations in the
+// masks are updated, and objects sharing a primary key are rejected
+func DefaultPatchSetPrimaryIncluded(ctx context.Context, objects []*PrimaryIncluded, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*PrimaryIncluded, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
 	}
-	if ormObj.Id == "" {
-		return errors.EmptyIdError
+	if len(objects) == 0 {
+		return []*PrimaryIncluded{}, nil
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithBeforeDelete_); ok {
-		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
-			return err
+	var err error
+	objectKeys := make([]go_uuid.UUID, 0, len(objects))
+	seen := make(map[go_uuid.UUID]struct{}, len(objects))
+	for _, in := range objects {
+		if in == nil {
+			return nil, errors.NilArgumentError
 		}
-	}
-	err = db.Where(&ormObj).Delete(&TestAssocHandlerClearORM{}).Error
+		ormObj, err := in.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if ormObj.Id == go_uuid.Nil {
+			return nil, errors.EmptyIdError
+		}
+		key := ormObj.Id
+		if _, ok := seen[key]; ok {
+			return nil, errors.DuplicateKeyError
+		}
+		seen[key] = struct{}{}
+		objectKeys = append(objectKeys, key)
+	}
+	batchSize := 100
+	results := make([]*PrimaryIncluded, len(objects))
+	err = db.Transaction(func(db *gorm.DB) error {
+		var err error
+		// the objects sharing the handle their hooks return share the queries
+		dbs := make([]*gorm.DB, len(objects))
+		group := func() ([]*gorm.DB, map[*gorm.DB][]int) {
+			var handles []*gorm.DB
+			groups := make(map[*gorm.DB][]int)
+			for i, handle := range dbs {
+				if _, ok := groups[handle]; !ok {
+					handles = append(handles, handle)
+				}
+				groups[handle] = append(groups[handle], i)
+			}
+			return handles, groups
+		}
+		for i, in := range objects {
+			dbs[i] = db
+			if hook, ok := interface{}(&PrimaryIncluded{}).(PrimaryIncludedWithBeforePatchRead); ok {
+				if dbs[i], err = hook.BeforePatchRead(ctx, in, updateMasks[i], db); err != nil {
+					return err
+				}
+			}
+		}
+		targets := make(map[go_uuid.UUID]PrimaryIncludedORM, len(objects))
+		handles, groups := group()
+		for _, handle := range handles {
+			keys := make([]go_uuid.UUID, 0, len(groups[handle]))
+			for _, i := range groups[handle] {
+				keys = append(keys, objectKeys[i])
+			}
+			query := handle.Session(&gorm.Session{}).Clauses(clause.Locking{Strength: "UPDATE"})
+			query = query.Where("id in (?)", keys)
+			rows := []PrimaryIncludedORM{}
+			if err = query.Find(&rows).Error; err != nil {
+				return err
+			}
+			for _, row := range rows {
+				targets[row.Id] = row
+			}
+		}
+		ormObjs := make([]PrimaryIncludedORM, len(objects))
+		prepare := func(i int) (*gorm.DB, error) {
+			in, key, updateMask, db := objects[i], objectKeys[i], updateMasks[i], dbs[i]
+			target, ok := targets[key]
+			if !ok {
+				return nil, gorm.ErrRecordNotFound
+			}
+			stored, err := target.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbObj := &stored
+			if hook, ok := interface{}(pbObj).(PrimaryIncludedWithBeforePatchApplyFieldMask); ok {
+				if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			if _, err := DefaultApplyFieldMaskPrimaryIncluded(ctx, pbObj, in, updateMask, "", db); err != nil {
+				return nil, err
+			}
+			if hook, ok := interface{}(pbObj).(PrimaryIncludedWithBeforePatchSave); ok {
+				if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObj, err := pbObj.ToORM(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithBeforeStrictUpdateCleanup); ok {
+				if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			masked := make(map[string]bool, len(updateMask.GetPaths()))
+			for _, path := range updateMask.GetPaths() {
+				masked[strings.SplitN(path, ".", 2)[0]] = true
+			}
+			if masked["Child"] || masked["*"] {
+				filterChild := ExternalChildORM{}
+				if ormObj.Id == go_uuid.Nil {
+					return nil, errors.EmptyIdError
+				}
+				filterChild.PrimaryIncludedId = new(go_uuid.UUID)
+				*filterChild.PrimaryIncludedId = ormObj.Id
+				if err = db.Where(filterChild).Delete(ExternalChildORM{}).Error; err != nil {
+					return nil, err
+				}
+			}
+			if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithBeforeStrictUpdateSave); ok {
+				if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObjs[i] = ormObj
+			return db, nil
+		}
+		for i := range objects {
+			if dbs[i], err = prepare(i); err != nil {
+				return err
+			}
+		}
+		handles, groups = group()
+		for _, handle := range handles {
+			indexes := groups[handle]
+			for start := 0; start < len(indexes); start += batchSize {
+				end := start + batchSize
+				if end > len(indexes) {
+					end = len(indexes)
+				}
+				batch := indexes[start:end]
+				objs := make([]*PrimaryIncludedORM, 0, len(batch))
+				for _, i := range batch {
+					objs = append(objs, &ormObjs[i])
+				}
+				// the associations are saved first, the batch is then written with the
+				// foreign keys of its belongs-to associations
+				if err = handle.Session(&gorm.Session{}).Select("Child").Model(&objs).Updates(map[string]interface{}{}).Error; err != nil {
+					return err
+				}
+			}
+		}
+		finish := func(i int) (*PrimaryIncluded, error) {
+			in, updateMask, db, ormObj := objects[i], updateMasks[i], dbs[i], ormObjs[i]
+			var err error
+			if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithAfterStrictUpdateSave); ok {
+				if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			saved, err := ormObj.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbResponse := &saved
+			if hook, ok := interface{}(pbResponse).(PrimaryIncludedWithAfterPatchSave); ok {
+				if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			return pbResponse, nil
+		}
+		for i := range objects {
+			if results[i], err = finish(i); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 	if err != nil {
-		return err
-	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithAfterDelete_); ok {
-		err = hook.AfterDelete_(ctx, db)
+		return nil, err
 	}
-	return err
-}
-
-type TestAssocHandlerClearORMWithBeforeDelete_ interface {
-	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type TestAssocHandlerClearORMWithAfterDelete_ interface {
-	AfterDelete_(context.Context, *gorm.DB) error
+	return results, nil
 }
 
-func DefaultDeleteTestAssocHandlerClearSet(ctx context.Context, in []*TestAssocHandlerClear, db *gorm.DB) error {
-	if in == nil {
-		return errors.NilArgumentError
+// DefaultApplyFieldMaskPrimaryIncluded patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskPrimaryIncluded(ctx context.Context, patchee *PrimaryIncluded, patcher *PrimaryIncluded, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*PrimaryIncluded, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
 	}
-	var err error
-	keys := []string{}
-	for _, obj := range in {
-		ormObj, err := obj.ToORM(ctx)
-		if err != nil {
-			return err
-		}
-		if ormObj.Id == "" {
-			return errors.EmptyIdError
+	if len(updateMask.GetPaths()) == 1 && updateMask.Paths[0] == prefix+"*" {
+		updateMask = &field_mask.FieldMask{Paths: []string{prefix + "Child"}}
+	}
+	for _, f := range updateMask.GetPaths() {
+		if strings.HasPrefix(f, prefix) && !DefaultHasFieldMaskPathPrimaryIncluded(strings.TrimPrefix(f, prefix)) {
+			return nil, &errors.InvalidFieldMaskError{Path: f}
 		}
-		keys = append(keys, ormObj.Id)
 	}
-	if hook, ok := (interface{}(&TestAssocHandlerClearORM{})).(TestAssocHandlerClearORMWithBeforeDeleteSet); ok {
-		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
-			return err
+	var err error
+	var updatedChild bool
+	for i, f := range updateMask.Paths {
+		if !updatedChild && strings.HasPrefix(f, prefix+"Child.") {
+			updatedChild = true
+			if patcher.Child == nil {
+				patchee.Child = nil
+				continue
+			}
+			if patchee.Child == nil {
+				patchee.Child = &ExternalChild{}
+			}
+			if o, err := DefaultApplyFieldMaskExternalChild(ctx, patchee.Child, patcher.Child, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"Child.", db); err != nil {
+				return nil, err
+			} else {
+				patchee.Child = o
+			}
+			continue
+		}
+		if f == prefix+"Child" {
+			updatedChild = true
+			patchee.Child = patcher.Child
+			continue
 		}
 	}
-	err = db.Where("id in (?)", keys).Delete(&TestAssocHandlerClearORM{}).Error
 	if err != nil {
-		return err
-	}
-	if hook, ok := (interface{}(&TestAssocHandlerClearORM{})).(TestAssocHandlerClearORMWithAfterDeleteSet); ok {
-		err = hook.AfterDeleteSet(ctx, in, db)
+		return nil, err
 	}
-	return err
+	return patchee, nil
 }
 
-type TestAssocHandlerClearORMWithBeforeDeleteSet interface {
-	BeforeDeleteSet(context.Context, []*TestAssocHandlerClear, *gorm.DB) (*gorm.DB, error)
-}
-type TestAssocHandlerClearORMWithAfterDeleteSet interface {
-	AfterDeleteSet(context.Context, []*TestAssocHandlerClear, *gorm.DB) error
+// DefaultHasFieldMaskPathPrimaryIncluded reports whether PrimaryIncluded has the field of the field mask path
+func DefaultHasFieldMaskPathPrimaryIncluded(path string) bool {
+	parts := strings.SplitN(path, ".", 2)
+	switch parts[0] {
+	case "Child":
+		return len(parts) == 1 || DefaultHasFieldMaskPathExternalChild(parts[1])
+	}
+	return false
 }
 
-// DefaultStrictUpdateTestAssocHandlerClear clears / replaces / appends first level 1:many children and then executes a gorm update call
-func DefaultStrictUpdateTestAssocHandlerClear(ctx context.Context, in *TestAssocHandlerClear, db *gorm.DB) (*TestAssocHandlerClear, error) {
-	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateTestAssocHandlerClear")
-	}
+// DefaultListPrimaryIncluded executes a gorm list call
+func DefaultListPrimaryIncluded(ctx context.Context, db *gorm.DB) ([]*PrimaryIncluded, error) {
+	in := PrimaryIncluded{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	var count int64
-	lockedRow := &TestAssocHandlerClearORM{}
-	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithBeforeStrictUpdateCleanup); ok {
-		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if err = db.Model(&ormObj).Association("TestTagAssoc").Clear(); err != nil {
-		return nil, err
-	}
-	ormObj.TestTagAssoc = nil
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithBeforeStrictUpdateSave); ok {
-		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if err = db.Omit().Save(&ormObj).Error; err != nil {
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []PrimaryIncludedORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithAfterStrictUpdateSave); ok {
-		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse, err := ormObj.ToPB(ctx)
-	if err != nil {
-		return nil, err
-	}
-	if count == 0 {
-		err = gateway.SetCreated(ctx, "")
+	pbResponse := []*PrimaryIncluded{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
 	}
-	return &pbResponse, err
+	return pbResponse, nil
 }
 
-type TestAssocHandlerClearORMWithBeforeStrictUpdateCleanup interface {
-	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+type PrimaryIncludedORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerClearORMWithBeforeStrictUpdateSave interface {
-	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+type PrimaryIncludedORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerClearORMWithAfterStrictUpdateSave interface {
-	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+type PrimaryIncludedORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]PrimaryIncludedORM) error
 }
 
-// DefaultPatchTestAssocHandlerClear executes a basic gorm update call with patch behavior
-func DefaultPatchTestAssocHandlerClear(ctx context.Context, in *TestAssocHandlerClear, updateMask *field_mask.FieldMask, db *gorm.DB) (*TestAssocHandlerClear, error) {
+func DefaultReadTenantEventCount(ctx context.Context, in *TenantEventCount, db *gorm.DB) (*TenantEventCount, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	var pbObj TestAssocHandlerClear
-	var err error
-	if hook, ok := interface{}(&pbObj).(TestAssocHandlerClearWithBeforePatchRead); ok {
-		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
-			return nil, err
-		}
-	}
-	pbReadRes, err := DefaultReadTestAssocHandlerClear(ctx, &TestAssocHandlerClear{Id: in.GetId()}, db)
+	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	pbObj = *pbReadRes
-	if hook, ok := interface{}(&pbObj).(TestAssocHandlerClearWithBeforePatchApplyFieldMask); ok {
-		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+	if ormObj.AccountId == "" {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(TenantEventCountORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if _, err := DefaultApplyFieldMaskTestAssocHandlerClear(ctx, &pbObj, in, updateMask, "", db); err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&pbObj).(TestAssocHandlerClearWithBeforePatchSave); ok {
-		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+	if hook, ok := interface{}(&ormObj).(TenantEventCountORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse, err := DefaultStrictUpdateTestAssocHandlerClear(ctx, &pbObj, db)
-	if err != nil {
+	ormResponse := TenantEventCountORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(pbResponse).(TestAssocHandlerClearWithAfterPatchSave); ok {
-		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+	if hook, ok := interface{}(&ormResponse).(TenantEventCountORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	return pbResponse, nil
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
 }
 
-type TestAssocHandlerClearWithBeforePatchRead interface {
-	BeforePatchRead(context.Context, *TestAssocHandlerClear, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
-}
-type TestAssocHandlerClearWithBeforePatchApplyFieldMask interface {
-	BeforePatchApplyFieldMask(context.Context, *TestAssocHandlerClear, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
-}
-type TestAssocHandlerClearWithBeforePatchSave interface {
-	BeforePatchSave(context.Context, *TestAssocHandlerClear, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
-}
-type TestAssocHandlerClearWithAfterPatchSave interface {
-	AfterPatchSave(context.Context, *TestAssocHandlerClear, *field_mask.FieldMask, *gorm.DB) error
+type TenantEventCountORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-
-// DefaultPatchSetTestAssocHandlerClear executes a bulk gorm update call with patch behavior
-func DefaultPatchSetTestAssocHandlerClear(ctx context.Context, objects []*TestAssocHandlerClear, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*TestAssocHandlerClear, error) {
-	if len(objects) != len(updateMasks) {
-		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
-	}
-
-	results := make([]*TestAssocHandlerClear, 0, len(objects))
-	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchTestAssocHandlerClear(ctx, patcher, updateMasks[i], db)
-		if err != nil {
-			return nil, err
-		}
-
-		results = append(results, pbResponse)
-	}
-
-	return results, nil
+type TenantEventCountORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-
-// DefaultApplyFieldMaskTestAssocHandlerClear patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskTestAssocHandlerClear(ctx context.Context, patchee *TestAssocHandlerClear, patcher *TestAssocHandlerClear, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*TestAssocHandlerClear, error) {
-	if patcher == nil {
-		return nil, nil
-	} else if patchee == nil {
-		return nil, errors.NilArgumentError
-	}
-	var err error
-	for _, f := range updateMask.Paths {
-		if f == prefix+"Id" {
-			patchee.Id = patcher.Id
-			continue
-		}
-		if f == prefix+"TestTagAssoc" {
-			patchee.TestTagAssoc = patcher.TestTagAssoc
-			continue
-		}
-	}
-	if err != nil {
-		return nil, err
-	}
-	return patchee, nil
+type TenantEventCountORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
 }
 
-// DefaultListTestAssocHandlerClear executes a gorm list call
-func DefaultListTestAssocHandlerClear(ctx context.Context, db *gorm.DB) ([]*TestAssocHandlerClear, error) {
-	in := TestAssocHandlerClear{}
+// DefaultListTenantEventCount executes a gorm list call
+func DefaultListTenantEventCount(ctx context.Context, db *gorm.DB) ([]*TenantEventCount, error) {
+	in := TenantEventCount{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithBeforeListApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(TenantEventCountORMWithBeforeListApplyQuery); ok {
 		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithBeforeListFind); ok {
+	if hook, ok := interface{}(&ormObj).(TenantEventCountORMWithBeforeListFind); ok {
 		if db, err = hook.BeforeListFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
 	db = db.Where(&ormObj)
-	db = db.Order("id")
-	ormResponse := []TestAssocHandlerClearORM{}
+	db = db.Order("account_id")
+	ormResponse := []TenantEventCountORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithAfterListFind); ok {
+	if hook, ok := interface{}(&ormObj).(TenantEventCountORMWithAfterListFind); ok {
 		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse := []*TestAssocHandlerClear{}
+	pbResponse := []*TenantEventCount{}
 	for _, responseEntry := range ormResponse {
 		temp, err := responseEntry.ToPB(ctx)
 		if err != nil {
@@ -4740,18 +10292,28 @@ func DefaultListTestAssocHandlerClear(ctx context.Context, db *gorm.DB) ([]*Test
 	return pbResponse, nil
 }
 
-type TestAssocHandlerClearORMWithBeforeListApplyQuery interface {
+type TenantEventCountORMWithBeforeListApplyQuery interface {
 	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerClearORMWithBeforeListFind interface {
+type TenantEventCountORMWithBeforeListFind interface {
 	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerClearORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]TestAssocHandlerClearORM) error
+type TenantEventCountORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]TenantEventCountORM) error
 }
 
-// DefaultCreateTestAssocHandlerAppend executes a basic gorm create call
-func DefaultCreateTestAssocHandlerAppend(ctx context.Context, in *TestAssocHandlerAppend, db *gorm.DB) (*TestAssocHandlerAppend, error) {
+// CreateTenantEventCountView creates the materialized view TenantEventCountORM is read from
+func CreateTenantEventCountView(ctx context.Context, db *gorm.DB) error {
+	return db.WithContext(ctx).Exec("CREATE MATERIALIZED VIEW tenant_event_counts AS SELECT account_id, count(*) AS events FROM tenant_events GROUP BY account_id").Error
+}
+
+// RefreshTenantEventCountView recomputes the materialized view TenantEventCountORM is read from
+func RefreshTenantEventCountView(ctx context.Context, db *gorm.DB) error {
+	return db.WithContext(ctx).Exec("REFRESH MATERIALIZED VIEW tenant_event_counts").Error
+}
+
+// DefaultCreateWarehouse executes a basic gorm create call
+func DefaultCreateWarehouse(ctx context.Context, in *Warehouse, db *gorm.DB) (*Warehouse, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -4759,7 +10321,7 @@ func DefaultCreateTestAssocHandlerAppend(ctx context.Context, in *TestAssocHandl
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithBeforeCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(WarehouseORMWithBeforeCreate_); ok {
 		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
 			return nil, err
 		}
@@ -4767,7 +10329,7 @@ func DefaultCreateTestAssocHandlerAppend(ctx context.Context, in *TestAssocHandl
 	if err = db.Omit().Create(&ormObj).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithAfterCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(WarehouseORMWithAfterCreate_); ok {
 		if err = hook.AfterCreate_(ctx, db); err != nil {
 			return nil, err
 		}
@@ -4776,14 +10338,78 @@ func DefaultCreateTestAssocHandlerAppend(ctx context.Context, in *TestAssocHandl
 	return &pbResponse, err
 }
 
-type TestAssocHandlerAppendORMWithBeforeCreate_ interface {
+type WarehouseORMWithBeforeCreate_ interface {
 	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerAppendORMWithAfterCreate_ interface {
+type WarehouseORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm.DB) error
 }
 
-func DefaultReadTestAssocHandlerAppend(ctx context.Context, in *TestAssocHandlerAppend, db *gorm.DB) (*TestAssocHandlerAppend, error) {
+// DefaultCreateWarehouseSet executes gorm create calls in batches of 100 objects
+// in a transaction, either all of the objects are created or none of them. The BeforeCreate_ hook
+// of each object runs before the insert of its batch, which uses the handle the hooks of the
+// batch return
+func DefaultCreateWarehouseSet(ctx context.Context, in []*Warehouse, db *gorm.DB) ([]*Warehouse, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObjs := make([]WarehouseORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, ormObj)
+	}
+	batchSize := 100
+	err := db.Transaction(func(db *gorm.DB) error {
+		var err error
+		for start := 0; start < len(ormObjs); start += batchSize {
+			end := start + batchSize
+			if end > len(ormObjs) {
+				end = len(ormObjs)
+			}
+			batch := ormObjs[start:end]
+			// the scopes the hooks add only apply to the insert of their own batch
+			batchDB := db
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(WarehouseORMWithBeforeCreate_); ok {
+					if batchDB, err = hook.BeforeCreate_(ctx, batchDB); err != nil {
+						return err
+					}
+				}
+			}
+			if err = batchDB.Omit().CreateInBatches(&batch, batchSize).Error; err != nil {
+				return err
+			}
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(WarehouseORMWithAfterCreate_); ok {
+					if err = hook.AfterCreate_(ctx, db); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*Warehouse, 0, len(ormObjs))
+	for i := range ormObjs {
+		pbResponse, err := ormObjs[i].ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, &pbResponse)
+	}
+	return results, nil
+}
+
+func DefaultReadWarehouse(ctx context.Context, in *Warehouse, db *gorm.DB) (*Warehouse, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -4791,24 +10417,24 @@ func DefaultReadTestAssocHandlerAppend(ctx context.Context, in *TestAssocHandler
 	if err != nil {
 		return nil, err
 	}
-	if ormObj.Id == "" {
+	if ormObj.Id == 0 {
 		return nil, errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithBeforeReadApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(WarehouseORMWithBeforeReadApplyQuery); ok {
 		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithBeforeReadFind); ok {
+	if hook, ok := interface{}(&ormObj).(WarehouseORMWithBeforeReadFind); ok {
 		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	ormResponse := TestAssocHandlerAppendORM{}
+	ormResponse := WarehouseORM{}
 	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormResponse).(TestAssocHandlerAppendORMWithAfterReadFind); ok {
+	if hook, ok := interface{}(&ormResponse).(WarehouseORMWithAfterReadFind); ok {
 		if err = hook.AfterReadFind(ctx, db); err != nil {
 			return nil, err
 		}
@@ -4817,17 +10443,17 @@ func DefaultReadTestAssocHandlerAppend(ctx context.Context, in *TestAssocHandler
 	return &pbResponse, err
 }
 
-type TestAssocHandlerAppendORMWithBeforeReadApplyQuery interface {
+type WarehouseORMWithBeforeReadApplyQuery interface {
 	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerAppendORMWithBeforeReadFind interface {
+type WarehouseORMWithBeforeReadFind interface {
 	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerAppendORMWithAfterReadFind interface {
+type WarehouseORMWithAfterReadFind interface {
 	AfterReadFind(context.Context, *gorm.DB) error
 }
 
-func DefaultDeleteTestAssocHandlerAppend(ctx context.Context, in *TestAssocHandlerAppend, db *gorm.DB) error {
+func DefaultDeleteWarehouse(ctx context.Context, in *Warehouse, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
@@ -4835,91 +10461,96 @@ func DefaultDeleteTestAssocHandlerAppend(ctx context.Context, in *TestAssocHandl
 	if err != nil {
 		return err
 	}
-	if ormObj.Id == "" {
+	if ormObj.Id == 0 {
 		return errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithBeforeDelete_); ok {
+	if hook, ok := interface{}(&ormObj).(WarehouseORMWithBeforeDelete_); ok {
 		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where(&ormObj).Delete(&TestAssocHandlerAppendORM{}).Error
+	err = db.Where(&ormObj).Delete(&WarehouseORM{}).Error
 	if err != nil {
 		return err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithAfterDelete_); ok {
+	if hook, ok := interface{}(&ormObj).(WarehouseORMWithAfterDelete_); ok {
 		err = hook.AfterDelete_(ctx, db)
 	}
 	return err
 }
 
-type TestAssocHandlerAppendORMWithBeforeDelete_ interface {
+type WarehouseORMWithBeforeDelete_ interface {
 	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerAppendORMWithAfterDelete_ interface {
+type WarehouseORMWithAfterDelete_ interface {
 	AfterDelete_(context.Context, *gorm.DB) error
 }
 
-func DefaultDeleteTestAssocHandlerAppendSet(ctx context.Context, in []*TestAssocHandlerAppend, db *gorm.DB) error {
+func DefaultDeleteWarehouseSet(ctx context.Context, in []*Warehouse, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
 	var err error
-	keys := []string{}
+	keys := []uint64{}
 	for _, obj := range in {
 		ormObj, err := obj.ToORM(ctx)
 		if err != nil {
 			return err
 		}
-		if ormObj.Id == "" {
+		if ormObj.Id == 0 {
 			return errors.EmptyIdError
 		}
 		keys = append(keys, ormObj.Id)
 	}
-	if hook, ok := (interface{}(&TestAssocHandlerAppendORM{})).(TestAssocHandlerAppendORMWithBeforeDeleteSet); ok {
+	if hook, ok := (interface{}(&WarehouseORM{})).(WarehouseORMWithBeforeDeleteSet); ok {
 		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where("id in (?)", keys).Delete(&TestAssocHandlerAppendORM{}).Error
+	err = db.Where("id in (?)", keys).Delete(&WarehouseORM{}).Error
 	if err != nil {
 		return err
 	}
-	if hook, ok := (interface{}(&TestAssocHandlerAppendORM{})).(TestAssocHandlerAppendORMWithAfterDeleteSet); ok {
+	if hook, ok := (interface{}(&WarehouseORM{})).(WarehouseORMWithAfterDeleteSet); ok {
 		err = hook.AfterDeleteSet(ctx, in, db)
 	}
 	return err
 }
 
-type TestAssocHandlerAppendORMWithBeforeDeleteSet interface {
-	BeforeDeleteSet(context.Context, []*TestAssocHandlerAppend, *gorm.DB) (*gorm.DB, error)
+type WarehouseORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Warehouse, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerAppendORMWithAfterDeleteSet interface {
-	AfterDeleteSet(context.Context, []*TestAssocHandlerAppend, *gorm.DB) error
+type WarehouseORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Warehouse, *gorm.DB) error
 }
 
-// DefaultStrictUpdateTestAssocHandlerAppend clears / replaces / appends first level 1:many children and then executes a gorm update call
-func DefaultStrictUpdateTestAssocHandlerAppend(ctx context.Context, in *TestAssocHandlerAppend, db *gorm.DB) (*TestAssocHandlerAppend, error) {
+// DefaultStrictUpdateWarehouse clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateWarehouse(ctx context.Context, in *Warehouse, db *gorm.DB) (*Warehouse, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateTestAssocHandlerAppend")
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateWarehouse")
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
 	var count int64
-	lockedRow := &TestAssocHandlerAppendORM{}
+	lockedRow := &WarehouseORM{}
 	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithBeforeStrictUpdateCleanup); ok {
+	if hook, ok := interface{}(&ormObj).(WarehouseORMWithBeforeStrictUpdateCleanup); ok {
 		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if err = db.Model(&ormObj).Association("TestTagAssoc").Append(ormObj.TestTagAssoc); err != nil {
+	filterAddress := AddressORM{}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	filterAddress.WarehouseId = new(uint64)
+	*filterAddress.WarehouseId = ormObj.Id
+	if err = db.Where(filterAddress).Delete(AddressORM{}).Error; err != nil {
 		return nil, err
 	}
-	ormObj.TestTagAssoc = nil
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithBeforeStrictUpdateSave); ok {
+	if hook, ok := interface{}(&ormObj).(WarehouseORMWithBeforeStrictUpdateSave); ok {
 		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
@@ -4927,7 +10558,7 @@ func DefaultStrictUpdateTestAssocHandlerAppend(ctx context.Context, in *TestAsso
 	if err = db.Omit().Save(&ormObj).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithAfterStrictUpdateSave); ok {
+	if hook, ok := interface{}(&ormObj).(WarehouseORMWithAfterStrictUpdateSave); ok {
 		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
@@ -4942,207 +10573,482 @@ func DefaultStrictUpdateTestAssocHandlerAppend(ctx context.Context, in *TestAsso
 	return &pbResponse, err
 }
 
-type TestAssocHandlerAppendORMWithBeforeStrictUpdateCleanup interface {
+type WarehouseORMWithBeforeStrictUpdateCleanup interface {
 	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerAppendORMWithBeforeStrictUpdateSave interface {
+type WarehouseORMWithBeforeStrictUpdateSave interface {
 	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerAppendORMWithAfterStrictUpdateSave interface {
+type WarehouseORMWithAfterStrictUpdateSave interface {
 	AfterStrictUpdateSave(context.Context, *gorm.DB) error
 }
 
-// DefaultPatchTestAssocHandlerAppend executes a basic gorm update call with patch behavior
-func DefaultPatchTestAssocHandlerAppend(ctx context.Context, in *TestAssocHandlerAppend, updateMask *field_mask.FieldMask, db *gorm.DB) (*TestAssocHandlerAppend, error) {
+// DefaultUpsertWarehouse executes a gorm create call resolving conflicts on conflictTarget,
+// the name of a unique index or the primary key when empty, by updating the columns
+// in updateMask, all of them when updateMask is empty, and returns the stored object
+func DefaultUpsertWarehouse(ctx context.Context, in *Warehouse, conflictTarget string, updateMask *field_mask.FieldMask, db *gorm.DB) (*Warehouse, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	var pbObj TestAssocHandlerAppend
-	var err error
-	if hook, ok := interface{}(&pbObj).(TestAssocHandlerAppendWithBeforePatchRead); ok {
-		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
-			return nil, err
-		}
-	}
-	pbReadRes, err := DefaultReadTestAssocHandlerAppend(ctx, &TestAssocHandlerAppend{Id: in.GetId()}, db)
+	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	pbObj = *pbReadRes
-	if hook, ok := interface{}(&pbObj).(TestAssocHandlerAppendWithBeforePatchApplyFieldMask); ok {
-		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
-			return nil, err
-		}
+	conflict := clause.OnConflict{}
+	var lookup func() map[string]interface{}
+	switch conflictTarget {
+	case "":
+		conflict.Columns = []clause.Column{{Name: "id"}}
+		lookup = func() map[string]interface{} { return map[string]interface{}{"id": ormObj.Id} }
+	default:
+		return nil, fmt.Errorf("unknown conflict target %q of Warehouse", conflictTarget)
 	}
-	if _, err := DefaultApplyFieldMaskTestAssocHandlerAppend(ctx, &pbObj, in, updateMask, "", db); err != nil {
-		return nil, err
+	if len(updateMask.GetPaths()) == 0 {
+		conflict.UpdateAll = true
+	} else {
+		var columns []string
+		for _, path := range updateMask.GetPaths() {
+			var pathColumns []string
+			switch path {
+			case "Location.Street":
+				pathColumns = []string{"location_street"}
+			case "Location.City":
+				pathColumns = []string{"location_city"}
+			case "Location.PostalCode":
+				pathColumns = []string{"location_postal_code"}
+			case "Location":
+				pathColumns = []string{"location_street", "location_city", "location_postal_code"}
+			case "ReturnLocation.Street":
+				pathColumns = []string{"returns_street"}
+			case "ReturnLocation.City":
+				pathColumns = []string{"returns_city"}
+			case "ReturnLocation.PostalCode":
+				pathColumns = []string{"returns_postal_code"}
+			case "ReturnLocation":
+				pathColumns = []string{"returns_street", "returns_city", "returns_postal_code"}
+			default:
+				return nil, &errors.InvalidFieldMaskError{Path: path}
+			}
+			for _, column := range pathColumns {
+				for _, target := range conflict.Columns {
+					if column == target.Name {
+						return nil, &errors.InvalidFieldMaskError{Path: path}
+					}
+				}
+			}
+			columns = append(columns, pathColumns...)
+		}
+		conflict.DoUpdates = clause.AssignmentColumns(columns)
 	}
-	if hook, ok := interface{}(&pbObj).(TestAssocHandlerAppendWithBeforePatchSave); ok {
-		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+	if hook, ok := interface{}(&ormObj).(WarehouseORMWithBeforeUpsert); ok {
+		if db, err = hook.BeforeUpsert(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse, err := DefaultStrictUpdateTestAssocHandlerAppend(ctx, &pbObj, db)
-	if err != nil {
+	if err = db.Clauses(conflict).Omit().Create(&ormObj).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(pbResponse).(TestAssocHandlerAppendWithAfterPatchSave); ok {
-		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+	if len(updateMask.GetPaths()) > 0 {
+		stored := WarehouseORM{}
+		if err = db.Where(lookup()).Take(&stored).Error; err != nil {
 			return nil, err
 		}
+		ormObj = stored
 	}
-	return pbResponse, nil
-}
-
-type TestAssocHandlerAppendWithBeforePatchRead interface {
-	BeforePatchRead(context.Context, *TestAssocHandlerAppend, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
-}
-type TestAssocHandlerAppendWithBeforePatchApplyFieldMask interface {
-	BeforePatchApplyFieldMask(context.Context, *TestAssocHandlerAppend, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
-}
-type TestAssocHandlerAppendWithBeforePatchSave interface {
-	BeforePatchSave(context.Context, *TestAssocHandlerAppend, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
-}
-type TestAssocHandlerAppendWithAfterPatchSave interface {
-	AfterPatchSave(context.Context, *TestAssocHandlerAppend, *field_mask.FieldMask, *gorm.DB) error
-}
-
-// DefaultPatchSetTestAssocHandlerAppend executes a bulk gorm update call with patch behavior
-func DefaultPatchSetTestAssocHandlerAppend(ctx context.Context, objects []*TestAssocHandlerAppend, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*TestAssocHandlerAppend, error) {
-	if len(objects) != len(updateMasks) {
-		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
-	}
-
-	results := make([]*TestAssocHandlerAppend, 0, len(objects))
-	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchTestAssocHandlerAppend(ctx, patcher, updateMasks[i], db)
-		if err != nil {
+	if hook, ok := interface{}(&ormObj).(WarehouseORMWithAfterUpsert); ok {
+		if err = hook.AfterUpsert(ctx, db); err != nil {
 			return nil, err
 		}
-
-		results = append(results, pbResponse)
 	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
 
-	return results, nil
+type WarehouseORMWithBeforeUpsert interface {
+	BeforeUpsert(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type WarehouseORMWithAfterUpsert interface {
+	AfterUpsert(context.Context, *gorm.DB) error
 }
 
-// DefaultApplyFieldMaskTestAssocHandlerAppend patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskTestAssocHandlerAppend(ctx context.Context, patchee *TestAssocHandlerAppend, patcher *TestAssocHandlerAppend, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*TestAssocHandlerAppend, error) {
-	if patcher == nil {
-		return nil, nil
-	} else if patchee == nil {
+// DefaultPatchWarehouse executes a basic gorm update call with patch behavior
+func DefaultPatchWarehouse(ctx context.Context, in *Warehouse, updateMask *field_mask.FieldMask, db *gorm.DB) (*Warehouse, error) {
+	if in == nil {
 		return nil, errors.NilArgumentError
 	}
+	pbObj := &Warehouse{}
 	var err error
-	for _, f := range updateMask.Paths {
-		if f == prefix+"Id" {
-			patchee.Id = patcher.Id
-			continue
-		}
-		if f == prefix+"TestTagAssoc" {
-			patchee.TestTagAssoc = patcher.TestTagAssoc
-			continue
+	if hook, ok := interface{}(pbObj).(WarehouseWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
 		}
 	}
-	if err != nil {
-		return nil, err
-	}
-	return patchee, nil
-}
-
-// DefaultListTestAssocHandlerAppend executes a gorm list call
-func DefaultListTestAssocHandlerAppend(ctx context.Context, db *gorm.DB) ([]*TestAssocHandlerAppend, error) {
-	in := TestAssocHandlerAppend{}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
+	if pbObj, err = DefaultReadWarehouse(ctx, &Warehouse{Id: in.GetId()}, db); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithBeforeListApplyQuery); ok {
-		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
-			return nil, err
-		}
-	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithBeforeListFind); ok {
-		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+	if hook, ok := interface{}(pbObj).(WarehouseWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	db = db.Where(&ormObj)
-	db = db.Order("id")
-	ormResponse := []TestAssocHandlerAppendORM{}
-	if err := db.Find(&ormResponse).Error; err != nil {
+	if _, err := DefaultApplyFieldMaskWarehouse(ctx, pbObj, in, updateMask, "", db); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithAfterListFind); ok {
-		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+	if hook, ok := interface{}(pbObj).(WarehouseWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse := []*TestAssocHandlerAppend{}
-	for _, responseEntry := range ormResponse {
-		temp, err := responseEntry.ToPB(ctx)
-		if err != nil {
+	pbResponse, err := DefaultStrictUpdateWarehouse(ctx, pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(WarehouseWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
-		pbResponse = append(pbResponse, &temp)
 	}
 	return pbResponse, nil
 }
 
-type TestAssocHandlerAppendORMWithBeforeListApplyQuery interface {
-	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+type WarehouseWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Warehouse, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerAppendORMWithBeforeListFind interface {
-	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+type WarehouseWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Warehouse, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerAppendORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]TestAssocHandlerAppendORM) error
+type WarehouseWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Warehouse, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type WarehouseWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Warehouse, *field_mask.FieldMask, *gorm.DB) error
 }
 
-// DefaultCreateTestTagAssociation executes a basic gorm create call
-func DefaultCreateTestTagAssociation(ctx context.Context, in *TestTagAssociation, db *gorm.DB) (*TestTagAssociation, error) {
-	if in == nil {
-		return nil, errors.NilArgumentError
+// DefaultPatchSetWarehouse patches the objects in a transaction: the masks are applied in memory to
+// the stored objects, read and locked with a single query, and the objects are written with an update
+// per batch of 100 objects. The objects are read and written with the handles their hooks
+// return, the objects of different handles don't share the queries. Only the associations in the
+// masks are updated, and objects sharing a primary key are rejected
+func DefaultPatchSetWarehouse(ctx context.Context, objects []*Warehouse, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Warehouse, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
 	}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return nil, err
+	if len(objects) == 0 {
+		return []*Warehouse{}, nil
 	}
-	if hook, ok := interface{}(&ormObj).(TestTagAssociationORMWithBeforeCreate_); ok {
-		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
-			return nil, err
+	var err error
+	objectKeys := make([]uint64, 0, len(objects))
+	seen := make(map[uint64]struct{}, len(objects))
+	for _, in := range objects {
+		if in == nil {
+			return nil, errors.NilArgumentError
 		}
-	}
-	if err = db.Omit().Create(&ormObj).Error; err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(TestTagAssociationORMWithAfterCreate_); ok {
-		if err = hook.AfterCreate_(ctx, db); err != nil {
+		ormObj, err := in.ToORM(ctx)
+		if err != nil {
 			return nil, err
 		}
+		if ormObj.Id == 0 {
+			return nil, errors.EmptyIdError
+		}
+		key := ormObj.Id
+		if _, ok := seen[key]; ok {
+			return nil, errors.DuplicateKeyError
+		}
+		seen[key] = struct{}{}
+		objectKeys = append(objectKeys, key)
+	}
+	batchSize := 100
+	results := make([]*Warehouse, len(objects))
+	err = db.Transaction(func(db *gorm.DB) error {
+		var err error
+		// the objects sharing the handle their hooks return share the queries
+		dbs := make([]*gorm.DB, len(objects))
+		group := func() ([]*gorm.DB, map[*gorm.DB][]int) {
+			var handles []*gorm.DB
+			groups := make(map[*gorm.DB][]int)
+			for i, handle := range dbs {
+				if _, ok := groups[handle]; !ok {
+					handles = append(handles, handle)
+				}
+				groups[handle] = append(groups[handle], i)
+			}
+			return handles, groups
+		}
+		for i, in := range objects {
+			dbs[i] = db
+			if hook, ok := interface{}(&Warehouse{}).(WarehouseWithBeforePatchRead); ok {
+				if dbs[i], err = hook.BeforePatchRead(ctx, in, updateMasks[i], db); err != nil {
+					return err
+				}
+			}
+		}
+		targets := make(map[uint64]WarehouseORM, len(objects))
+		handles, groups := group()
+		for _, handle := range handles {
+			keys := make([]uint64, 0, len(groups[handle]))
+			for _, i := range groups[handle] {
+				keys = append(keys, objectKeys[i])
+			}
+			query := handle.Session(&gorm.Session{}).Clauses(clause.Locking{Strength: "UPDATE"})
+			query = query.Where("id in (?)", keys)
+			rows := []WarehouseORM{}
+			if err = query.Find(&rows).Error; err != nil {
+				return err
+			}
+			for _, row := range rows {
+				targets[row.Id] = row
+			}
+		}
+		ormObjs := make([]WarehouseORM, len(objects))
+		prepare := func(i int) (*gorm.DB, error) {
+			in, key, updateMask, db := objects[i], objectKeys[i], updateMasks[i], dbs[i]
+			target, ok := targets[key]
+			if !ok {
+				return nil, gorm.ErrRecordNotFound
+			}
+			stored, err := target.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbObj := &stored
+			if hook, ok := interface{}(pbObj).(WarehouseWithBeforePatchApplyFieldMask); ok {
+				if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			if _, err := DefaultApplyFieldMaskWarehouse(ctx, pbObj, in, updateMask, "", db); err != nil {
+				return nil, err
+			}
+			if hook, ok := interface{}(pbObj).(WarehouseWithBeforePatchSave); ok {
+				if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObj, err := pbObj.ToORM(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if hook, ok := interface{}(&ormObj).(WarehouseORMWithBeforeStrictUpdateCleanup); ok {
+				if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			masked := make(map[string]bool, len(updateMask.GetPaths()))
+			for _, path := range updateMask.GetPaths() {
+				masked[strings.SplitN(path, ".", 2)[0]] = true
+			}
+			if masked["Address"] || masked["*"] {
+				filterAddress := AddressORM{}
+				if ormObj.Id == 0 {
+					return nil, errors.EmptyIdError
+				}
+				filterAddress.WarehouseId = new(uint64)
+				*filterAddress.WarehouseId = ormObj.Id
+				if err = db.Where(filterAddress).Delete(AddressORM{}).Error; err != nil {
+					return nil, err
+				}
+			}
+			if hook, ok := interface{}(&ormObj).(WarehouseORMWithBeforeStrictUpdateSave); ok {
+				if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObjs[i] = ormObj
+			return db, nil
+		}
+		for i := range objects {
+			if dbs[i], err = prepare(i); err != nil {
+				return err
+			}
+		}
+		write := func(db *gorm.DB, batch []int) error {
+			conds := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*2)
+			for _, i := range batch {
+				conds = append(conds, "(id = ?)")
+				args = append(args, objectKeys[i])
+			}
+			column := func(name string, value func(ormObj *WarehouseORM) interface{}) clause.Expr {
+				whens := make([]interface{}, 0, len(batch)*2)
+				for _, i := range batch {
+					whens = append(whens, objectKeys[i], value(&ormObjs[i]))
+				}
+				return gorm.Expr("CASE "+strings.Repeat("WHEN id = ? THEN ? ", len(batch))+"ELSE "+name+" END", whens...)
+			}
+			res := db.Session(&gorm.Session{}).Model(&WarehouseORM{}).Where(strings.Join(conds, " OR "), args...).Updates(map[string]interface{}{
+				"address_id":           column("address_id", func(ormObj *WarehouseORM) interface{} { return ormObj.AddressId }),
+				"location_city":        column("location_city", func(ormObj *WarehouseORM) interface{} { return ormObj.Location.City }),
+				"location_postal_code": column("location_postal_code", func(ormObj *WarehouseORM) interface{} { return ormObj.Location.PostalCode }),
+				"location_street":      column("location_street", func(ormObj *WarehouseORM) interface{} { return ormObj.Location.Street }),
+				"returns_city":         column("returns_city", func(ormObj *WarehouseORM) interface{} { return ormObj.ReturnLocation.City }),
+				"returns_postal_code":  column("returns_postal_code", func(ormObj *WarehouseORM) interface{} { return ormObj.ReturnLocation.PostalCode }),
+				"returns_street":       column("returns_street", func(ormObj *WarehouseORM) interface{} { return ormObj.ReturnLocation.Street }),
+			})
+			return res.Error
+		}
+		handles, groups = group()
+		for _, handle := range handles {
+			indexes := groups[handle]
+			for start := 0; start < len(indexes); start += batchSize {
+				end := start + batchSize
+				if end > len(indexes) {
+					end = len(indexes)
+				}
+				batch := indexes[start:end]
+				objs := make([]*WarehouseORM, 0, len(batch))
+				for _, i := range batch {
+					objs = append(objs, &ormObjs[i])
+				}
+				// the associations are saved first, the batch is then written with the
+				// foreign keys of its belongs-to associations
+				if err = handle.Session(&gorm.Session{}).Select("Address", "BillingAddress").Model(&objs).Updates(map[string]interface{}{}).Error; err != nil {
+					return err
+				}
+				if err = write(handle, batch); err != nil {
+					return err
+				}
+			}
+		}
+		finish := func(i int) (*Warehouse, error) {
+			in, updateMask, db, ormObj := objects[i], updateMasks[i], dbs[i], ormObjs[i]
+			var err error
+			if hook, ok := interface{}(&ormObj).(WarehouseORMWithAfterStrictUpdateSave); ok {
+				if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			saved, err := ormObj.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbResponse := &saved
+			if hook, ok := interface{}(pbResponse).(WarehouseWithAfterPatchSave); ok {
+				if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			return pbResponse, nil
+		}
+		for i := range objects {
+			if results[i], err = finish(i); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
-	pbResponse, err := ormObj.ToPB(ctx)
-	return &pbResponse, err
-}
-
-type TestTagAssociationORMWithBeforeCreate_ interface {
-	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type TestTagAssociationORMWithAfterCreate_ interface {
-	AfterCreate_(context.Context, *gorm.DB) error
+	return results, nil
 }
 
-// DefaultApplyFieldMaskTestTagAssociation patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskTestTagAssociation(ctx context.Context, patchee *TestTagAssociation, patcher *TestTagAssociation, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*TestTagAssociation, error) {
+// DefaultApplyFieldMaskWarehouse patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskWarehouse(ctx context.Context, patchee *Warehouse, patcher *Warehouse, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Warehouse, error) {
 	if patcher == nil {
 		return nil, nil
 	} else if patchee == nil {
 		return nil, errors.NilArgumentError
 	}
+	if len(updateMask.GetPaths()) == 1 && updateMask.Paths[0] == prefix+"*" {
+		updateMask = &field_mask.FieldMask{Paths: []string{prefix + "Id", prefix + "Address", prefix + "BillingAddress", prefix + "Location", prefix + "ReturnLocation"}}
+	}
+	for _, f := range updateMask.GetPaths() {
+		if strings.HasPrefix(f, prefix) && !DefaultHasFieldMaskPathWarehouse(strings.TrimPrefix(f, prefix)) {
+			return nil, &errors.InvalidFieldMaskError{Path: f}
+		}
+	}
 	var err error
-	for _, f := range updateMask.Paths {
-		if f == prefix+"SomeField" {
-			patchee.SomeField = patcher.SomeField
+	var updatedAddress bool
+	var updatedBillingAddress bool
+	var updatedLocation bool
+	var updatedReturnLocation bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if !updatedAddress && strings.HasPrefix(f, prefix+"Address.") {
+			updatedAddress = true
+			if patcher.Address == nil {
+				patchee.Address = nil
+				continue
+			}
+			if patchee.Address == nil {
+				patchee.Address = &Address{}
+			}
+			if o, err := DefaultApplyFieldMaskAddress(ctx, patchee.Address, patcher.Address, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"Address.", db); err != nil {
+				return nil, err
+			} else {
+				patchee.Address = o
+			}
+			continue
+		}
+		if f == prefix+"Address" {
+			updatedAddress = true
+			patchee.Address = patcher.Address
+			continue
+		}
+		if !updatedBillingAddress && strings.HasPrefix(f, prefix+"BillingAddress.") {
+			updatedBillingAddress = true
+			if patcher.BillingAddress == nil {
+				patchee.BillingAddress = nil
+				continue
+			}
+			if patchee.BillingAddress == nil {
+				patchee.BillingAddress = &user.Address{}
+			}
+			if o, err := user.DefaultApplyFieldMaskAddress(ctx, patchee.BillingAddress, patcher.BillingAddress, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"BillingAddress.", db); err != nil {
+				return nil, err
+			} else {
+				patchee.BillingAddress = o
+			}
+			continue
+		}
+		if f == prefix+"BillingAddress" {
+			updatedBillingAddress = true
+			patchee.BillingAddress = patcher.BillingAddress
+			continue
+		}
+		if !updatedLocation && strings.HasPrefix(f, prefix+"Location.") {
+			updatedLocation = true
+			if patcher.Location == nil {
+				patchee.Location = nil
+				continue
+			}
+			if patchee.Location == nil {
+				patchee.Location = &Location{}
+			}
+			if o, err := DefaultApplyFieldMaskLocation(ctx, patchee.Location, patcher.Location, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"Location.", db); err != nil {
+				return nil, err
+			} else {
+				patchee.Location = o
+			}
+			continue
+		}
+		if f == prefix+"Location" {
+			updatedLocation = true
+			patchee.Location = patcher.Location
+			continue
+		}
+		if !updatedReturnLocation && strings.HasPrefix(f, prefix+"ReturnLocation.") {
+			updatedReturnLocation = true
+			if patcher.ReturnLocation == nil {
+				patchee.ReturnLocation = nil
+				continue
+			}
+			if patchee.ReturnLocation == nil {
+				patchee.ReturnLocation = &Location{}
+			}
+			if o, err := DefaultApplyFieldMaskLocation(ctx, patchee.ReturnLocation, patcher.ReturnLocation, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"ReturnLocation.", db); err != nil {
+				return nil, err
+			} else {
+				patchee.ReturnLocation = o
+			}
+			continue
+		}
+		if f == prefix+"ReturnLocation" {
+			updatedReturnLocation = true
+			patchee.ReturnLocation = patcher.ReturnLocation
 			continue
 		}
 	}
@@ -5152,34 +11058,53 @@ func DefaultApplyFieldMaskTestTagAssociation(ctx context.Context, patchee *TestT
 	return patchee, nil
 }
 
-// DefaultListTestTagAssociation executes a gorm list call
-func DefaultListTestTagAssociation(ctx context.Context, db *gorm.DB) ([]*TestTagAssociation, error) {
-	in := TestTagAssociation{}
+// DefaultHasFieldMaskPathWarehouse reports whether Warehouse has the field of the field mask path
+func DefaultHasFieldMaskPathWarehouse(path string) bool {
+	parts := strings.SplitN(path, ".", 2)
+	switch parts[0] {
+	case "Address":
+		return len(parts) == 1 || DefaultHasFieldMaskPathAddress(parts[1])
+	case "BillingAddress":
+		return len(parts) == 1 || user.DefaultHasFieldMaskPathAddress(parts[1])
+	case "Location":
+		return len(parts) == 1 || DefaultHasFieldMaskPathLocation(parts[1])
+	case "ReturnLocation":
+		return len(parts) == 1 || DefaultHasFieldMaskPathLocation(parts[1])
+	case "Id":
+		return len(parts) == 1
+	}
+	return false
+}
+
+// DefaultListWarehouse executes a gorm list call
+func DefaultListWarehouse(ctx context.Context, db *gorm.DB) ([]*Warehouse, error) {
+	in := Warehouse{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestTagAssociationORMWithBeforeListApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(WarehouseORMWithBeforeListApplyQuery); ok {
 		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if hook, ok := interface{}(&ormObj).(TestTagAssociationORMWithBeforeListFind); ok {
+	if hook, ok := interface{}(&ormObj).(WarehouseORMWithBeforeListFind); ok {
 		if db, err = hook.BeforeListFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
 	db = db.Where(&ormObj)
-	ormResponse := []TestTagAssociationORM{}
+	db = db.Order("id")
+	ormResponse := []WarehouseORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestTagAssociationORMWithAfterListFind); ok {
+	if hook, ok := interface{}(&ormObj).(WarehouseORMWithAfterListFind); ok {
 		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse := []*TestTagAssociation{}
+	pbResponse := []*Warehouse{}
 	for _, responseEntry := range ormResponse {
 		temp, err := responseEntry.ToPB(ctx)
 		if err != nil {
@@ -5190,18 +11115,18 @@ func DefaultListTestTagAssociation(ctx context.Context, db *gorm.DB) ([]*TestTag
 	return pbResponse, nil
 }
 
-type TestTagAssociationORMWithBeforeListApplyQuery interface {
+type WarehouseORMWithBeforeListApplyQuery interface {
 	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestTagAssociationORMWithBeforeListFind interface {
+type WarehouseORMWithBeforeListFind interface {
 	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestTagAssociationORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]TestTagAssociationORM) error
+type WarehouseORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]WarehouseORM) error
 }
 
-// DefaultCreatePrimaryIncluded executes a basic gorm create call
-func DefaultCreatePrimaryIncluded(ctx context.Context, in *PrimaryIncluded, db *gorm.DB) (*PrimaryIncluded, error) {
+// DefaultCreateAddress executes a basic gorm create call
+func DefaultCreateAddress(ctx context.Context, in *Address, db *gorm.DB) (*Address, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -5209,7 +11134,7 @@ func DefaultCreatePrimaryIncluded(ctx context.Context, in *PrimaryIncluded, db *
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithBeforeCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(AddressORMWithBeforeCreate_); ok {
 		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
 			return nil, err
 		}
@@ -5217,7 +11142,7 @@ func DefaultCreatePrimaryIncluded(ctx context.Context, in *PrimaryIncluded, db *
 	if err = db.Omit().Create(&ormObj).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithAfterCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(AddressORMWithAfterCreate_); ok {
 		if err = hook.AfterCreate_(ctx, db); err != nil {
 			return nil, err
 		}
@@ -5226,14 +11151,78 @@ func DefaultCreatePrimaryIncluded(ctx context.Context, in *PrimaryIncluded, db *
 	return &pbResponse, err
 }
 
-type PrimaryIncludedORMWithBeforeCreate_ interface {
+type AddressORMWithBeforeCreate_ interface {
 	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryIncludedORMWithAfterCreate_ interface {
+type AddressORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm.DB) error
 }
 
-func DefaultReadPrimaryIncluded(ctx context.Context, in *PrimaryIncluded, db *gorm.DB) (*PrimaryIncluded, error) {
+// DefaultCreateAddressSet executes gorm create calls in batches of 100 objects
+// in a transaction, either all of the objects are created or none of them. The BeforeCreate_ hook
+// of each object runs before the insert of its batch, which uses the handle the hooks of the
+// batch return
+func DefaultCreateAddressSet(ctx context.Context, in []*Address, db *gorm.DB) ([]*Address, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObjs := make([]AddressORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, ormObj)
+	}
+	batchSize := 100
+	err := db.Transaction(func(db *gorm.DB) error {
+		var err error
+		for start := 0; start < len(ormObjs); start += batchSize {
+			end := start + batchSize
+			if end > len(ormObjs) {
+				end = len(ormObjs)
+			}
+			batch := ormObjs[start:end]
+			// the scopes the hooks add only apply to the insert of their own batch
+			batchDB := db
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(AddressORMWithBeforeCreate_); ok {
+					if batchDB, err = hook.BeforeCreate_(ctx, batchDB); err != nil {
+						return err
+					}
+				}
+			}
+			if err = batchDB.Omit().CreateInBatches(&batch, batchSize).Error; err != nil {
+				return err
+			}
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(AddressORMWithAfterCreate_); ok {
+					if err = hook.AfterCreate_(ctx, db); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*Address, 0, len(ormObjs))
+	for i := range ormObjs {
+		pbResponse, err := ormObjs[i].ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, &pbResponse)
+	}
+	return results, nil
+}
+
+func DefaultReadAddress(ctx context.Context, in *Address, db *gorm.DB) (*Address, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -5241,24 +11230,24 @@ func DefaultReadPrimaryIncluded(ctx context.Context, in *PrimaryIncluded, db *go
 	if err != nil {
 		return nil, err
 	}
-	if ormObj.Id == go_uuid.Nil {
+	if ormObj.Id == 0 {
 		return nil, errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithBeforeReadApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(AddressORMWithBeforeReadApplyQuery); ok {
 		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithBeforeReadFind); ok {
+	if hook, ok := interface{}(&ormObj).(AddressORMWithBeforeReadFind); ok {
 		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	ormResponse := PrimaryIncludedORM{}
+	ormResponse := AddressORM{}
 	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormResponse).(PrimaryIncludedORMWithAfterReadFind); ok {
+	if hook, ok := interface{}(&ormResponse).(AddressORMWithAfterReadFind); ok {
 		if err = hook.AfterReadFind(ctx, db); err != nil {
 			return nil, err
 		}
@@ -5267,17 +11256,17 @@ func DefaultReadPrimaryIncluded(ctx context.Context, in *PrimaryIncluded, db *go
 	return &pbResponse, err
 }
 
-type PrimaryIncludedORMWithBeforeReadApplyQuery interface {
+type AddressORMWithBeforeReadApplyQuery interface {
 	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryIncludedORMWithBeforeReadFind interface {
+type AddressORMWithBeforeReadFind interface {
 	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryIncludedORMWithAfterReadFind interface {
+type AddressORMWithAfterReadFind interface {
 	AfterReadFind(context.Context, *gorm.DB) error
 }
 
-func DefaultDeletePrimaryIncluded(ctx context.Context, in *PrimaryIncluded, db *gorm.DB) error {
+func DefaultDeleteAddress(ctx context.Context, in *Address, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
@@ -5285,96 +11274,87 @@ func DefaultDeletePrimaryIncluded(ctx context.Context, in *PrimaryIncluded, db *
 	if err != nil {
 		return err
 	}
-	if ormObj.Id == go_uuid.Nil {
+	if ormObj.Id == 0 {
 		return errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithBeforeDelete_); ok {
+	if hook, ok := interface{}(&ormObj).(AddressORMWithBeforeDelete_); ok {
 		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where(&ormObj).Delete(&PrimaryIncludedORM{}).Error
+	err = db.Where(&ormObj).Delete(&AddressORM{}).Error
 	if err != nil {
 		return err
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithAfterDelete_); ok {
+	if hook, ok := interface{}(&ormObj).(AddressORMWithAfterDelete_); ok {
 		err = hook.AfterDelete_(ctx, db)
 	}
 	return err
 }
 
-type PrimaryIncludedORMWithBeforeDelete_ interface {
+type AddressORMWithBeforeDelete_ interface {
 	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryIncludedORMWithAfterDelete_ interface {
+type AddressORMWithAfterDelete_ interface {
 	AfterDelete_(context.Context, *gorm.DB) error
 }
 
-func DefaultDeletePrimaryIncludedSet(ctx context.Context, in []*PrimaryIncluded, db *gorm.DB) error {
+func DefaultDeleteAddressSet(ctx context.Context, in []*Address, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
 	var err error
-	keys := []go_uuid.UUID{}
+	keys := []uint64{}
 	for _, obj := range in {
 		ormObj, err := obj.ToORM(ctx)
 		if err != nil {
 			return err
 		}
-		if ormObj.Id == go_uuid.Nil {
+		if ormObj.Id == 0 {
 			return errors.EmptyIdError
 		}
 		keys = append(keys, ormObj.Id)
 	}
-	if hook, ok := (interface{}(&PrimaryIncludedORM{})).(PrimaryIncludedORMWithBeforeDeleteSet); ok {
+	if hook, ok := (interface{}(&AddressORM{})).(AddressORMWithBeforeDeleteSet); ok {
 		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where("id in (?)", keys).Delete(&PrimaryIncludedORM{}).Error
+	err = db.Where("id in (?)", keys).Delete(&AddressORM{}).Error
 	if err != nil {
 		return err
 	}
-	if hook, ok := (interface{}(&PrimaryIncludedORM{})).(PrimaryIncludedORMWithAfterDeleteSet); ok {
+	if hook, ok := (interface{}(&AddressORM{})).(AddressORMWithAfterDeleteSet); ok {
 		err = hook.AfterDeleteSet(ctx, in, db)
 	}
 	return err
 }
 
-type PrimaryIncludedORMWithBeforeDeleteSet interface {
-	BeforeDeleteSet(context.Context, []*PrimaryIncluded, *gorm.DB) (*gorm.DB, error)
+type AddressORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Address, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryIncludedORMWithAfterDeleteSet interface {
-	AfterDeleteSet(context.Context, []*PrimaryIncluded, *gorm.DB) error
+type AddressORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Address, *gorm.DB) error
 }
 
-// DefaultStrictUpdatePrimaryIncluded clears / replaces / appends first level 1:many children and then executes a gorm update call
-func DefaultStrictUpdatePrimaryIncluded(ctx context.Context, in *PrimaryIncluded, db *gorm.DB) (*PrimaryIncluded, error) {
+// DefaultStrictUpdateAddress clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateAddress(ctx context.Context, in *Address, db *gorm.DB) (*Address, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdatePrimaryIncluded")
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateAddress")
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
 	var count int64
-	lockedRow := &PrimaryIncludedORM{}
+	lockedRow := &AddressORM{}
 	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
-	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithBeforeStrictUpdateCleanup); ok {
+	if hook, ok := interface{}(&ormObj).(AddressORMWithBeforeStrictUpdateCleanup); ok {
 		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	filterChild := ExternalChildORM{}
-	if ormObj.Id == go_uuid.Nil {
-		return nil, errors.EmptyIdError
-	}
-	filterChild.PrimaryIncludedId = new(go_uuid.UUID)
-	*filterChild.PrimaryIncludedId = ormObj.Id
-	if err = db.Where(filterChild).Delete(ExternalChildORM{}).Error; err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithBeforeStrictUpdateSave); ok {
+	if hook, ok := interface{}(&ormObj).(AddressORMWithBeforeStrictUpdateSave); ok {
 		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
@@ -5382,7 +11362,7 @@ func DefaultStrictUpdatePrimaryIncluded(ctx context.Context, in *PrimaryIncluded
 	if err = db.Omit().Save(&ormObj).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithAfterStrictUpdateSave); ok {
+	if hook, ok := interface{}(&ormObj).(AddressORMWithAfterStrictUpdateSave); ok {
 		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
@@ -5397,46 +11377,125 @@ func DefaultStrictUpdatePrimaryIncluded(ctx context.Context, in *PrimaryIncluded
 	return &pbResponse, err
 }
 
-type PrimaryIncludedORMWithBeforeStrictUpdateCleanup interface {
+type AddressORMWithBeforeStrictUpdateCleanup interface {
 	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryIncludedORMWithBeforeStrictUpdateSave interface {
+type AddressORMWithBeforeStrictUpdateSave interface {
 	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryIncludedORMWithAfterStrictUpdateSave interface {
+type AddressORMWithAfterStrictUpdateSave interface {
 	AfterStrictUpdateSave(context.Context, *gorm.DB) error
 }
 
-// DefaultPatchPrimaryIncluded executes a basic gorm update call with patch behavior
-func DefaultPatchPrimaryIncluded(ctx context.Context, in *PrimaryIncluded, updateMask *field_mask.FieldMask, db *gorm.DB) (*PrimaryIncluded, error) {
+// DefaultUpsertAddress executes a gorm create call resolving conflicts on conflictTarget,
+// the name of a unique index or the primary key when empty, by updating the columns
+// in updateMask, all of them when updateMask is empty, and returns the stored object
+func DefaultUpsertAddress(ctx context.Context, in *Address, conflictTarget string, updateMask *field_mask.FieldMask, db *gorm.DB) (*Address, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conflict := clause.OnConflict{}
+	var lookup func() map[string]interface{}
+	switch conflictTarget {
+	case "":
+		conflict.Columns = []clause.Column{{Name: "id"}}
+		lookup = func() map[string]interface{} { return map[string]interface{}{"id": ormObj.Id} }
+	default:
+		return nil, fmt.Errorf("unknown conflict target %q of Address", conflictTarget)
+	}
+	if len(updateMask.GetPaths()) == 0 {
+		conflict.UpdateAll = true
+	} else {
+		var columns []string
+		for _, path := range updateMask.GetPaths() {
+			var pathColumns []string
+			switch path {
+			case "Street":
+				pathColumns = []string{"street"}
+			case "City":
+				pathColumns = []string{"city"}
+			default:
+				return nil, &errors.InvalidFieldMaskError{Path: path}
+			}
+			for _, column := range pathColumns {
+				for _, target := range conflict.Columns {
+					if column == target.Name {
+						return nil, &errors.InvalidFieldMaskError{Path: path}
+					}
+				}
+			}
+			columns = append(columns, pathColumns...)
+		}
+		conflict.DoUpdates = clause.AssignmentColumns(columns)
+	}
+	if hook, ok := interface{}(&ormObj).(AddressORMWithBeforeUpsert); ok {
+		if db, err = hook.BeforeUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Clauses(conflict).Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if len(updateMask.GetPaths()) > 0 {
+		stored := AddressORM{}
+		if err = db.Where(lookup()).Take(&stored).Error; err != nil {
+			return nil, err
+		}
+		ormObj = stored
+	}
+	if hook, ok := interface{}(&ormObj).(AddressORMWithAfterUpsert); ok {
+		if err = hook.AfterUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type AddressORMWithBeforeUpsert interface {
+	BeforeUpsert(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type AddressORMWithAfterUpsert interface {
+	AfterUpsert(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchAddress executes a basic gorm update call with patch behavior
+func DefaultPatchAddress(ctx context.Context, in *Address, updateMask *field_mask.FieldMask, db *gorm.DB) (*Address, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	var pbObj PrimaryIncluded
+	pbObj := &Address{}
 	var err error
-	if hook, ok := interface{}(&pbObj).(PrimaryIncludedWithBeforePatchRead); ok {
+	if hook, ok := interface{}(pbObj).(AddressWithBeforePatchRead); ok {
 		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	if hook, ok := interface{}(&pbObj).(PrimaryIncludedWithBeforePatchApplyFieldMask); ok {
+	if pbObj, err = DefaultReadAddress(ctx, &Address{Id: in.GetId()}, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbObj).(AddressWithBeforePatchApplyFieldMask); ok {
 		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	if _, err := DefaultApplyFieldMaskPrimaryIncluded(ctx, &pbObj, in, updateMask, "", db); err != nil {
+	if _, err := DefaultApplyFieldMaskAddress(ctx, pbObj, in, updateMask, "", db); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&pbObj).(PrimaryIncludedWithBeforePatchSave); ok {
+	if hook, ok := interface{}(pbObj).(AddressWithBeforePatchSave); ok {
 		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse, err := DefaultStrictUpdatePrimaryIncluded(ctx, &pbObj, db)
+	pbResponse, err := DefaultStrictUpdateAddress(ctx, pbObj, db)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(pbResponse).(PrimaryIncludedWithAfterPatchSave); ok {
+	if hook, ok := interface{}(pbResponse).(AddressWithAfterPatchSave); ok {
 		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
@@ -5444,67 +11503,236 @@ func DefaultPatchPrimaryIncluded(ctx context.Context, in *PrimaryIncluded, updat
 	return pbResponse, nil
 }
 
-type PrimaryIncludedWithBeforePatchRead interface {
-	BeforePatchRead(context.Context, *PrimaryIncluded, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type AddressWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Address, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryIncludedWithBeforePatchApplyFieldMask interface {
-	BeforePatchApplyFieldMask(context.Context, *PrimaryIncluded, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type AddressWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Address, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryIncludedWithBeforePatchSave interface {
-	BeforePatchSave(context.Context, *PrimaryIncluded, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type AddressWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Address, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryIncludedWithAfterPatchSave interface {
-	AfterPatchSave(context.Context, *PrimaryIncluded, *field_mask.FieldMask, *gorm.DB) error
+type AddressWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Address, *field_mask.FieldMask, *gorm.DB) error
 }
 
-// DefaultPatchSetPrimaryIncluded executes a bulk gorm update call with patch behavior
-func DefaultPatchSetPrimaryIncluded(ctx context.Context, objects []*PrimaryIncluded, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*PrimaryIncluded, error) {
+// DefaultPatchSetAddress patches the objects in a transaction: the masks are applied in memory to
+// the stored objects, read and locked with a single query, and the objects are written with an update
+// per batch of 100 objects. The objects are read and written with the handles their hooks
+// return, the objects of different handles don't share the queries. Only the associations in the
+// masks are updated, and objects sharing a primary key are rejected
+func DefaultPatchSetAddress(ctx context.Context, objects []*Address, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Address, error) {
 	if len(objects) != len(updateMasks) {
 		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
 	}
-
-	results := make([]*PrimaryIncluded, 0, len(objects))
-	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchPrimaryIncluded(ctx, patcher, updateMasks[i], db)
+	if len(objects) == 0 {
+		return []*Address{}, nil
+	}
+	var err error
+	objectKeys := make([]uint64, 0, len(objects))
+	seen := make(map[uint64]struct{}, len(objects))
+	for _, in := range objects {
+		if in == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := in.ToORM(ctx)
 		if err != nil {
 			return nil, err
 		}
-
-		results = append(results, pbResponse)
+		if ormObj.Id == 0 {
+			return nil, errors.EmptyIdError
+		}
+		key := ormObj.Id
+		if _, ok := seen[key]; ok {
+			return nil, errors.DuplicateKeyError
+		}
+		seen[key] = struct{}{}
+		objectKeys = append(objectKeys, key)
+	}
+	batchSize := 100
+	results := make([]*Address, len(objects))
+	err = db.Transaction(func(db *gorm.DB) error {
+		var err error
+		// the objects sharing the handle their hooks return share the queries
+		dbs := make([]*gorm.DB, len(objects))
+		group := func() ([]*gorm.DB, map[*gorm.DB][]int) {
+			var handles []*gorm.DB
+			groups := make(map[*gorm.DB][]int)
+			for i, handle := range dbs {
+				if _, ok := groups[handle]; !ok {
+					handles = append(handles, handle)
+				}
+				groups[handle] = append(groups[handle], i)
+			}
+			return handles, groups
+		}
+		for i, in := range objects {
+			dbs[i] = db
+			if hook, ok := interface{}(&Address{}).(AddressWithBeforePatchRead); ok {
+				if dbs[i], err = hook.BeforePatchRead(ctx, in, updateMasks[i], db); err != nil {
+					return err
+				}
+			}
+		}
+		targets := make(map[uint64]AddressORM, len(objects))
+		handles, groups := group()
+		for _, handle := range handles {
+			keys := make([]uint64, 0, len(groups[handle]))
+			for _, i := range groups[handle] {
+				keys = append(keys, objectKeys[i])
+			}
+			query := handle.Session(&gorm.Session{}).Clauses(clause.Locking{Strength: "UPDATE"})
+			query = query.Where("id in (?)", keys)
+			rows := []AddressORM{}
+			if err = query.Find(&rows).Error; err != nil {
+				return err
+			}
+			for _, row := range rows {
+				targets[row.Id] = row
+			}
+		}
+		ormObjs := make([]AddressORM, len(objects))
+		prepare := func(i int) (*gorm.DB, error) {
+			in, key, updateMask, db := objects[i], objectKeys[i], updateMasks[i], dbs[i]
+			target, ok := targets[key]
+			if !ok {
+				return nil, gorm.ErrRecordNotFound
+			}
+			stored, err := target.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbObj := &stored
+			if hook, ok := interface{}(pbObj).(AddressWithBeforePatchApplyFieldMask); ok {
+				if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			if _, err := DefaultApplyFieldMaskAddress(ctx, pbObj, in, updateMask, "", db); err != nil {
+				return nil, err
+			}
+			if hook, ok := interface{}(pbObj).(AddressWithBeforePatchSave); ok {
+				if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObj, err := pbObj.ToORM(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if hook, ok := interface{}(&ormObj).(AddressORMWithBeforeStrictUpdateCleanup); ok {
+				if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			if hook, ok := interface{}(&ormObj).(AddressORMWithBeforeStrictUpdateSave); ok {
+				if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObjs[i] = ormObj
+			return db, nil
+		}
+		for i := range objects {
+			if dbs[i], err = prepare(i); err != nil {
+				return err
+			}
+		}
+		write := func(db *gorm.DB, batch []int) error {
+			conds := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*2)
+			for _, i := range batch {
+				conds = append(conds, "(id = ?)")
+				args = append(args, objectKeys[i])
+			}
+			column := func(name string, value func(ormObj *AddressORM) interface{}) clause.Expr {
+				whens := make([]interface{}, 0, len(batch)*2)
+				for _, i := range batch {
+					whens = append(whens, objectKeys[i], value(&ormObjs[i]))
+				}
+				return gorm.Expr("CASE "+strings.Repeat("WHEN id = ? THEN ? ", len(batch))+"ELSE "+name+" END", whens...)
+			}
+			res := db.Session(&gorm.Session{}).Model(&AddressORM{}).Where(strings.Join(conds, " OR "), args...).Updates(map[string]interface{}{
+				"city":         column("city", func(ormObj *AddressORM) interface{} { return ormObj.City }),
+				"street":       column("street", func(ormObj *AddressORM) interface{} { return ormObj.Street }),
+				"warehouse_id": column("warehouse_id", func(ormObj *AddressORM) interface{} { return ormObj.WarehouseId }),
+			})
+			return res.Error
+		}
+		handles, groups = group()
+		for _, handle := range handles {
+			indexes := groups[handle]
+			for start := 0; start < len(indexes); start += batchSize {
+				end := start + batchSize
+				if end > len(indexes) {
+					end = len(indexes)
+				}
+				batch := indexes[start:end]
+				if err = write(handle, batch); err != nil {
+					return err
+				}
+			}
+		}
+		finish := func(i int) (*Address, error) {
+			in, updateMask, db, ormObj := objects[i], updateMasks[i], dbs[i], ormObjs[i]
+			var err error
+			if hook, ok := interface{}(&ormObj).(AddressORMWithAfterStrictUpdateSave); ok {
+				if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			saved, err := ormObj.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbResponse := &saved
+			if hook, ok := interface{}(pbResponse).(AddressWithAfterPatchSave); ok {
+				if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			return pbResponse, nil
+		}
+		for i := range objects {
+			if results[i], err = finish(i); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
-
 	return results, nil
 }
 
-// DefaultApplyFieldMaskPrimaryIncluded patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskPrimaryIncluded(ctx context.Context, patchee *PrimaryIncluded, patcher *PrimaryIncluded, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*PrimaryIncluded, error) {
+// DefaultApplyFieldMaskAddress patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskAddress(ctx context.Context, patchee *Address, patcher *Address, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Address, error) {
 	if patcher == nil {
 		return nil, nil
 	} else if patchee == nil {
 		return nil, errors.NilArgumentError
 	}
+	if len(updateMask.GetPaths()) == 1 && updateMask.Paths[0] == prefix+"*" {
+		updateMask = &field_mask.FieldMask{Paths: []string{prefix + "Id", prefix + "Street", prefix + "City"}}
+	}
+	for _, f := range updateMask.GetPaths() {
+		if strings.HasPrefix(f, prefix) && !DefaultHasFieldMaskPathAddress(strings.TrimPrefix(f, prefix)) {
+			return nil, &errors.InvalidFieldMaskError{Path: f}
+		}
+	}
 	var err error
-	var updatedChild bool
-	for i, f := range updateMask.Paths {
-		if !updatedChild && strings.HasPrefix(f, prefix+"Child.") {
-			updatedChild = true
-			if patcher.Child == nil {
-				patchee.Child = nil
-				continue
-			}
-			if patchee.Child == nil {
-				patchee.Child = &ExternalChild{}
-			}
-			if o, err := DefaultApplyFieldMaskExternalChild(ctx, patchee.Child, patcher.Child, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"Child.", db); err != nil {
-				return nil, err
-			} else {
-				patchee.Child = o
-			}
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
 			continue
 		}
-		if f == prefix+"Child" {
-			updatedChild = true
-			patchee.Child = patcher.Child
+		if f == prefix+"Street" {
+			patchee.Street = patcher.Street
+			continue
+		}
+		if f == prefix+"City" {
+			patchee.City = patcher.City
 			continue
 		}
 	}
@@ -5514,35 +11742,45 @@ func DefaultApplyFieldMaskPrimaryIncluded(ctx context.Context, patchee *PrimaryI
 	return patchee, nil
 }
 
-// DefaultListPrimaryIncluded executes a gorm list call
-func DefaultListPrimaryIncluded(ctx context.Context, db *gorm.DB) ([]*PrimaryIncluded, error) {
-	in := PrimaryIncluded{}
+// DefaultHasFieldMaskPathAddress reports whether Address has the field of the field mask path
+func DefaultHasFieldMaskPathAddress(path string) bool {
+	parts := strings.SplitN(path, ".", 2)
+	switch parts[0] {
+	case "Id", "Street", "City":
+		return len(parts) == 1
+	}
+	return false
+}
+
+// DefaultListAddress executes a gorm list call
+func DefaultListAddress(ctx context.Context, db *gorm.DB) ([]*Address, error) {
+	in := Address{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithBeforeListApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(AddressORMWithBeforeListApplyQuery); ok {
 		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithBeforeListFind); ok {
+	if hook, ok := interface{}(&ormObj).(AddressORMWithBeforeListFind); ok {
 		if db, err = hook.BeforeListFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
 	db = db.Where(&ormObj)
 	db = db.Order("id")
-	ormResponse := []PrimaryIncludedORM{}
+	ormResponse := []AddressORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithAfterListFind); ok {
+	if hook, ok := interface{}(&ormObj).(AddressORMWithAfterListFind); ok {
 		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse := []*PrimaryIncluded{}
+	pbResponse := []*Address{}
 	for _, responseEntry := range ormResponse {
 		temp, err := responseEntry.ToPB(ctx)
 		if err != nil {
@@ -5553,12 +11791,12 @@ func DefaultListPrimaryIncluded(ctx context.Context, db *gorm.DB) ([]*PrimaryInc
 	return pbResponse, nil
 }
 
-type PrimaryIncludedORMWithBeforeListApplyQuery interface {
+type AddressORMWithBeforeListApplyQuery interface {
 	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryIncludedORMWithBeforeListFind interface {
+type AddressORMWithBeforeListFind interface {
 	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryIncludedORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]PrimaryIncludedORM) error
+type AddressORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]AddressORM) error
 }
@@ -108,4 +108,17 @@ func TestTypeWithIDORM_ToPB(t *testing.T) {
 			}
 		}
 	})
-}
\ No newline at end of file
+}
+func TestWarehouseORM_ToPB(t *testing.T) {
+	orm := &WarehouseORM{Id: 1, Location: LocationORM{City: "Tacoma"}}
+	pb, err := orm.ToPB(context.Background())
+	if err != nil {
+		t.Fatalf("orm.ToPB=%v; want success", err)
+	}
+	if got, want := pb.GetLocation().GetCity(), "Tacoma"; got != want {
+		t.Errorf("pb.Location.City=%q; want %q", got, want)
+	}
+	if pb.ReturnLocation != nil {
+		t.Errorf("pb.ReturnLocation=%v; want nil for zero columns", pb.ReturnLocation)
+	}
+}
@@ -0,0 +1,548 @@
+package example
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	errors1 "errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+
+	jwt "github.com/golang-jwt/jwt/v4"
+	"github.com/infobloxopen/atlas-app-toolkit/v2/query"
+	"github.com/infobloxopen/protoc-gen-gorm/errors"
+	field_mask "google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/callbacks"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/logger"
+	"gorm.io/gorm/schema"
+)
+
+// result is what the recording database answers to a statement
+type result struct {
+	columns  []string
+	rows     [][]driver.Value
+	affected int64
+	insertID int64
+}
+
+func (r result) LastInsertId() (int64, error) { return r.insertID, nil }
+func (r result) RowsAffected() (int64, error) { return r.affected, nil }
+
+// recording is a database which records the statements it receives and
+// answers them with the results of respond
+type recording struct {
+	mu         sync.Mutex
+	statements []string
+	respond    func(statement string) result
+}
+
+func (r *recording) answer(query string, args []driver.NamedValue) result {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	values := make([]string, 0, len(args))
+	for _, arg := range args {
+		values = append(values, fmt.Sprint(arg.Value))
+	}
+	statement := query
+	if len(values) != 0 {
+		statement += " [" + strings.Join(values, ", ") + "]"
+	}
+	r.statements = append(r.statements, statement)
+	if r.respond == nil {
+		return result{affected: 1}
+	}
+	return r.respond(statement)
+}
+
+func (r *recording) Open(string) (driver.Conn, error) { return recordingConn{r}, nil }
+
+type recordingConn struct{ r *recording }
+
+func (c recordingConn) Prepare(string) (driver.Stmt, error) {
+	return nil, fmt.Errorf("prepared statements are not supported")
+}
+func (c recordingConn) Close() error              { return nil }
+func (c recordingConn) Begin() (driver.Tx, error) { return c, nil }
+func (c recordingConn) Commit() error {
+	c.r.answer("COMMIT", nil)
+	return nil
+}
+func (c recordingConn) Rollback() error {
+	c.r.answer("ROLLBACK", nil)
+	return nil
+}
+
+func (c recordingConn) ExecContext(_ context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return c.r.answer(query, args), nil
+}
+
+func (c recordingConn) QueryContext(_ context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	res := c.r.answer(query, args)
+	return &recordingRows{result: res}, nil
+}
+
+type recordingRows struct {
+	result
+	next int
+}
+
+func (r *recordingRows) Columns() []string { return r.columns }
+func (r *recordingRows) Close() error      { return nil }
+func (r *recordingRows) Next(dest []driver.Value) error {
+	if r.next == len(r.rows) {
+		return io.EOF
+	}
+	copy(dest, r.rows[r.next])
+	r.next++
+	return nil
+}
+
+// recordingDialector plugs the recording database into gorm with plain
+// question mark placeholders and unquoted identifiers
+type recordingDialector struct{ conn *sql.DB }
+
+func (recordingDialector) Name() string { return "recording" }
+func (d recordingDialector) Initialize(db *gorm.DB) error {
+	callbacks.RegisterDefaultCallbacks(db, &callbacks.Config{})
+	db.ConnPool = d.conn
+	return nil
+}
+func (recordingDialector) Migrator(*gorm.DB) gorm.Migrator      { return nil }
+func (recordingDialector) DataTypeOf(*schema.Field) string      { return "" }
+func (recordingDialector) QuoteTo(w clause.Writer, name string) { w.WriteString(name) }
+func (recordingDialector) DefaultValueOf(*schema.Field) clause.Expression {
+	return clause.Expr{SQL: "DEFAULT"}
+}
+func (recordingDialector) BindVarTo(w clause.Writer, _ *gorm.Statement, _ interface{}) {
+	w.WriteByte('?')
+}
+func (recordingDialector) Explain(sql string, vars ...interface{}) string {
+	return logger.ExplainSQL(sql, nil, `'`, vars...)
+}
+
+// openRecording returns a gorm handle on a new recording database
+func openRecording(t *testing.T, respond func(statement string) result) (*gorm.DB, *recording) {
+	t.Helper()
+	r := &recording{respond: respond}
+	name := fmt.Sprintf("recording-%p", r)
+	sql.Register(name, r)
+	conn, err := sql.Open(name, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	db, err := gorm.Open(recordingDialector{conn}, &gorm.Config{SkipDefaultTransaction: true, Logger: logger.Discard})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return db, r
+}
+
+// accountContext returns a context carrying a token of the account
+func accountContext(t *testing.T, accountID string) context.Context {
+	t.Helper()
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"account_id": accountID}).SignedString([]byte("secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+}
+
+// checkStatements compares the statements a database received with the expected ones
+func checkStatements(t *testing.T, r *recording, expected ...string) {
+	t.Helper()
+	if len(r.statements) != len(expected) {
+		t.Fatalf("expected statements %q, got %q", expected, r.statements)
+	}
+	for i := range expected {
+		if r.statements[i] != expected[i] {
+			t.Errorf("expected statement %q, got %q", expected[i], r.statements[i])
+		}
+	}
+}
+
+// ticketRows answers the read of the tickets with the stored rows, and the
+// writes as if they affected each of them
+func ticketRows(rows ...[]driver.Value) func(string) result {
+	return func(statement string) result {
+		if strings.HasPrefix(statement, "SELECT * FROM tickets") {
+			return result{columns: []string{"id", "title", "version"}, rows: rows}
+		}
+		return result{affected: int64(len(rows))}
+	}
+}
+
+func TestPatchSet(t *testing.T) {
+	masks := []*field_mask.FieldMask{{Paths: []string{"Title"}}, {Paths: []string{"Title"}}}
+	t.Run("updates the stored rows in a transaction", func(t *testing.T) {
+		db, r := openRecording(t, ticketRows([]driver.Value{int64(1), "one", int64(3)}, []driver.Value{int64(2), "two", int64(5)}))
+		objects := []*Ticket{{Id: 1, Title: "first"}, {Id: 2, Title: "second", Version: 5}}
+		res, err := DefaultPatchSetTicket(context.Background(), objects, masks, db)
+		if err != nil {
+			t.Fatal(err)
+		}
+		checkStatements(t, r,
+			"SELECT * FROM tickets WHERE id in (?,?) FOR UPDATE [1, 2]",
+			"UPDATE tickets SET title=CASE WHEN id = ? THEN ? WHEN id = ? THEN ? ELSE title END,"+
+				"version=CASE WHEN id = ? THEN ? WHEN id = ? THEN ? ELSE version END "+
+				"WHERE (id = ? AND version = ?) OR (id = ? AND version = ?) [1, first, 2, second, 1, 4, 2, 6, 1, 3, 2, 5]",
+			"COMMIT",
+		)
+		if len(res) != 2 || res[0].Title != "first" || res[0].Version != 4 || res[1].Title != "second" || res[1].Version != 6 {
+			t.Errorf("unexpected results %v", res)
+		}
+	})
+	t.Run("replaces the comments in the mask only", func(t *testing.T) {
+		db, r := openRecording(t, ticketRows([]driver.Value{int64(1), "one", int64(3)}, []driver.Value{int64(2), "two", int64(5)}))
+		objects := []*Ticket{{Id: 1, Title: "first"}, {Id: 2, Comments: []*Comment{{Id: 9, Text: "new"}}}}
+		masks := []*field_mask.FieldMask{{Paths: []string{"Title"}}, {Paths: []string{"Comments"}}}
+		if _, err := DefaultPatchSetTicket(context.Background(), objects, masks, db); err != nil {
+			t.Fatal(err)
+		}
+		checkStatements(t, r,
+			"SELECT * FROM tickets WHERE id in (?,?) FOR UPDATE [1, 2]",
+			"DELETE FROM comments WHERE comments.owner_id = ? AND comments.owner_type = ? [2, tickets]",
+			"INSERT INTO comments (owner_id,owner_type,text,id) VALUES (?,?,?,?) ON CONFLICT (id) DO UPDATE SET owner_type=excluded.owner_type,owner_id=excluded.owner_id [2, tickets, new, 9]",
+			"UPDATE tickets SET title=CASE WHEN id = ? THEN ? WHEN id = ? THEN ? ELSE title END,"+
+				"version=CASE WHEN id = ? THEN ? WHEN id = ? THEN ? ELSE version END "+
+				"WHERE (id = ? AND version = ?) OR (id = ? AND version = ?) [1, first, 2, two, 1, 4, 2, 6, 1, 3, 2, 5]",
+			"COMMIT",
+		)
+	})
+	t.Run("rolls back on a version conflict", func(t *testing.T) {
+		db, r := openRecording(t, ticketRows([]driver.Value{int64(1), "one", int64(3)}, []driver.Value{int64(2), "two", int64(5)}))
+		objects := []*Ticket{{Id: 1, Title: "first"}, {Id: 2, Title: "second", Version: 4}}
+		if _, err := DefaultPatchSetTicket(context.Background(), objects, masks, db); err != errors.VersionConflictError {
+			t.Fatalf("expected a version conflict, got %v", err)
+		}
+		if last := r.statements[len(r.statements)-1]; last != "ROLLBACK" {
+			t.Errorf("expected the transaction to roll back, got %q", last)
+		}
+	})
+	t.Run("doesn't insert missing rows", func(t *testing.T) {
+		db, r := openRecording(t, ticketRows([]driver.Value{int64(1), "one", int64(3)}))
+		objects := []*Ticket{{Id: 1, Title: "first"}, {Id: 2, Title: "second"}}
+		if _, err := DefaultPatchSetTicket(context.Background(), objects, masks, db); err != gorm.ErrRecordNotFound {
+			t.Fatalf("expected a missing record, got %v", err)
+		}
+		for _, statement := range r.statements {
+			if strings.HasPrefix(statement, "INSERT") {
+				t.Errorf("unexpected insert %q", statement)
+			}
+		}
+	})
+	t.Run("rejects duplicate keys", func(t *testing.T) {
+		db, r := openRecording(t, nil)
+		objects := []*Ticket{{Id: 1, Title: "first"}, {Id: 1, Title: "second"}}
+		if _, err := DefaultPatchSetTicket(context.Background(), objects, masks, db); err != errors.DuplicateKeyError {
+			t.Fatalf("expected a duplicate key, got %v", err)
+		}
+		checkStatements(t, r)
+	})
+	t.Run("updates within the account", func(t *testing.T) {
+		db, r := openRecording(t, func(statement string) result {
+			if strings.HasPrefix(statement, "SELECT") {
+				return result{columns: []string{"account_id", "id", "some_field"}, rows: [][]driver.Value{{"acc", int64(1), "old"}}}
+			}
+			return result{affected: 1}
+		})
+		objects := []*MultiaccountTypeWithID{{Id: 1, SomeField: "new"}}
+		masks := []*field_mask.FieldMask{{Paths: []string{"SomeField"}}}
+		if _, err := DefaultPatchSetMultiaccountTypeWithID(accountContext(t, "acc"), objects, masks, db); err != nil {
+			t.Fatal(err)
+		}
+		checkStatements(t, r,
+			"SELECT * FROM multiaccount_type_with_ids WHERE account_id = ? AND id in (?) FOR UPDATE [acc, 1]",
+			"UPDATE multiaccount_type_with_ids SET account_id=CASE WHEN id = ? THEN ? ELSE account_id END,"+
+				"compartment_id=CASE WHEN id = ? THEN ? ELSE compartment_id END,"+
+				"some_field=CASE WHEN id = ? THEN ? ELSE some_field END "+
+				"WHERE account_id = ? AND (id = ?) [1, acc, 1, , 1, new, acc, 1]",
+			"COMMIT",
+		)
+	})
+}
+
+func TestVersionConflictStatus(t *testing.T) {
+	db, _ := openRecording(t, ticketRows([]driver.Value{int64(1), "one", int64(3)}))
+	server := &TicketServiceDefaultServer{DB: db}
+	in := &UpdateSetTicketRequest{
+		Objects: []*Ticket{{Id: 1, Title: "first", Version: 2}},
+		Masks:   []*field_mask.FieldMask{{Paths: []string{"Title"}}},
+	}
+	if _, err := server.UpdateSet(context.Background(), in); status.Code(err) != codes.Aborted {
+		t.Errorf("expected an Aborted status, got %v", err)
+	}
+}
+
+func TestListTicketCursor(t *testing.T) {
+	db, r := openRecording(t, func(statement string) result {
+		switch {
+		case strings.HasPrefix(statement, "SELECT count(*)"):
+			return result{columns: []string{"count"}, rows: [][]driver.Value{{int64(7)}}}
+		case strings.HasPrefix(statement, "SELECT * FROM tickets"):
+			return result{
+				columns: []string{"id", "title", "version"},
+				rows:    [][]driver.Value{{int64(3), "c", int64(1)}, {int64(4), "d", int64(1)}, {int64(5), "e", int64(1)}},
+			}
+		}
+		return result{}
+	})
+	server := &TicketServiceDefaultServer{DB: db}
+	in := &ListTicketRequest{
+		OrderBy: &query.Sorting{Criterias: []*query.SortCriteria{{Tag: "id"}}},
+		Paging:  &query.Pagination{Limit: 2, PageToken: "WzJd"},
+	}
+	out, err := server.List(accountContext(t, "acc"), in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkStatements(t, r,
+		"SELECT count(*) FROM tickets ",
+		"SELECT * FROM tickets WHERE (id) > (?) ORDER BY tickets.id LIMIT 3 [2]",
+		"SELECT * FROM comments WHERE owner_type = ? AND comments.owner_id IN (?,?,?) [tickets, 3, 4, 5]",
+	)
+	if len(out.Results) != 2 || out.Results[1].Id != 4 {
+		t.Errorf("unexpected results %v", out.Results)
+	}
+	if out.GetPageInfo().GetSize() != 7 {
+		t.Errorf("expected the count of the rows, got %d", out.GetPageInfo().GetSize())
+	}
+	if token := out.GetPageInfo().GetPageToken(); token != "WzRd" {
+		t.Errorf("expected the token of the last ticket, got %q", token)
+	}
+	if in.Paging.Limit != 2 {
+		t.Errorf("the paging of the request was modified")
+	}
+}
+
+func TestDeleteDocumentCascade(t *testing.T) {
+	respond := func(statement string) result {
+		if strings.HasPrefix(statement, "SELECT id FROM documents") {
+			return result{columns: []string{"id"}, rows: [][]driver.Value{{int64(1)}}}
+		}
+		return result{affected: 1}
+	}
+
+	t.Run("keeps the comments of a soft deleted document", func(t *testing.T) {
+		db, r := openRecording(t, respond)
+		if err := DefaultDeleteDocument(context.Background(), &Document{Id: 1}, db); err != nil {
+			t.Fatal(err)
+		}
+		if len(r.statements) != 1 || !strings.HasPrefix(r.statements[0], "UPDATE documents SET deleted_at=? WHERE documents.deleted_at IS NULL AND documents.id = ?") {
+			t.Errorf("expected the document to be soft deleted alone, got %q", r.statements)
+		}
+	})
+	t.Run("purges the comments along with the document", func(t *testing.T) {
+		db, r := openRecording(t, respond)
+		if err := DefaultHardDeleteDocument(context.Background(), &Document{Id: 1}, db); err != nil {
+			t.Fatal(err)
+		}
+		checkStatements(t, r,
+			"SELECT id FROM documents WHERE documents.id = ? [1]",
+			"DELETE FROM comments WHERE owner_id IN (?) AND owner_type = ? [1, documents]",
+			"DELETE FROM documents WHERE documents.id = ? [1]",
+			"COMMIT",
+		)
+	})
+	t.Run("reads a soft deleted document when asked to", func(t *testing.T) {
+		db, r := openRecording(t, func(string) result {
+			return result{columns: []string{"id", "title"}, rows: [][]driver.Value{{int64(1), "draft"}}}
+		})
+		if _, err := DefaultReadDocument(context.Background(), &Document{Id: 1}, db, true); err != nil {
+			t.Fatal(err)
+		}
+		checkStatements(t, r,
+			"SELECT * FROM documents WHERE documents.id = ? ORDER BY documents.id LIMIT 1 [1]",
+		)
+	})
+}
+
+// ticketStream collects the tickets a stream list sends
+type ticketStream struct {
+	grpc.ServerStream
+	sent []*Ticket
+}
+
+func (s *ticketStream) Context() context.Context { return context.Background() }
+func (s *ticketStream) Send(ticket *Ticket) error {
+	s.sent = append(s.sent, ticket)
+	return nil
+}
+
+func TestListStreamTicket(t *testing.T) {
+	db, r := openRecording(t, ticketRows(
+		[]driver.Value{int64(3), "c", int64(1)},
+		[]driver.Value{int64(4), "d", int64(1)},
+	))
+	server := &TicketServiceDefaultServer{DB: db}
+	stream := &ticketStream{}
+	in := &ListTicketRequest{Paging: &query.Pagination{PageToken: "WzJd"}}
+	if err := server.ListStream(in, stream); err != nil {
+		t.Fatal(err)
+	}
+	if len(r.statements) == 0 || !strings.HasPrefix(r.statements[0], "SELECT * FROM tickets WHERE (id) > (?)") {
+		t.Errorf("expected the tickets after the page token, got %q", r.statements)
+	}
+	if len(stream.sent) != 2 || stream.sent[0].Id != 3 || stream.sent[1].Id != 4 {
+		t.Errorf("unexpected tickets sent %v", stream.sent)
+	}
+}
+
+func TestUpsertSetting(t *testing.T) {
+	respond := func(statement string) result {
+		if strings.HasPrefix(statement, "SELECT * FROM settings") {
+			return result{columns: []string{"id", "name", "value"}, rows: [][]driver.Value{{int64(7), "theme", "dark"}}}
+		}
+		return result{affected: 1, insertID: 7}
+	}
+
+	t.Run("reads a new row back by its generated key", func(t *testing.T) {
+		db, r := openRecording(t, respond)
+		in := &Setting{Name: "theme", Value: "dark"}
+		out, err := DefaultUpsertSetting(context.Background(), in, "", &field_mask.FieldMask{Paths: []string{"Value"}}, db)
+		if err != nil {
+			t.Fatal(err)
+		}
+		checkStatements(t, r,
+			"INSERT INTO settings (name,value) VALUES (?,?) ON CONFLICT (id) DO UPDATE SET value=excluded.value [theme, dark]",
+			"SELECT * FROM settings WHERE id = ? LIMIT 1 [7]",
+		)
+		if out.Id != 7 {
+			t.Errorf("expected the generated key, got %d", out.Id)
+		}
+	})
+	t.Run("updates every column for an empty mask", func(t *testing.T) {
+		db, r := openRecording(t, respond)
+		in := &Setting{Id: 7, Name: "theme", Value: "dark"}
+		if _, err := DefaultUpsertSetting(context.Background(), in, "idx_setting_name", &field_mask.FieldMask{}, db); err != nil {
+			t.Fatal(err)
+		}
+		checkStatements(t, r,
+			"INSERT INTO settings (name,value,id) VALUES (?,?,?) ON CONFLICT (name) DO UPDATE SET name=excluded.name,value=excluded.value [theme, dark, 7]",
+		)
+	})
+	for _, tc := range []struct {
+		name, target, path string
+	}{
+		{"rejects the primary key", "", "Id"},
+		{"rejects the conflict target", "idx_setting_name", "Name"},
+		{"rejects proto field names", "", "value"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			db, r := openRecording(t, respond)
+			in := &Setting{Name: "theme", Value: "dark"}
+			_, err := DefaultUpsertSetting(context.Background(), in, tc.target, &field_mask.FieldMask{Paths: []string{tc.path}}, db)
+			var invalid *errors.InvalidFieldMaskError
+			if !errors1.As(err, &invalid) || invalid.Path != tc.path {
+				t.Errorf("expected an invalid field mask error for %s, got %v", tc.path, err)
+			}
+			checkStatements(t, r)
+		})
+	}
+}
+
+func TestHasFieldMaskPath(t *testing.T) {
+	for path, want := range map[string]bool{
+		"SyntheticField":             true,
+		"SyntheticField.Contents":    true,
+		"SyntheticField.Contents.X":  false,
+		"SyntheticField.Anything":    false,
+		"DeletedAt.Seconds":          true,
+		"DeletedAt.Seconds.Anything": false,
+		"Point.X":                    true,
+		"Point.Z":                    false,
+		"Address.Anything":           false,
+	} {
+		if got := DefaultHasFieldMaskPathTypeWithID(path); got != want {
+			t.Errorf("expected %v for %s, got %v", want, path, got)
+		}
+	}
+	if !DefaultHasFieldMaskPathTestTypes("JsonField.Anything.Here") {
+		t.Error("expected any path below a JSON field")
+	}
+}
+
+// intPointHooks counts the BeforeCreate_ hooks of the int points
+var intPointHooks int
+
+func (m *IntPointORM) BeforeCreate_(ctx context.Context, db *gorm.DB) (*gorm.DB, error) {
+	intPointHooks++
+	return db, nil
+}
+
+func TestCreateIntPointSet(t *testing.T) {
+	db, r := openRecording(t, nil)
+	intPointHooks = 0
+	in := []*IntPoint{{X: 1, Y: 2}, {X: 3, Y: 4}, {X: 5, Y: 6}}
+	if _, err := DefaultCreateIntPointSet(context.Background(), in, db); err != nil {
+		t.Fatal(err)
+	}
+	checkStatements(t, r,
+		"INSERT INTO int_points (x,y) VALUES (?,?),(?,?),(?,?) [1, 2, 3, 4, 5, 6]",
+		"COMMIT",
+	)
+	if intPointHooks != len(in) {
+		t.Errorf("expected the hook of each object to run, got %d calls", intPointHooks)
+	}
+}
+
+func TestListTicketInvalidPageToken(t *testing.T) {
+	db, _ := openRecording(t, ticketRows())
+	server := &TicketServiceDefaultServer{DB: db}
+	in := &ListTicketRequest{Paging: &query.Pagination{Limit: 2, PageToken: "not a token"}}
+	if _, err := server.List(accountContext(t, "acc"), in); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected an InvalidArgument status, got %v", err)
+	}
+	if err := server.ListStream(in, &ticketStream{}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected an InvalidArgument status from the stream, got %v", err)
+	}
+}
+
+func TestMergeBasketItems(t *testing.T) {
+	t.Run("updates the items of the basket only", func(t *testing.T) {
+		db, r := openRecording(t, func(statement string) result {
+			if strings.HasPrefix(statement, "SELECT * FROM baskets") {
+				return result{columns: []string{"id", "name"}, rows: [][]driver.Value{{int64(1), "bag"}}}
+			}
+			return result{affected: 1, insertID: 8}
+		})
+		in := &Basket{Id: 1, Name: "groceries", Items: []*BasketItem{{Id: 5, Name: "milk", Quantity: 2}, {Name: "eggs", Quantity: 6}}}
+		if _, err := DefaultStrictUpdateBasket(context.Background(), in, db); err != nil {
+			t.Fatal(err)
+		}
+		checkStatements(t, r,
+			"SELECT * FROM baskets WHERE id=? ORDER BY baskets.id LIMIT 1 [1]",
+			"UPDATE basket_items SET basket_id=?,name=?,quantity=? WHERE basket_items.basket_id = ? AND id = ? [1, milk, 2, 1, 5]",
+			"DELETE FROM basket_items WHERE basket_items.basket_id = ? AND id NOT IN (?) [1, 5]",
+			"INSERT INTO basket_items (basket_id,name,quantity) VALUES (?,?,?) [1, eggs, 6]",
+			"UPDATE baskets SET name=? WHERE id = ? [groceries, 1]",
+		)
+	})
+	t.Run("deletes the items of an emptied basket", func(t *testing.T) {
+		db, r := openRecording(t, func(statement string) result {
+			if strings.HasPrefix(statement, "SELECT * FROM baskets") {
+				return result{columns: []string{"id", "name"}, rows: [][]driver.Value{{int64(1), "groceries"}}}
+			}
+			return result{affected: 1}
+		})
+		objects := []*Basket{{Id: 1}}
+		masks := []*field_mask.FieldMask{{Paths: []string{"Items"}}}
+		if _, err := DefaultPatchSetBasket(context.Background(), objects, masks, db); err != nil {
+			t.Fatal(err)
+		}
+		checkStatements(t, r,
+			"SELECT * FROM baskets WHERE id in (?) FOR UPDATE [1]",
+			"DELETE FROM basket_items WHERE basket_items.basket_id = ? [1]",
+			"UPDATE baskets SET name=CASE WHEN id = ? THEN ? ELSE name END WHERE (id = ?) [1, groceries, 1]",
+			"COMMIT",
+		)
+	})
+}
@@ -8,6 +8,8 @@ import (
 	pq "github.com/lib/pq"
 	field_mask "google.golang.org/genproto/protobuf/field_mask"
 	gorm "gorm.io/gorm"
+	clause "gorm.io/gorm/clause"
+	strings "strings"
 )
 
 type ExampleORM struct {
@@ -148,6 +150,70 @@ type ExampleORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm.DB) error
 }
 
+// DefaultCreateExampleSet executes gorm create calls in batches of 100 objects
+// in a transaction, either all of the objects are created or none of them. The BeforeCreate_ hook
+// of each object runs before the insert of its batch, which uses the handle the hooks of the
+// batch return
+func DefaultCreateExampleSet(ctx context.Context, in []*Example, db *gorm.DB) ([]*Example, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObjs := make([]ExampleORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, ormObj)
+	}
+	batchSize := 100
+	err := db.Transaction(func(db *gorm.DB) error {
+		var err error
+		for start := 0; start < len(ormObjs); start += batchSize {
+			end := start + batchSize
+			if end > len(ormObjs) {
+				end = len(ormObjs)
+			}
+			batch := ormObjs[start:end]
+			// the scopes the hooks add only apply to the insert of their own batch
+			batchDB := db
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(ExampleORMWithBeforeCreate_); ok {
+					if batchDB, err = hook.BeforeCreate_(ctx, batchDB); err != nil {
+						return err
+					}
+				}
+			}
+			if err = batchDB.Omit().CreateInBatches(&batch, batchSize).Error; err != nil {
+				return err
+			}
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(ExampleORMWithAfterCreate_); ok {
+					if err = hook.AfterCreate_(ctx, db); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*Example, 0, len(ormObjs))
+	for i := range ormObjs {
+		pbResponse, err := ormObjs[i].ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, &pbResponse)
+	}
+	return results, nil
+}
+
 func DefaultReadExample(ctx context.Context, in *Example, db *gorm.DB) (*Example, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
@@ -313,37 +379,117 @@ type ExampleORMWithAfterStrictUpdateSave interface {
 	AfterStrictUpdateSave(context.Context, *gorm.DB) error
 }
 
+// DefaultUpsertExample executes a gorm create call resolving conflicts on conflictTarget,
+// the name of a unique index or the primary key when empty, by updating the columns
+// in updateMask, all of them when updateMask is empty, and returns the stored object
+func DefaultUpsertExample(ctx context.Context, in *Example, conflictTarget string, updateMask *field_mask.FieldMask, db *gorm.DB) (*Example, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conflict := clause.OnConflict{}
+	var lookup func() map[string]interface{}
+	switch conflictTarget {
+	case "":
+		conflict.Columns = []clause.Column{{Name: "id"}}
+		lookup = func() map[string]interface{} { return map[string]interface{}{"id": ormObj.Id} }
+	default:
+		return nil, fmt.Errorf("unknown conflict target %q of Example", conflictTarget)
+	}
+	if len(updateMask.GetPaths()) == 0 {
+		conflict.UpdateAll = true
+	} else {
+		var columns []string
+		for _, path := range updateMask.GetPaths() {
+			var pathColumns []string
+			switch path {
+			case "Description":
+				pathColumns = []string{"description"}
+			case "ArrayOfBools":
+				pathColumns = []string{"array_of_bools"}
+			case "ArrayOfFloat64":
+				pathColumns = []string{"array_of_float64"}
+			case "ArrayOfInt64":
+				pathColumns = []string{"array_of_int64"}
+			case "ArrayOfString":
+				pathColumns = []string{"array_of_string"}
+			default:
+				return nil, &errors.InvalidFieldMaskError{Path: path}
+			}
+			for _, column := range pathColumns {
+				for _, target := range conflict.Columns {
+					if column == target.Name {
+						return nil, &errors.InvalidFieldMaskError{Path: path}
+					}
+				}
+			}
+			columns = append(columns, pathColumns...)
+		}
+		conflict.DoUpdates = clause.AssignmentColumns(columns)
+	}
+	if hook, ok := interface{}(&ormObj).(ExampleORMWithBeforeUpsert); ok {
+		if db, err = hook.BeforeUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Clauses(conflict).Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if len(updateMask.GetPaths()) > 0 {
+		stored := ExampleORM{}
+		if err = db.Where(lookup()).Take(&stored).Error; err != nil {
+			return nil, err
+		}
+		ormObj = stored
+	}
+	if hook, ok := interface{}(&ormObj).(ExampleORMWithAfterUpsert); ok {
+		if err = hook.AfterUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type ExampleORMWithBeforeUpsert interface {
+	BeforeUpsert(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ExampleORMWithAfterUpsert interface {
+	AfterUpsert(context.Context, *gorm.DB) error
+}
+
 // DefaultPatchExample executes a basic gorm update call with patch behavior
 func DefaultPatchExample(ctx context.Context, in *Example, updateMask *field_mask.FieldMask, db *gorm.DB) (*Example, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	var pbObj Example
+	pbObj := &Example{}
 	var err error
-	if hook, ok := interface{}(&pbObj).(ExampleWithBeforePatchRead); ok {
+	if hook, ok := interface{}(pbObj).(ExampleWithBeforePatchRead); ok {
 		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbReadRes, err := DefaultReadExample(ctx, &Example{Id: in.GetId()}, db)
-	if err != nil {
+	if pbObj, err = DefaultReadExample(ctx, &Example{Id: in.GetId()}, db); err != nil {
 		return nil, err
 	}
-	pbObj = *pbReadRes
-	if hook, ok := interface{}(&pbObj).(ExampleWithBeforePatchApplyFieldMask); ok {
+	if hook, ok := interface{}(pbObj).(ExampleWithBeforePatchApplyFieldMask); ok {
 		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	if _, err := DefaultApplyFieldMaskExample(ctx, &pbObj, in, updateMask, "", db); err != nil {
+	if _, err := DefaultApplyFieldMaskExample(ctx, pbObj, in, updateMask, "", db); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&pbObj).(ExampleWithBeforePatchSave); ok {
+	if hook, ok := interface{}(pbObj).(ExampleWithBeforePatchSave); ok {
 		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse, err := DefaultStrictUpdateExample(ctx, &pbObj, db)
+	pbResponse, err := DefaultStrictUpdateExample(ctx, pbObj, db)
 	if err != nil {
 		return nil, err
 	}
@@ -368,22 +514,195 @@ type ExampleWithAfterPatchSave interface {
 	AfterPatchSave(context.Context, *Example, *field_mask.FieldMask, *gorm.DB) error
 }
 
-// DefaultPatchSetExample executes a bulk gorm update call with patch behavior
+// DefaultPatchSetExample patches the objects in a transaction: the masks are applied in memory to
+// the stored objects, read and locked with a single query, and the objects are written with an update
+// per batch of 100 objects. The objects are read and written with the handles their hooks
+// return, the objects of different handles don't share the queries. Only the associations in the
+// masks are updated, and objects sharing a primary key are rejected
 func DefaultPatchSetExample(ctx context.Context, objects []*Example, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Example, error) {
 	if len(objects) != len(updateMasks) {
 		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
 	}
-
-	results := make([]*Example, 0, len(objects))
-	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchExample(ctx, patcher, updateMasks[i], db)
+	if len(objects) == 0 {
+		return []*Example{}, nil
+	}
+	var err error
+	objectKeys := make([]string, 0, len(objects))
+	seen := make(map[string]struct{}, len(objects))
+	for _, in := range objects {
+		if in == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := in.ToORM(ctx)
 		if err != nil {
 			return nil, err
 		}
-
-		results = append(results, pbResponse)
+		if ormObj.Id == "" {
+			return nil, errors.EmptyIdError
+		}
+		key := ormObj.Id
+		if _, ok := seen[key]; ok {
+			return nil, errors.DuplicateKeyError
+		}
+		seen[key] = struct{}{}
+		objectKeys = append(objectKeys, key)
+	}
+	batchSize := 100
+	results := make([]*Example, len(objects))
+	err = db.Transaction(func(db *gorm.DB) error {
+		var err error
+		// the objects sharing the handle their hooks return share the queries
+		dbs := make([]*gorm.DB, len(objects))
+		group := func() ([]*gorm.DB, map[*gorm.DB][]int) {
+			var handles []*gorm.DB
+			groups := make(map[*gorm.DB][]int)
+			for i, handle := range dbs {
+				if _, ok := groups[handle]; !ok {
+					handles = append(handles, handle)
+				}
+				groups[handle] = append(groups[handle], i)
+			}
+			return handles, groups
+		}
+		for i, in := range objects {
+			dbs[i] = db
+			if hook, ok := interface{}(&Example{}).(ExampleWithBeforePatchRead); ok {
+				if dbs[i], err = hook.BeforePatchRead(ctx, in, updateMasks[i], db); err != nil {
+					return err
+				}
+			}
+		}
+		targets := make(map[string]ExampleORM, len(objects))
+		handles, groups := group()
+		for _, handle := range handles {
+			keys := make([]string, 0, len(groups[handle]))
+			for _, i := range groups[handle] {
+				keys = append(keys, objectKeys[i])
+			}
+			query := handle.Session(&gorm.Session{}).Clauses(clause.Locking{Strength: "UPDATE"})
+			query = query.Where("id in (?)", keys)
+			rows := []ExampleORM{}
+			if err = query.Find(&rows).Error; err != nil {
+				return err
+			}
+			for _, row := range rows {
+				targets[row.Id] = row
+			}
+		}
+		ormObjs := make([]ExampleORM, len(objects))
+		prepare := func(i int) (*gorm.DB, error) {
+			in, key, updateMask, db := objects[i], objectKeys[i], updateMasks[i], dbs[i]
+			target, ok := targets[key]
+			if !ok {
+				return nil, gorm.ErrRecordNotFound
+			}
+			stored, err := target.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbObj := &stored
+			if hook, ok := interface{}(pbObj).(ExampleWithBeforePatchApplyFieldMask); ok {
+				if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			if _, err := DefaultApplyFieldMaskExample(ctx, pbObj, in, updateMask, "", db); err != nil {
+				return nil, err
+			}
+			if hook, ok := interface{}(pbObj).(ExampleWithBeforePatchSave); ok {
+				if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObj, err := pbObj.ToORM(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if hook, ok := interface{}(&ormObj).(ExampleORMWithBeforeStrictUpdateCleanup); ok {
+				if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			if hook, ok := interface{}(&ormObj).(ExampleORMWithBeforeStrictUpdateSave); ok {
+				if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObjs[i] = ormObj
+			return db, nil
+		}
+		for i := range objects {
+			if dbs[i], err = prepare(i); err != nil {
+				return err
+			}
+		}
+		write := func(db *gorm.DB, batch []int) error {
+			conds := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*2)
+			for _, i := range batch {
+				conds = append(conds, "(id = ?)")
+				args = append(args, objectKeys[i])
+			}
+			column := func(name string, value func(ormObj *ExampleORM) interface{}) clause.Expr {
+				whens := make([]interface{}, 0, len(batch)*2)
+				for _, i := range batch {
+					whens = append(whens, objectKeys[i], value(&ormObjs[i]))
+				}
+				return gorm.Expr("CASE "+strings.Repeat("WHEN id = ? THEN ? ", len(batch))+"ELSE "+name+" END", whens...)
+			}
+			res := db.Session(&gorm.Session{}).Model(&ExampleORM{}).Where(strings.Join(conds, " OR "), args...).Updates(map[string]interface{}{
+				"array_of_bools":   column("array_of_bools", func(ormObj *ExampleORM) interface{} { return ormObj.ArrayOfBools }),
+				"array_of_float64": column("array_of_float64", func(ormObj *ExampleORM) interface{} { return ormObj.ArrayOfFloat64 }),
+				"array_of_int64":   column("array_of_int64", func(ormObj *ExampleORM) interface{} { return ormObj.ArrayOfInt64 }),
+				"array_of_string":  column("array_of_string", func(ormObj *ExampleORM) interface{} { return ormObj.ArrayOfString }),
+				"description":      column("description", func(ormObj *ExampleORM) interface{} { return ormObj.Description }),
+			})
+			return res.Error
+		}
+		handles, groups = group()
+		for _, handle := range handles {
+			indexes := groups[handle]
+			for start := 0; start < len(indexes); start += batchSize {
+				end := start + batchSize
+				if end > len(indexes) {
+					end = len(indexes)
+				}
+				batch := indexes[start:end]
+				if err = write(handle, batch); err != nil {
+					return err
+				}
+			}
+		}
+		finish := func(i int) (*Example, error) {
+			in, updateMask, db, ormObj := objects[i], updateMasks[i], dbs[i], ormObjs[i]
+			var err error
+			if hook, ok := interface{}(&ormObj).(ExampleORMWithAfterStrictUpdateSave); ok {
+				if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			saved, err := ormObj.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbResponse := &saved
+			if hook, ok := interface{}(pbResponse).(ExampleWithAfterPatchSave); ok {
+				if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			return pbResponse, nil
+		}
+		for i := range objects {
+			if results[i], err = finish(i); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
-
 	return results, nil
 }
 
@@ -394,6 +713,14 @@ func DefaultApplyFieldMaskExample(ctx context.Context, patchee *Example, patcher
 	} else if patchee == nil {
 		return nil, errors.NilArgumentError
 	}
+	if len(updateMask.GetPaths()) == 1 && updateMask.Paths[0] == prefix+"*" {
+		updateMask = &field_mask.FieldMask{Paths: []string{prefix + "Id", prefix + "Description", prefix + "ArrayOfBools", prefix + "ArrayOfFloat64", prefix + "ArrayOfInt64", prefix + "ArrayOfString"}}
+	}
+	for _, f := range updateMask.GetPaths() {
+		if strings.HasPrefix(f, prefix) && !DefaultHasFieldMaskPathExample(strings.TrimPrefix(f, prefix)) {
+			return nil, &errors.InvalidFieldMaskError{Path: f}
+		}
+	}
 	var err error
 	for _, f := range updateMask.Paths {
 		if f == prefix+"Id" {
@@ -427,6 +754,16 @@ func DefaultApplyFieldMaskExample(ctx context.Context, patchee *Example, patcher
 	return patchee, nil
 }
 
+// DefaultHasFieldMaskPathExample reports whether Example has the field of the field mask path
+func DefaultHasFieldMaskPathExample(path string) bool {
+	parts := strings.SplitN(path, ".", 2)
+	switch parts[0] {
+	case "Id", "Description", "ArrayOfBools", "ArrayOfFloat64", "ArrayOfInt64", "ArrayOfString":
+		return len(parts) == 1
+	}
+	return false
+}
+
 // DefaultListExample executes a gorm list call
 func DefaultListExample(ctx context.Context, db *gorm.DB) ([]*Example, error) {
 	in := Example{}
@@ -11,6 +11,7 @@ import (
 	field_mask "google.golang.org/genproto/protobuf/field_mask"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	gorm "gorm.io/gorm"
+	clause "gorm.io/gorm/clause"
 	strings "strings"
 	time "time"
 )
@@ -975,6 +976,70 @@ type UserORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm.DB) error
 }
 
+// DefaultCreateUserSet executes gorm create calls in batches of 100 objects
+// in a transaction, either all of the objects are created or none of them. The BeforeCreate_ hook
+// of each object runs before the insert of its batch, which uses the handle the hooks of the
+// batch return
+func DefaultCreateUserSet(ctx context.Context, in []*User, db *gorm.DB) ([]*User, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObjs := make([]UserORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, ormObj)
+	}
+	batchSize := 100
+	err := db.Transaction(func(db *gorm.DB) error {
+		var err error
+		for start := 0; start < len(ormObjs); start += batchSize {
+			end := start + batchSize
+			if end > len(ormObjs) {
+				end = len(ormObjs)
+			}
+			batch := ormObjs[start:end]
+			// the scopes the hooks add only apply to the insert of their own batch
+			batchDB := db
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(UserORMWithBeforeCreate_); ok {
+					if batchDB, err = hook.BeforeCreate_(ctx, batchDB); err != nil {
+						return err
+					}
+				}
+			}
+			if err = batchDB.Omit("Emails").CreateInBatches(&batch, batchSize).Error; err != nil {
+				return err
+			}
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(UserORMWithAfterCreate_); ok {
+					if err = hook.AfterCreate_(ctx, db); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*User, 0, len(ormObjs))
+	for i := range ormObjs {
+		pbResponse, err := ormObjs[i].ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, &pbResponse)
+	}
+	return results, nil
+}
+
 func DefaultReadUser(ctx context.Context, in *User, db *gorm.DB) (*User, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
@@ -1216,32 +1281,30 @@ func DefaultPatchUser(ctx context.Context, in *User, updateMask *field_mask.Fiel
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	var pbObj User
+	pbObj := &User{}
 	var err error
-	if hook, ok := interface{}(&pbObj).(UserWithBeforePatchRead); ok {
+	if hook, ok := interface{}(pbObj).(UserWithBeforePatchRead); ok {
 		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbReadRes, err := DefaultReadUser(ctx, &User{Id: in.GetId()}, db)
-	if err != nil {
+	if pbObj, err = DefaultReadUser(ctx, &User{Id: in.GetId()}, db); err != nil {
 		return nil, err
 	}
-	pbObj = *pbReadRes
-	if hook, ok := interface{}(&pbObj).(UserWithBeforePatchApplyFieldMask); ok {
+	if hook, ok := interface{}(pbObj).(UserWithBeforePatchApplyFieldMask); ok {
 		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	if _, err := DefaultApplyFieldMaskUser(ctx, &pbObj, in, updateMask, "", db); err != nil {
+	if _, err := DefaultApplyFieldMaskUser(ctx, pbObj, in, updateMask, "", db); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&pbObj).(UserWithBeforePatchSave); ok {
+	if hook, ok := interface{}(pbObj).(UserWithBeforePatchSave); ok {
 		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse, err := DefaultStrictUpdateUser(ctx, &pbObj, db)
+	pbResponse, err := DefaultStrictUpdateUser(ctx, pbObj, db)
 	if err != nil {
 		return nil, err
 	}
@@ -1266,23 +1329,514 @@ type UserWithAfterPatchSave interface {
 	AfterPatchSave(context.Context, *User, *field_mask.FieldMask, *gorm.DB) error
 }
 
-// DefaultPatchSetUser executes a bulk gorm update call with patch behavior
+// DefaultPatchSetUser patches the objects in a transaction: the masks are applied in memory to
+// the stored objects, read and locked with a single query, and the objects are written with an update
+// per batch of 100 objects. The objects are read and written with the handles their hooks
+// return, the objects of different handles don't share the queries. Only the associations in the
+// masks are updated, and objects sharing a primary key are rejected
 func DefaultPatchSetUser(ctx context.Context, objects []*User, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*User, error) {
 	if len(objects) != len(updateMasks) {
 		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
 	}
+	if len(objects) == 0 {
+		return []*User{}, nil
+	}
+	var err error
+	accountID, err := auth.GetAccountID(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	compartmentID, err := auth.GetCompartmentID(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	if compartmentID != "" {
+		db = db.Where(map[string]interface{}{"account_id": accountID, "compartment_id": compartmentID})
+	} else {
+		db = db.Where(map[string]interface{}{"account_id": accountID})
+	}
+	objectKeys := make([]string, 0, len(objects))
+	seen := make(map[string]struct{}, len(objects))
+	for _, in := range objects {
+		if in == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := in.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if ormObj.Id == "" {
+			return nil, errors.EmptyIdError
+		}
+		key := ormObj.Id
+		if _, ok := seen[key]; ok {
+			return nil, errors.DuplicateKeyError
+		}
+		seen[key] = struct{}{}
+		objectKeys = append(objectKeys, key)
+	}
+	batchSize := 100
+	results := make([]*User, len(objects))
+	err = db.Transaction(func(db *gorm.DB) error {
+		var err error
+		// the objects sharing the handle their hooks return share the queries
+		dbs := make([]*gorm.DB, len(objects))
+		group := func() ([]*gorm.DB, map[*gorm.DB][]int) {
+			var handles []*gorm.DB
+			groups := make(map[*gorm.DB][]int)
+			for i, handle := range dbs {
+				if _, ok := groups[handle]; !ok {
+					handles = append(handles, handle)
+				}
+				groups[handle] = append(groups[handle], i)
+			}
+			return handles, groups
+		}
+		for i, in := range objects {
+			dbs[i] = db
+			if hook, ok := interface{}(&User{}).(UserWithBeforePatchRead); ok {
+				if dbs[i], err = hook.BeforePatchRead(ctx, in, updateMasks[i], db); err != nil {
+					return err
+				}
+			}
+		}
+		targets := make(map[string]UserORM, len(objects))
+		handles, groups := group()
+		for _, handle := range handles {
+			keys := make([]string, 0, len(groups[handle]))
+			for _, i := range groups[handle] {
+				keys = append(keys, objectKeys[i])
+			}
+			query := handle.Session(&gorm.Session{}).Clauses(clause.Locking{Strength: "UPDATE"})
+			query = query.Where("id in (?)", keys)
+			rows := []UserORM{}
+			if err = query.Find(&rows).Error; err != nil {
+				return err
+			}
+			for _, row := range rows {
+				targets[row.Id] = row
+			}
+		}
+		ormObjs := make([]UserORM, len(objects))
+		prepare := func(i int) (*gorm.DB, error) {
+			in, key, updateMask, db := objects[i], objectKeys[i], updateMasks[i], dbs[i]
+			target, ok := targets[key]
+			if !ok {
+				return nil, gorm.ErrRecordNotFound
+			}
+			stored, err := target.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbObj := &stored
+			if hook, ok := interface{}(pbObj).(UserWithBeforePatchApplyFieldMask); ok {
+				if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			if _, err := DefaultApplyFieldMaskUser(ctx, pbObj, in, updateMask, "", db); err != nil {
+				return nil, err
+			}
+			if hook, ok := interface{}(pbObj).(UserWithBeforePatchSave); ok {
+				if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObj, err := pbObj.ToORM(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if hook, ok := interface{}(&ormObj).(UserORMWithBeforeStrictUpdateCleanup); ok {
+				if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			masked := make(map[string]bool, len(updateMask.GetPaths()))
+			for _, path := range updateMask.GetPaths() {
+				masked[strings.SplitN(path, ".", 2)[0]] = true
+			}
+			if masked["CreditCard"] || masked["*"] {
+				filterCreditCard := CreditCardORM{}
+				if ormObj.Id == "" {
+					return nil, errors.EmptyIdError
+				}
+				filterCreditCard.UserId = new(string)
+				*filterCreditCard.UserId = ormObj.Id
+				if err = db.Where(filterCreditCard).Delete(CreditCardORM{}).Error; err != nil {
+					return nil, err
+				}
+			}
+			if masked["Department"] || masked["*"] {
+				filterDepartment := DepartmentORM{}
+				if ormObj.Id == "" {
+					return nil, errors.EmptyIdError
+				}
+				filterDepartment.UserId = new(string)
+				*filterDepartment.UserId = ormObj.Id
+				if err = db.Where(filterDepartment).Delete(DepartmentORM{}).Error; err != nil {
+					return nil, err
+				}
+			}
+			if masked["Emails"] || masked["*"] {
+				filterEmails := EmailORM{}
+				if ormObj.Id == "" {
+					return nil, errors.EmptyIdError
+				}
+				filterEmails.UserId = new(string)
+				*filterEmails.UserId = ormObj.Id
+				if err = db.Where(filterEmails).Delete(EmailORM{}).Error; err != nil {
+					return nil, err
+				}
+			}
+			if masked["Friends"] || masked["*"] {
+				if err = db.Model(&ormObj).Association("Friends").Replace(ormObj.Friends); err != nil {
+					return nil, err
+				}
+				ormObj.Friends = nil
+			}
+			if masked["Languages"] || masked["*"] {
+				if err = db.Model(&ormObj).Association("Languages").Replace(ormObj.Languages); err != nil {
+					return nil, err
+				}
+				ormObj.Languages = nil
+			}
+			if masked["Tasks"] || masked["*"] {
+				filterTasks := TaskORM{}
+				if ormObj.Id == "" {
+					return nil, errors.EmptyIdError
+				}
+				filterTasks.UserId = ormObj.Id
+				if err = db.Where(filterTasks).Delete(TaskORM{}).Error; err != nil {
+					return nil, err
+				}
+			}
+			if hook, ok := interface{}(&ormObj).(UserORMWithBeforeStrictUpdateSave); ok {
+				if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObjs[i] = ormObj
+			return db, nil
+		}
+		for i := range objects {
+			if dbs[i], err = prepare(i); err != nil {
+				return err
+			}
+		}
+		write := func(db *gorm.DB, batch []int) error {
+			conds := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*2)
+			for _, i := range batch {
+				conds = append(conds, "(id = ?)")
+				args = append(args, objectKeys[i])
+			}
+			column := func(name string, value func(ormObj *UserORM) interface{}) clause.Expr {
+				whens := make([]interface{}, 0, len(batch)*2)
+				for _, i := range batch {
+					whens = append(whens, objectKeys[i], value(&ormObjs[i]))
+				}
+				return gorm.Expr("CASE "+strings.Repeat("WHEN id = ? THEN ? ", len(batch))+"ELSE "+name+" END", whens...)
+			}
+			res := db.Session(&gorm.Session{}).Model(&UserORM{}).Where(strings.Join(conds, " OR "), args...).Updates(map[string]interface{}{
+				"account_id":          column("account_id", func(ormObj *UserORM) interface{} { return ormObj.AccountID }),
+				"billing_address_id":  column("billing_address_id", func(ormObj *UserORM) interface{} { return ormObj.BillingAddressId }),
+				"birthday":            column("birthday", func(ormObj *UserORM) interface{} { return ormObj.Birthday }),
+				"compartment_id":      column("compartment_id", func(ormObj *UserORM) interface{} { return ormObj.CompartmentID }),
+				"created_at":          column("created_at", func(ormObj *UserORM) interface{} { return ormObj.CreatedAt }),
+				"external_uuid":       column("external_uuid", func(ormObj *UserORM) interface{} { return ormObj.ExternalUuid }),
+				"num":                 column("num", func(ormObj *UserORM) interface{} { return ormObj.Num }),
+				"shipping_address_id": column("shipping_address_id", func(ormObj *UserORM) interface{} { return ormObj.ShippingAddressId }),
+			})
+			return res.Error
+		}
+		handles, groups = group()
+		for _, handle := range handles {
+			indexes := groups[handle]
+			for start := 0; start < len(indexes); start += batchSize {
+				end := start + batchSize
+				if end > len(indexes) {
+					end = len(indexes)
+				}
+				batch := indexes[start:end]
+				objs := make([]*UserORM, 0, len(batch))
+				for _, i := range batch {
+					objs = append(objs, &ormObjs[i])
+				}
+				// the associations are saved first, the batch is then written with the
+				// foreign keys of its belongs-to associations
+				if err = handle.Session(&gorm.Session{}).Select("BillingAddress", "CreditCard", "Department", "Emails", "Friends", "Languages", "ShippingAddress", "Tasks").Omit("Emails", "UpdatedAt").Model(&objs).Updates(map[string]interface{}{}).Error; err != nil {
+					return err
+				}
+				if err = write(handle, batch); err != nil {
+					return err
+				}
+			}
+		}
+		finish := func(i int) (*User, error) {
+			in, updateMask, db, ormObj := objects[i], updateMasks[i], dbs[i], ormObjs[i]
+			var err error
+			if hook, ok := interface{}(&ormObj).(UserORMWithAfterStrictUpdateSave); ok {
+				if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			saved, err := ormObj.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbResponse := &saved
+			if hook, ok := interface{}(pbResponse).(UserWithAfterPatchSave); ok {
+				if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			return pbResponse, nil
+		}
+		for i := range objects {
+			if results[i], err = finish(i); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// DefaultAddFriendsToUser adds the stored objects to the Friends of the object
+func DefaultAddFriendsToUser(ctx context.Context, in *User, objects []*User, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == "" {
+		return errors.EmptyIdError
+	}
+	owner := UserORM{}
+	if err = db.Where(&UserORM{Id: ormObj.Id, AccountID: ormObj.AccountID, CompartmentID: ormObj.CompartmentID}).First(&owner).Error; err != nil {
+		return err
+	}
+	keys := make(map[string]interface{}, len(objects))
+	filter := UserORM{}
+	for _, object := range objects {
+		if object == nil {
+			return errors.NilArgumentError
+		}
+		child, err := object.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if child.Id == "" {
+			return errors.EmptyIdError
+		}
+		keys[fmt.Sprintf("%#v", child.Id)] = child.Id
+		filter.AccountID = child.AccountID
+		filter.CompartmentID = child.CompartmentID
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	values := make([]interface{}, 0, len(keys))
+	for _, value := range keys {
+		values = append(values, value)
+	}
+	children := []UserORM{}
+	if err = db.Where(&filter).Where("id IN ?", values).Find(&children).Error; err != nil {
+		return err
+	}
+	if len(children) != len(values) {
+		return gorm.ErrRecordNotFound
+	}
+	return db.Model(&owner).Association("Friends").Append(&children)
+}
+
+// DefaultRemoveFriendsFromUser removes the objects from the Friends of the object
+func DefaultRemoveFriendsFromUser(ctx context.Context, in *User, objects []*User, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == "" {
+		return errors.EmptyIdError
+	}
+	owner := UserORM{}
+	if err = db.Where(&UserORM{Id: ormObj.Id, AccountID: ormObj.AccountID, CompartmentID: ormObj.CompartmentID}).First(&owner).Error; err != nil {
+		return err
+	}
+	children := make([]UserORM, 0, len(objects))
+	for _, object := range objects {
+		if object == nil {
+			return errors.NilArgumentError
+		}
+		child, err := object.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if child.Id == "" {
+			return errors.EmptyIdError
+		}
+		children = append(children, child)
+	}
+	if len(children) == 0 {
+		return nil
+	}
+	return db.Model(&owner).Association("Friends").Delete(&children)
+}
 
-	results := make([]*User, 0, len(objects))
-	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchUser(ctx, patcher, updateMasks[i], db)
+// DefaultListFriendsOfUser returns the Friends of the object
+func DefaultListFriendsOfUser(ctx context.Context, in *User, db *gorm.DB) ([]*User, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	owner := UserORM{}
+	if err = db.Where(&UserORM{Id: ormObj.Id, AccountID: ormObj.AccountID, CompartmentID: ormObj.CompartmentID}).First(&owner).Error; err != nil {
+		return nil, err
+	}
+	children := []UserORM{}
+	if err = db.Model(&owner).Association("Friends").Find(&children); err != nil {
+		return nil, err
+	}
+	pbResponse := make([]*User, 0, len(children))
+	for _, child := range children {
+		temp, err := child.ToPB(ctx)
 		if err != nil {
 			return nil, err
 		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+// DefaultAddLanguagesToUser adds the stored objects to the Languages of the object
+func DefaultAddLanguagesToUser(ctx context.Context, in *User, objects []*Language, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == "" {
+		return errors.EmptyIdError
+	}
+	owner := UserORM{}
+	if err = db.Where(&UserORM{Id: ormObj.Id, AccountID: ormObj.AccountID, CompartmentID: ormObj.CompartmentID}).First(&owner).Error; err != nil {
+		return err
+	}
+	keys := make(map[string]interface{}, len(objects))
+	filter := LanguageORM{}
+	for _, object := range objects {
+		if object == nil {
+			return errors.NilArgumentError
+		}
+		child, err := object.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if child.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys[fmt.Sprintf("%#v", child.Id)] = child.Id
+		filter.AccountID = child.AccountID
+		filter.CompartmentID = child.CompartmentID
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	values := make([]interface{}, 0, len(keys))
+	for _, value := range keys {
+		values = append(values, value)
+	}
+	children := []LanguageORM{}
+	if err = db.Where(&filter).Where("id IN ?", values).Find(&children).Error; err != nil {
+		return err
+	}
+	if len(children) != len(values) {
+		return gorm.ErrRecordNotFound
+	}
+	return db.Model(&owner).Association("Languages").Append(&children)
+}
 
-		results = append(results, pbResponse)
+// DefaultRemoveLanguagesFromUser removes the objects from the Languages of the object
+func DefaultRemoveLanguagesFromUser(ctx context.Context, in *User, objects []*Language, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == "" {
+		return errors.EmptyIdError
+	}
+	owner := UserORM{}
+	if err = db.Where(&UserORM{Id: ormObj.Id, AccountID: ormObj.AccountID, CompartmentID: ormObj.CompartmentID}).First(&owner).Error; err != nil {
+		return err
+	}
+	children := make([]LanguageORM, 0, len(objects))
+	for _, object := range objects {
+		if object == nil {
+			return errors.NilArgumentError
+		}
+		child, err := object.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if child.Id == 0 {
+			return errors.EmptyIdError
+		}
+		children = append(children, child)
 	}
+	if len(children) == 0 {
+		return nil
+	}
+	return db.Model(&owner).Association("Languages").Delete(&children)
+}
 
-	return results, nil
+// DefaultListLanguagesOfUser returns the Languages of the object
+func DefaultListLanguagesOfUser(ctx context.Context, in *User, db *gorm.DB) ([]*Language, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	owner := UserORM{}
+	if err = db.Where(&UserORM{Id: ormObj.Id, AccountID: ormObj.AccountID, CompartmentID: ormObj.CompartmentID}).First(&owner).Error; err != nil {
+		return nil, err
+	}
+	children := []LanguageORM{}
+	if err = db.Model(&owner).Association("Languages").Find(&children); err != nil {
+		return nil, err
+	}
+	pbResponse := make([]*Language, 0, len(children))
+	for _, child := range children {
+		temp, err := child.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
 }
 
 // DefaultApplyFieldMaskUser patches an pbObject with patcher according to a field mask.
@@ -1292,6 +1846,14 @@ func DefaultApplyFieldMaskUser(ctx context.Context, patchee *User, patcher *User
 	} else if patchee == nil {
 		return nil, errors.NilArgumentError
 	}
+	if len(updateMask.GetPaths()) == 1 && updateMask.Paths[0] == prefix+"*" {
+		updateMask = &field_mask.FieldMask{Paths: []string{prefix + "Id", prefix + "CreatedAt", prefix + "UpdatedAt", prefix + "Birthday", prefix + "Age", prefix + "Num", prefix + "CreditCard", prefix + "Emails", prefix + "Tasks", prefix + "BillingAddress", prefix + "ShippingAddress", prefix + "Languages", prefix + "Friends", prefix + "ShippingAddressId", prefix + "ExternalUuid", prefix + "Department"}}
+	}
+	for _, f := range updateMask.GetPaths() {
+		if strings.HasPrefix(f, prefix) && !DefaultHasFieldMaskPathUser(strings.TrimPrefix(f, prefix)) {
+			return nil, &errors.InvalidFieldMaskError{Path: f}
+		}
+	}
 	var err error
 	var updatedCreatedAt bool
 	var updatedUpdatedAt bool
@@ -1320,7 +1882,7 @@ func DefaultApplyFieldMaskUser(ctx context.Context, patchee *User, patcher *User
 				}
 			}
 			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
-				return nil, nil
+				return nil, &errors.InvalidFieldMaskError{Path: f}
 			}
 		}
 		if f == prefix+"CreatedAt" {
@@ -1343,7 +1905,7 @@ func DefaultApplyFieldMaskUser(ctx context.Context, patchee *User, patcher *User
 				}
 			}
 			if err := gorm1.MergeWithMask(patcher.UpdatedAt, patchee.UpdatedAt, childMask); err != nil {
-				return nil, nil
+				return nil, &errors.InvalidFieldMaskError{Path: f}
 			}
 		}
 		if f == prefix+"UpdatedAt" {
@@ -1366,7 +1928,7 @@ func DefaultApplyFieldMaskUser(ctx context.Context, patchee *User, patcher *User
 				}
 			}
 			if err := gorm1.MergeWithMask(patcher.Birthday, patchee.Birthday, childMask); err != nil {
-				return nil, nil
+				return nil, &errors.InvalidFieldMaskError{Path: f}
 			}
 		}
 		if f == prefix+"Birthday" {
@@ -1497,6 +2059,54 @@ func DefaultApplyFieldMaskUser(ctx context.Context, patchee *User, patcher *User
 	return patchee, nil
 }
 
+// DefaultHasFieldMaskPathUser reports whether User has the field of the field mask path
+func DefaultHasFieldMaskPathUser(path string) bool {
+	parts := strings.SplitN(path, ".", 2)
+	switch parts[0] {
+	case "CreatedAt":
+		if len(parts) == 1 {
+			return true
+		}
+		parts = strings.SplitN(parts[1], ".", 2)
+		switch parts[0] {
+		case "Seconds", "Nanos":
+			return len(parts) == 1
+		}
+		return false
+	case "UpdatedAt":
+		if len(parts) == 1 {
+			return true
+		}
+		parts = strings.SplitN(parts[1], ".", 2)
+		switch parts[0] {
+		case "Seconds", "Nanos":
+			return len(parts) == 1
+		}
+		return false
+	case "Birthday":
+		if len(parts) == 1 {
+			return true
+		}
+		parts = strings.SplitN(parts[1], ".", 2)
+		switch parts[0] {
+		case "Seconds", "Nanos":
+			return len(parts) == 1
+		}
+		return false
+	case "CreditCard":
+		return len(parts) == 1 || DefaultHasFieldMaskPathCreditCard(parts[1])
+	case "BillingAddress":
+		return len(parts) == 1 || DefaultHasFieldMaskPathAddress(parts[1])
+	case "ShippingAddress":
+		return len(parts) == 1 || DefaultHasFieldMaskPathAddress(parts[1])
+	case "Department":
+		return len(parts) == 1 || DefaultHasFieldMaskPathDepartment(parts[1])
+	case "Id", "Age", "Num", "Emails", "Tasks", "Languages", "Friends", "ShippingAddressId", "ExternalUuid":
+		return len(parts) == 1
+	}
+	return false
+}
+
 // DefaultListUser executes a gorm list call
 func DefaultListUser(ctx context.Context, db *gorm.DB) ([]*User, error) {
 	in := User{}
@@ -1579,11 +2189,75 @@ type EmailORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm.DB) error
 }
 
-func DefaultReadEmail(ctx context.Context, in *Email, db *gorm.DB) (*Email, error) {
+// DefaultCreateEmailSet executes gorm create calls in batches of 100 objects
+// in a transaction, either all of the objects are created or none of them. The BeforeCreate_ hook
+// of each object runs before the insert of its batch, which uses the handle the hooks of the
+// batch return
+func DefaultCreateEmailSet(ctx context.Context, in []*Email, db *gorm.DB) ([]*Email, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	ormObj, err := in.ToORM(ctx)
+	ormObjs := make([]EmailORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, ormObj)
+	}
+	batchSize := 100
+	err := db.Transaction(func(db *gorm.DB) error {
+		var err error
+		for start := 0; start < len(ormObjs); start += batchSize {
+			end := start + batchSize
+			if end > len(ormObjs) {
+				end = len(ormObjs)
+			}
+			batch := ormObjs[start:end]
+			// the scopes the hooks add only apply to the insert of their own batch
+			batchDB := db
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(EmailORMWithBeforeCreate_); ok {
+					if batchDB, err = hook.BeforeCreate_(ctx, batchDB); err != nil {
+						return err
+					}
+				}
+			}
+			if err = batchDB.Omit().CreateInBatches(&batch, batchSize).Error; err != nil {
+				return err
+			}
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(EmailORMWithAfterCreate_); ok {
+					if err = hook.AfterCreate_(ctx, db); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*Email, 0, len(ormObjs))
+	for i := range ormObjs {
+		pbResponse, err := ormObjs[i].ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, &pbResponse)
+	}
+	return results, nil
+}
+
+func DefaultReadEmail(ctx context.Context, in *Email, db *gorm.DB) (*Email, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1777,32 +2451,30 @@ func DefaultPatchEmail(ctx context.Context, in *Email, updateMask *field_mask.Fi
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	var pbObj Email
+	pbObj := &Email{}
 	var err error
-	if hook, ok := interface{}(&pbObj).(EmailWithBeforePatchRead); ok {
+	if hook, ok := interface{}(pbObj).(EmailWithBeforePatchRead); ok {
 		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbReadRes, err := DefaultReadEmail(ctx, &Email{Id: in.GetId()}, db)
-	if err != nil {
+	if pbObj, err = DefaultReadEmail(ctx, &Email{Id: in.GetId()}, db); err != nil {
 		return nil, err
 	}
-	pbObj = *pbReadRes
-	if hook, ok := interface{}(&pbObj).(EmailWithBeforePatchApplyFieldMask); ok {
+	if hook, ok := interface{}(pbObj).(EmailWithBeforePatchApplyFieldMask); ok {
 		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	if _, err := DefaultApplyFieldMaskEmail(ctx, &pbObj, in, updateMask, "", db); err != nil {
+	if _, err := DefaultApplyFieldMaskEmail(ctx, pbObj, in, updateMask, "", db); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&pbObj).(EmailWithBeforePatchSave); ok {
+	if hook, ok := interface{}(pbObj).(EmailWithBeforePatchSave); ok {
 		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse, err := DefaultStrictUpdateEmail(ctx, &pbObj, db)
+	pbResponse, err := DefaultStrictUpdateEmail(ctx, pbObj, db)
 	if err != nil {
 		return nil, err
 	}
@@ -1827,22 +2499,209 @@ type EmailWithAfterPatchSave interface {
 	AfterPatchSave(context.Context, *Email, *field_mask.FieldMask, *gorm.DB) error
 }
 
-// DefaultPatchSetEmail executes a bulk gorm update call with patch behavior
+// DefaultPatchSetEmail patches the objects in a transaction: the masks are applied in memory to
+// the stored objects, read and locked with a single query, and the objects are written with an update
+// per batch of 100 objects. The objects are read and written with the handles their hooks
+// return, the objects of different handles don't share the queries. Only the associations in the
+// masks are updated, and objects sharing a primary key are rejected
 func DefaultPatchSetEmail(ctx context.Context, objects []*Email, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Email, error) {
 	if len(objects) != len(updateMasks) {
 		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
 	}
-
-	results := make([]*Email, 0, len(objects))
-	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchEmail(ctx, patcher, updateMasks[i], db)
+	if len(objects) == 0 {
+		return []*Email{}, nil
+	}
+	var err error
+	accountID, err := auth.GetAccountID(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	compartmentID, err := auth.GetCompartmentID(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	if compartmentID != "" {
+		db = db.Where(map[string]interface{}{"account_id": accountID, "compartment_id": compartmentID})
+	} else {
+		db = db.Where(map[string]interface{}{"account_id": accountID})
+	}
+	objectKeys := make([]string, 0, len(objects))
+	seen := make(map[string]struct{}, len(objects))
+	for _, in := range objects {
+		if in == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := in.ToORM(ctx)
 		if err != nil {
 			return nil, err
 		}
-
-		results = append(results, pbResponse)
+		if ormObj.Id == "" {
+			return nil, errors.EmptyIdError
+		}
+		key := ormObj.Id
+		if _, ok := seen[key]; ok {
+			return nil, errors.DuplicateKeyError
+		}
+		seen[key] = struct{}{}
+		objectKeys = append(objectKeys, key)
+	}
+	batchSize := 100
+	results := make([]*Email, len(objects))
+	err = db.Transaction(func(db *gorm.DB) error {
+		var err error
+		// the objects sharing the handle their hooks return share the queries
+		dbs := make([]*gorm.DB, len(objects))
+		group := func() ([]*gorm.DB, map[*gorm.DB][]int) {
+			var handles []*gorm.DB
+			groups := make(map[*gorm.DB][]int)
+			for i, handle := range dbs {
+				if _, ok := groups[handle]; !ok {
+					handles = append(handles, handle)
+				}
+				groups[handle] = append(groups[handle], i)
+			}
+			return handles, groups
+		}
+		for i, in := range objects {
+			dbs[i] = db
+			if hook, ok := interface{}(&Email{}).(EmailWithBeforePatchRead); ok {
+				if dbs[i], err = hook.BeforePatchRead(ctx, in, updateMasks[i], db); err != nil {
+					return err
+				}
+			}
+		}
+		targets := make(map[string]EmailORM, len(objects))
+		handles, groups := group()
+		for _, handle := range handles {
+			keys := make([]string, 0, len(groups[handle]))
+			for _, i := range groups[handle] {
+				keys = append(keys, objectKeys[i])
+			}
+			query := handle.Session(&gorm.Session{}).Clauses(clause.Locking{Strength: "UPDATE"})
+			query = query.Where("id in (?)", keys)
+			rows := []EmailORM{}
+			if err = query.Find(&rows).Error; err != nil {
+				return err
+			}
+			for _, row := range rows {
+				targets[row.Id] = row
+			}
+		}
+		ormObjs := make([]EmailORM, len(objects))
+		prepare := func(i int) (*gorm.DB, error) {
+			in, key, updateMask, db := objects[i], objectKeys[i], updateMasks[i], dbs[i]
+			target, ok := targets[key]
+			if !ok {
+				return nil, gorm.ErrRecordNotFound
+			}
+			stored, err := target.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbObj := &stored
+			if hook, ok := interface{}(pbObj).(EmailWithBeforePatchApplyFieldMask); ok {
+				if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			if _, err := DefaultApplyFieldMaskEmail(ctx, pbObj, in, updateMask, "", db); err != nil {
+				return nil, err
+			}
+			if hook, ok := interface{}(pbObj).(EmailWithBeforePatchSave); ok {
+				if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObj, err := pbObj.ToORM(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if hook, ok := interface{}(&ormObj).(EmailORMWithBeforeStrictUpdateCleanup); ok {
+				if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			if hook, ok := interface{}(&ormObj).(EmailORMWithBeforeStrictUpdateSave); ok {
+				if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObjs[i] = ormObj
+			return db, nil
+		}
+		for i := range objects {
+			if dbs[i], err = prepare(i); err != nil {
+				return err
+			}
+		}
+		write := func(db *gorm.DB, batch []int) error {
+			conds := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*2)
+			for _, i := range batch {
+				conds = append(conds, "(id = ?)")
+				args = append(args, objectKeys[i])
+			}
+			column := func(name string, value func(ormObj *EmailORM) interface{}) clause.Expr {
+				whens := make([]interface{}, 0, len(batch)*2)
+				for _, i := range batch {
+					whens = append(whens, objectKeys[i], value(&ormObjs[i]))
+				}
+				return gorm.Expr("CASE "+strings.Repeat("WHEN id = ? THEN ? ", len(batch))+"ELSE "+name+" END", whens...)
+			}
+			res := db.Session(&gorm.Session{}).Model(&EmailORM{}).Where(strings.Join(conds, " OR "), args...).Updates(map[string]interface{}{
+				"account_id":        column("account_id", func(ormObj *EmailORM) interface{} { return ormObj.AccountID }),
+				"compartment_id":    column("compartment_id", func(ormObj *EmailORM) interface{} { return ormObj.CompartmentID }),
+				"email":             column("email", func(ormObj *EmailORM) interface{} { return ormObj.Email }),
+				"external_not_null": column("external_not_null", func(ormObj *EmailORM) interface{} { return ormObj.ExternalNotNull }),
+				"subscribed":        column("subscribed", func(ormObj *EmailORM) interface{} { return ormObj.Subscribed }),
+				"user_id":           column("user_id", func(ormObj *EmailORM) interface{} { return ormObj.UserId }),
+			})
+			return res.Error
+		}
+		handles, groups = group()
+		for _, handle := range handles {
+			indexes := groups[handle]
+			for start := 0; start < len(indexes); start += batchSize {
+				end := start + batchSize
+				if end > len(indexes) {
+					end = len(indexes)
+				}
+				batch := indexes[start:end]
+				if err = write(handle, batch); err != nil {
+					return err
+				}
+			}
+		}
+		finish := func(i int) (*Email, error) {
+			in, updateMask, db, ormObj := objects[i], updateMasks[i], dbs[i], ormObjs[i]
+			var err error
+			if hook, ok := interface{}(&ormObj).(EmailORMWithAfterStrictUpdateSave); ok {
+				if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			saved, err := ormObj.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbResponse := &saved
+			if hook, ok := interface{}(pbResponse).(EmailWithAfterPatchSave); ok {
+				if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			return pbResponse, nil
+		}
+		for i := range objects {
+			if results[i], err = finish(i); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
-
 	return results, nil
 }
 
@@ -1853,6 +2712,14 @@ func DefaultApplyFieldMaskEmail(ctx context.Context, patchee *Email, patcher *Em
 	} else if patchee == nil {
 		return nil, errors.NilArgumentError
 	}
+	if len(updateMask.GetPaths()) == 1 && updateMask.Paths[0] == prefix+"*" {
+		updateMask = &field_mask.FieldMask{Paths: []string{prefix + "Id", prefix + "Email", prefix + "Subscribed", prefix + "UserId", prefix + "ExternalNotNull"}}
+	}
+	for _, f := range updateMask.GetPaths() {
+		if strings.HasPrefix(f, prefix) && !DefaultHasFieldMaskPathEmail(strings.TrimPrefix(f, prefix)) {
+			return nil, &errors.InvalidFieldMaskError{Path: f}
+		}
+	}
 	var err error
 	for _, f := range updateMask.Paths {
 		if f == prefix+"Id" {
@@ -1882,6 +2749,16 @@ func DefaultApplyFieldMaskEmail(ctx context.Context, patchee *Email, patcher *Em
 	return patchee, nil
 }
 
+// DefaultHasFieldMaskPathEmail reports whether Email has the field of the field mask path
+func DefaultHasFieldMaskPathEmail(path string) bool {
+	parts := strings.SplitN(path, ".", 2)
+	switch parts[0] {
+	case "Id", "Email", "Subscribed", "UserId", "ExternalNotNull":
+		return len(parts) == 1
+	}
+	return false
+}
+
 // DefaultListEmail executes a gorm list call
 func DefaultListEmail(ctx context.Context, db *gorm.DB) ([]*Email, error) {
 	in := Email{}
@@ -1964,6 +2841,70 @@ type AddressORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm.DB) error
 }
 
+// DefaultCreateAddressSet executes gorm create calls in batches of 100 objects
+// in a transaction, either all of the objects are created or none of them. The BeforeCreate_ hook
+// of each object runs before the insert of its batch, which uses the handle the hooks of the
+// batch return
+func DefaultCreateAddressSet(ctx context.Context, in []*Address, db *gorm.DB) ([]*Address, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObjs := make([]AddressORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, ormObj)
+	}
+	batchSize := 100
+	err := db.Transaction(func(db *gorm.DB) error {
+		var err error
+		for start := 0; start < len(ormObjs); start += batchSize {
+			end := start + batchSize
+			if end > len(ormObjs) {
+				end = len(ormObjs)
+			}
+			batch := ormObjs[start:end]
+			// the scopes the hooks add only apply to the insert of their own batch
+			batchDB := db
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(AddressORMWithBeforeCreate_); ok {
+					if batchDB, err = hook.BeforeCreate_(ctx, batchDB); err != nil {
+						return err
+					}
+				}
+			}
+			if err = batchDB.Omit().CreateInBatches(&batch, batchSize).Error; err != nil {
+				return err
+			}
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(AddressORMWithAfterCreate_); ok {
+					if err = hook.AfterCreate_(ctx, db); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*Address, 0, len(ormObjs))
+	for i := range ormObjs {
+		pbResponse, err := ormObjs[i].ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, &pbResponse)
+	}
+	return results, nil
+}
+
 func DefaultReadAddress(ctx context.Context, in *Address, db *gorm.DB) (*Address, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
@@ -2162,32 +3103,30 @@ func DefaultPatchAddress(ctx context.Context, in *Address, updateMask *field_mas
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	var pbObj Address
+	pbObj := &Address{}
 	var err error
-	if hook, ok := interface{}(&pbObj).(AddressWithBeforePatchRead); ok {
+	if hook, ok := interface{}(pbObj).(AddressWithBeforePatchRead); ok {
 		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbReadRes, err := DefaultReadAddress(ctx, &Address{Id: in.GetId()}, db)
-	if err != nil {
+	if pbObj, err = DefaultReadAddress(ctx, &Address{Id: in.GetId()}, db); err != nil {
 		return nil, err
 	}
-	pbObj = *pbReadRes
-	if hook, ok := interface{}(&pbObj).(AddressWithBeforePatchApplyFieldMask); ok {
+	if hook, ok := interface{}(pbObj).(AddressWithBeforePatchApplyFieldMask); ok {
 		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	if _, err := DefaultApplyFieldMaskAddress(ctx, &pbObj, in, updateMask, "", db); err != nil {
+	if _, err := DefaultApplyFieldMaskAddress(ctx, pbObj, in, updateMask, "", db); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&pbObj).(AddressWithBeforePatchSave); ok {
+	if hook, ok := interface{}(pbObj).(AddressWithBeforePatchSave); ok {
 		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse, err := DefaultStrictUpdateAddress(ctx, &pbObj, db)
+	pbResponse, err := DefaultStrictUpdateAddress(ctx, pbObj, db)
 	if err != nil {
 		return nil, err
 	}
@@ -2212,22 +3151,210 @@ type AddressWithAfterPatchSave interface {
 	AfterPatchSave(context.Context, *Address, *field_mask.FieldMask, *gorm.DB) error
 }
 
-// DefaultPatchSetAddress executes a bulk gorm update call with patch behavior
+// DefaultPatchSetAddress patches the objects in a transaction: the masks are applied in memory to
+// the stored objects, read and locked with a single query, and the objects are written with an update
+// per batch of 100 objects. The objects are read and written with the handles their hooks
+// return, the objects of different handles don't share the queries. Only the associations in the
+// masks are updated, and objects sharing a primary key are rejected
 func DefaultPatchSetAddress(ctx context.Context, objects []*Address, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Address, error) {
 	if len(objects) != len(updateMasks) {
 		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
 	}
-
-	results := make([]*Address, 0, len(objects))
-	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchAddress(ctx, patcher, updateMasks[i], db)
+	if len(objects) == 0 {
+		return []*Address{}, nil
+	}
+	var err error
+	accountID, err := auth.GetAccountID(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	compartmentID, err := auth.GetCompartmentID(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	if compartmentID != "" {
+		db = db.Where(map[string]interface{}{"account_id": accountID, "compartment_id": compartmentID})
+	} else {
+		db = db.Where(map[string]interface{}{"account_id": accountID})
+	}
+	objectKeys := make([]int64, 0, len(objects))
+	seen := make(map[int64]struct{}, len(objects))
+	for _, in := range objects {
+		if in == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := in.ToORM(ctx)
 		if err != nil {
 			return nil, err
 		}
-
-		results = append(results, pbResponse)
+		if ormObj.Id == 0 {
+			return nil, errors.EmptyIdError
+		}
+		key := ormObj.Id
+		if _, ok := seen[key]; ok {
+			return nil, errors.DuplicateKeyError
+		}
+		seen[key] = struct{}{}
+		objectKeys = append(objectKeys, key)
+	}
+	batchSize := 100
+	results := make([]*Address, len(objects))
+	err = db.Transaction(func(db *gorm.DB) error {
+		var err error
+		// the objects sharing the handle their hooks return share the queries
+		dbs := make([]*gorm.DB, len(objects))
+		group := func() ([]*gorm.DB, map[*gorm.DB][]int) {
+			var handles []*gorm.DB
+			groups := make(map[*gorm.DB][]int)
+			for i, handle := range dbs {
+				if _, ok := groups[handle]; !ok {
+					handles = append(handles, handle)
+				}
+				groups[handle] = append(groups[handle], i)
+			}
+			return handles, groups
+		}
+		for i, in := range objects {
+			dbs[i] = db
+			if hook, ok := interface{}(&Address{}).(AddressWithBeforePatchRead); ok {
+				if dbs[i], err = hook.BeforePatchRead(ctx, in, updateMasks[i], db); err != nil {
+					return err
+				}
+			}
+		}
+		targets := make(map[int64]AddressORM, len(objects))
+		handles, groups := group()
+		for _, handle := range handles {
+			keys := make([]int64, 0, len(groups[handle]))
+			for _, i := range groups[handle] {
+				keys = append(keys, objectKeys[i])
+			}
+			query := handle.Session(&gorm.Session{}).Clauses(clause.Locking{Strength: "UPDATE"})
+			query = query.Where("id in (?)", keys)
+			rows := []AddressORM{}
+			if err = query.Find(&rows).Error; err != nil {
+				return err
+			}
+			for _, row := range rows {
+				targets[row.Id] = row
+			}
+		}
+		ormObjs := make([]AddressORM, len(objects))
+		prepare := func(i int) (*gorm.DB, error) {
+			in, key, updateMask, db := objects[i], objectKeys[i], updateMasks[i], dbs[i]
+			target, ok := targets[key]
+			if !ok {
+				return nil, gorm.ErrRecordNotFound
+			}
+			stored, err := target.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbObj := &stored
+			if hook, ok := interface{}(pbObj).(AddressWithBeforePatchApplyFieldMask); ok {
+				if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			if _, err := DefaultApplyFieldMaskAddress(ctx, pbObj, in, updateMask, "", db); err != nil {
+				return nil, err
+			}
+			if hook, ok := interface{}(pbObj).(AddressWithBeforePatchSave); ok {
+				if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObj, err := pbObj.ToORM(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if hook, ok := interface{}(&ormObj).(AddressORMWithBeforeStrictUpdateCleanup); ok {
+				if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			if hook, ok := interface{}(&ormObj).(AddressORMWithBeforeStrictUpdateSave); ok {
+				if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObjs[i] = ormObj
+			return db, nil
+		}
+		for i := range objects {
+			if dbs[i], err = prepare(i); err != nil {
+				return err
+			}
+		}
+		write := func(db *gorm.DB, batch []int) error {
+			conds := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*2)
+			for _, i := range batch {
+				conds = append(conds, "(id = ?)")
+				args = append(args, objectKeys[i])
+			}
+			column := func(name string, value func(ormObj *AddressORM) interface{}) clause.Expr {
+				whens := make([]interface{}, 0, len(batch)*2)
+				for _, i := range batch {
+					whens = append(whens, objectKeys[i], value(&ormObjs[i]))
+				}
+				return gorm.Expr("CASE "+strings.Repeat("WHEN id = ? THEN ? ", len(batch))+"ELSE "+name+" END", whens...)
+			}
+			res := db.Session(&gorm.Session{}).Model(&AddressORM{}).Where(strings.Join(conds, " OR "), args...).Updates(map[string]interface{}{
+				"account_id":     column("account_id", func(ormObj *AddressORM) interface{} { return ormObj.AccountID }),
+				"address_1":      column("address_1", func(ormObj *AddressORM) interface{} { return ormObj.Address_1 }),
+				"address_2":      column("address_2", func(ormObj *AddressORM) interface{} { return ormObj.Address_2 }),
+				"compartment_id": column("compartment_id", func(ormObj *AddressORM) interface{} { return ormObj.CompartmentID }),
+				"external":       column("external", func(ormObj *AddressORM) interface{} { return ormObj.External }),
+				"implicit_fk":    column("implicit_fk", func(ormObj *AddressORM) interface{} { return ormObj.ImplicitFk }),
+				"post":           column("post", func(ormObj *AddressORM) interface{} { return ormObj.Post }),
+			})
+			return res.Error
+		}
+		handles, groups = group()
+		for _, handle := range handles {
+			indexes := groups[handle]
+			for start := 0; start < len(indexes); start += batchSize {
+				end := start + batchSize
+				if end > len(indexes) {
+					end = len(indexes)
+				}
+				batch := indexes[start:end]
+				if err = write(handle, batch); err != nil {
+					return err
+				}
+			}
+		}
+		finish := func(i int) (*Address, error) {
+			in, updateMask, db, ormObj := objects[i], updateMasks[i], dbs[i], ormObjs[i]
+			var err error
+			if hook, ok := interface{}(&ormObj).(AddressORMWithAfterStrictUpdateSave); ok {
+				if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			saved, err := ormObj.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbResponse := &saved
+			if hook, ok := interface{}(pbResponse).(AddressWithAfterPatchSave); ok {
+				if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			return pbResponse, nil
+		}
+		for i := range objects {
+			if results[i], err = finish(i); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
-
 	return results, nil
 }
 
@@ -2238,6 +3365,14 @@ func DefaultApplyFieldMaskAddress(ctx context.Context, patchee *Address, patcher
 	} else if patchee == nil {
 		return nil, errors.NilArgumentError
 	}
+	if len(updateMask.GetPaths()) == 1 && updateMask.Paths[0] == prefix+"*" {
+		updateMask = &field_mask.FieldMask{Paths: []string{prefix + "Id", prefix + "Address_1", prefix + "Address_2", prefix + "Post", prefix + "External", prefix + "ImplicitFk"}}
+	}
+	for _, f := range updateMask.GetPaths() {
+		if strings.HasPrefix(f, prefix) && !DefaultHasFieldMaskPathAddress(strings.TrimPrefix(f, prefix)) {
+			return nil, &errors.InvalidFieldMaskError{Path: f}
+		}
+	}
 	var err error
 	for _, f := range updateMask.Paths {
 		if f == prefix+"Id" {
@@ -2271,6 +3406,16 @@ func DefaultApplyFieldMaskAddress(ctx context.Context, patchee *Address, patcher
 	return patchee, nil
 }
 
+// DefaultHasFieldMaskPathAddress reports whether Address has the field of the field mask path
+func DefaultHasFieldMaskPathAddress(path string) bool {
+	parts := strings.SplitN(path, ".", 2)
+	switch parts[0] {
+	case "Id", "Address_1", "Address_2", "Post", "External", "ImplicitFk":
+		return len(parts) == 1
+	}
+	return false
+}
+
 // DefaultListAddress executes a gorm list call
 func DefaultListAddress(ctx context.Context, db *gorm.DB) ([]*Address, error) {
 	in := Address{}
@@ -2353,6 +3498,70 @@ type LanguageORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm.DB) error
 }
 
+// DefaultCreateLanguageSet executes gorm create calls in batches of 100 objects
+// in a transaction, either all of the objects are created or none of them. The BeforeCreate_ hook
+// of each object runs before the insert of its batch, which uses the handle the hooks of the
+// batch return
+func DefaultCreateLanguageSet(ctx context.Context, in []*Language, db *gorm.DB) ([]*Language, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObjs := make([]LanguageORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, ormObj)
+	}
+	batchSize := 100
+	err := db.Transaction(func(db *gorm.DB) error {
+		var err error
+		for start := 0; start < len(ormObjs); start += batchSize {
+			end := start + batchSize
+			if end > len(ormObjs) {
+				end = len(ormObjs)
+			}
+			batch := ormObjs[start:end]
+			// the scopes the hooks add only apply to the insert of their own batch
+			batchDB := db
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(LanguageORMWithBeforeCreate_); ok {
+					if batchDB, err = hook.BeforeCreate_(ctx, batchDB); err != nil {
+						return err
+					}
+				}
+			}
+			if err = batchDB.Omit().CreateInBatches(&batch, batchSize).Error; err != nil {
+				return err
+			}
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(LanguageORMWithAfterCreate_); ok {
+					if err = hook.AfterCreate_(ctx, db); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*Language, 0, len(ormObjs))
+	for i := range ormObjs {
+		pbResponse, err := ormObjs[i].ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, &pbResponse)
+	}
+	return results, nil
+}
+
 func DefaultReadLanguage(ctx context.Context, in *Language, db *gorm.DB) (*Language, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
@@ -2551,32 +3760,30 @@ func DefaultPatchLanguage(ctx context.Context, in *Language, updateMask *field_m
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	var pbObj Language
+	pbObj := &Language{}
 	var err error
-	if hook, ok := interface{}(&pbObj).(LanguageWithBeforePatchRead); ok {
+	if hook, ok := interface{}(pbObj).(LanguageWithBeforePatchRead); ok {
 		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbReadRes, err := DefaultReadLanguage(ctx, &Language{Id: in.GetId()}, db)
-	if err != nil {
+	if pbObj, err = DefaultReadLanguage(ctx, &Language{Id: in.GetId()}, db); err != nil {
 		return nil, err
 	}
-	pbObj = *pbReadRes
-	if hook, ok := interface{}(&pbObj).(LanguageWithBeforePatchApplyFieldMask); ok {
+	if hook, ok := interface{}(pbObj).(LanguageWithBeforePatchApplyFieldMask); ok {
 		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	if _, err := DefaultApplyFieldMaskLanguage(ctx, &pbObj, in, updateMask, "", db); err != nil {
+	if _, err := DefaultApplyFieldMaskLanguage(ctx, pbObj, in, updateMask, "", db); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&pbObj).(LanguageWithBeforePatchSave); ok {
+	if hook, ok := interface{}(pbObj).(LanguageWithBeforePatchSave); ok {
 		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse, err := DefaultStrictUpdateLanguage(ctx, &pbObj, db)
+	pbResponse, err := DefaultStrictUpdateLanguage(ctx, pbObj, db)
 	if err != nil {
 		return nil, err
 	}
@@ -2601,32 +3808,226 @@ type LanguageWithAfterPatchSave interface {
 	AfterPatchSave(context.Context, *Language, *field_mask.FieldMask, *gorm.DB) error
 }
 
-// DefaultPatchSetLanguage executes a bulk gorm update call with patch behavior
+// DefaultPatchSetLanguage patches the objects in a transaction: the masks are applied in memory to
+// the stored objects, read and locked with a single query, and the objects are written with an update
+// per batch of 100 objects. The objects are read and written with the handles their hooks
+// return, the objects of different handles don't share the queries. Only the associations in the
+// masks are updated, and objects sharing a primary key are rejected
 func DefaultPatchSetLanguage(ctx context.Context, objects []*Language, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Language, error) {
 	if len(objects) != len(updateMasks) {
 		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
 	}
-
-	results := make([]*Language, 0, len(objects))
-	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchLanguage(ctx, patcher, updateMasks[i], db)
+	if len(objects) == 0 {
+		return []*Language{}, nil
+	}
+	var err error
+	accountID, err := auth.GetAccountID(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	compartmentID, err := auth.GetCompartmentID(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	if compartmentID != "" {
+		db = db.Where(map[string]interface{}{"account_id": accountID, "compartment_id": compartmentID})
+	} else {
+		db = db.Where(map[string]interface{}{"account_id": accountID})
+	}
+	objectKeys := make([]int64, 0, len(objects))
+	seen := make(map[int64]struct{}, len(objects))
+	for _, in := range objects {
+		if in == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := in.ToORM(ctx)
 		if err != nil {
 			return nil, err
 		}
-
-		results = append(results, pbResponse)
-	}
-
-	return results, nil
-}
-
-// DefaultApplyFieldMaskLanguage patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskLanguage(ctx context.Context, patchee *Language, patcher *Language, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Language, error) {
-	if patcher == nil {
+		if ormObj.Id == 0 {
+			return nil, errors.EmptyIdError
+		}
+		key := ormObj.Id
+		if _, ok := seen[key]; ok {
+			return nil, errors.DuplicateKeyError
+		}
+		seen[key] = struct{}{}
+		objectKeys = append(objectKeys, key)
+	}
+	batchSize := 100
+	results := make([]*Language, len(objects))
+	err = db.Transaction(func(db *gorm.DB) error {
+		var err error
+		// the objects sharing the handle their hooks return share the queries
+		dbs := make([]*gorm.DB, len(objects))
+		group := func() ([]*gorm.DB, map[*gorm.DB][]int) {
+			var handles []*gorm.DB
+			groups := make(map[*gorm.DB][]int)
+			for i, handle := range dbs {
+				if _, ok := groups[handle]; !ok {
+					handles = append(handles, handle)
+				}
+				groups[handle] = append(groups[handle], i)
+			}
+			return handles, groups
+		}
+		for i, in := range objects {
+			dbs[i] = db
+			if hook, ok := interface{}(&Language{}).(LanguageWithBeforePatchRead); ok {
+				if dbs[i], err = hook.BeforePatchRead(ctx, in, updateMasks[i], db); err != nil {
+					return err
+				}
+			}
+		}
+		targets := make(map[int64]LanguageORM, len(objects))
+		handles, groups := group()
+		for _, handle := range handles {
+			keys := make([]int64, 0, len(groups[handle]))
+			for _, i := range groups[handle] {
+				keys = append(keys, objectKeys[i])
+			}
+			query := handle.Session(&gorm.Session{}).Clauses(clause.Locking{Strength: "UPDATE"})
+			query = query.Where("id in (?)", keys)
+			rows := []LanguageORM{}
+			if err = query.Find(&rows).Error; err != nil {
+				return err
+			}
+			for _, row := range rows {
+				targets[row.Id] = row
+			}
+		}
+		ormObjs := make([]LanguageORM, len(objects))
+		prepare := func(i int) (*gorm.DB, error) {
+			in, key, updateMask, db := objects[i], objectKeys[i], updateMasks[i], dbs[i]
+			target, ok := targets[key]
+			if !ok {
+				return nil, gorm.ErrRecordNotFound
+			}
+			stored, err := target.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbObj := &stored
+			if hook, ok := interface{}(pbObj).(LanguageWithBeforePatchApplyFieldMask); ok {
+				if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			if _, err := DefaultApplyFieldMaskLanguage(ctx, pbObj, in, updateMask, "", db); err != nil {
+				return nil, err
+			}
+			if hook, ok := interface{}(pbObj).(LanguageWithBeforePatchSave); ok {
+				if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObj, err := pbObj.ToORM(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if hook, ok := interface{}(&ormObj).(LanguageORMWithBeforeStrictUpdateCleanup); ok {
+				if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			if hook, ok := interface{}(&ormObj).(LanguageORMWithBeforeStrictUpdateSave); ok {
+				if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObjs[i] = ormObj
+			return db, nil
+		}
+		for i := range objects {
+			if dbs[i], err = prepare(i); err != nil {
+				return err
+			}
+		}
+		write := func(db *gorm.DB, batch []int) error {
+			conds := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*2)
+			for _, i := range batch {
+				conds = append(conds, "(id = ?)")
+				args = append(args, objectKeys[i])
+			}
+			column := func(name string, value func(ormObj *LanguageORM) interface{}) clause.Expr {
+				whens := make([]interface{}, 0, len(batch)*2)
+				for _, i := range batch {
+					whens = append(whens, objectKeys[i], value(&ormObjs[i]))
+				}
+				return gorm.Expr("CASE "+strings.Repeat("WHEN id = ? THEN ? ", len(batch))+"ELSE "+name+" END", whens...)
+			}
+			res := db.Session(&gorm.Session{}).Model(&LanguageORM{}).Where(strings.Join(conds, " OR "), args...).Updates(map[string]interface{}{
+				"account_id":     column("account_id", func(ormObj *LanguageORM) interface{} { return ormObj.AccountID }),
+				"code":           column("code", func(ormObj *LanguageORM) interface{} { return ormObj.Code }),
+				"compartment_id": column("compartment_id", func(ormObj *LanguageORM) interface{} { return ormObj.CompartmentID }),
+				"external_int":   column("external_int", func(ormObj *LanguageORM) interface{} { return ormObj.ExternalInt }),
+				"name":           column("name", func(ormObj *LanguageORM) interface{} { return ormObj.Name }),
+			})
+			return res.Error
+		}
+		handles, groups = group()
+		for _, handle := range handles {
+			indexes := groups[handle]
+			for start := 0; start < len(indexes); start += batchSize {
+				end := start + batchSize
+				if end > len(indexes) {
+					end = len(indexes)
+				}
+				batch := indexes[start:end]
+				if err = write(handle, batch); err != nil {
+					return err
+				}
+			}
+		}
+		finish := func(i int) (*Language, error) {
+			in, updateMask, db, ormObj := objects[i], updateMasks[i], dbs[i], ormObjs[i]
+			var err error
+			if hook, ok := interface{}(&ormObj).(LanguageORMWithAfterStrictUpdateSave); ok {
+				if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			saved, err := ormObj.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbResponse := &saved
+			if hook, ok := interface{}(pbResponse).(LanguageWithAfterPatchSave); ok {
+				if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			return pbResponse, nil
+		}
+		for i := range objects {
+			if results[i], err = finish(i); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// DefaultApplyFieldMaskLanguage patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskLanguage(ctx context.Context, patchee *Language, patcher *Language, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Language, error) {
+	if patcher == nil {
 		return nil, nil
 	} else if patchee == nil {
 		return nil, errors.NilArgumentError
 	}
+	if len(updateMask.GetPaths()) == 1 && updateMask.Paths[0] == prefix+"*" {
+		updateMask = &field_mask.FieldMask{Paths: []string{prefix + "Id", prefix + "Name", prefix + "Code", prefix + "ExternalInt"}}
+	}
+	for _, f := range updateMask.GetPaths() {
+		if strings.HasPrefix(f, prefix) && !DefaultHasFieldMaskPathLanguage(strings.TrimPrefix(f, prefix)) {
+			return nil, &errors.InvalidFieldMaskError{Path: f}
+		}
+	}
 	var err error
 	for _, f := range updateMask.Paths {
 		if f == prefix+"Id" {
@@ -2652,6 +4053,16 @@ func DefaultApplyFieldMaskLanguage(ctx context.Context, patchee *Language, patch
 	return patchee, nil
 }
 
+// DefaultHasFieldMaskPathLanguage reports whether Language has the field of the field mask path
+func DefaultHasFieldMaskPathLanguage(path string) bool {
+	parts := strings.SplitN(path, ".", 2)
+	switch parts[0] {
+	case "Id", "Name", "Code", "ExternalInt":
+		return len(parts) == 1
+	}
+	return false
+}
+
 // DefaultListLanguage executes a gorm list call
 func DefaultListLanguage(ctx context.Context, db *gorm.DB) ([]*Language, error) {
 	in := Language{}
@@ -2734,6 +4145,70 @@ type CreditCardORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm.DB) error
 }
 
+// DefaultCreateCreditCardSet executes gorm create calls in batches of 100 objects
+// in a transaction, either all of the objects are created or none of them. The BeforeCreate_ hook
+// of each object runs before the insert of its batch, which uses the handle the hooks of the
+// batch return
+func DefaultCreateCreditCardSet(ctx context.Context, in []*CreditCard, db *gorm.DB) ([]*CreditCard, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObjs := make([]CreditCardORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, ormObj)
+	}
+	batchSize := 100
+	err := db.Transaction(func(db *gorm.DB) error {
+		var err error
+		for start := 0; start < len(ormObjs); start += batchSize {
+			end := start + batchSize
+			if end > len(ormObjs) {
+				end = len(ormObjs)
+			}
+			batch := ormObjs[start:end]
+			// the scopes the hooks add only apply to the insert of their own batch
+			batchDB := db
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(CreditCardORMWithBeforeCreate_); ok {
+					if batchDB, err = hook.BeforeCreate_(ctx, batchDB); err != nil {
+						return err
+					}
+				}
+			}
+			if err = batchDB.Omit().CreateInBatches(&batch, batchSize).Error; err != nil {
+				return err
+			}
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(CreditCardORMWithAfterCreate_); ok {
+					if err = hook.AfterCreate_(ctx, db); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*CreditCard, 0, len(ormObjs))
+	for i := range ormObjs {
+		pbResponse, err := ormObjs[i].ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, &pbResponse)
+	}
+	return results, nil
+}
+
 func DefaultReadCreditCard(ctx context.Context, in *CreditCard, db *gorm.DB) (*CreditCard, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
@@ -2932,32 +4407,30 @@ func DefaultPatchCreditCard(ctx context.Context, in *CreditCard, updateMask *fie
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	var pbObj CreditCard
+	pbObj := &CreditCard{}
 	var err error
-	if hook, ok := interface{}(&pbObj).(CreditCardWithBeforePatchRead); ok {
+	if hook, ok := interface{}(pbObj).(CreditCardWithBeforePatchRead); ok {
 		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbReadRes, err := DefaultReadCreditCard(ctx, &CreditCard{Id: in.GetId()}, db)
-	if err != nil {
+	if pbObj, err = DefaultReadCreditCard(ctx, &CreditCard{Id: in.GetId()}, db); err != nil {
 		return nil, err
 	}
-	pbObj = *pbReadRes
-	if hook, ok := interface{}(&pbObj).(CreditCardWithBeforePatchApplyFieldMask); ok {
+	if hook, ok := interface{}(pbObj).(CreditCardWithBeforePatchApplyFieldMask); ok {
 		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	if _, err := DefaultApplyFieldMaskCreditCard(ctx, &pbObj, in, updateMask, "", db); err != nil {
+	if _, err := DefaultApplyFieldMaskCreditCard(ctx, pbObj, in, updateMask, "", db); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&pbObj).(CreditCardWithBeforePatchSave); ok {
+	if hook, ok := interface{}(pbObj).(CreditCardWithBeforePatchSave); ok {
 		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse, err := DefaultStrictUpdateCreditCard(ctx, &pbObj, db)
+	pbResponse, err := DefaultStrictUpdateCreditCard(ctx, pbObj, db)
 	if err != nil {
 		return nil, err
 	}
@@ -2982,22 +4455,208 @@ type CreditCardWithAfterPatchSave interface {
 	AfterPatchSave(context.Context, *CreditCard, *field_mask.FieldMask, *gorm.DB) error
 }
 
-// DefaultPatchSetCreditCard executes a bulk gorm update call with patch behavior
+// DefaultPatchSetCreditCard patches the objects in a transaction: the masks are applied in memory to
+// the stored objects, read and locked with a single query, and the objects are written with an update
+// per batch of 100 objects. The objects are read and written with the handles their hooks
+// return, the objects of different handles don't share the queries. Only the associations in the
+// masks are updated, and objects sharing a primary key are rejected
 func DefaultPatchSetCreditCard(ctx context.Context, objects []*CreditCard, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*CreditCard, error) {
 	if len(objects) != len(updateMasks) {
 		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
 	}
-
-	results := make([]*CreditCard, 0, len(objects))
-	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchCreditCard(ctx, patcher, updateMasks[i], db)
+	if len(objects) == 0 {
+		return []*CreditCard{}, nil
+	}
+	var err error
+	accountID, err := auth.GetAccountID(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	compartmentID, err := auth.GetCompartmentID(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	if compartmentID != "" {
+		db = db.Where(map[string]interface{}{"account_id": accountID, "compartment_id": compartmentID})
+	} else {
+		db = db.Where(map[string]interface{}{"account_id": accountID})
+	}
+	objectKeys := make([]int64, 0, len(objects))
+	seen := make(map[int64]struct{}, len(objects))
+	for _, in := range objects {
+		if in == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := in.ToORM(ctx)
 		if err != nil {
 			return nil, err
 		}
-
-		results = append(results, pbResponse)
+		if ormObj.Id == 0 {
+			return nil, errors.EmptyIdError
+		}
+		key := ormObj.Id
+		if _, ok := seen[key]; ok {
+			return nil, errors.DuplicateKeyError
+		}
+		seen[key] = struct{}{}
+		objectKeys = append(objectKeys, key)
+	}
+	batchSize := 100
+	results := make([]*CreditCard, len(objects))
+	err = db.Transaction(func(db *gorm.DB) error {
+		var err error
+		// the objects sharing the handle their hooks return share the queries
+		dbs := make([]*gorm.DB, len(objects))
+		group := func() ([]*gorm.DB, map[*gorm.DB][]int) {
+			var handles []*gorm.DB
+			groups := make(map[*gorm.DB][]int)
+			for i, handle := range dbs {
+				if _, ok := groups[handle]; !ok {
+					handles = append(handles, handle)
+				}
+				groups[handle] = append(groups[handle], i)
+			}
+			return handles, groups
+		}
+		for i, in := range objects {
+			dbs[i] = db
+			if hook, ok := interface{}(&CreditCard{}).(CreditCardWithBeforePatchRead); ok {
+				if dbs[i], err = hook.BeforePatchRead(ctx, in, updateMasks[i], db); err != nil {
+					return err
+				}
+			}
+		}
+		targets := make(map[int64]CreditCardORM, len(objects))
+		handles, groups := group()
+		for _, handle := range handles {
+			keys := make([]int64, 0, len(groups[handle]))
+			for _, i := range groups[handle] {
+				keys = append(keys, objectKeys[i])
+			}
+			query := handle.Session(&gorm.Session{}).Clauses(clause.Locking{Strength: "UPDATE"})
+			query = query.Where("id in (?)", keys)
+			rows := []CreditCardORM{}
+			if err = query.Find(&rows).Error; err != nil {
+				return err
+			}
+			for _, row := range rows {
+				targets[row.Id] = row
+			}
+		}
+		ormObjs := make([]CreditCardORM, len(objects))
+		prepare := func(i int) (*gorm.DB, error) {
+			in, key, updateMask, db := objects[i], objectKeys[i], updateMasks[i], dbs[i]
+			target, ok := targets[key]
+			if !ok {
+				return nil, gorm.ErrRecordNotFound
+			}
+			stored, err := target.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbObj := &stored
+			if hook, ok := interface{}(pbObj).(CreditCardWithBeforePatchApplyFieldMask); ok {
+				if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			if _, err := DefaultApplyFieldMaskCreditCard(ctx, pbObj, in, updateMask, "", db); err != nil {
+				return nil, err
+			}
+			if hook, ok := interface{}(pbObj).(CreditCardWithBeforePatchSave); ok {
+				if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObj, err := pbObj.ToORM(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if hook, ok := interface{}(&ormObj).(CreditCardORMWithBeforeStrictUpdateCleanup); ok {
+				if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			if hook, ok := interface{}(&ormObj).(CreditCardORMWithBeforeStrictUpdateSave); ok {
+				if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObjs[i] = ormObj
+			return db, nil
+		}
+		for i := range objects {
+			if dbs[i], err = prepare(i); err != nil {
+				return err
+			}
+		}
+		write := func(db *gorm.DB, batch []int) error {
+			conds := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*2)
+			for _, i := range batch {
+				conds = append(conds, "(id = ?)")
+				args = append(args, objectKeys[i])
+			}
+			column := func(name string, value func(ormObj *CreditCardORM) interface{}) clause.Expr {
+				whens := make([]interface{}, 0, len(batch)*2)
+				for _, i := range batch {
+					whens = append(whens, objectKeys[i], value(&ormObjs[i]))
+				}
+				return gorm.Expr("CASE "+strings.Repeat("WHEN id = ? THEN ? ", len(batch))+"ELSE "+name+" END", whens...)
+			}
+			res := db.Session(&gorm.Session{}).Model(&CreditCardORM{}).Where(strings.Join(conds, " OR "), args...).Updates(map[string]interface{}{
+				"account_id":     column("account_id", func(ormObj *CreditCardORM) interface{} { return ormObj.AccountID }),
+				"compartment_id": column("compartment_id", func(ormObj *CreditCardORM) interface{} { return ormObj.CompartmentID }),
+				"created_at":     column("created_at", func(ormObj *CreditCardORM) interface{} { return ormObj.CreatedAt }),
+				"number":         column("number", func(ormObj *CreditCardORM) interface{} { return ormObj.Number }),
+				"user_id":        column("user_id", func(ormObj *CreditCardORM) interface{} { return ormObj.UserId }),
+			})
+			return res.Error
+		}
+		handles, groups = group()
+		for _, handle := range handles {
+			indexes := groups[handle]
+			for start := 0; start < len(indexes); start += batchSize {
+				end := start + batchSize
+				if end > len(indexes) {
+					end = len(indexes)
+				}
+				batch := indexes[start:end]
+				if err = write(handle, batch); err != nil {
+					return err
+				}
+			}
+		}
+		finish := func(i int) (*CreditCard, error) {
+			in, updateMask, db, ormObj := objects[i], updateMasks[i], dbs[i], ormObjs[i]
+			var err error
+			if hook, ok := interface{}(&ormObj).(CreditCardORMWithAfterStrictUpdateSave); ok {
+				if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			saved, err := ormObj.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbResponse := &saved
+			if hook, ok := interface{}(pbResponse).(CreditCardWithAfterPatchSave); ok {
+				if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			return pbResponse, nil
+		}
+		for i := range objects {
+			if results[i], err = finish(i); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
-
 	return results, nil
 }
 
@@ -3008,6 +4667,14 @@ func DefaultApplyFieldMaskCreditCard(ctx context.Context, patchee *CreditCard, p
 	} else if patchee == nil {
 		return nil, errors.NilArgumentError
 	}
+	if len(updateMask.GetPaths()) == 1 && updateMask.Paths[0] == prefix+"*" {
+		updateMask = &field_mask.FieldMask{Paths: []string{prefix + "Id", prefix + "CreatedAt", prefix + "UpdatedAt", prefix + "Number", prefix + "UserId"}}
+	}
+	for _, f := range updateMask.GetPaths() {
+		if strings.HasPrefix(f, prefix) && !DefaultHasFieldMaskPathCreditCard(strings.TrimPrefix(f, prefix)) {
+			return nil, &errors.InvalidFieldMaskError{Path: f}
+		}
+	}
 	var err error
 	var updatedCreatedAt bool
 	var updatedUpdatedAt bool
@@ -3031,7 +4698,7 @@ func DefaultApplyFieldMaskCreditCard(ctx context.Context, patchee *CreditCard, p
 				}
 			}
 			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
-				return nil, nil
+				return nil, &errors.InvalidFieldMaskError{Path: f}
 			}
 		}
 		if f == prefix+"CreatedAt" {
@@ -3054,7 +4721,7 @@ func DefaultApplyFieldMaskCreditCard(ctx context.Context, patchee *CreditCard, p
 				}
 			}
 			if err := gorm1.MergeWithMask(patcher.UpdatedAt, patchee.UpdatedAt, childMask); err != nil {
-				return nil, nil
+				return nil, &errors.InvalidFieldMaskError{Path: f}
 			}
 		}
 		if f == prefix+"UpdatedAt" {
@@ -3077,6 +4744,36 @@ func DefaultApplyFieldMaskCreditCard(ctx context.Context, patchee *CreditCard, p
 	return patchee, nil
 }
 
+// DefaultHasFieldMaskPathCreditCard reports whether CreditCard has the field of the field mask path
+func DefaultHasFieldMaskPathCreditCard(path string) bool {
+	parts := strings.SplitN(path, ".", 2)
+	switch parts[0] {
+	case "CreatedAt":
+		if len(parts) == 1 {
+			return true
+		}
+		parts = strings.SplitN(parts[1], ".", 2)
+		switch parts[0] {
+		case "Seconds", "Nanos":
+			return len(parts) == 1
+		}
+		return false
+	case "UpdatedAt":
+		if len(parts) == 1 {
+			return true
+		}
+		parts = strings.SplitN(parts[1], ".", 2)
+		switch parts[0] {
+		case "Seconds", "Nanos":
+			return len(parts) == 1
+		}
+		return false
+	case "Id", "Number", "UserId":
+		return len(parts) == 1
+	}
+	return false
+}
+
 // DefaultListCreditCard executes a gorm list call
 func DefaultListCreditCard(ctx context.Context, db *gorm.DB) ([]*CreditCard, error) {
 	in := CreditCard{}
@@ -3159,6 +4856,70 @@ type TaskORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm.DB) error
 }
 
+// DefaultCreateTaskSet executes gorm create calls in batches of 100 objects
+// in a transaction, either all of the objects are created or none of them. The BeforeCreate_ hook
+// of each object runs before the insert of its batch, which uses the handle the hooks of the
+// batch return
+func DefaultCreateTaskSet(ctx context.Context, in []*Task, db *gorm.DB) ([]*Task, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObjs := make([]TaskORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, ormObj)
+	}
+	batchSize := 100
+	err := db.Transaction(func(db *gorm.DB) error {
+		var err error
+		for start := 0; start < len(ormObjs); start += batchSize {
+			end := start + batchSize
+			if end > len(ormObjs) {
+				end = len(ormObjs)
+			}
+			batch := ormObjs[start:end]
+			// the scopes the hooks add only apply to the insert of their own batch
+			batchDB := db
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(TaskORMWithBeforeCreate_); ok {
+					if batchDB, err = hook.BeforeCreate_(ctx, batchDB); err != nil {
+						return err
+					}
+				}
+			}
+			if err = batchDB.Omit().CreateInBatches(&batch, batchSize).Error; err != nil {
+				return err
+			}
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(TaskORMWithAfterCreate_); ok {
+					if err = hook.AfterCreate_(ctx, db); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*Task, 0, len(ormObjs))
+	for i := range ormObjs {
+		pbResponse, err := ormObjs[i].ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, &pbResponse)
+	}
+	return results, nil
+}
+
 func DefaultReadTask(ctx context.Context, in *Task, db *gorm.DB) (*Task, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
@@ -3357,32 +5118,30 @@ func DefaultPatchTask(ctx context.Context, in *Task, updateMask *field_mask.Fiel
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	var pbObj Task
+	pbObj := &Task{}
 	var err error
-	if hook, ok := interface{}(&pbObj).(TaskWithBeforePatchRead); ok {
+	if hook, ok := interface{}(pbObj).(TaskWithBeforePatchRead); ok {
 		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbReadRes, err := DefaultReadTask(ctx, &Task{Id: in.Id}, db)
-	if err != nil {
+	if pbObj, err = DefaultReadTask(ctx, &Task{Id: in.Id}, db); err != nil {
 		return nil, err
 	}
-	pbObj = *pbReadRes
-	if hook, ok := interface{}(&pbObj).(TaskWithBeforePatchApplyFieldMask); ok {
+	if hook, ok := interface{}(pbObj).(TaskWithBeforePatchApplyFieldMask); ok {
 		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	if _, err := DefaultApplyFieldMaskTask(ctx, &pbObj, in, updateMask, "", db); err != nil {
+	if _, err := DefaultApplyFieldMaskTask(ctx, pbObj, in, updateMask, "", db); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&pbObj).(TaskWithBeforePatchSave); ok {
+	if hook, ok := interface{}(pbObj).(TaskWithBeforePatchSave); ok {
 		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse, err := DefaultStrictUpdateTask(ctx, &pbObj, db)
+	pbResponse, err := DefaultStrictUpdateTask(ctx, pbObj, db)
 	if err != nil {
 		return nil, err
 	}
@@ -3407,22 +5166,209 @@ type TaskWithAfterPatchSave interface {
 	AfterPatchSave(context.Context, *Task, *field_mask.FieldMask, *gorm.DB) error
 }
 
-// DefaultPatchSetTask executes a bulk gorm update call with patch behavior
+// DefaultPatchSetTask patches the objects in a transaction: the masks are applied in memory to
+// the stored objects, read and locked with a single query, and the objects are written with an update
+// per batch of 100 objects. The objects are read and written with the handles their hooks
+// return, the objects of different handles don't share the queries. Only the associations in the
+// masks are updated, and objects sharing a primary key are rejected
 func DefaultPatchSetTask(ctx context.Context, objects []*Task, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Task, error) {
 	if len(objects) != len(updateMasks) {
 		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
 	}
-
-	results := make([]*Task, 0, len(objects))
-	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchTask(ctx, patcher, updateMasks[i], db)
+	if len(objects) == 0 {
+		return []*Task{}, nil
+	}
+	var err error
+	accountID, err := auth.GetAccountID(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	compartmentID, err := auth.GetCompartmentID(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	if compartmentID != "" {
+		db = db.Where(map[string]interface{}{"account_id": accountID, "compartment_id": compartmentID})
+	} else {
+		db = db.Where(map[string]interface{}{"account_id": accountID})
+	}
+	objectKeys := make([]string, 0, len(objects))
+	seen := make(map[string]struct{}, len(objects))
+	for _, in := range objects {
+		if in == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := in.ToORM(ctx)
 		if err != nil {
 			return nil, err
 		}
-
-		results = append(results, pbResponse)
+		if ormObj.Id == nil || *ormObj.Id == "" {
+			return nil, errors.EmptyIdError
+		}
+		key := *ormObj.Id
+		if _, ok := seen[key]; ok {
+			return nil, errors.DuplicateKeyError
+		}
+		seen[key] = struct{}{}
+		objectKeys = append(objectKeys, key)
+	}
+	batchSize := 100
+	results := make([]*Task, len(objects))
+	err = db.Transaction(func(db *gorm.DB) error {
+		var err error
+		// the objects sharing the handle their hooks return share the queries
+		dbs := make([]*gorm.DB, len(objects))
+		group := func() ([]*gorm.DB, map[*gorm.DB][]int) {
+			var handles []*gorm.DB
+			groups := make(map[*gorm.DB][]int)
+			for i, handle := range dbs {
+				if _, ok := groups[handle]; !ok {
+					handles = append(handles, handle)
+				}
+				groups[handle] = append(groups[handle], i)
+			}
+			return handles, groups
+		}
+		for i, in := range objects {
+			dbs[i] = db
+			if hook, ok := interface{}(&Task{}).(TaskWithBeforePatchRead); ok {
+				if dbs[i], err = hook.BeforePatchRead(ctx, in, updateMasks[i], db); err != nil {
+					return err
+				}
+			}
+		}
+		targets := make(map[string]TaskORM, len(objects))
+		handles, groups := group()
+		for _, handle := range handles {
+			keys := make([]string, 0, len(groups[handle]))
+			for _, i := range groups[handle] {
+				keys = append(keys, objectKeys[i])
+			}
+			query := handle.Session(&gorm.Session{}).Clauses(clause.Locking{Strength: "UPDATE"})
+			query = query.Where("id in (?)", keys)
+			rows := []TaskORM{}
+			if err = query.Find(&rows).Error; err != nil {
+				return err
+			}
+			for _, row := range rows {
+				targets[*row.Id] = row
+			}
+		}
+		ormObjs := make([]TaskORM, len(objects))
+		prepare := func(i int) (*gorm.DB, error) {
+			in, key, updateMask, db := objects[i], objectKeys[i], updateMasks[i], dbs[i]
+			target, ok := targets[key]
+			if !ok {
+				return nil, gorm.ErrRecordNotFound
+			}
+			stored, err := target.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbObj := &stored
+			if hook, ok := interface{}(pbObj).(TaskWithBeforePatchApplyFieldMask); ok {
+				if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			if _, err := DefaultApplyFieldMaskTask(ctx, pbObj, in, updateMask, "", db); err != nil {
+				return nil, err
+			}
+			if hook, ok := interface{}(pbObj).(TaskWithBeforePatchSave); ok {
+				if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObj, err := pbObj.ToORM(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if hook, ok := interface{}(&ormObj).(TaskORMWithBeforeStrictUpdateCleanup); ok {
+				if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			if hook, ok := interface{}(&ormObj).(TaskORMWithBeforeStrictUpdateSave); ok {
+				if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObjs[i] = ormObj
+			return db, nil
+		}
+		for i := range objects {
+			if dbs[i], err = prepare(i); err != nil {
+				return err
+			}
+		}
+		write := func(db *gorm.DB, batch []int) error {
+			conds := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*2)
+			for _, i := range batch {
+				conds = append(conds, "(id = ?)")
+				args = append(args, objectKeys[i])
+			}
+			column := func(name string, value func(ormObj *TaskORM) interface{}) clause.Expr {
+				whens := make([]interface{}, 0, len(batch)*2)
+				for _, i := range batch {
+					whens = append(whens, objectKeys[i], value(&ormObjs[i]))
+				}
+				return gorm.Expr("CASE "+strings.Repeat("WHEN id = ? THEN ? ", len(batch))+"ELSE "+name+" END", whens...)
+			}
+			res := db.Session(&gorm.Session{}).Model(&TaskORM{}).Where(strings.Join(conds, " OR "), args...).Updates(map[string]interface{}{
+				"account_id":     column("account_id", func(ormObj *TaskORM) interface{} { return ormObj.AccountID }),
+				"compartment_id": column("compartment_id", func(ormObj *TaskORM) interface{} { return ormObj.CompartmentID }),
+				"description":    column("description", func(ormObj *TaskORM) interface{} { return ormObj.Description }),
+				"name":           column("name", func(ormObj *TaskORM) interface{} { return ormObj.Name }),
+				"priority":       column("priority", func(ormObj *TaskORM) interface{} { return ormObj.Priority }),
+				"user_id":        column("user_id", func(ormObj *TaskORM) interface{} { return ormObj.UserId }),
+			})
+			return res.Error
+		}
+		handles, groups = group()
+		for _, handle := range handles {
+			indexes := groups[handle]
+			for start := 0; start < len(indexes); start += batchSize {
+				end := start + batchSize
+				if end > len(indexes) {
+					end = len(indexes)
+				}
+				batch := indexes[start:end]
+				if err = write(handle, batch); err != nil {
+					return err
+				}
+			}
+		}
+		finish := func(i int) (*Task, error) {
+			in, updateMask, db, ormObj := objects[i], updateMasks[i], dbs[i], ormObjs[i]
+			var err error
+			if hook, ok := interface{}(&ormObj).(TaskORMWithAfterStrictUpdateSave); ok {
+				if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			saved, err := ormObj.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbResponse := &saved
+			if hook, ok := interface{}(pbResponse).(TaskWithAfterPatchSave); ok {
+				if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			return pbResponse, nil
+		}
+		for i := range objects {
+			if results[i], err = finish(i); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
-
 	return results, nil
 }
 
@@ -3433,6 +5379,14 @@ func DefaultApplyFieldMaskTask(ctx context.Context, patchee *Task, patcher *Task
 	} else if patchee == nil {
 		return nil, errors.NilArgumentError
 	}
+	if len(updateMask.GetPaths()) == 1 && updateMask.Paths[0] == prefix+"*" {
+		updateMask = &field_mask.FieldMask{Paths: []string{prefix + "Name", prefix + "Description", prefix + "Priority", prefix + "Id"}}
+	}
+	for _, f := range updateMask.GetPaths() {
+		if strings.HasPrefix(f, prefix) && !DefaultHasFieldMaskPathTask(strings.TrimPrefix(f, prefix)) {
+			return nil, &errors.InvalidFieldMaskError{Path: f}
+		}
+	}
 	var err error
 	for _, f := range updateMask.Paths {
 		if f == prefix+"Name" {
@@ -3458,6 +5412,16 @@ func DefaultApplyFieldMaskTask(ctx context.Context, patchee *Task, patcher *Task
 	return patchee, nil
 }
 
+// DefaultHasFieldMaskPathTask reports whether Task has the field of the field mask path
+func DefaultHasFieldMaskPathTask(path string) bool {
+	parts := strings.SplitN(path, ".", 2)
+	switch parts[0] {
+	case "Name", "Description", "Priority", "Id":
+		return len(parts) == 1
+	}
+	return false
+}
+
 // DefaultListTask executes a gorm list call
 func DefaultListTask(ctx context.Context, db *gorm.DB) ([]*Task, error) {
 	in := Task{}
@@ -3540,6 +5504,70 @@ type DepartmentORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm.DB) error
 }
 
+// DefaultCreateDepartmentSet executes gorm create calls in batches of 100 objects
+// in a transaction, either all of the objects are created or none of them. The BeforeCreate_ hook
+// of each object runs before the insert of its batch, which uses the handle the hooks of the
+// batch return
+func DefaultCreateDepartmentSet(ctx context.Context, in []*Department, db *gorm.DB) ([]*Department, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObjs := make([]DepartmentORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, ormObj)
+	}
+	batchSize := 100
+	err := db.Transaction(func(db *gorm.DB) error {
+		var err error
+		for start := 0; start < len(ormObjs); start += batchSize {
+			end := start + batchSize
+			if end > len(ormObjs) {
+				end = len(ormObjs)
+			}
+			batch := ormObjs[start:end]
+			// the scopes the hooks add only apply to the insert of their own batch
+			batchDB := db
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(DepartmentORMWithBeforeCreate_); ok {
+					if batchDB, err = hook.BeforeCreate_(ctx, batchDB); err != nil {
+						return err
+					}
+				}
+			}
+			if err = batchDB.Omit().CreateInBatches(&batch, batchSize).Error; err != nil {
+				return err
+			}
+			for i := range batch {
+				if hook, ok := interface{}(&batch[i]).(DepartmentORMWithAfterCreate_); ok {
+					if err = hook.AfterCreate_(ctx, db); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*Department, 0, len(ormObjs))
+	for i := range ormObjs {
+		pbResponse, err := ormObjs[i].ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, &pbResponse)
+	}
+	return results, nil
+}
+
 func DefaultReadDepartment(ctx context.Context, in *Department, db *gorm.DB) (*Department, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
@@ -3623,6 +5651,415 @@ type DepartmentORMWithAfterDelete_ interface {
 	AfterDelete_(context.Context, *gorm.DB) error
 }
 
+func DefaultDeleteDepartmentSet(ctx context.Context, in []*Department, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := [][]interface{}{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		if ormObj.Name == "" {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, []interface{}{ormObj.Id, ormObj.Name})
+	}
+	if hook, ok := (interface{}(&DepartmentORM{})).(DepartmentORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	keysConds := make([]string, 0, len(keys))
+	keysArgs := make([]interface{}, 0, len(keys)*2)
+	for _, key := range keys {
+		keysConds = append(keysConds, "(id = ? AND name = ?)")
+		keysArgs = append(keysArgs, key...)
+	}
+	db = db.Where(strings.Join(keysConds, " OR "), keysArgs...)
+	err = db.Delete(&DepartmentORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&DepartmentORM{})).(DepartmentORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type DepartmentORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Department, *gorm.DB) (*gorm.DB, error)
+}
+type DepartmentORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Department, *gorm.DB) error
+}
+
+// DefaultStrictUpdateDepartment clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateDepartment(ctx context.Context, in *Department, db *gorm.DB) (*Department, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateDepartment")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var count int64
+	lockedRow := &DepartmentORM{}
+	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=? AND name=?", ormObj.Id, ormObj.Name).First(lockedRow).RowsAffected
+	if hook, ok := interface{}(&ormObj).(DepartmentORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(DepartmentORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Omit().Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(DepartmentORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		err = gateway.SetCreated(ctx, "")
+	}
+	return &pbResponse, err
+}
+
+type DepartmentORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type DepartmentORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type DepartmentORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultUpsertDepartment executes a gorm create call resolving conflicts on conflictTarget,
+// the name of a unique index or the primary key when empty, by updating the columns
+// in updateMask, all of them when updateMask is empty, and returns the stored object
+func DefaultUpsertDepartment(ctx context.Context, in *Department, conflictTarget string, updateMask *field_mask.FieldMask, db *gorm.DB) (*Department, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conflict := clause.OnConflict{}
+	var lookup func() map[string]interface{}
+	switch conflictTarget {
+	case "":
+		conflict.Columns = []clause.Column{{Name: "id"}, {Name: "name"}}
+		lookup = func() map[string]interface{} { return map[string]interface{}{"id": ormObj.Id, "name": ormObj.Name} }
+	default:
+		return nil, fmt.Errorf("unknown conflict target %q of Department", conflictTarget)
+	}
+	if len(updateMask.GetPaths()) == 0 {
+		conflict.UpdateAll = true
+	} else {
+		return nil, &errors.InvalidFieldMaskError{Path: updateMask.GetPaths()[0]}
+	}
+	if hook, ok := interface{}(&ormObj).(DepartmentORMWithBeforeUpsert); ok {
+		if db, err = hook.BeforeUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Clauses(conflict).Omit().Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if len(updateMask.GetPaths()) > 0 {
+		stored := DepartmentORM{}
+		if err = db.Where(lookup()).Take(&stored).Error; err != nil {
+			return nil, err
+		}
+		ormObj = stored
+	}
+	if hook, ok := interface{}(&ormObj).(DepartmentORMWithAfterUpsert); ok {
+		if err = hook.AfterUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type DepartmentORMWithBeforeUpsert interface {
+	BeforeUpsert(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type DepartmentORMWithAfterUpsert interface {
+	AfterUpsert(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchDepartment executes a basic gorm update call with patch behavior
+func DefaultPatchDepartment(ctx context.Context, in *Department, updateMask *field_mask.FieldMask, db *gorm.DB) (*Department, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	pbObj := &Department{}
+	var err error
+	if hook, ok := interface{}(pbObj).(DepartmentWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if pbObj, err = DefaultReadDepartment(ctx, &Department{Id: in.GetId(), Name: in.GetName()}, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbObj).(DepartmentWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskDepartment(ctx, pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbObj).(DepartmentWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateDepartment(ctx, pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(DepartmentWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type DepartmentWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Department, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type DepartmentWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Department, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type DepartmentWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Department, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type DepartmentWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Department, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetDepartment patches the objects in a transaction: the masks are applied in memory to
+// the stored objects, read and locked with a single query, and the objects are written with an update
+// per batch of 100 objects. The objects are read and written with the handles their hooks
+// return, the objects of different handles don't share the queries. Only the associations in the
+// masks are updated, and objects sharing a primary key are rejected
+func DefaultPatchSetDepartment(ctx context.Context, objects []*Department, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Department, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+	if len(objects) == 0 {
+		return []*Department{}, nil
+	}
+	var err error
+	type patchSetKey struct {
+		Id   int64
+		Name string
+	}
+	objectKeys := make([]patchSetKey, 0, len(objects))
+	seen := make(map[patchSetKey]struct{}, len(objects))
+	for _, in := range objects {
+		if in == nil {
+			return nil, errors.NilArgumentError
+		}
+		ormObj, err := in.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if ormObj.Id == 0 {
+			return nil, errors.EmptyIdError
+		}
+		if ormObj.Name == "" {
+			return nil, errors.EmptyIdError
+		}
+		key := patchSetKey{Id: ormObj.Id, Name: ormObj.Name}
+		if _, ok := seen[key]; ok {
+			return nil, errors.DuplicateKeyError
+		}
+		seen[key] = struct{}{}
+		objectKeys = append(objectKeys, key)
+	}
+	batchSize := 100
+	results := make([]*Department, len(objects))
+	err = db.Transaction(func(db *gorm.DB) error {
+		var err error
+		// the objects sharing the handle their hooks return share the queries
+		dbs := make([]*gorm.DB, len(objects))
+		group := func() ([]*gorm.DB, map[*gorm.DB][]int) {
+			var handles []*gorm.DB
+			groups := make(map[*gorm.DB][]int)
+			for i, handle := range dbs {
+				if _, ok := groups[handle]; !ok {
+					handles = append(handles, handle)
+				}
+				groups[handle] = append(groups[handle], i)
+			}
+			return handles, groups
+		}
+		for i, in := range objects {
+			dbs[i] = db
+			if hook, ok := interface{}(&Department{}).(DepartmentWithBeforePatchRead); ok {
+				if dbs[i], err = hook.BeforePatchRead(ctx, in, updateMasks[i], db); err != nil {
+					return err
+				}
+			}
+		}
+		targets := make(map[patchSetKey]DepartmentORM, len(objects))
+		handles, groups := group()
+		for _, handle := range handles {
+			keys := make([][]interface{}, 0, len(groups[handle]))
+			for _, i := range groups[handle] {
+				key := objectKeys[i]
+				keys = append(keys, []interface{}{key.Id, key.Name})
+			}
+			query := handle.Session(&gorm.Session{}).Clauses(clause.Locking{Strength: "UPDATE"})
+			keysConds := make([]string, 0, len(keys))
+			keysArgs := make([]interface{}, 0, len(keys)*2)
+			for _, key := range keys {
+				keysConds = append(keysConds, "(id = ? AND name = ?)")
+				keysArgs = append(keysArgs, key...)
+			}
+			query = query.Where(strings.Join(keysConds, " OR "), keysArgs...)
+			rows := []DepartmentORM{}
+			if err = query.Find(&rows).Error; err != nil {
+				return err
+			}
+			for _, row := range rows {
+				targets[patchSetKey{Id: row.Id, Name: row.Name}] = row
+			}
+		}
+		ormObjs := make([]DepartmentORM, len(objects))
+		prepare := func(i int) (*gorm.DB, error) {
+			in, key, updateMask, db := objects[i], objectKeys[i], updateMasks[i], dbs[i]
+			target, ok := targets[key]
+			if !ok {
+				return nil, gorm.ErrRecordNotFound
+			}
+			stored, err := target.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbObj := &stored
+			if hook, ok := interface{}(pbObj).(DepartmentWithBeforePatchApplyFieldMask); ok {
+				if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			if _, err := DefaultApplyFieldMaskDepartment(ctx, pbObj, in, updateMask, "", db); err != nil {
+				return nil, err
+			}
+			if hook, ok := interface{}(pbObj).(DepartmentWithBeforePatchSave); ok {
+				if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObj, err := pbObj.ToORM(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if hook, ok := interface{}(&ormObj).(DepartmentORMWithBeforeStrictUpdateCleanup); ok {
+				if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			if hook, ok := interface{}(&ormObj).(DepartmentORMWithBeforeStrictUpdateSave); ok {
+				if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			ormObjs[i] = ormObj
+			return db, nil
+		}
+		for i := range objects {
+			if dbs[i], err = prepare(i); err != nil {
+				return err
+			}
+		}
+		write := func(db *gorm.DB, batch []int) error {
+			conds := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*3)
+			for _, i := range batch {
+				conds = append(conds, "(id = ? AND name = ?)")
+				args = append(args, objectKeys[i].Id, objectKeys[i].Name)
+			}
+			column := func(name string, value func(ormObj *DepartmentORM) interface{}) clause.Expr {
+				whens := make([]interface{}, 0, len(batch)*3)
+				for _, i := range batch {
+					whens = append(whens, objectKeys[i].Id, objectKeys[i].Name, value(&ormObjs[i]))
+				}
+				return gorm.Expr("CASE "+strings.Repeat("WHEN id = ? AND name = ? THEN ? ", len(batch))+"ELSE "+name+" END", whens...)
+			}
+			res := db.Session(&gorm.Session{}).Model(&DepartmentORM{}).Where(strings.Join(conds, " OR "), args...).Updates(map[string]interface{}{
+				"user_id": column("user_id", func(ormObj *DepartmentORM) interface{} { return ormObj.UserId }),
+			})
+			return res.Error
+		}
+		handles, groups = group()
+		for _, handle := range handles {
+			indexes := groups[handle]
+			for start := 0; start < len(indexes); start += batchSize {
+				end := start + batchSize
+				if end > len(indexes) {
+					end = len(indexes)
+				}
+				batch := indexes[start:end]
+				if err = write(handle, batch); err != nil {
+					return err
+				}
+			}
+		}
+		finish := func(i int) (*Department, error) {
+			in, updateMask, db, ormObj := objects[i], updateMasks[i], dbs[i], ormObjs[i]
+			var err error
+			if hook, ok := interface{}(&ormObj).(DepartmentORMWithAfterStrictUpdateSave); ok {
+				if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+					return nil, err
+				}
+			}
+			saved, err := ormObj.ToPB(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pbResponse := &saved
+			if hook, ok := interface{}(pbResponse).(DepartmentWithAfterPatchSave); ok {
+				if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+					return nil, err
+				}
+			}
+			return pbResponse, nil
+		}
+		for i := range objects {
+			if results[i], err = finish(i); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
 // DefaultApplyFieldMaskDepartment patches an pbObject with patcher according to a field mask.
 func DefaultApplyFieldMaskDepartment(ctx context.Context, patchee *Department, patcher *Department, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Department, error) {
 	if patcher == nil {
@@ -3630,6 +6067,14 @@ func DefaultApplyFieldMaskDepartment(ctx context.Context, patchee *Department, p
 	} else if patchee == nil {
 		return nil, errors.NilArgumentError
 	}
+	if len(updateMask.GetPaths()) == 1 && updateMask.Paths[0] == prefix+"*" {
+		updateMask = &field_mask.FieldMask{Paths: []string{prefix + "Name", prefix + "Id"}}
+	}
+	for _, f := range updateMask.GetPaths() {
+		if strings.HasPrefix(f, prefix) && !DefaultHasFieldMaskPathDepartment(strings.TrimPrefix(f, prefix)) {
+			return nil, &errors.InvalidFieldMaskError{Path: f}
+		}
+	}
 	var err error
 	for _, f := range updateMask.Paths {
 		if f == prefix+"Name" {
@@ -3647,6 +6092,16 @@ func DefaultApplyFieldMaskDepartment(ctx context.Context, patchee *Department, p
 	return patchee, nil
 }
 
+// DefaultHasFieldMaskPathDepartment reports whether Department has the field of the field mask path
+func DefaultHasFieldMaskPathDepartment(path string) bool {
+	parts := strings.SplitN(path, ".", 2)
+	switch parts[0] {
+	case "Name", "Id":
+		return len(parts) == 1
+	}
+	return false
+}
+
 // DefaultListDepartment executes a gorm list call
 func DefaultListDepartment(ctx context.Context, db *gorm.DB) ([]*Department, error) {
 	in := Department{}
@@ -20,12 +20,18 @@ import (
 
 const (
 	createService    = "Create"
+	createSetService = "CreateSet"
 	readService      = "Read"
 	updateService    = "Update"
 	updateSetService = "UpdateSet"
 	deleteService    = "Delete"
 	deleteSetService = "DeleteSet"
 	listService      = "List"
+	upsertService    = "Upsert"
+	undeleteService  = "Undelete"
+	purgeService     = "Purge"
+	addService       = "Add"
+	removeService    = "Remove"
 )
 
 var (
@@ -34,6 +40,7 @@ var (
 
 var (
 	gormImport         = "gorm.io/gorm"
+	gormClauseImport   = "gorm.io/gorm/clause"
 	tkgormImport       = "github.com/infobloxopen/atlas-app-toolkit/v2/gorm"
 	uuidImport         = "github.com/satori/go.uuid"
 	authImport         = "github.com/infobloxopen/protoc-gen-gorm/auth"
@@ -52,7 +59,12 @@ var (
 	stdCtxImport       = "context"
 	stdStringsImport   = "strings"
 	stdTimeImport      = "time"
+	stdSortImport      = "sort"
+	stdErrorsImport    = "errors"
+	stdReflectImport   = "reflect"
 	encodingJsonImport = "encoding/json"
+	grpcStatusImport   = "google.golang.org/grpc/status"
+	grpcCodesImport    = "google.golang.org/grpc/codes"
 	bigintImport       = "math/big"
 )
 
@@ -103,6 +115,13 @@ type pkFieldObjs struct {
 	field *Field
 }
 
+// partitionStrategies maps the partition strategy option to its postgres keyword
+var partitionStrategies = map[string]string{
+	"range": "RANGE",
+	"list":  "LIST",
+	"hash":  "HASH",
+}
+
 const (
 	protoTypeTimestamp = "Timestamp" // last segment, first will be *google_protobufX
 	protoTypeDuration  = "Duration"
@@ -122,16 +141,21 @@ const (
 )
 
 type ORMBuilder struct {
-	plugin          *protogen.Plugin
-	ormableTypes    map[string]*OrmableType
-	messages        map[string]struct{}
-	currentFile     string
-	currentPackage  string
-	ormableServices []autogenService
-	dbEngine        int
-	stringEnums     bool
-	gateway         bool
-	suppressWarn    bool
+	plugin       *protogen.Plugin
+	ormableTypes map[string]*OrmableType
+	// embeddedTypes are the message types embedded into the tables of ormable types
+	embeddedTypes  map[string]*OrmableType
+	generatedFiles map[string]*protogen.GeneratedFile
+	messages       map[string]struct{}
+	currentFile    string
+	currentPackage string
+	// currentProtoPackage resolves bare message names to ormables of the file
+	currentProtoPackage string
+	ormableServices     []autogenService
+	dbEngine            int
+	stringEnums         bool
+	gateway             bool
+	suppressWarn        bool
 }
 
 func New(opts protogen.Options, request *pluginpb.CodeGeneratorRequest) (*ORMBuilder, error) {
@@ -142,9 +166,11 @@ func New(opts protogen.Options, request *pluginpb.CodeGeneratorRequest) (*ORMBui
 	SetSupportedFeaturesOnPluginGen(plugin)
 
 	builder := &ORMBuilder{
-		plugin:       plugin,
-		ormableTypes: make(map[string]*OrmableType),
-		messages:     make(map[string]struct{}),
+		plugin:         plugin,
+		ormableTypes:   make(map[string]*OrmableType),
+		embeddedTypes:  make(map[string]*OrmableType),
+		generatedFiles: make(map[string]*protogen.GeneratedFile),
+		messages:       make(map[string]struct{}),
 	}
 
 	params := parseParameter(request.GetParameter())
@@ -193,6 +219,12 @@ type OrmableType struct {
 	Name       string
 	OriginName string
 	Package    string
+	// View is set when the type is backed by a read-only SQL view
+	View *gormopts.ViewOptions
+	// CursorPagination is set when the List handler pages with page tokens
+	CursorPagination bool
+	// WithTotalCount is set when the List handler counts the listed rows
+	WithTotalCount bool
 }
 
 func NewOrmableType(originalName string, pkg string, file *protogen.File) *OrmableType {
@@ -224,6 +256,8 @@ type autogenMethod struct {
 	fieldMaskName     string
 	ccName            string
 	followsConvention bool
+	streaming         bool
+	association       string
 }
 
 type fileImports struct {
@@ -252,14 +286,18 @@ type pkgImport struct {
 }
 
 func (b *ORMBuilder) Generate() (*pluginpb.CodeGeneratorResponse, error) {
-	genFileMap := make(map[string]*protogen.GeneratedFile)
-
+	// the files are created upfront, the fields of embedded types are parsed
+	// within the file defining them
 	for _, protoFile := range b.plugin.Files {
 		fileName := protoFile.GeneratedFilenamePrefix + ".pb.gorm.go"
-		g := b.plugin.NewGeneratedFile(fileName, ".")
-		genFileMap[fileName] = g
+		b.generatedFiles[protoFile.Desc.Path()] = b.plugin.NewGeneratedFile(fileName, ".")
+	}
+
+	for _, protoFile := range b.plugin.Files {
+		g := b.generatedFiles[protoFile.Desc.Path()]
 
 		b.currentPackage = protoFile.GoImportPath.String()
+		b.currentProtoPackage = string(protoFile.Desc.Package())
 
 		// first traverse: preload the messages
 		for _, message := range protoFile.Messages {
@@ -272,7 +310,10 @@ func (b *ORMBuilder) Generate() (*pluginpb.CodeGeneratorResponse, error) {
 
 			if isOrmable(message) {
 				ormable := NewOrmableType(typeName, string(protoFile.GoPackageName), protoFile)
-				b.ormableTypes[typeName] = ormable
+				ormable.View = getMessageOptions(message).GetView()
+				ormable.CursorPagination = getMessageOptions(message).GetCursorPagination()
+				ormable.WithTotalCount = getMessageOptions(message).GetWithTotalCount()
+				b.ormableTypes[string(message.Desc.FullName())] = ormable
 			}
 		}
 
@@ -299,13 +340,14 @@ func (b *ORMBuilder) Generate() (*pluginpb.CodeGeneratorResponse, error) {
 	}
 
 	for _, protoFile := range b.plugin.Files {
+		b.currentPackage = protoFile.GoImportPath.String()
+		b.currentProtoPackage = string(protoFile.Desc.Package())
 		b.parseServices(protoFile)
 	}
 
 	for _, protoFile := range b.plugin.Files {
 		// generate actual code
-		fileName := protoFile.GeneratedFilenamePrefix + ".pb.gorm.go"
-		g, ok := genFileMap[fileName]
+		g, ok := b.generatedFiles[protoFile.Desc.Path()]
 		if !ok {
 			panic("generated file should be present")
 		}
@@ -315,10 +357,13 @@ func (b *ORMBuilder) Generate() (*pluginpb.CodeGeneratorResponse, error) {
 			continue
 		}
 
+		b.currentPackage = protoFile.GoImportPath.String()
+		b.currentProtoPackage = string(protoFile.Desc.Package())
+
 		skip := true
 
 		for _, message := range protoFile.Messages {
-			if isOrmable(message) {
+			if _, embedded := b.embeddedTypes[string(message.Desc.FullName())]; embedded || isOrmable(message) {
 				skip = false
 				break
 			}
@@ -344,6 +389,12 @@ func (b *ORMBuilder) Generate() (*pluginpb.CodeGeneratorResponse, error) {
 				b.generateTableNameFunctions(g, message)
 				b.generateConvertFunctions(g, message)
 				b.generateHookInterfaces(g, message)
+			} else if _, ok := b.embeddedTypes[string(message.Desc.FullName())]; ok {
+				b.generateOrmable(g, message)
+				b.generateConvertFunctions(g, message)
+				b.generateHookInterfaces(g, message)
+				b.generateApplyFieldMask(message, g)
+				b.generateHasFieldMaskPath(message, g)
 			}
 		}
 
@@ -358,7 +409,7 @@ func (b *ORMBuilder) Generate() (*pluginpb.CodeGeneratorResponse, error) {
 
 func (b *ORMBuilder) generateConvertFunctions(g *protogen.GeneratedFile, message *protogen.Message) {
 	typeName := string(message.Desc.Name())
-	ormable := b.getOrmable(camelCase(typeName))
+	ormable := b.getMessageType(message)
 
 	// /// To Orm
 	g.P(`// ToORM runs the BeforeToORM hook if present, converts the fields of this`)
@@ -407,14 +458,25 @@ func (b *ORMBuilder) generateConvertFunctions(g *protogen.GeneratedFile, message
 
 	g.P()
 	// /// To Pb
+	// embedded messages are converted to pointers, so that the message state
+	// holding a mutex is never copied
+	_, embedded := b.embeddedTypes[string(message.Desc.FullName())]
+	toRef, toType := `&to`, typeName
+	if embedded {
+		toRef, toType = `to`, `*`+typeName
+	}
 	g.P(`// ToPB runs the BeforeToPB hook if present, converts the fields of this`)
 	g.P(`// object to PB format, runs the AfterToPB hook, then returns the PB object`)
 	g.P(`func (m *`, typeName, `ORM) ToPB (ctx context.Context) (`,
-		typeName, `, error) {`)
-	g.P(`to := `, typeName, `{}`)
+		toType, `, error) {`)
+	if embedded {
+		g.P(`to := &`, typeName, `{}`)
+	} else {
+		g.P(`to := `, typeName, `{}`)
+	}
 	g.P(`var err error`)
 	g.P(`if prehook, ok := interface{}(m).(`, typeName, `WithBeforeToPB); ok {`)
-	g.P(`if err = prehook.BeforeToPB(ctx, &to); err != nil {`)
+	g.P(`if err = prehook.BeforeToPB(ctx, `, toRef, `); err != nil {`)
 	g.P(`return to, err`)
 	g.P(`}`)
 	g.P(`}`)
@@ -429,7 +491,7 @@ func (b *ORMBuilder) generateConvertFunctions(g *protogen.GeneratedFile, message
 		b.generateFieldConversion(message, field, false, ofield, g)
 	}
 	g.P(`if posthook, ok := interface{}(m).(`, typeName, `WithAfterToPB); ok {`)
-	g.P(`err = posthook.AfterToPB(ctx, &to)`)
+	g.P(`err = posthook.AfterToPB(ctx, `, toRef, `)`)
 	g.P(`}`)
 	g.P(`return to, err`)
 	g.P(`}`)
@@ -441,17 +503,87 @@ func (b *ORMBuilder) generateTableNameFunctions(g *protogen.GeneratedFile, messa
 
 	g.P(`// TableName overrides the default tablename generated by GORM`)
 	g.P(`func (`, typeName, `ORM) TableName() string {`)
+	g.P(`return "`, getTableName(message), `"`)
+	g.P(`}`)
+
+	if partition := getMessageOptions(message).GetPartition(); partition != nil {
+		if getMessageOptions(message).GetView() != nil {
+			panic(fmt.Sprintf("View %s can't be partitioned", msgName))
+		}
+		b.generateTableOptionsFunction(g, message, partition)
+	}
+	b.generateSetupJoinTables(message, g)
+}
+
+// generateSetupJoinTables renders the registration of the join models of the
+// many-to-many associations declaring one
+func (b *ORMBuilder) generateSetupJoinTables(message *protogen.Message, g *protogen.GeneratedFile) {
+	typeName := string(message.Desc.Name())
+	ormable := b.getOrmable(typeName)
+
+	var fieldNames []string
+	for name, field := range ormable.Fields {
+		if field.GetManyToMany().GetThrough() != "" {
+			fieldNames = append(fieldNames, name)
+		}
+	}
+	if len(fieldNames) == 0 {
+		return
+	}
+	sort.Strings(fieldNames)
 
-	tableName := gschema.NamingStrategy{}.TableName(msgName)
+	g.P()
+	g.P(`// Setup`, typeName, `JoinTables registers the join models of the many-to-many associations`)
+	g.P(`// of `, typeName, `, it has to be called before the associations are used`)
+	g.P(`func Setup`, typeName, `JoinTables(db *`, generateImport("DB", gormImport, g), `) error {`)
+	for _, name := range fieldNames {
+		joinModel := b.getOrmable(ormable.Fields[name].GetManyToMany().GetThrough())
+		g.P(`if err := db.SetupJoinTable(&`, ormable.Name, `{}, "`, name, `", &`, joinModel.Name, `{}); err != nil {`)
+		g.P(`return err`)
+		g.P(`}`)
+	}
+	g.P(`return nil`)
+	g.P(`}`)
+}
+
+func getTableName(message *protogen.Message) string {
+	tableName := gschema.NamingStrategy{}.TableName(string(message.Desc.Name()))
 	if opts := getMessageOptions(message); opts != nil && len(opts.Table) > 0 {
 		tableName = opts.GetTable()
 	}
-	g.P(`return "`, tableName, `"`)
+	return tableName
+}
+
+// generateTableOptionsFunction renders the PARTITION BY clause of a partitioned table,
+// GORM appends it to CREATE TABLE when passed as "gorm:table_options" to AutoMigrate
+func (b *ORMBuilder) generateTableOptionsFunction(g *protogen.GeneratedFile, message *protogen.Message, partition *gormopts.PartitionOptions) {
+	typeName := string(message.Desc.Name())
+	strategy, ok := partitionStrategies[strings.ToLower(partition.GetStrategy())]
+	if !ok {
+		panic(fmt.Sprintf("Unknown partition strategy %q in %s", partition.GetStrategy(), typeName))
+	}
+
+	var columns []string
+	for _, key := range b.getPartitionKeys(message) {
+		columns = append(columns, columnName(key.name, key.field))
+	}
+	if len(columns) == 0 {
+		panic(fmt.Sprintf("Partitioned table %s must declare at least one partition key", typeName))
+	}
+
+	if b.dbEngine != ENGINE_POSTGRES {
+		fmt.Fprintf(os.Stderr, "partitioning of %s is declared for postgres, other engines will reject the generated table options.\n", typeName)
+	}
+
+	g.P(`// TableOptions returns the partitioning clause of `, typeName, `ORM, use it as`)
+	g.P(`// "gorm:table_options" when migrating so the table is created partitioned`)
+	g.P(`func (`, typeName, `ORM) TableOptions() string {`)
+	g.P(`return "PARTITION BY `, strategy, ` (`, strings.Join(columns, ", "), `)"`)
 	g.P(`}`)
 }
 
 func (b *ORMBuilder) generateOrmable(g *protogen.GeneratedFile, message *protogen.Message) {
-	ormable := b.getOrmable(message.GoIdent.GoName)
+	ormable := b.getMessageType(message)
 	g.P(`type `, ormable.Name, ` struct {`)
 
 	var names []string
@@ -506,7 +638,7 @@ func (b *ORMBuilder) parseAssociations(msg *protogen.Message, g *protogen.Genera
 		if field.Desc.Message() == nil {
 			fieldType = field.Desc.Kind().String() // was GoType
 		} else {
-			fieldType = string(field.Desc.Message().Name())
+			fieldType = string(field.Desc.Message().FullName())
 		}
 		fieldType = strings.Trim(fieldType, "[]*")
 		parts := strings.Split(fieldType, ".")
@@ -530,7 +662,8 @@ func (b *ORMBuilder) parseAssociations(msg *protogen.Message, g *protogen.Genera
 				}
 				fieldType = fmt.Sprintf("[]*%sORM", fieldType)
 			} else {
-				if fieldOpts.GetBelongsTo() != nil {
+				// a message referencing its own type references its parent
+				if fieldOpts.GetBelongsTo() != nil || (fieldOpts.GetHasOne() == nil && assocOrmable == ormable) {
 					b.parseBelongsTo(msg, ormable, fieldName, fieldTypeShort, assocOrmable, fieldOpts)
 				} else {
 					b.parseHasOne(msg, ormable, fieldName, fieldTypeShort, assocOrmable, fieldOpts)
@@ -573,9 +706,15 @@ func (b *ORMBuilder) hasCompositePrimaryKey(ormable *OrmableType) bool {
 	return false
 }
 
+// isOrmableField reports whether the field is of an ormable message type, the
+// type is looked up by its full name as it may be defined in another package
+func (b *ORMBuilder) isOrmableField(field *protogen.Field) bool {
+	return field.Message != nil && b.isOrmable(string(field.Message.Desc.FullName()))
+}
+
 func (b *ORMBuilder) isOrmable(typeName string) bool {
-	_, ok := b.ormableTypes[typeName]
-	return ok
+	_, err := b.lookupOrmable(typeName)
+	return err == nil
 }
 
 func (b *ORMBuilder) findPrimaryKey(ormable *OrmableType) (string, *Field) {
@@ -624,8 +763,129 @@ func (b *ORMBuilder) getPrimaryKeys(ormable *OrmableType) []pkFieldObjs {
 	return fieldobjs
 }
 
+// uniqueKey is a unique column or a named unique index of an ormable type
+type uniqueKey struct {
+	name   string
+	fields []pkFieldObjs
+	// aliases are the names of the other unique keys over the same fields
+	aliases []string
+}
+
+// named reports whether the key goes by the name of an index or a column
+func (k uniqueKey) named(name string) bool {
+	if k.name == name {
+		return true
+	}
+	for _, alias := range k.aliases {
+		if alias == name {
+			return true
+		}
+	}
+	return false
+}
+
+// getUniqueKeys returns the unique keys of the ormable type sorted by name, unique
+// columns are named by their column and unique indexes by the index name, the keys
+// over the same fields are merged into the first one by name
+func (b *ORMBuilder) getUniqueKeys(ormable *OrmableType) []uniqueKey {
+	var names []string
+	for name := range ormable.Fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	keys := map[string]*uniqueKey{}
+	addKey := func(keyName string, name string, field *Field) {
+		if keys[keyName] == nil {
+			keys[keyName] = &uniqueKey{name: keyName}
+		}
+		keys[keyName].fields = append(keys[keyName].fields, pkFieldObjs{name, field})
+	}
+	for _, name := range names {
+		field := ormable.Fields[name]
+		if field.GetTag().GetUnique() {
+			addKey(columnName(name, field), name, field)
+		}
+		if index := field.GetTag().GetUniqueIndex(); index != "" {
+			addKey(index, name, field)
+		}
+	}
+
+	var sorted []uniqueKey
+	for _, key := range keys {
+		sorted = append(sorted, *key)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].name < sorted[j].name
+	})
+
+	var result []uniqueKey
+	merged := map[string]int{}
+	for _, key := range sorted {
+		var fieldNames []string
+		for _, field := range key.fields {
+			fieldNames = append(fieldNames, field.name)
+		}
+		signature := strings.Join(fieldNames, ",")
+		if i, ok := merged[signature]; ok {
+			result[i].aliases = append(result[i].aliases, key.name)
+			continue
+		}
+		merged[signature] = len(result)
+		result = append(result, key)
+	}
+
+	return result
+}
+
+// getPartitionKeys returns the partition key field objects in declaration order
+func (b *ORMBuilder) getPartitionKeys(message *protogen.Message) []pkFieldObjs {
+	ormable := b.getOrmable(string(message.Desc.Name()))
+
+	var fieldobjs []pkFieldObjs
+	for _, key := range getMessageOptions(message).GetPartition().GetKeys() {
+		keyName := camelCase(key)
+		if field, ok := ormable.Fields[keyName]; ok {
+			fieldobjs = append(fieldobjs, pkFieldObjs{keyName, field})
+			continue
+		}
+		var found bool
+		for fieldName, field := range ormable.Fields {
+			// fields like AccountID do not follow camelCase of their column
+			if strings.EqualFold(fieldName, keyName) {
+				fieldobjs = append(fieldobjs, pkFieldObjs{fieldName, field})
+				found = true
+				break
+			}
+		}
+		if !found {
+			panic(fmt.Sprintf("Missing partition key %s field in %s", key, ormable.Name))
+		}
+	}
+
+	return fieldobjs
+}
+
+// columnName returns the db column of an ormable field
+func columnName(fieldName string, field *Field) string {
+	if column := field.GetTag().GetColumn(); len(column) > 0 {
+		return column
+	}
+
+	return gschema.NamingStrategy{SingularTable: true}.TableName(fieldName)
+}
+
+// getMessageType returns the ormable or embedded type of the message
+func (b *ORMBuilder) getMessageType(message *protogen.Message) *OrmableType {
+	if embedded, ok := b.embeddedTypes[string(message.Desc.FullName())]; ok {
+		return embedded
+	}
+
+	return b.getOrmable(string(message.Desc.Name()))
+}
+
 func (b *ORMBuilder) getOrmable(typeName string) *OrmableType {
-	r, err := GetOrmable(b.ormableTypes, typeName)
+	r, err := b.lookupOrmable(typeName)
 	if err != nil {
 		panic(err)
 	}
@@ -633,6 +893,16 @@ func (b *ORMBuilder) getOrmable(typeName string) *OrmableType {
 	return r
 }
 
+// lookupOrmable resolves a bare message name within the proto package of the
+// current file first, full names and qualified Go type names are resolved by GetOrmable
+func (b *ORMBuilder) lookupOrmable(typeName string) (*OrmableType, error) {
+	if ormable, ok := b.ormableTypes[b.currentProtoPackage+"."+typeName]; ok && b.currentProtoPackage != "" {
+		return ormable, nil
+	}
+
+	return GetOrmable(b.ormableTypes, typeName)
+}
+
 func (b *ORMBuilder) parseManyToMany(msg *protogen.Message, ormable *OrmableType, fieldName string, fieldType string, assoc *OrmableType, opts *gormopts.GormFieldOptions) {
 	typeName := camelCase(string(msg.Desc.Name()))
 	mtm := opts.GetManyToMany()
@@ -663,9 +933,21 @@ func (b *ORMBuilder) parseManyToMany(msg *protogen.Message, ormable *OrmableType
 		}
 	}
 	mtm.AssociationForeignkey = assocKeyName
+	var joinModel *OrmableType
+	if through := mtm.GetThrough(); through != "" {
+		if !b.isOrmable(through) || b.getOrmable(through).Package != ormable.Package {
+			panic(fmt.Sprintf("Join model %s of %s in %s must be an ormable type of the same package", through, fieldName, ormable.Name))
+		}
+		joinModel = b.getOrmable(through)
+	}
 	ns := gschema.NamingStrategy{SingularTable: true}
 	var jt string
-	if jt = ns.TableName(mtm.GetJointable()); jt == "" {
+	if joinModel != nil {
+		jt = b.ormableTable(joinModel)
+		if mtm.GetJointable() != "" && mtm.GetJointable() != jt {
+			panic(fmt.Sprintf("Join table of %s in %s must be the %s table of its join model", fieldName, ormable.Name, jt))
+		}
+	} else if jt = ns.TableName(mtm.GetJointable()); jt == "" {
 		if b.countManyToManyAssociationDimension(msg, fieldType) == 1 && typeName != fieldType {
 			jt = ns.TableName(typeName + inflection.Plural(fieldType))
 		} else {
@@ -687,6 +969,82 @@ func (b *ORMBuilder) parseManyToMany(msg *protogen.Message, ormable *OrmableType
 		}
 	}
 	mtm.AssociationJointableForeignkey = camelCase(jtAssocForeignKey)
+	if joinModel != nil {
+		b.addJoinModelKey(joinModel, mtm.JointableForeignkey, ormable.Fields[foreignKeyName])
+		b.addJoinModelKey(joinModel, mtm.AssociationJointableForeignkey, assoc.Fields[assocKeyName])
+	}
+	if posField := camelCase(mtm.GetPositionField()); posField != "" {
+		if joinModel == nil {
+			panic(fmt.Sprintf("Position field of %s in %s requires a through join model", fieldName, ormable.Name))
+		}
+		b.addPositionField(joinModel, posField, mtm.GetPositionFieldTag())
+		mtm.PositionField = posField
+	}
+}
+
+// isJoinModel reports whether the type is the join model of a many-to-many
+// association of the message
+func isJoinModel(msg *protogen.Message, typeName string) bool {
+	for _, field := range msg.Fields {
+		options := field.Desc.Options().(*descriptorpb.FieldOptions)
+		if getFieldOptions(options).GetManyToMany().GetThrough() == typeName {
+			return true
+		}
+	}
+	return false
+}
+
+// ormableTable returns the table of an ormable type
+func (b *ORMBuilder) ormableTable(ormable *OrmableType) string {
+	for _, message := range ormable.File.Messages {
+		if string(message.Desc.Name()) == ormable.OriginName {
+			return getTableName(message)
+		}
+	}
+	return gschema.NamingStrategy{}.TableName(ormable.OriginName)
+}
+
+// addJoinModelKey makes the column referencing one side of the association a
+// primary key of the join model, the column is added unless the model has it
+func (b *ORMBuilder) addJoinModelKey(joinModel *OrmableType, name string, key *Field) {
+	keyType := strings.TrimPrefix(key.TypeName, "*")
+	field, ok := joinModel.Fields[name]
+	if !ok {
+		field = &Field{TypeName: keyType, Package: key.Package}
+		joinModel.Fields[name] = field
+	} else if strings.TrimPrefix(field.TypeName, "*") != keyType {
+		panic(fmt.Sprintf("Cannot include %s field into %s as it already exists there with a different type: %s, %s",
+			name, joinModel.Name, field.TypeName, keyType))
+	}
+	if field.GormFieldOptions == nil {
+		field.GormFieldOptions = &gormopts.GormFieldOptions{}
+	}
+	if field.Tag == nil {
+		field.Tag = &gormopts.GormTag{}
+	}
+	field.Tag.PrimaryKey = true
+}
+
+// parsePolymorphic adds the owner type column of a polymorphic association to
+// the child and returns the name of the owner key, the key is not nullable and
+// shared by all the owners of the child, which reference it by primary key
+func (b *ORMBuilder) parsePolymorphic(parent *OrmableType, fieldName string, child *OrmableType, polymorphic string, assocKeyName string, foreignKey *Field) string {
+	if pkName, _ := b.findPrimaryKey(parent); assocKeyName != pkName {
+		panic(fmt.Sprintf("Polymorphic association %s of %s needs to reference the primary key", fieldName, parent.Name))
+	}
+	ownerKey, ownerType := polymorphic+"ID", polymorphic+"Type"
+	if _, ok := child.Fields[polymorphic+"Id"]; ok {
+		panic(fmt.Sprintf("Owner key of polymorphic association %s of %s is added to %s as %s, remove %s from it",
+			fieldName, parent.Name, child.Name, ownerKey, polymorphic+"Id"))
+	}
+	if exField, ok := child.Fields[ownerType]; !ok {
+		child.Fields[ownerType] = &Field{TypeName: "string"}
+	} else if exField.TypeName != "string" {
+		panic(fmt.Sprintf("Cannot include %s field into %s as it already exists there with a different type: %s, string",
+			ownerType, child.Name, exField.TypeName))
+	}
+	foreignKey.TypeName = strings.TrimPrefix(foreignKey.TypeName, "*")
+	return ownerKey
 }
 
 func (b *ORMBuilder) parseHasOne(msg *protogen.Message, parent *OrmableType, fieldName string, fieldType string, child *OrmableType, opts *gormopts.GormFieldOptions) {
@@ -724,7 +1082,12 @@ func (b *ORMBuilder) parseHasOne(msg *protogen.Message, parent *OrmableType, fie
 
 	foreignKey := &Field{TypeName: foreignKeyType, Package: assocKey.Package, GormFieldOptions: &gormopts.GormFieldOptions{Tag: hasOne.GetForeignkeyTag()}}
 	var foreignKeyName string
-	if foreignKeyName = camelCase(hasOne.GetForeignkey()); foreignKeyName == "" {
+	if polymorphic := camelCase(hasOne.GetPolymorphic()); polymorphic != "" {
+		foreignKeyName = b.parsePolymorphic(parent, fieldName, child, polymorphic, assocKeyName, foreignKey)
+		if hasOne.GetPolymorphicValue() == "" {
+			hasOne.PolymorphicValue = b.ormableTable(parent)
+		}
+	} else if foreignKeyName = camelCase(hasOne.GetForeignkey()); foreignKeyName == "" {
 		if b.countHasAssociationDimension(msg, fieldType) == 1 {
 			foreignKeyName = fmt.Sprintf(typeName + assocKeyName)
 		} else {
@@ -783,8 +1146,16 @@ func (b *ORMBuilder) parseHasMany(msg *protogen.Message, parent *OrmableType, fi
 	}
 	foreignKey := &Field{TypeName: foreignKeyType, Package: assocKey.Package, GormFieldOptions: &gormopts.GormFieldOptions{Tag: hasMany.GetForeignkeyTag()}}
 	var foreignKeyName string
-	if foreignKeyName = hasMany.GetForeignkey(); foreignKeyName == "" {
-		if b.countHasAssociationDimension(msg, fieldType) == 1 {
+	if polymorphic := camelCase(hasMany.GetPolymorphic()); polymorphic != "" {
+		foreignKeyName = b.parsePolymorphic(parent, fieldName, child, polymorphic, assocKeyName, foreignKey)
+		if hasMany.GetPolymorphicValue() == "" {
+			hasMany.PolymorphicValue = b.ormableTable(parent)
+		}
+	} else if foreignKeyName = hasMany.GetForeignkey(); foreignKeyName == "" {
+		if parentKey := b.selfBelongsToForeignKey(msg, assocKeyName); child == parent && parentKey != "" {
+			// the children of a tree reference their parent
+			foreignKeyName = parentKey
+		} else if b.countHasAssociationDimension(msg, fieldType) == 1 {
 			foreignKeyName = fmt.Sprintf(typeName + assocKeyName)
 		} else {
 			foreignKeyName = fmt.Sprintf(fieldName + typeName + assocKeyName)
@@ -799,27 +1170,63 @@ func (b *ORMBuilder) parseHasMany(msg *protogen.Message, parent *OrmableType, fi
 	if exField, ok := child.Fields[foreignKeyName]; !ok {
 		child.Fields[foreignKeyName] = foreignKey
 	} else {
+		// the keys of a join model exposing the attributes of the links aren't pointers
+		joinKey := isJoinModel(msg, child.OriginName) && strings.TrimPrefix(exField.TypeName, "*") == strings.TrimPrefix(foreignKey.TypeName, "*")
 		if exField.TypeName == "interface{}" {
 			exField.TypeName = foreignKey.TypeName
-		} else if !b.sameType(exField, foreignKey) {
+		} else if !b.sameType(exField, foreignKey) && !joinKey {
 			panic(fmt.Sprintf("Cannot include %s field into %s as it already exists there with a different type: %s, %s",
 				foreignKeyName, child.Name, exField.TypeName, foreignKey.TypeName))
 		}
 	}
 	child.Fields[foreignKeyName].ParentOrigName = parent.OriginName
 
-	var posField string
-	if posField = camelCase(hasMany.GetPositionField()); posField != "" {
-		if exField, ok := child.Fields[posField]; !ok {
-			child.Fields[posField] = &Field{TypeName: "int", GormFieldOptions: &gormopts.GormFieldOptions{Tag: hasMany.GetPositionFieldTag()}}
-		} else {
-			if !strings.Contains(exField.TypeName, "int") {
-				panic(fmt.Sprintf("Cannot include %s field into %s as it already exists there with a different type.",
-					posField, child.Name))
+	if posField := camelCase(hasMany.GetPositionField()); posField != "" {
+		b.addPositionField(child, posField, hasMany.GetPositionFieldTag())
+		hasMany.PositionField = posField
+	}
+}
+
+// addPositionField adds the int column keeping the order of the children to
+// the model unless the model has it
+func (b *ORMBuilder) addPositionField(model *OrmableType, posField string, tag *gormopts.GormTag) {
+	if exField, ok := model.Fields[posField]; !ok {
+		model.Fields[posField] = &Field{TypeName: "int", GormFieldOptions: &gormopts.GormFieldOptions{Tag: tag}}
+	} else {
+		if !strings.Contains(exField.TypeName, "int") {
+			panic(fmt.Sprintf("Cannot include %s field into %s as it already exists there with a different type.",
+				posField, model.Name))
+		}
+	}
+}
+
+// selfBelongsToForeignKey returns the foreign key of the belongs-to association
+// of the message to its own type, referencing the parent in a tree, when the
+// message has a single one
+func (b *ORMBuilder) selfBelongsToForeignKey(msg *protogen.Message, assocKeyName string) string {
+	var foreignKeys []string
+	for _, field := range msg.Fields {
+		if field.Message == nil || field.Message.Desc.FullName() != msg.Desc.FullName() || field.Desc.IsList() {
+			continue
+		}
+		fieldOpts := getFieldOptions(field.Desc.Options().(*descriptorpb.FieldOptions))
+		if fieldOpts.GetDrop() || fieldOpts.GetHasOne() != nil {
+			continue
+		}
+		foreignKey := camelCase(fieldOpts.GetBelongsTo().GetForeignkey())
+		if foreignKey == "" {
+			keyName := assocKeyName
+			if name := camelCase(fieldOpts.GetBelongsTo().GetAssociationForeignkey()); name != "" {
+				keyName = name
 			}
+			foreignKey = camelCase(string(field.Desc.Name())) + keyName
 		}
-		hasMany.PositionField = posField
+		foreignKeys = append(foreignKeys, foreignKey)
+	}
+	if len(foreignKeys) != 1 {
+		return ""
 	}
+	return foreignKeys[0]
 }
 
 func (b *ORMBuilder) parseBelongsTo(msg *protogen.Message, child *OrmableType, fieldName string, fieldType string, parent *OrmableType, opts *gormopts.GormFieldOptions) {
@@ -853,7 +1260,7 @@ func (b *ORMBuilder) parseBelongsTo(msg *protogen.Message, child *OrmableType, f
 	foreignKey := &Field{TypeName: foreignKeyType, Package: assocKey.Package, GormFieldOptions: &gormopts.GormFieldOptions{Tag: belongsTo.GetForeignkeyTag()}}
 	var foreignKeyName string
 	if foreignKeyName = camelCase(belongsTo.GetForeignkey()); foreignKeyName == "" {
-		if b.countBelongsToAssociationDimension(msg, fieldType) == 1 {
+		if b.countBelongsToAssociationDimension(msg, fieldType) == 1 && child != parent {
 			foreignKeyName = fmt.Sprintf(fieldType + assocKeyName)
 		} else {
 			foreignKeyName = fmt.Sprintf(fieldName + assocKeyName)
@@ -875,12 +1282,44 @@ func (b *ORMBuilder) parseBelongsTo(msg *protogen.Message, child *OrmableType, f
 
 func (b *ORMBuilder) parseBasicFields(msg *protogen.Message, g *protogen.GeneratedFile) {
 	typeName := string(msg.Desc.Name())
-	ormable, ok := b.ormableTypes[typeName]
+	ormable, ok := b.ormableTypes[string(msg.Desc.FullName())]
 	if !ok {
 		panic("typeName should be found")
 	}
 	ormable.Name = fmt.Sprintf("%sORM", typeName) // TODO: there are no reason to do it here
 
+	b.parseMessageFields(msg, ormable, g)
+
+	gormMsgOptions := getMessageOptions(msg)
+	if gormMsgOptions.GetMultiAccount() {
+		if accID, ok := ormable.Fields["AccountID"]; !ok {
+			ormable.Fields["AccountID"] = &Field{TypeName: "string"}
+		} else if accID.TypeName != "string" {
+			panic("cannot include AccountID field")
+		}
+	}
+
+	if gormMsgOptions.GetMultiCompartment() {
+		if comID, ok := ormable.Fields["CompartmentID"]; !ok {
+			ormable.Fields["CompartmentID"] = &Field{TypeName: "string"}
+		} else if comID.TypeName != "string" {
+			panic("cannot include CompartmentID field")
+		}
+	}
+
+	// TODO: GetInclude
+	for _, field := range gormMsgOptions.GetInclude() {
+		fieldName := camelCase(field.GetName())
+		if _, ok := ormable.Fields[fieldName]; !ok {
+			b.addIncludedField(ormable, field, g)
+		} else {
+			panic("cound not include")
+		}
+	}
+}
+
+// parseMessageFields adds the fields of the message, other than associations, to the type
+func (b *ORMBuilder) parseMessageFields(msg *protogen.Message, ormable *OrmableType, g *protogen.GeneratedFile) {
 	for _, field := range msg.Fields {
 		fd := field.Desc
 		options := fd.Options().(*descriptorpb.FieldOptions)
@@ -896,6 +1335,7 @@ func (b *ORMBuilder) parseBasicFields(msg *protogen.Message, g *protogen.Generat
 		fieldName := camelCase(string(fd.Name()))
 		fieldType := fd.Kind().String()
 		var typePackage string
+		var embedded *OrmableType
 
 		if b.dbEngine == ENGINE_POSTGRES && b.IsAbleToMakePQArray(fieldType) && field.Desc.IsList() {
 			switch fieldType {
@@ -914,7 +1354,7 @@ func (b *ORMBuilder) parseBasicFields(msg *protogen.Message, g *protogen.Generat
 			default:
 				continue
 			}
-		} else if (field.Message == nil || !b.isOrmable(fieldType)) && field.Desc.IsList() {
+		} else if !b.isOrmableField(field) && field.Desc.IsList() {
 			// not implemented
 			continue
 		} else if field.Enum != nil {
@@ -1004,6 +1444,16 @@ func (b *ORMBuilder) parseBasicFields(msg *protogen.Message, g *protogen.Generat
 			} else if rawType == protoTimeOnly {
 				fieldType = "string"
 				gormOptions.Tag = tagWithType(tag, "time")
+			} else if tag.GetEmbedded() && !b.isOrmableField(field) {
+				if field.Message.GoIdent.GoImportPath != msg.GoIdent.GoImportPath {
+					panic(fmt.Sprintf("Embedded message %s of %s needs to be of the same Go package", rawType, msg.Desc.Name()))
+				}
+				embedded = b.getEmbeddedType(field.Message)
+				typePackage = embedded.Package
+				fieldType = embedded.Name
+				if tag.GetEmbeddedPrefix() == "" {
+					tag.EmbeddedPrefix = gschema.NamingStrategy{SingularTable: true}.ColumnName("", fieldName) + "_"
+				}
 			} else {
 				continue
 			}
@@ -1030,6 +1480,7 @@ func (b *ORMBuilder) parseBasicFields(msg *protogen.Message, g *protogen.Generat
 			GormFieldOptions: gormOptions,
 			ParentGoType:     "",
 			TypeName:         fieldType,
+			Type:             embedded,
 			Package:          typePackage,
 		}
 
@@ -1042,33 +1493,34 @@ func (b *ORMBuilder) parseBasicFields(msg *protogen.Message, g *protogen.Generat
 
 		ormable.Fields[fieldName] = f
 	}
+}
 
-	gormMsgOptions := getMessageOptions(msg)
-	if gormMsgOptions.GetMultiAccount() {
-		if accID, ok := ormable.Fields["AccountID"]; !ok {
-			ormable.Fields["AccountID"] = &Field{TypeName: "string"}
-		} else if accID.TypeName != "string" {
-			panic("cannot include AccountID field")
+// getEmbeddedType returns the type of a message embedded into tables as a group
+// of columns, its fields are parsed within the file defining the message
+func (b *ORMBuilder) getEmbeddedType(message *protogen.Message) *OrmableType {
+	fullName := string(message.Desc.FullName())
+	if embedded, ok := b.embeddedTypes[fullName]; ok {
+		// the name is set once the fields are parsed
+		if embedded.Name == "" {
+			panic(fmt.Sprintf("Embedded message %s can't embed itself", fullName))
 		}
+		return embedded
 	}
-
-	if gormMsgOptions.GetMultiCompartment() {
-		if comID, ok := ormable.Fields["CompartmentID"]; !ok {
-			ormable.Fields["CompartmentID"] = &Field{TypeName: "string"}
-		} else if comID.TypeName != "string" {
-			panic("cannot include CompartmentID field")
-		}
+	if _, nested := message.Desc.Parent().(protoreflect.MessageDescriptor); nested {
+		panic(fmt.Sprintf("Embedded message %s needs to be a top-level message", fullName))
 	}
 
-	// TODO: GetInclude
-	for _, field := range gormMsgOptions.GetInclude() {
-		fieldName := camelCase(field.GetName())
-		if _, ok := ormable.Fields[fieldName]; !ok {
-			b.addIncludedField(ormable, field, g)
-		} else {
-			panic("cound not include")
-		}
-	}
+	file := b.plugin.FilesByPath[message.Desc.ParentFile().Path()]
+	embedded := NewOrmableType(string(message.Desc.Name()), string(file.GoPackageName), file)
+	b.embeddedTypes[fullName] = embedded
+
+	currentPackage, currentProtoPackage := b.currentPackage, b.currentProtoPackage
+	b.currentPackage, b.currentProtoPackage = file.GoImportPath.String(), string(file.Desc.Package())
+	b.parseMessageFields(message, embedded, b.generatedFiles[file.Desc.Path()])
+	b.currentPackage, b.currentProtoPackage = currentPackage, currentProtoPackage
+
+	embedded.Name = fmt.Sprintf("%sORM", embedded.OriginName)
+	return embedded
 }
 
 func (b *ORMBuilder) addIncludedField(ormable *OrmableType, field *gormopts.ExtraField, g *protogen.GeneratedFile) {
@@ -1270,6 +1722,9 @@ func (b *ORMBuilder) renderGormTag(field *Field) string {
 	}
 	if len(tag.Default) > 0 {
 		gormRes += fmt.Sprintf("default:%s;", tag.GetDefault())
+	} else if field.GetVersion() {
+		// new rows start at the first version
+		gormRes += "default:1;"
 	}
 	if tag.GetNotNull() {
 		gormRes += "not null;"
@@ -1306,10 +1761,12 @@ func (b *ORMBuilder) renderGormTag(field *Field) string {
 	}
 
 	var foreignKey, associationForeignKey, joinTable, joinTableForeignKey, associationJoinTableForeignKey string
+	var polymorphic, polymorphicValue string
 	var replace, append, clear bool
 	if hasOne := field.GetHasOne(); hasOne != nil {
 		foreignKey = hasOne.Foreignkey
 		associationForeignKey = hasOne.AssociationForeignkey
+		polymorphic, polymorphicValue = hasOne.Polymorphic, hasOne.PolymorphicValue
 		clear = hasOne.Clear
 		replace = hasOne.Replace
 		append = hasOne.Append
@@ -1319,6 +1776,7 @@ func (b *ORMBuilder) renderGormTag(field *Field) string {
 	} else if hasMany := field.GetHasMany(); hasMany != nil {
 		foreignKey = hasMany.Foreignkey
 		associationForeignKey = hasMany.AssociationForeignkey
+		polymorphic, polymorphicValue = hasMany.Polymorphic, hasMany.PolymorphicValue
 		clear = hasMany.Clear
 		replace = hasMany.Replace
 		append = hasMany.Append
@@ -1342,6 +1800,13 @@ func (b *ORMBuilder) renderGormTag(field *Field) string {
 		associationJoinTableForeignKey = tag.AssociationJointableForeignkey
 	}
 
+	if len(polymorphic) > 0 {
+		// the owner columns are named after the polymorphic option
+		gormRes += fmt.Sprintf("polymorphic:%s;", camelCase(polymorphic))
+		gormRes += fmt.Sprintf("polymorphicValue:%s;", polymorphicValue)
+		foreignKey, associationForeignKey = "", ""
+	}
+
 	if len(foreignKey) > 0 {
 		gormRes += fmt.Sprintf("foreignKey:%s;", foreignKey)
 	}
@@ -1384,8 +1849,7 @@ func (b *ORMBuilder) renderGormTag(field *Field) string {
 }
 
 func (b *ORMBuilder) setupOrderedHasMany(message *protogen.Message, g *protogen.GeneratedFile) {
-	typeName := string(message.Desc.Name())
-	ormable := b.getOrmable(typeName)
+	ormable := b.getMessageType(message)
 	var fieldNames []string
 	for name := range ormable.Fields {
 		fieldNames = append(fieldNames, name)
@@ -1398,8 +1862,7 @@ func (b *ORMBuilder) setupOrderedHasMany(message *protogen.Message, g *protogen.
 }
 
 func (b *ORMBuilder) setupOrderedHasManyByName(message *protogen.Message, fieldName string, g *protogen.GeneratedFile) {
-	typeName := string(message.Desc.Name())
-	ormable := b.getOrmable(typeName)
+	ormable := b.getMessageType(message)
 	field := ormable.Fields[fieldName]
 
 	if field == nil {
@@ -1408,7 +1871,7 @@ func (b *ORMBuilder) setupOrderedHasManyByName(message *protogen.Message, fieldN
 
 	if field.GetHasMany().GetPositionField() != "" {
 		positionField := field.GetHasMany().GetPositionField()
-		positionFieldType := b.getOrmable(field.TypeName).Fields[positionField].TypeName
+		positionFieldType := field.Type.Fields[positionField].TypeName
 		g.P(`for i, e := range `, `to.`, fieldName, `{`)
 		g.P(`e.`, positionField, ` = `, positionFieldType, `(i)`)
 		g.P(`}`)
@@ -1441,9 +1904,17 @@ func (b *ORMBuilder) generateFieldConversion(message *protogen.Message, field *p
 			}
 			g.P(`copy(to.`, fieldName, `, m.`, fieldName, `)`)
 			g.P(`}`)
-		} else if b.isOrmable(fieldType) { // Repeated ORMable type
+		} else if b.isOrmableField(field) { // Repeated ORMable type
 			// fieldType = strings.Trim(fieldType, "[]*")
 
+			if ofield != nil && toORM {
+				if merge, _ := mergeOptions(ofield); merge {
+					// an emptied collection is merged, unlike a missing one
+					g.P(`if m.`, fieldName, ` != nil {`)
+					g.P(`to.`, fieldName, ` = make(`, ofield.TypeName, `, 0, len(m.`, fieldName, `))`)
+					g.P(`}`)
+				}
+			}
 			g.P(`for _, v := range m.`, fieldName, ` {`)
 			g.P(`if v != nil {`)
 			if toORM {
@@ -1462,6 +1933,21 @@ func (b *ORMBuilder) generateFieldConversion(message *protogen.Message, field *p
 		} else {
 			g.P(`// Repeated type `, fieldType, ` is not an ORMable message type`)
 		}
+	} else if b.isEmbeddedField(message, field) { // Singular embedded message, stored as columns of the object
+		if toORM {
+			g.P(`if m.`, fieldName, ` != nil {`)
+			g.P(`if to.`, fieldName, `, err = m.`, fieldName, `.ToORM(ctx); err != nil {`)
+			g.P(`return to, err`)
+			g.P(`}`)
+			g.P(`}`)
+		} else {
+			// columns left zero read back as an unset message rather than an empty one
+			g.P(`if !`, generateImport("ValueOf", stdReflectImport, g), `(m.`, fieldName, `).IsZero() {`)
+			g.P(`if to.`, fieldName, `, err = m.`, fieldName, `.ToPB(ctx); err != nil {`)
+			g.P(`return to, err`)
+			g.P(`}`)
+			g.P(`}`)
+		}
 	} else if field.Enum != nil { // Singular Enum, which is an int32 ---
 		fieldType = b.typeName(field.Enum.GoIdent, g)
 		if toORM {
@@ -1687,7 +2173,7 @@ func (b *ORMBuilder) generateFieldConversion(message *protogen.Message, field *p
 				g.P(`}`)
 				g.P(`}`)
 			}
-		} else if b.isOrmable(fieldType) {
+		} else if b.isOrmableField(field) {
 			// Not a WKT, but a type we're building converters for
 			g.P(`if m.`, fieldName, ` != nil {`)
 			if toORM {
@@ -1710,26 +2196,102 @@ func (b *ORMBuilder) generateFieldConversion(message *protogen.Message, field *p
 func (b *ORMBuilder) generateDefaultHandlers(file *protogen.File, g *protogen.GeneratedFile) {
 	for _, message := range file.Messages {
 		if isOrmable(message) {
-			b.generateCreateHandler(message, g)
 			typeName := string(message.Desc.Name())
 			ormable := b.getOrmable(typeName)
 
-			if b.hasCompositePrimaryKey(ormable) {
-				// TODO support for Update,Patch,DeleteSet when composite primary keys exist
-				b.generateReadHandler(message, g)
-				b.generateDeleteHandler(message, g)
-			} else if b.hasPrimaryKey(ormable) {
+			if ormable.View != nil {
+				// views are read-only, only Read and List are generated
+				if b.hasPrimaryKey(ormable) {
+					b.generateReadHandler(message, g)
+					b.generateReadByHandlers(message, g)
+				}
+				b.generateListHandler(message, g)
+				if b.listHasCursorPagination(ormable) {
+					b.generatePageTokenHandler(message, g)
+				}
+				if ormable.WithTotalCount {
+					b.generateCountHandler(message, g)
+				}
+				if b.listHasStreaming(ormable) {
+					b.generateStreamListHandler(message, g)
+				}
+				b.generateViewHandlers(message, g)
+				continue
+			}
+
+			b.generateCreateHandler(message, g)
+			b.generateCreateSetHandler(message, g)
+
+			if b.hasPrimaryKey(ormable) {
 				b.generateReadHandler(message, g)
+				b.generateReadByHandlers(message, g)
 				b.generateDeleteHandler(message, g)
 				b.generateDeleteSetHandler(message, g)
 				b.generateStrictUpdateHandler(message, g)
+				if b.hasUpsert(ormable) {
+					b.generateUpsertHandler(message, g)
+				}
 				b.generatePatchHandler(message, g)
 				b.generatePatchSetHandler(message, g)
+				b.generateAssociationHandlers(message, g)
+				b.generateTreeHandlers(message, g)
+				if b.hasSoftDelete(ormable) {
+					b.generateUndeleteHandler(message, g)
+					b.generateHardDeleteHandler(message, g)
+				}
 			}
 
+			b.generateCascadeDeleteHandler(message, g)
+			b.generatePositionHandlers(message, g)
 			b.generateApplyFieldMask(message, g)
+			b.generateHasFieldMaskPath(message, g)
+			if b.updateHasImpliedMask(ormable) {
+				b.generateImpliedFieldMask(message, g)
+			}
 			b.generateListHandler(message, g)
-		}
+			if b.listHasCursorPagination(ormable) {
+				b.generatePageTokenHandler(message, g)
+			}
+			if ormable.WithTotalCount {
+				b.generateCountHandler(message, g)
+			}
+			if b.listHasStreaming(ormable) {
+				b.generateStreamListHandler(message, g)
+			}
+		}
+	}
+}
+
+// generateViewHandlers renders the helpers creating and refreshing the view
+// a read-only type is backed by
+func (b *ORMBuilder) generateViewHandlers(message *protogen.Message, g *protogen.GeneratedFile) {
+	typeName := string(message.Desc.Name())
+	view := b.getOrmable(typeName).View
+	if strings.TrimSpace(view.GetSql()) == "" {
+		panic(fmt.Sprintf("View %s must declare its sql", typeName))
+	}
+
+	kind := "VIEW"
+	if view.GetMaterialized() {
+		kind = "MATERIALIZED VIEW"
+		if b.dbEngine != ENGINE_POSTGRES {
+			fmt.Fprintf(os.Stderr, "materialized view %s is declared for postgres, other engines will reject the generated statements.\n", typeName)
+		}
+	}
+	tableName := getTableName(message)
+
+	g.P(`// Create`, typeName, `View creates the `, strings.ToLower(kind), ` `, typeName, `ORM is read from`)
+	g.P(`func Create`, typeName, `View(ctx context.Context, db *`, generateImport("DB", gormImport, g), `) error {`)
+	g.P(`return db.WithContext(ctx).Exec(`, strconv.Quote("CREATE "+kind+" "+tableName+" AS "+strings.TrimSpace(view.GetSql())), `).Error`)
+	g.P(`}`)
+	g.P()
+
+	if view.GetMaterialized() {
+		g.P(`// Refresh`, typeName, `View recomputes the materialized view `, typeName, `ORM is read from`)
+		g.P(`func Refresh`, typeName, `View(ctx context.Context, db *`, generateImport("DB", gormImport, g), `) error {`)
+		g.P(`return db.WithContext(ctx).Exec("REFRESH MATERIALIZED VIEW `, tableName, `").Error`)
+		g.P(`}`)
+		g.P()
 	}
 }
 
@@ -1804,6 +2366,45 @@ func parseRecursiveFields(model *OrmableType, take func(*Field) bool) []*fieldPa
 	return rec(model)
 }
 
+// renderPreloads renders the Preload chain of the paths, the children of an
+// ordered has-many association are loaded by position
+func (b *ORMBuilder) renderPreloads(ormable *OrmableType, paths []*fieldPath, g *protogen.GeneratedFile) string {
+	preloadBldr := strings.Builder{}
+	for _, path := range paths {
+		preloadBldr.WriteString(`Preload(`)
+		preloadBldr.WriteString(path.Quoted())
+		if order := b.preloadOrder(ormable, path.String()); order != "" {
+			preloadBldr.WriteString(`, func(db *`)
+			preloadBldr.WriteString(generateImport("DB", gormImport, g))
+			preloadBldr.WriteString(`) *gorm.DB { return db.Order("`)
+			preloadBldr.WriteString(order)
+			preloadBldr.WriteString(`") }`)
+		}
+		preloadBldr.WriteString(`).`)
+	}
+	return preloadBldr.String()
+}
+
+// preloadOrder returns the position column the association at the path is
+// ordered by, if any
+func (b *ORMBuilder) preloadOrder(ormable *OrmableType, path string) string {
+	var field *Field
+	for _, name := range strings.Split(path, ".") {
+		if ormable == nil {
+			return ""
+		}
+		if field = ormable.Fields[name]; field == nil {
+			return ""
+		}
+		ormable = field.Type
+	}
+	posField := field.GetHasMany().GetPositionField()
+	if posField == "" || ormable == nil {
+		return ""
+	}
+	return columnName(posField, ormable.Fields[posField])
+}
+
 // fieldPathsToQuoted parses each path and qoutes it in ["{path.String()}",] separated by ','
 func fieldPathsToQuoted(paths []*fieldPath) string {
 	strs := make([]string, 0, len(paths))
@@ -1850,18 +2451,12 @@ func (b *ORMBuilder) generateCreateHandler(message *protogen.Message, g *protoge
 		},
 	)
 
-	preloadBldr := strings.Builder{}
-	for _, path := range preloadPaths {
-		preloadBldr.WriteString(`Preload(`)
-		preloadBldr.WriteString(path.Quoted())
-		preloadBldr.WriteString(`).`)
-	}
-
 	g.P(`if err = db.Omit(`, fieldPathsToQuoted(omitPaths), `).`,
-		preloadBldr.String(),
+		b.renderPreloads(orm, preloadPaths, g),
 		`Create(&ormObj).Error; err != nil {`)
 	g.P(`return nil, err`)
 	g.P(`}`)
+	b.generateSavePositionsCall(orm, "&ormObj", "return nil, ", g)
 	b.generateAfterHookCall(orm, create, g)
 	g.P(`pbResponse, err := ormObj.ToPB(ctx)`)
 	g.P(`return &pbResponse, err`)
@@ -1870,6 +2465,285 @@ func (b *ORMBuilder) generateCreateHandler(message *protogen.Message, g *protoge
 	b.generateAfterHookDef(orm, create, g)
 }
 
+// defaultBatchSize bounds the rows written per statement by the set handlers
+// when the batch_size option is not set
+const defaultBatchSize = 100
+
+func getBatchSize(message *protogen.Message) int32 {
+	if size := getMessageOptions(message).GetBatchSize(); size > 0 {
+		return size
+	}
+	return defaultBatchSize
+}
+
+func (b *ORMBuilder) generateCreateSetHandler(message *protogen.Message, g *protogen.GeneratedFile) {
+	typeName := string(message.Desc.Name())
+	orm := b.getOrmable(typeName)
+	create := "Create_"
+
+	g.P(`// DefaultCreate`, typeName, `Set executes gorm create calls in batches of `, getBatchSize(message), ` objects`)
+	g.P(`// in a transaction, either all of the objects are created or none of them. The Before`, create, ` hook`)
+	g.P(`// of each object runs before the insert of its batch, which uses the handle the hooks of the`)
+	g.P(`// batch return`)
+	g.P(`func DefaultCreate`, typeName, `Set(ctx context.Context, in []*`,
+		typeName, `, db *`, generateImport("DB", gormImport, g), `) ([]*`, typeName, `, error) {`)
+	g.P(`if in == nil {`)
+	g.P(`return nil, `, generateImport("NilArgumentError", gerrorsImport, g))
+	g.P(`}`)
+	g.P(`ormObjs := make([]`, orm.Name, `, 0, len(in))`)
+	g.P(`for _, obj := range in {`)
+	g.P(`if obj == nil {`)
+	g.P(`return nil, `, generateImport("NilArgumentError", gerrorsImport, g))
+	g.P(`}`)
+	g.P(`ormObj, err := obj.ToORM(ctx)`)
+	g.P(`if err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	g.P(`ormObjs = append(ormObjs, ormObj)`)
+	g.P(`}`)
+	omitPaths := parseRecursiveFields(orm,
+		func(f *Field) bool {
+			// check only fields with association info (e.g. other Gorm types)
+			if f.FieldAssociationInfo == nil {
+				return false
+			}
+			// omit field if autocreate disabled
+			return f.FieldAssociationInfo.GetDisableAssociationAutocreate()
+		},
+	)
+	omit := fieldPathsToQuoted(omitPaths)
+	g.P(`batchSize := `, getBatchSize(message))
+	g.P(`err := db.Transaction(func(db *`, generateImport("DB", gormImport, g), `) error {`)
+	g.P(`var err error`)
+	g.P(`for start := 0; start < len(ormObjs); start += batchSize {`)
+	g.P(`end := start + batchSize`)
+	g.P(`if end > len(ormObjs) {`)
+	g.P(`end = len(ormObjs)`)
+	g.P(`}`)
+	g.P(`batch := ormObjs[start:end]`)
+	g.P(`// the scopes the hooks add only apply to the insert of their own batch`)
+	g.P(`batchDB := db`)
+	g.P(`for i := range batch {`)
+	g.P(`if hook, ok := interface{}(&batch[i]).(`, orm.Name, `WithBefore`, create, `); ok {`)
+	g.P(`if batchDB, err = hook.Before`, create, `(ctx, batchDB); err != nil {`)
+	g.P(`return err`)
+	g.P(`}`)
+	g.P(`}`)
+	g.P(`}`)
+	g.P(`if err = batchDB.Omit(`, omit, `).CreateInBatches(&batch, batchSize).Error; err != nil {`)
+	g.P(`return err`)
+	g.P(`}`)
+	g.P(`for i := range batch {`)
+	b.generateSavePositionsCall(orm, "&batch[i]", "return ", g)
+	g.P(`if hook, ok := interface{}(&batch[i]).(`, orm.Name, `WithAfter`, create, `); ok {`)
+	g.P(`if err = hook.After`, create, `(ctx, db); err != nil {`)
+	g.P(`return err`)
+	g.P(`}`)
+	g.P(`}`)
+	g.P(`}`)
+	g.P(`}`)
+	g.P(`return nil`)
+	g.P(`})`)
+	g.P(`if err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	g.P(`results := make([]*`, typeName, `, 0, len(ormObjs))`)
+	g.P(`for i := range ormObjs {`)
+	g.P(`pbResponse, err := ormObjs[i].ToPB(ctx)`)
+	g.P(`if err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	g.P(`results = append(results, &pbResponse)`)
+	g.P(`}`)
+	g.P(`return results, nil`)
+	g.P(`}`)
+	g.P()
+}
+
+// hasUpsert reports whether DefaultUpsert is generated for the type, versions
+// can't be compared on conflict, and the account of multi-account types is only
+// guarded by the conflict condition of postgres
+func (b *ORMBuilder) hasUpsert(ormable *OrmableType) bool {
+	if _, version := b.findVersionField(ormable); version != nil {
+		return false
+	}
+	_, multiAccount := ormable.Fields["AccountID"]
+	return !multiAccount || b.dbEngine == ENGINE_POSTGRES
+}
+
+func (b *ORMBuilder) generateUpsertHandler(message *protogen.Message, g *protogen.GeneratedFile) {
+	typeName := string(message.Desc.Name())
+	orm := b.getOrmable(typeName)
+	_ = generateImport("", "fmt", g)
+	onConflict := generateImport("OnConflict", gormClauseImport, g)
+	clauseColumn := generateImport("Column", gormClauseImport, g)
+
+	g.P(`// DefaultUpsert`, typeName, ` executes a gorm create call resolving conflicts on conflictTarget,`)
+	g.P(`// the name of a unique index or the primary key when empty, by updating the columns`)
+	g.P(`// in updateMask, all of them when updateMask is empty, and returns the stored object`)
+	if getMessageOptions(message).GetMultiAccount() {
+		g.P(`// gorm.ErrRecordNotFound is returned when the conflicting row belongs to another account`)
+	}
+	g.P(`func DefaultUpsert`, typeName, `(ctx context.Context, in *`, typeName, `, conflictTarget string, updateMask *`,
+		generateImport("FieldMask", fmImport, g), `, db *`, generateImport("DB", gormImport, g), `) (*`, typeName, `, error) {`)
+	g.P(`if in == nil {`)
+	g.P(`return nil, `, generateImport("NilArgumentError", gerrorsImport, g))
+	g.P(`}`)
+	g.P(`ormObj, err := in.ToORM(ctx)`)
+	g.P(`if err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+
+	multiAccount := getMessageOptions(message).GetMultiAccount()
+	// the conflict columns, and the lookup of the stored row by their values
+	renderColumns := func(keys []pkFieldObjs) (string, string) {
+		var columns, lookup []string
+		for _, key := range keys {
+			columns = append(columns, `{Name: "`+columnName(key.name, key.field)+`"}`)
+			lookup = append(lookup, `"`+columnName(key.name, key.field)+`": ormObj.`+key.name)
+		}
+		if multiAccount {
+			lookup = append(lookup, `"account_id": ormObj.AccountID`)
+		}
+		return strings.Join(columns, ", "), strings.Join(lookup, ", ")
+	}
+	// the lookup reads the keys once the create back-filled the generated ones
+	g.P(`conflict := `, onConflict, `{}`)
+	g.P(`var lookup func() map[string]interface{}`)
+	g.P(`switch conflictTarget {`)
+	g.P(`case "":`)
+	columns, lookup := renderColumns(b.getPrimaryKeys(orm))
+	g.P(`conflict.Columns = []`, clauseColumn, `{`, columns, `}`)
+	g.P(`lookup = func() map[string]interface{} { return map[string]interface{}{`, lookup, `} }`)
+	for _, key := range b.getUniqueKeys(orm) {
+		columns, lookup := renderColumns(key.fields)
+		g.P(`case "`, strings.Join(append([]string{key.name}, key.aliases...), `", "`), `":`)
+		g.P(`conflict.Columns = []`, clauseColumn, `{`, columns, `}`)
+		g.P(`lookup = func() map[string]interface{} { return map[string]interface{}{`, lookup, `} }`)
+	}
+	g.P(`default:`)
+	g.P(`return nil, fmt.Errorf("unknown conflict target %q of `, typeName, `", conflictTarget)`)
+	g.P(`}`)
+
+	// the paths follow the Go field names of the patch masks, the primary key
+	// can't be updated and neither can the columns of the conflict target
+	primaryKeys := map[string]bool{}
+	for _, key := range b.getPrimaryKeys(orm) {
+		primaryKeys[key.name] = true
+	}
+	// the cases map each path to its columns
+	var cases [][2]string
+	for _, field := range message.Fields {
+		fieldName := camelCase(string(field.Desc.Name()))
+		ormField, ok := orm.Fields[fieldName]
+		if ok && b.isEmbeddedField(message, field) {
+			// an embedded message updates its columns, all of them or a single one
+			var all []string
+			for _, sub := range field.Message.Fields {
+				subName := camelCase(string(sub.Desc.Name()))
+				subField, ok := ormField.Type.Fields[subName]
+				if !ok || subField.Type != nil {
+					continue
+				}
+				column := strconv.Quote(ormField.GetTag().GetEmbeddedPrefix() + columnName(subName, subField))
+				cases = append(cases, [2]string{field.GoName + `.` + sub.GoName, column})
+				all = append(all, column)
+			}
+			if len(all) > 0 {
+				cases = append(cases, [2]string{field.GoName, strings.Join(all, ", ")})
+			}
+			continue
+		}
+		if !ok || ormField.Type != nil || primaryKeys[fieldName] {
+			continue
+		}
+		cases = append(cases, [2]string{field.GoName, `"` + columnName(fieldName, ormField) + `"`})
+	}
+	invalidFieldMask := generateImport("InvalidFieldMaskError", gerrorsImport, g)
+	g.P(`if len(updateMask.GetPaths()) == 0 {`)
+	g.P(`conflict.UpdateAll = true`)
+	if len(cases) == 0 {
+		g.P(`} else {`)
+		g.P(`return nil, &`, invalidFieldMask, `{Path: updateMask.GetPaths()[0]}`)
+		g.P(`}`)
+	} else {
+		g.P(`} else {`)
+		g.P(`var columns []string`)
+		g.P(`for _, path := range updateMask.GetPaths() {`)
+		g.P(`var pathColumns []string`)
+		g.P(`switch path {`)
+		for _, c := range cases {
+			g.P(`case "`, c[0], `":`)
+			g.P(`pathColumns = []string{`, c[1], `}`)
+		}
+		g.P(`default:`)
+		g.P(`return nil, &`, invalidFieldMask, `{Path: path}`)
+		g.P(`}`)
+		g.P(`for _, column := range pathColumns {`)
+		g.P(`for _, target := range conflict.Columns {`)
+		g.P(`if column == target.Name {`)
+		g.P(`return nil, &`, invalidFieldMask, `{Path: path}`)
+		g.P(`}`)
+		g.P(`}`)
+		g.P(`}`)
+		g.P(`columns = append(columns, pathColumns...)`)
+		g.P(`}`)
+		g.P(`conflict.DoUpdates = `, generateImport("AssignmentColumns", gormClauseImport, g), `(columns)`)
+		g.P(`}`)
+	}
+
+	if multiAccount {
+		// never take over the row of another account on conflict
+		g.P(`conflict.Where = `, generateImport("Where", gormClauseImport, g), `{Exprs: []`, generateImport("Expression", gormClauseImport, g), `{`,
+			generateImport("Eq", gormClauseImport, g), `{Column: `, clauseColumn, `{Table: "`, getTableName(message), `", Name: "account_id"}, Value: ormObj.AccountID}}}`)
+	}
+
+	upsert := "Upsert"
+	b.generateBeforeHookCall(orm, upsert, g)
+	omitPaths := parseRecursiveFields(orm,
+		func(f *Field) bool {
+			// check only fields with association info (e.g. other Gorm types)
+			if f.FieldAssociationInfo == nil {
+				return false
+			}
+			// omit field if autocreate disabled
+			return f.FieldAssociationInfo.GetDisableAssociationAutocreate()
+		},
+	)
+	preloadPaths := parseRecursiveFields(orm,
+		func(f *Field) bool {
+			// check only fields with association info (e.g. other Gorm types)
+			if f.FieldAssociationInfo == nil {
+				return false
+			}
+			return f.FieldAssociationInfo.GetPreload()
+		},
+	)
+	g.P(`if err = db.Clauses(conflict).Omit(`, fieldPathsToQuoted(omitPaths), `).Create(&ormObj).Error; err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	b.generateSavePositionsCall(orm, "&ormObj", "return nil, ", g)
+	// the stored row differs from the payload when the conflict updates a part of the
+	// columns, and a conflict with the row of another account leaves it untouched
+	if !multiAccount {
+		g.P(`if len(updateMask.GetPaths()) > 0 {`)
+	}
+	g.P(`stored := `, orm.Name, `{}`)
+	g.P(`if err = db.Where(lookup()).`, b.renderPreloads(orm, preloadPaths, g), `Take(&stored).Error; err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	g.P(`ormObj = stored`)
+	if !multiAccount {
+		g.P(`}`)
+	}
+	b.generateAfterHookCall(orm, upsert, g)
+	g.P(`pbResponse, err := ormObj.ToPB(ctx)`)
+	g.P(`return &pbResponse, err`)
+	g.P(`}`)
+	b.generateBeforeHookDef(orm, upsert, g)
+	b.generateAfterHookDef(orm, upsert, g)
+}
+
 func (b *ORMBuilder) generateBeforeHookCall(orm *OrmableType, method string, g *protogen.GeneratedFile) {
 	g.P(`if hook, ok := interface{}(&ormObj).(`, orm.Name, `WithBefore`, method, `); ok {`)
 	g.P(`if db, err = hook.Before`, method, `(ctx, db); err != nil {`)
@@ -1909,12 +2783,60 @@ func (b *ORMBuilder) generateReadHandler(message *protogen.Message, g *protogen.
 	typeName := string(message.Desc.Name())
 	ormable := b.getOrmable(typeName)
 
+	b.generateReadByKeyHandler(message, `DefaultRead`+typeName, b.getPrimaryKeys(ormable), g)
+
+	b.generateBeforeReadHookDef(ormable, "ApplyQuery", g)
+	b.generateBeforeReadHookDef(ormable, "Find", g)
+	b.generateAfterReadHookDef(ormable, g)
+
+}
+
+// generateReadByHandlers renders a read handler for each unique key of the type,
+// they share the hooks of DefaultRead
+func (b *ORMBuilder) generateReadByHandlers(message *protogen.Message, g *protogen.GeneratedFile) {
+	typeName := string(message.Desc.Name())
+	for _, key := range b.getUniqueKeys(b.getOrmable(typeName)) {
+		handlerName := readByHandlerName(key, typeName)
+		g.P(`// `, handlerName, ` executes a basic gorm read call looking the object up by its `, key.name, ` unique key`)
+		b.generateReadByKeyHandler(message, handlerName, key.fields, g)
+	}
+}
+
+// readByHandlerName names the read handler of a unique key after its field, or
+// after the index for a group of fields
+func readByHandlerName(key uniqueKey, typeName string) string {
+	if len(key.fields) == 1 {
+		return `DefaultReadBy` + key.fields[0].name + typeName
+	}
+	return `DefaultReadBy` + camelCase(key.name) + typeName
+}
+
+// findUniqueKey returns the unique key of the type named like the index or the column
+func (b *ORMBuilder) findUniqueKey(ormable *OrmableType, name string) (uniqueKey, bool) {
+	for _, key := range b.getUniqueKeys(ormable) {
+		if key.named(name) {
+			return key, true
+		}
+	}
+	return uniqueKey{}, false
+}
+
+// generateReadByKeyHandler renders a read handler, the key fields of the
+// object passed in must be set
+func (b *ORMBuilder) generateReadByKeyHandler(message *protogen.Message, handlerName string, keys []pkFieldObjs, g *protogen.GeneratedFile) {
+	typeName := string(message.Desc.Name())
+	ormable := b.getOrmable(typeName)
+
+	var showDeleted string
+	if b.readHasShowDeleted(ormable) {
+		showDeleted = `, showDeleted bool`
+	}
 	if b.readHasFieldSelection(ormable) {
-		g.P(`func DefaultRead`, typeName, `(ctx context.Context, in *`,
-			typeName, `, db *`, generateImport("DB", gormImport, g), `, fs *`, generateImport("FieldSelection", queryImport, g), `) (*`, typeName, `, error) {`)
+		g.P(`func `, handlerName, `(ctx context.Context, in *`,
+			typeName, `, db *`, generateImport("DB", gormImport, g), `, fs *`, generateImport("FieldSelection", queryImport, g), showDeleted, `) (*`, typeName, `, error) {`)
 	} else {
-		g.P(`func DefaultRead`, typeName, `(ctx context.Context, in *`,
-			typeName, `, db *`, "gorm", `.DB) (*`, typeName, `, error) {`)
+		g.P(`func `, handlerName, `(ctx context.Context, in *`,
+			typeName, `, db *`, "gorm", `.DB`, showDeleted, `) (*`, typeName, `, error) {`)
 	}
 	g.P(`if in == nil {`)
 	g.P(`return nil, `, "errors", `.NilArgumentError`)
@@ -1924,9 +2846,11 @@ func (b *ORMBuilder) generateReadHandler(message *protogen.Message, g *protogen.
 	g.P(`if err != nil {`)
 	g.P(`return nil, err`)
 	g.P(`}`)
+	if showDeleted != "" {
+		b.generateUnscoped(ormable, g)
+	}
 
-	pkfieldMapping := b.getPrimaryKeys(ormable)
-	for _, pkfieldObj := range pkfieldMapping {
+	for _, pkfieldObj := range keys {
 		if strings.Contains(pkfieldObj.field.TypeName, "*") {
 			g.P(`if ormObj.`, pkfieldObj.name, ` == nil || *ormObj.`, pkfieldObj.name, ` == `, b.guessZeroValue(pkfieldObj.field.TypeName, g), ` {`)
 		} else {
@@ -1952,25 +2876,85 @@ func (b *ORMBuilder) generateReadHandler(message *protogen.Message, g *protogen.
 
 	b.generateBeforeReadHookCall(ormable, "Find", g)
 	g.P(`ormResponse := `, ormable.Name, `{}`)
+	// struct conditions already include the partition keys when they are set
 	g.P(`if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {`)
 	g.P(`return nil, err`)
 	g.P(`}`)
+	b.generateSortPositionsCall(ormable, "&ormResponse", "return nil, ", g)
 
 	b.generateAfterReadHookCall(ormable, g)
 	g.P(`pbResponse, err := ormResponse.ToPB(ctx)`)
 	g.P(`return &pbResponse, err`)
 	g.P(`}`)
+}
 
-	b.generateBeforeReadHookDef(ormable, "ApplyQuery", g)
-	b.generateBeforeReadHookDef(ormable, "Find", g)
-	b.generateAfterReadHookDef(ormable, g)
+// readDefaultArgs returns the trailing DefaultRead arguments of an internal read,
+// which selects every field and skips soft deleted rows
+func (b *ORMBuilder) readDefaultArgs(ormable *OrmableType) string {
+	var args string
+	if b.readHasFieldSelection(ormable) {
+		args += `, nil`
+	}
+	if b.readHasShowDeleted(ormable) {
+		args += `, false`
+	}
+	return args
+}
+
+func (b *ORMBuilder) readHasShowDeleted(ormable *OrmableType) bool {
+	if !b.hasSoftDelete(ormable) {
+		return false
+	}
+	for _, method := range ormable.Methods {
+		if method.verb == readService && b.getShowDeleted(method.inType) != "" {
+			return true
+		}
+	}
+	return false
+}
+
+func (b *ORMBuilder) listHasShowDeleted(ormable *OrmableType) bool {
+	if !b.hasSoftDelete(ormable) {
+		return false
+	}
+	for _, method := range ormable.Methods {
+		if method.verb == listService && b.getShowDeleted(method.inType) != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// getShowDeleted returns the name of the bool "show_deleted" field of the request
+func (b *ORMBuilder) getShowDeleted(message *protogen.Message) string {
+	for _, field := range message.Fields {
+		if string(field.Desc.Name()) == "show_deleted" && field.Desc.Kind() == protoreflect.BoolKind {
+			return field.GoName
+		}
+	}
+	return ""
+}
+
+// findSoftDeleteField returns the field tagged with the "deleted_at" type, it
+// maps to gorm.DeletedAt and makes GORM soft delete the rows
+func (b *ORMBuilder) findSoftDeleteField(ormable *OrmableType) (string, *Field) {
+	for fieldName, field := range ormable.Fields {
+		if field.GetTag().GetType() == "deleted_at" {
+			return fieldName, field
+		}
+	}
+	return "", nil
+}
 
+func (b *ORMBuilder) hasSoftDelete(ormable *OrmableType) bool {
+	_, field := b.findSoftDeleteField(ormable)
+	return field != nil
 }
 
 func (b *ORMBuilder) readHasFieldSelection(ormable *OrmableType) bool {
 	for _, method := range ormable.Methods {
 		if method.verb == readService {
-			if s := b.getFieldSelection(method.inType); s != "" {
+			if s := b.getFieldSelection(method.inType); s != "" || b.getView(method.inType) != nil {
 				return true
 			}
 		}
@@ -2077,7 +3061,23 @@ func (b *ORMBuilder) generateDeleteHandler(message *protogen.Message, g *protoge
 		g.P(`}`)
 	}
 
+	// a soft deleted object keeps its children, so that undeleting it restores
+	// them too, they are removed along with it by the hard delete
+	cascaded := len(b.cascadedAssociations(ormable)) > 0 && !b.hasSoftDelete(ormable)
+	if cascaded {
+		// the owned children are deleted in the transaction of the object
+		g.P(`return db.Transaction(func(db *`, generateImport("DB", gormImport, g), `) error {`)
+	}
 	b.generateBeforeDeleteHookCall(ormable, g)
+	if cascaded {
+		pkName, pk := b.cascadeKey(ormable)
+		g.P(`keys := []`, pk.TypeName, `{}`)
+		g.P(`if err = db.Model(&`, ormable.Name, `{}).Where(&ormObj).Pluck("`, columnName(pkName, pk), `", &keys).Error; err != nil {`)
+		g.P(`return err`)
+		g.P(`}`)
+		b.generateCascadeDeleteCall(ormable, "keys", false, g)
+	}
+	// struct conditions already include the partition keys when they are set
 	g.P(`err = db.Where(&ormObj).Delete(&`, ormable.Name, `{}).Error`)
 	g.P(`if err != nil {`)
 	g.P(`return err`)
@@ -2085,6 +3085,9 @@ func (b *ORMBuilder) generateDeleteHandler(message *protogen.Message, g *protoge
 
 	b.generateAfterDeleteHookCall(ormable, g)
 	g.P(`return err`)
+	if cascaded {
+		g.P(`})`)
+	}
 	g.P(`}`)
 	delete := "Delete_"
 	b.generateBeforeHookDef(ormable, delete, g)
@@ -2118,35 +3121,225 @@ func (b *ORMBuilder) generateAfterHookDef(orm *OrmableType, method string, g *pr
 	g.P(`}`)
 }
 
-func (b *ORMBuilder) generateDeleteSetHandler(message *protogen.Message, g *protogen.GeneratedFile) {
-	ns := gschema.NamingStrategy{SingularTable: true}
+// generateUnscoped renders the removal of the soft delete condition when
+// showDeleted is set, along with the deleted_at value of the object that the
+// struct conditions built from it would filter on
+func (b *ORMBuilder) generateUnscoped(ormable *OrmableType, g *protogen.GeneratedFile) {
+	deletedAtName, _ := b.findSoftDeleteField(ormable)
+	g.P(`if showDeleted {`)
+	g.P(`db = db.Unscoped()`)
+	g.P(`ormObj.`, deletedAtName, ` = nil`)
+	g.P(`}`)
+}
+
+// generateUndeleteHandler renders the handler restoring a soft deleted row by
+// clearing its deleted_at column
+func (b *ORMBuilder) generateUndeleteHandler(message *protogen.Message, g *protogen.GeneratedFile) {
 	typeName := string(message.Desc.Name())
-	gormDB := generateImport("DB", gormImport, g)
+	ormable := b.getOrmable(typeName)
+	deletedAtName, deletedAt := b.findSoftDeleteField(ormable)
 
-	g.P(`func DefaultDelete`, typeName, `Set(ctx context.Context, in []*`,
-		typeName, `, db *`, gormDB, `) error {`)
+	g.P(`func DefaultUndelete`, typeName, `(ctx context.Context, in *`,
+		typeName, `, db *`, generateImport("DB", gormImport, g), `) (*`, typeName, `, error) {`)
 	g.P(`if in == nil {`)
-	g.P(`return `, generateImport("NilArgumentError", gerrorsImport, g))
+	g.P(`return nil, `, generateImport("NilArgumentError", gerrorsImport, g))
 	g.P(`}`)
-	g.P(`var err error`)
-	ormable := b.getOrmable(typeName)
-	pkName, pk := b.findPrimaryKey(ormable)
-	g.P(`keys := []`, pk.TypeName, `{}`)
-	g.P(`for _, obj := range in {`)
-	g.P(`ormObj, err := obj.ToORM(ctx)`)
+	g.P(`ormObj, err := in.ToORM(ctx)`)
 	g.P(`if err != nil {`)
-	g.P(`return err`)
+	g.P(`return nil, err`)
 	g.P(`}`)
-	if strings.Contains(pk.TypeName, "*") {
-		g.P(`if ormObj.`, pkName, ` == nil || *ormObj.`, pkName, ` == `, b.guessZeroValue(pk.TypeName, g), ` {`)
+	for _, pkFieldObj := range b.getPrimaryKeys(ormable) {
+		if strings.Contains(pkFieldObj.field.TypeName, "*") {
+			g.P(`if ormObj.`, pkFieldObj.name, ` == nil || *ormObj.`, pkFieldObj.name, ` == `, b.guessZeroValue(pkFieldObj.field.TypeName, g), ` {`)
+		} else {
+			g.P(`if ormObj.`, pkFieldObj.name, ` == `, b.guessZeroValue(pkFieldObj.field.TypeName, g), ` {`)
+		}
+		g.P(`return nil, `, generateImport("EmptyIdError", gerrorsImport, g))
+		g.P(`}`)
+	}
+	g.P(`ormObj.`, deletedAtName, ` = nil`)
+
+	undelete := "Undelete_"
+	b.generateBeforeHookCall(ormable, undelete, g)
+	// struct conditions already include the partition keys when they are set
+	g.P(`res := db.Unscoped().Model(&`, ormable.Name, `{}).Where(&ormObj).Update("`, columnName(deletedAtName, deletedAt), `", nil)`)
+	g.P(`if res.Error != nil {`)
+	g.P(`return nil, res.Error`)
+	g.P(`}`)
+	g.P(`if res.RowsAffected == 0 {`)
+	g.P(`return nil, `, generateImport("ErrRecordNotFound", gormImport, g))
+	g.P(`}`)
+	g.P(`if err = db.Where(&ormObj).First(&ormObj).Error; err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	b.generateAfterHookCall(ormable, undelete, g)
+	g.P(`pbResponse, err := ormObj.ToPB(ctx)`)
+	g.P(`return &pbResponse, err`)
+	g.P(`}`)
+	b.generateBeforeHookDef(ormable, undelete, g)
+	b.generateAfterHookDef(ormable, undelete, g)
+}
+
+// generateHardDeleteHandler renders the handler removing a row for good,
+// regardless of it being soft deleted
+func (b *ORMBuilder) generateHardDeleteHandler(message *protogen.Message, g *protogen.GeneratedFile) {
+	typeName := string(message.Desc.Name())
+	ormable := b.getOrmable(typeName)
+
+	g.P(`func DefaultHardDelete`, typeName, `(ctx context.Context, in *`,
+		typeName, `, db *`, generateImport("DB", gormImport, g), `) error {`)
+	g.P(`if in == nil {`)
+	g.P(`return `, generateImport("NilArgumentError", gerrorsImport, g))
+	g.P(`}`)
+	g.P(`ormObj, err := in.ToORM(ctx)`)
+	g.P(`if err != nil {`)
+	g.P(`return err`)
+	g.P(`}`)
+	for _, pkFieldObj := range b.getPrimaryKeys(ormable) {
+		if strings.Contains(pkFieldObj.field.TypeName, "*") {
+			g.P(`if ormObj.`, pkFieldObj.name, ` == nil || *ormObj.`, pkFieldObj.name, ` == `, b.guessZeroValue(pkFieldObj.field.TypeName, g), ` {`)
+		} else {
+			g.P(`if ormObj.`, pkFieldObj.name, ` == `, b.guessZeroValue(pkFieldObj.field.TypeName, g), ` {`)
+		}
+		g.P(`return `, generateImport("EmptyIdError", gerrorsImport, g))
+		g.P(`}`)
+	}
+	deletedAtName, _ := b.findSoftDeleteField(ormable)
+	g.P(`ormObj.`, deletedAtName, ` = nil`)
+
+	cascaded := len(b.cascadedAssociations(ormable)) > 0
+	if cascaded {
+		// the owned children are deleted for good in the transaction of the object
+		g.P(`return db.Transaction(func(db *`, generateImport("DB", gormImport, g), `) error {`)
+	}
+	g.P(`if hook, ok := interface{}(&ormObj).(`, ormable.Name, `WithBeforeHardDelete_); ok {`)
+	g.P(`if db, err = hook.BeforeHardDelete_(ctx, db); err != nil {`)
+	g.P(`return err`)
+	g.P(`}`)
+	g.P(`}`)
+	if cascaded {
+		pkName, pk := b.cascadeKey(ormable)
+		g.P(`keys := []`, pk.TypeName, `{}`)
+		g.P(`if err = db.Unscoped().Model(&`, ormable.Name, `{}).Where(&ormObj).Pluck("`, columnName(pkName, pk), `", &keys).Error; err != nil {`)
+		g.P(`return err`)
+		g.P(`}`)
+		b.generateCascadeDeleteCall(ormable, "keys", true, g)
+	}
+	g.P(`err = db.Unscoped().Where(&ormObj).Delete(&`, ormable.Name, `{}).Error`)
+	g.P(`if err != nil {`)
+	g.P(`return err`)
+	g.P(`}`)
+	g.P(`if hook, ok := interface{}(&ormObj).(`, ormable.Name, `WithAfterHardDelete_); ok {`)
+	g.P(`err = hook.AfterHardDelete_(ctx, db)`)
+	g.P(`}`)
+	g.P(`return err`)
+	if cascaded {
+		g.P(`})`)
+	}
+	g.P(`}`)
+	hardDelete := "HardDelete_"
+	b.generateBeforeHookDef(ormable, hardDelete, g)
+	b.generateAfterHookDef(ormable, hardDelete, g)
+}
+
+func (b *ORMBuilder) generateDeleteSetHandler(message *protogen.Message, g *protogen.GeneratedFile) {
+	ns := gschema.NamingStrategy{SingularTable: true}
+	typeName := string(message.Desc.Name())
+	gormDB := generateImport("DB", gormImport, g)
+
+	g.P(`func DefaultDelete`, typeName, `Set(ctx context.Context, in []*`,
+		typeName, `, db *`, gormDB, `) error {`)
+	g.P(`if in == nil {`)
+	g.P(`return `, generateImport("NilArgumentError", gerrorsImport, g))
+	g.P(`}`)
+	g.P(`var err error`)
+	ormable := b.getOrmable(typeName)
+	pkName, pk := b.findPrimaryKey(ormable)
+	composite := b.hasCompositePrimaryKey(ormable)
+	partitionKeys := b.getPartitionKeys(message)
+	if composite {
+		g.P(`keys := [][]interface{}{}`)
 	} else {
-		g.P(`if ormObj.`, pkName, ` == `, b.guessZeroValue(pk.TypeName, g), `{`)
+		g.P(`keys := []`, pk.TypeName, `{}`)
+	}
+	for _, key := range partitionKeys {
+		g.P(`partition`, key.name, ` := []`, key.field.TypeName, `{}`)
 	}
-	g.P(`return `, generateImport("EmptyIdError", gerrorsImport, g))
+	g.P(`for _, obj := range in {`)
+	g.P(`ormObj, err := obj.ToORM(ctx)`)
+	g.P(`if err != nil {`)
+	g.P(`return err`)
 	g.P(`}`)
-	g.P(`keys = append(keys, ormObj.`, pkName, `)`)
+	if composite {
+		var values []string
+		for _, key := range b.getPrimaryKeys(ormable) {
+			if cond := b.emptyKeyCondition("ormObj."+key.name, key.field, g); cond != "" {
+				g.P(`if `, cond, ` {`)
+				g.P(`return `, generateImport("EmptyIdError", gerrorsImport, g))
+				g.P(`}`)
+			}
+			values = append(values, "ormObj."+key.name)
+		}
+		g.P(`keys = append(keys, []interface{}{`, strings.Join(values, ", "), `})`)
+	} else {
+		if strings.Contains(pk.TypeName, "*") {
+			g.P(`if ormObj.`, pkName, ` == nil || *ormObj.`, pkName, ` == `, b.guessZeroValue(pk.TypeName, g), ` {`)
+		} else {
+			g.P(`if ormObj.`, pkName, ` == `, b.guessZeroValue(pk.TypeName, g), `{`)
+		}
+		g.P(`return `, generateImport("EmptyIdError", gerrorsImport, g))
+		g.P(`}`)
+		g.P(`keys = append(keys, ormObj.`, pkName, `)`)
+	}
+	for _, key := range partitionKeys {
+		if guard := b.partitionKeyGuard("ormObj."+key.name, key.field, g); guard != "" {
+			g.P(`if `, guard, ` {`)
+			g.P(`partition`, key.name, ` = append(partition`, key.name, `, ormObj.`, key.name, `)`)
+			g.P(`}`)
+		} else {
+			g.P(`partition`, key.name, ` = append(partition`, key.name, `, ormObj.`, key.name, `)`)
+		}
+	}
 	g.P(`}`)
+	for _, key := range partitionKeys {
+		// prune partitions only when every object carries its partition key
+		g.P(`if len(partition`, key.name, `) == len(keys) {`)
+		g.P(`db = db.Where("`, columnName(key.name, key.field), ` in (?)", partition`, key.name, `)`)
+		g.P(`}`)
+	}
+	// soft deleted objects keep their children, as in DefaultDelete
+	cascaded := len(b.cascadedAssociations(ormable)) > 0 && !b.hasSoftDelete(ormable)
+	if cascaded {
+		// the owned children are deleted in the transaction of the objects
+		g.P(`return db.Transaction(func(db *`, gormDB, `) error {`)
+	}
 	b.generateBeforeDeleteSetHookCall(ormable, g)
+	if cascaded {
+		pkName, pk := b.cascadeKey(ormable)
+		// only the objects of the account own the children deleted
+		g.P(`owned := []`, pk.TypeName, `{}`)
+		g.P(`query := db.Model(&`, ormable.Name, `{}).Where("`, columnName(pkName, pk), ` in (?)", keys)`)
+		if getMessageOptions(message).GetMultiAccount() {
+			g.P(`accountID, err := `, generateImport("GetAccountID", authImport, g), `(ctx, nil)`)
+			g.P(`if err != nil {`)
+			g.P(`return err`)
+			g.P(`}`)
+			g.P(`query = query.Where(map[string]interface{}{"account_id": accountID})`)
+		}
+		g.P(`if err = query.Pluck("`, columnName(pkName, pk), `", &owned).Error; err != nil {`)
+		g.P(`return err`)
+		g.P(`}`)
+		b.generateCascadeDeleteCall(ormable, "owned", false, g)
+	}
+
+	// keysCond restricts the statement to keys, it is empty for composite keys
+	// as they are filtered before
+	keysCond := ` AND ` + ns.TableName(pkName) + ` in (?)`
+	keysArg := `, keys`
+	if composite {
+		b.generateCompositeKeysWhereClause(ormable, "db", g)
+		keysCond, keysArg = ``, ``
+	}
 
 	if getMessageOptions(message).GetMultiAccount() {
 		g.P(`accountId, err := `, generateImport("GetAccountID", authImport, g), `(ctx, nil)`)
@@ -2160,22 +3353,27 @@ func (b *ORMBuilder) generateDeleteSetHandler(message *protogen.Message, g *prot
 			g.P(`return err`)
 			g.P(`}`)
 			g.P(`if compartmentId != "" {`)
-			g.P(`err = db.Where("account_id = ? AND compartment_id like ?% AND `, ns.TableName(pkName), ` in (?)", accountId, compartmentId, keys).Delete(&`, ormable.Name, `{}).Error`)
+			g.P(`err = db.Where("account_id = ? AND compartment_id like ?%`, keysCond, `", accountId, compartmentId`, keysArg, `).Delete(&`, ormable.Name, `{}).Error`)
 			g.P(`if err != nil {`)
 			g.P(`return err`)
 			g.P(`}`)
 			g.P(`} else {`)
-			g.P(`err = db.Where("account_id = ? AND `, ns.TableName(pkName), ` in (?)", accountId, keys).Delete(&`, ormable.Name, `{}).Error`)
+			g.P(`err = db.Where("account_id = ?`, keysCond, `", accountId`, keysArg, `).Delete(&`, ormable.Name, `{}).Error`)
 			g.P(`if err != nil {`)
 			g.P(`return err`)
 			g.P(`}`)
 			g.P(`}`)
 		} else {
-			g.P(`err = db.Where("account_id = ? AND `, ns.TableName(pkName), ` in (?)", accountId, keys).Delete(&`, ormable.Name, `{}).Error`)
+			g.P(`err = db.Where("account_id = ?`, keysCond, `", accountId`, keysArg, `).Delete(&`, ormable.Name, `{}).Error`)
 			g.P(`if err != nil {`)
 			g.P(`return err`)
 			g.P(`}`)
 		}
+	} else if composite {
+		g.P(`err = db.Delete(&`, ormable.Name, `{}).Error`)
+		g.P(`if err != nil {`)
+		g.P(`return err`)
+		g.P(`}`)
 	} else {
 		g.P(`err = db.Where("`, ns.TableName(pkName), ` in (?)", keys).Delete(&`, ormable.Name, `{}).Error`)
 		g.P(`if err != nil {`)
@@ -2185,6 +3383,9 @@ func (b *ORMBuilder) generateDeleteSetHandler(message *protogen.Message, g *prot
 
 	b.generateAfterDeleteSetHookCall(ormable, g)
 	g.P(`return err`)
+	if cascaded {
+		g.P(`})`)
+	}
 	g.P(`}`)
 	g.P(`type `, ormable.Name, `WithBeforeDeleteSet interface {`)
 	g.P(`BeforeDeleteSet(context.Context, []*`, ormable.OriginName, `, *`, gormDB, `) (*`, gormDB, `, error)`)
@@ -2194,6 +3395,254 @@ func (b *ORMBuilder) generateDeleteSetHandler(message *protogen.Message, g *prot
 	g.P(`}`)
 }
 
+// positionedAssociations returns the many-to-many associations of the type
+// keeping the order of the children in the position column of their join model
+func (b *ORMBuilder) positionedAssociations(ormable *OrmableType) []string {
+	var fieldNames []string
+	for name, field := range ormable.Fields {
+		if field.GetManyToMany().GetPositionField() != "" {
+			fieldNames = append(fieldNames, name)
+		}
+	}
+	sort.Strings(fieldNames)
+
+	return fieldNames
+}
+
+// generateSavePositionsCall renders the update of the positions of the links
+// of the objects in the expression, once they are saved, the links are not
+// restricted by the conditions set on the objects
+func (b *ORMBuilder) generateSavePositionsCall(ormable *OrmableType, objs string, failure string, g *protogen.GeneratedFile) {
+	if len(b.positionedAssociations(ormable)) == 0 {
+		return
+	}
+	g.P(`if err = DefaultSave`, ormable.OriginName, `Positions(ctx, db.Session(&`, generateImport("Session", gormImport, g), `{NewDB: true}), `, objs, `); err != nil {`)
+	g.P(failure, `err`)
+	g.P(`}`)
+}
+
+// generateSortPositionsCall renders the ordering of the children of the loaded
+// objects in the expression by the positions of their links
+func (b *ORMBuilder) generateSortPositionsCall(ormable *OrmableType, objs string, failure string, g *protogen.GeneratedFile) {
+	if len(b.positionedAssociations(ormable)) == 0 {
+		return
+	}
+	g.P(`if err = DefaultSort`, ormable.OriginName, `Positions(ctx, db.Session(&`, generateImport("Session", gormImport, g), `{NewDB: true}), `, objs, `); err != nil {`)
+	g.P(failure, `err`)
+	g.P(`}`)
+}
+
+// generatePositionHandlers renders the handlers storing the order of the
+// children of the positioned many-to-many associations in their join model,
+// and restoring it when the children are loaded
+func (b *ORMBuilder) generatePositionHandlers(message *protogen.Message, g *protogen.GeneratedFile) {
+	typeName := string(message.Desc.Name())
+	ormable := b.getOrmable(typeName)
+	fieldNames := b.positionedAssociations(ormable)
+	if len(fieldNames) == 0 {
+		return
+	}
+	gormDB := generateImport("DB", gormImport, g)
+
+	g.P(`// DefaultSave`, typeName, `Positions stores the position of the children of the`)
+	g.P(`// many-to-many associations of the saved objects in their links`)
+	g.P(`func DefaultSave`, typeName, `Positions(ctx context.Context, db *`, gormDB, `, objs ...*`, ormable.Name, `) error {`)
+	g.P(`for _, obj := range objs {`)
+	for _, fieldName := range fieldNames {
+		mtm := ormable.Fields[fieldName].GetManyToMany()
+		joinModel := b.getOrmable(mtm.GetThrough())
+		conds := columnName(mtm.GetJointableForeignkey(), joinModel.Fields[mtm.GetJointableForeignkey()]) + " = ? AND " +
+			columnName(mtm.GetAssociationJointableForeignkey(), joinModel.Fields[mtm.GetAssociationJointableForeignkey()]) + " = ?"
+		g.P(`for i, child := range obj.`, fieldName, ` {`)
+		g.P(`if err := db.WithContext(ctx).Model(&`, joinModel.Name, `{}).Where("`, conds, `", obj.`, mtm.GetForeignkey(), `, child.`, mtm.GetAssociationForeignkey(), `).Update("`,
+			columnName(mtm.GetPositionField(), joinModel.Fields[mtm.GetPositionField()]), `", i).Error; err != nil {`)
+		g.P(`return err`)
+		g.P(`}`)
+		g.P(`}`)
+	}
+	g.P(`}`)
+	g.P(`return nil`)
+	g.P(`}`)
+	g.P()
+
+	g.P(`// DefaultSort`, typeName, `Positions orders the children of the many-to-many`)
+	g.P(`// associations of the loaded objects by the position of their links`)
+	g.P(`func DefaultSort`, typeName, `Positions(ctx context.Context, db *`, gormDB, `, objs ...*`, ormable.Name, `) error {`)
+	for _, fieldName := range fieldNames {
+		mtm := ormable.Fields[fieldName].GetManyToMany()
+		joinModel := b.getOrmable(mtm.GetThrough())
+		ownerKey := joinModel.Fields[mtm.GetJointableForeignkey()]
+		childKey := joinModel.Fields[mtm.GetAssociationJointableForeignkey()]
+		prefix := strings.ToLower(fieldName[:1]) + fieldName[1:]
+		owner := "obj." + mtm.GetForeignkey()
+		ownerValue, ownerSet, ownerUnset := owner, "", ""
+		if strings.HasPrefix(ormable.Fields[mtm.GetForeignkey()].TypeName, "*") {
+			ownerValue, ownerSet, ownerUnset = "*"+owner, " && "+owner+" != nil", " || "+owner+" == nil"
+		}
+		childValue := func(index string) string {
+			value := "children[" + index + "]." + mtm.GetAssociationForeignkey()
+			if strings.HasPrefix(ormable.Fields[fieldName].Type.Fields[mtm.GetAssociationForeignkey()].TypeName, "*") {
+				return "*" + value
+			}
+			return value
+		}
+		g.P(prefix, `Keys := []`, ownerKey.TypeName, `{}`)
+		g.P(`for _, obj := range objs {`)
+		g.P(`if len(obj.`, fieldName, `) > 1`, ownerSet, ` {`)
+		g.P(prefix, `Keys = append(`, prefix, `Keys, `, ownerValue, `)`)
+		g.P(`}`)
+		g.P(`}`)
+		g.P(`if len(`, prefix, `Keys) > 0 {`)
+		g.P(prefix, `Links := []`, joinModel.Name, `{}`)
+		g.P(`if err := db.WithContext(ctx).Where("`, columnName(mtm.GetJointableForeignkey(), ownerKey), ` IN ?", `, prefix, `Keys).Find(&`, prefix, `Links).Error; err != nil {`)
+		g.P(`return err`)
+		g.P(`}`)
+		g.P(prefix, `Positions := map[`, ownerKey.TypeName, `]map[`, childKey.TypeName, `]int{}`)
+		g.P(`for _, link := range `, prefix, `Links {`)
+		g.P(`if `, prefix, `Positions[link.`, mtm.GetJointableForeignkey(), `] == nil {`)
+		g.P(prefix, `Positions[link.`, mtm.GetJointableForeignkey(), `] = map[`, childKey.TypeName, `]int{}`)
+		g.P(`}`)
+		g.P(prefix, `Positions[link.`, mtm.GetJointableForeignkey(), `][link.`, mtm.GetAssociationJointableForeignkey(), `] = int(link.`, mtm.GetPositionField(), `)`)
+		g.P(`}`)
+		g.P(`for _, obj := range objs {`)
+		g.P(`if len(obj.`, fieldName, `) < 2`, ownerUnset, ` {`)
+		g.P(`continue`)
+		g.P(`}`)
+		g.P(`children, positions := obj.`, fieldName, `, `, prefix, `Positions[`, ownerValue, `]`)
+		g.P(generateImport("SliceStable", stdSortImport, g), `(children, func(i, j int) bool {`)
+		g.P(`return positions[`, childValue("i"), `] < positions[`, childValue("j"), `]`)
+		g.P(`})`)
+		g.P(`}`)
+		g.P(`}`)
+	}
+	g.P(`return nil`)
+	g.P(`}`)
+	g.P()
+}
+
+// cascadedAssociations returns the has-one and has-many associations of the type
+// whose children are deleted along with it
+func (b *ORMBuilder) cascadedAssociations(ormable *OrmableType) []string {
+	var fieldNames []string
+	for name, field := range ormable.Fields {
+		if field.GetHasOne().GetCascadeDelete() || field.GetHasMany().GetCascadeDelete() {
+			fieldNames = append(fieldNames, name)
+		}
+	}
+	sort.Strings(fieldNames)
+
+	return fieldNames
+}
+
+// cascadeKey returns the primary key the cascaded deletes select the objects by
+func (b *ORMBuilder) cascadeKey(ormable *OrmableType) (string, *Field) {
+	keys := b.getPrimaryKeys(ormable)
+	if len(keys) != 1 {
+		panic(fmt.Sprintf("Cascaded deletes of %s need a single primary key", ormable.Name))
+	}
+
+	return keys[0].name, keys[0].field
+}
+
+// generateCascadeDeleteCall renders the deletion of the children owned by the
+// objects of the primary keys collected in the keys variable, unscoped deletes
+// remove the soft deletable children for good
+func (b *ORMBuilder) generateCascadeDeleteCall(ormable *OrmableType, keys string, unscoped bool, g *protogen.GeneratedFile) {
+	// the children are not restricted by the conditions set on the object
+	session := generateImport("Session", gormImport, g)
+	cascadeDB := `db.Session(&` + session + `{NewDB: true})`
+	if unscoped {
+		// the last session keeps every chained statement of the handler unscoped
+		cascadeDB += `.Unscoped().Session(&` + session + `{})`
+	}
+	g.P(`if err = DefaultCascadeDelete`, ormable.OriginName, `(ctx, `, keys, `, `, cascadeDB, `); err != nil {`)
+	g.P(`return err`)
+	g.P(`}`)
+}
+
+// generateCascadeDeleteHandler renders the deletion of the children owned by
+// objects of the type, the children owning children in turn are deleted
+// recursively by their own handler
+func (b *ORMBuilder) generateCascadeDeleteHandler(message *protogen.Message, g *protogen.GeneratedFile) {
+	typeName := string(message.Desc.Name())
+	ormable := b.getOrmable(typeName)
+	fieldNames := b.cascadedAssociations(ormable)
+	if len(fieldNames) == 0 {
+		return
+	}
+	pkName, _ := b.cascadeKey(ormable)
+
+	g.P(`// DefaultCascadeDelete`, typeName, ` deletes the children owned by the objects of the given`)
+	g.P(`// primary keys, along with the children they own in turn`)
+	g.P(`func DefaultCascadeDelete`, typeName, `(ctx context.Context, keys interface{}, db *`, generateImport("DB", gormImport, g), `) error {`)
+	for _, fieldName := range fieldNames {
+		field := ormable.Fields[fieldName]
+		if strings.Contains(field.TypeName, ".") {
+			panic(fmt.Sprintf("Cascaded association %s of %s needs children of the same package", fieldName, ormable.Name))
+		}
+		var assocKeyName, foreignKeyName string
+		if hasMany := field.GetHasMany(); hasMany != nil {
+			assocKeyName, foreignKeyName = hasMany.GetAssociationForeignkey(), hasMany.GetForeignkey()
+		} else {
+			assocKeyName, foreignKeyName = field.GetHasOne().GetAssociationForeignkey(), field.GetHasOne().GetForeignkey()
+		}
+		if assocKeyName != pkName {
+			panic(fmt.Sprintf("Cascaded association %s of %s needs to reference the primary key", fieldName, ormable.Name))
+		}
+		child := field.Type
+		owned := columnName(foreignKeyName, child.Fields[foreignKeyName]) + ` IN ?", keys`
+		if ownerType, ownerValue := polymorphicOwner(field); ownerType != "" {
+			owned = columnName(foreignKeyName, child.Fields[foreignKeyName]) + ` IN ? AND ` + columnName(ownerType, child.Fields[ownerType]) + ` = ?", keys, "` + ownerValue + `"`
+		}
+		if len(b.cascadedAssociations(child)) == 0 {
+			g.P(`if err := db.Where("`, owned, `).Delete(&`, child.Name, `{}).Error; err != nil {`)
+			g.P(`return err`)
+			g.P(`}`)
+			continue
+		}
+		childKeyName, childKey := b.cascadeKey(child)
+		g.P(`keys`, fieldName, ` := []`, childKey.TypeName, `{}`)
+		g.P(`if err := db.Model(&`, child.Name, `{}).Where("`, owned, `).Pluck("`, columnName(childKeyName, childKey), `", &keys`, fieldName, `).Error; err != nil {`)
+		g.P(`return err`)
+		g.P(`}`)
+		g.P(`if len(keys`, fieldName, `) > 0 {`)
+		g.P(`if err := DefaultCascadeDelete`, child.OriginName, `(ctx, keys`, fieldName, `, db); err != nil {`)
+		g.P(`return err`)
+		g.P(`}`)
+		g.P(`if err := db.Where("`, columnName(childKeyName, childKey), ` IN ?", keys`, fieldName, `).Delete(&`, child.Name, `{}).Error; err != nil {`)
+		g.P(`return err`)
+		g.P(`}`)
+		g.P(`}`)
+	}
+	g.P(`return nil`)
+	g.P(`}`)
+	g.P()
+}
+
+// generateCompositeKeysWhereClause restricts the dbVar statement to the composite
+// primary keys collected in keys, as a tuple IN for postgres and an OR chain otherwise
+func (b *ORMBuilder) generateCompositeKeysWhereClause(ormable *OrmableType, dbVar string, g *protogen.GeneratedFile) {
+	var columns, conds []string
+	for _, key := range b.getPrimaryKeys(ormable) {
+		column := columnName(key.name, key.field)
+		columns = append(columns, column)
+		conds = append(conds, column+" = ?")
+	}
+
+	if b.dbEngine == ENGINE_POSTGRES {
+		g.P(dbVar, ` = `, dbVar, `.Where("(`, strings.Join(columns, ", "), `) in ?", keys)`)
+		return
+	}
+
+	g.P(`keysConds := make([]string, 0, len(keys))`)
+	g.P(`keysArgs := make([]interface{}, 0, len(keys)*`, len(columns), `)`)
+	g.P(`for _, key := range keys {`)
+	g.P(`keysConds = append(keysConds, "(`, strings.Join(conds, " AND "), `)")`)
+	g.P(`keysArgs = append(keysArgs, key...)`)
+	g.P(`}`)
+	g.P(dbVar, ` = `, dbVar, `.Where(`, generateImport("Join", stdStringsImport, g), `(keysConds, " OR "), keysArgs...)`)
+}
+
 func (b *ORMBuilder) generateBeforeDeleteSetHookCall(orm *OrmableType, g *protogen.GeneratedFile) {
 	g.P(`if hook, ok := (interface{}(&`, orm.Name, `{})).(`, orm.Name, `WithBeforeDeleteSet); ok {`)
 	g.P(`if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {`)
@@ -2230,26 +3679,47 @@ func (b *ORMBuilder) generateStrictUpdateHandler(message *protogen.Message, g *p
 			b.generateAccountIdWhereClause(g)
 		}
 	}
+	b.generatePartitionWhereClause(message, g)
 
 	ormable := b.getOrmable(typeName)
-	if b.gateway {
+	versionName, version := b.findVersionField(ormable)
+	if b.gateway || version != nil {
 		g.P(`var count int64`)
 	}
 
 	if b.hasPrimaryKey(ormable) {
-		pkName, pk := b.findPrimaryKey(ormable)
-		column := pk.GetTag().GetColumn()
-		if len(column) == 0 {
-			column = gschema.NamingStrategy{SingularTable: true}.TableName(pkName)
+		var conds, values []string
+		for _, key := range b.getPrimaryKeys(ormable) {
+			conds = append(conds, columnName(key.name, key.field)+"=?")
+			values = append(values, "ormObj."+key.name)
 		}
 		g.P(`lockedRow := &`, typeName, `ORM{}`)
 		var count string
 		var rowsAffected string
-		if b.gateway {
+		if b.gateway || version != nil {
 			count = `count = `
 			rowsAffected = `.RowsAffected`
 		}
-		g.P(count+`db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("`, column, `=?", ormObj.`, pkName, `).First(lockedRow)`+rowsAffected)
+		g.P(count+`db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("`, strings.Join(conds, " AND "), `", `, strings.Join(values, ", "), `).First(lockedRow)`+rowsAffected)
+
+		if version != nil {
+			column := columnName(versionName, version)
+			// the row only moves to the next version when it is still at the
+			// expected one, the current one when the caller didn't provide it
+			g.P(`if count != 0 {`)
+			g.P(`if ormObj.`, versionName, ` == 0 {`)
+			g.P(`ormObj.`, versionName, ` = lockedRow.`, versionName)
+			g.P(`}`)
+			g.P(`res := db.Session(&`, generateImport("Session", gormImport, g), `{}).Model(&`, ormable.Name, `{}).Where("`, strings.Join(conds, " AND "), `", `, strings.Join(values, ", "), `).Where("`, column, ` = ?", ormObj.`, versionName, `).Update("`, column, `", ormObj.`, versionName, `+1)`)
+			g.P(`if res.Error != nil {`)
+			g.P(`return nil, res.Error`)
+			g.P(`}`)
+			g.P(`if res.RowsAffected == 0 {`)
+			g.P(`return nil, `, generateImport("VersionConflictError", gerrorsImport, g))
+			g.P(`}`)
+			g.P(`ormObj.`, versionName, `++`)
+			g.P(`}`)
+		}
 	}
 	b.generateBeforeHookCall(ormable, "StrictUpdateCleanup", g)
 	b.handleChildAssociations(message, g)
@@ -2264,6 +3734,7 @@ func (b *ORMBuilder) generateStrictUpdateHandler(message *protogen.Message, g *p
 			return f.FieldAssociationInfo.GetDisableAssociationAutoupdate()
 		},
 	)
+	omitPaths = append(omitPaths, b.mergedAssociationPaths(ormable)...)
 
 	preloadPaths := parseRecursiveFields(ormable,
 		func(f *Field) bool {
@@ -2275,18 +3746,12 @@ func (b *ORMBuilder) generateStrictUpdateHandler(message *protogen.Message, g *p
 		},
 	)
 
-	preloadBldr := strings.Builder{}
-	for _, path := range preloadPaths {
-		preloadBldr.WriteString(`Preload(`)
-		preloadBldr.WriteString(path.Quoted())
-		preloadBldr.WriteString(`).`)
-	}
-
 	g.P(`if err = db.Omit(`, fieldPathsToQuoted(omitPaths), `).`,
-		preloadBldr.String(),
+		b.renderPreloads(ormable, preloadPaths, g),
 		`Save(&ormObj).Error; err != nil {`)
 	g.P(`return nil, err`)
 	g.P(`}`)
+	b.generateSavePositionsCall(ormable, "&ormObj", "return nil, ", g)
 	b.generateAfterHookCall(ormable, "StrictUpdateSave", g)
 	g.P(`pbResponse, err := ormObj.ToPB(ctx)`)
 	g.P(`if err != nil {`)
@@ -2331,6 +3796,50 @@ func (b *ORMBuilder) generateAccountIdAndCompartmentIdWhereClause(g *protogen.Ge
 	g.P(`}`)
 }
 
+// generatePartitionWhereClause restricts db to the partition of ormObj, so that
+// postgres can prune the other partitions. Keys which are not set are skipped.
+func (b *ORMBuilder) generatePartitionWhereClause(message *protogen.Message, g *protogen.GeneratedFile) {
+	for _, key := range b.getPartitionKeys(message) {
+		guard := b.partitionKeyGuard("ormObj."+key.name, key.field, g)
+		if guard != "" {
+			g.P(`if `, guard, ` {`)
+		}
+		g.P(`db = db.Where("`, columnName(key.name, key.field), ` = ?", ormObj.`, key.name, `)`)
+		if guard != "" {
+			g.P(`}`)
+		}
+	}
+}
+
+// partitionKeyGuard returns the condition under which the partition key is set
+func (b *ORMBuilder) partitionKeyGuard(value string, field *Field, g *protogen.GeneratedFile) string {
+	if strings.HasPrefix(field.TypeName, "*") {
+		return value + ` != nil`
+	}
+	if zero := b.guessZeroValue(field.TypeName, g); zero != "" {
+		return value + ` != ` + zero
+	}
+
+	return ""
+}
+
+// emptyKeyCondition returns the condition under which the primary key is not set
+func (b *ORMBuilder) emptyKeyCondition(value string, field *Field, g *protogen.GeneratedFile) string {
+	zero := b.guessZeroValue(field.TypeName, g)
+	switch {
+	case strings.HasPrefix(field.TypeName, "*") && zero != "":
+		return value + ` == nil || *` + value + ` == ` + zero
+	case strings.HasPrefix(field.TypeName, "*"):
+		return value + ` == nil`
+	case zero != "":
+		return value + ` == ` + zero
+	case strings.HasSuffix(field.TypeName, "time.Time"):
+		return value + `.IsZero()`
+	}
+
+	return ""
+}
+
 func (b *ORMBuilder) handleChildAssociations(message *protogen.Message, g *protogen.GeneratedFile) {
 	ormable := b.getOrmable(string(message.Desc.Name()))
 
@@ -2354,6 +3863,11 @@ func (b *ORMBuilder) handleChildAssociationsByName(message *protogen.Message, fi
 		return
 	}
 
+	if merge, _ := mergeOptions(field); merge {
+		b.mergeChildAssociationsByName(message, fieldName, g)
+		return
+	}
+
 	if field.GetHasMany() != nil || field.GetHasOne() != nil || field.GetManyToMany() != nil {
 		var assocHandler string
 		switch {
@@ -2409,16 +3923,163 @@ func (b *ORMBuilder) handleChildAssociationsByName(message *protogen.Message, fi
 	}
 }
 
-func (b *ORMBuilder) removeChildAssociationsByName(message *protogen.Message, fieldName string, g *protogen.GeneratedFile) {
-	typeName := string(message.Desc.Name())
-	ormable := b.getOrmable(typeName)
-	field := ormable.Fields[fieldName]
+// mergeOptions returns whether the updates merge the children of the association
+// by primary key, and whether the merges delete the children left out
+func mergeOptions(field *Field) (merge, deleteMissing bool) {
+	switch {
+	case field.GetHasMany() != nil:
+		return field.GetHasMany().GetMerge(), field.GetHasMany().GetDeleteMissing()
+	case field.GetManyToMany() != nil:
+		return field.GetManyToMany().GetMerge(), field.GetManyToMany().GetDeleteMissing()
+	}
+	return false, false
+}
 
-	if field == nil {
-		return
+// polymorphicOwner returns the owner type field of the children of a
+// polymorphic association and the value identifying the type of the object
+func polymorphicOwner(field *Field) (string, string) {
+	switch {
+	case field.GetHasMany().GetPolymorphic() != "":
+		return camelCase(field.GetHasMany().GetPolymorphic()) + "Type", field.GetHasMany().GetPolymorphicValue()
+	case field.GetHasOne().GetPolymorphic() != "":
+		return camelCase(field.GetHasOne().GetPolymorphic()) + "Type", field.GetHasOne().GetPolymorphicValue()
 	}
+	return "", ""
+}
 
-	if field.GetHasMany() != nil || field.GetHasOne() != nil {
+// mergedAssociationPaths returns the associations saved by the merge rather than
+// along with the object
+func (b *ORMBuilder) mergedAssociationPaths(ormable *OrmableType) []*fieldPath {
+	var paths []*fieldPath
+	for fieldName, field := range ormable.Fields {
+		if merge, _ := mergeOptions(field); merge {
+			paths = append(paths, NewFieldPath(fieldName))
+		}
+	}
+	sort.Slice(paths, func(i, j int) bool {
+		return paths[i].String() < paths[j].String()
+	})
+	return paths
+}
+
+// getMergeKey returns the primary key the children of a merged association are
+// matched by
+func (b *ORMBuilder) getMergeKey(ormable *OrmableType, fieldName string) pkFieldObjs {
+	field := ormable.Fields[fieldName]
+	keys := b.getPrimaryKeys(field.Type)
+	if len(keys) != 1 {
+		panic(fmt.Sprintf("Merged association %s of %s needs children with a single primary key", fieldName, ormable.Name))
+	}
+	return keys[0]
+}
+
+// mergeChildAssociationsByName upserts the children of the object, the rest of
+// the association is left as is unless the missing children are deleted. The
+// association isn't touched when the object has no children, while an emptied
+// one deletes them all with the missing children. The Has-Many children are
+// only updated within the object, and only the ones without a key are inserted.
+func (b *ORMBuilder) mergeChildAssociationsByName(message *protogen.Message, fieldName string, g *protogen.GeneratedFile) {
+	ormable := b.getOrmable(string(message.Desc.Name()))
+	field := ormable.Fields[fieldName]
+	_, deleteMissing := mergeOptions(field)
+	childType := strings.Trim(field.TypeName, "[]*")
+	key := b.getMergeKey(ormable, fieldName)
+
+	g.P(`if ormObj.`, fieldName, ` != nil {`)
+	if field.GetHasMany() != nil {
+		assocKeyName := field.GetHasMany().GetAssociationForeignkey()
+		foreignKeyName := field.GetHasMany().GetForeignkey()
+		assocKeyType := ormable.Fields[assocKeyName].TypeName
+		foreignKeyType := field.Type.Fields[foreignKeyName].TypeName
+		zeroValue := b.guessZeroValue(assocKeyType, g)
+		if strings.Contains(assocKeyType, "*") {
+			g.P(`if ormObj.`, assocKeyName, ` == nil || *ormObj.`, assocKeyName, ` == `, zeroValue, `{`)
+		} else {
+			g.P(`if ormObj.`, assocKeyName, ` == `, zeroValue, `{`)
+		}
+		g.P(`return nil, `, generateImport("EmptyIdError", gerrorsImport, g))
+		g.P(`}`)
+		ormDesc := "ormObj." + assocKeyName
+		if strings.HasPrefix(assocKeyType, "*") {
+			ormDesc = "*" + ormDesc
+		}
+		setForeignKey := func(target string) {
+			if strings.HasPrefix(foreignKeyType, "*") {
+				g.P(target, ` = new(`, strings.TrimPrefix(foreignKeyType, "*"), `)`)
+				target = "*" + target
+			}
+			g.P(target, " = ", ormDesc)
+		}
+		ownerType, ownerValue := polymorphicOwner(field)
+		g.P(`filter`, fieldName, ` := `, childType, `{}`)
+		setForeignKey("filter" + fieldName + "." + foreignKeyName)
+		if ownerType != "" {
+			g.P(`filter`, fieldName, `.`, ownerType, ` = "`, ownerValue, `"`)
+		}
+		g.P(`new`, fieldName, ` := `, field.TypeName, `{}`)
+		g.P(`keep`, fieldName, ` := []interface{}{}`)
+		g.P(`for _, child := range ormObj.`, fieldName, ` {`)
+		setForeignKey("child." + foreignKeyName)
+		if ownerType != "" {
+			g.P(`child.`, ownerType, ` = "`, ownerValue, `"`)
+		}
+		if cond := b.emptyKeyCondition("child."+key.name, key.field, g); cond != "" {
+			g.P(`if `, cond, ` {`)
+			g.P(`new`, fieldName, ` = append(new`, fieldName, `, child)`)
+			g.P(`continue`)
+			g.P(`}`)
+		}
+		g.P(`keep`, fieldName, ` = append(keep`, fieldName, `, child.`, key.name, `)`)
+		g.P(`// the update is scoped to the object, the children of other objects are left as they are`)
+		g.P(`if err = db.Session(&`, generateImport("Session", gormImport, g), `{}).Where(filter`, fieldName, `).Select("*").Updates(child).Error; err != nil {`)
+		g.P(`return nil, err`)
+		g.P(`}`)
+		g.P(`}`)
+		if deleteMissing {
+			g.P(`query`, fieldName, ` := db.Where(filter`, fieldName, `)`)
+			g.P(`if len(keep`, fieldName, `) > 0 {`)
+			g.P(`query`, fieldName, ` = query`, fieldName, `.Where("`, columnName(key.name, key.field), ` NOT IN ?", keep`, fieldName, `)`)
+			g.P(`}`)
+			g.P(`if err = query`, fieldName, `.Delete(`, childType, `{}).Error; err != nil {`)
+			g.P(`return nil, err`)
+			g.P(`}`)
+		}
+		g.P(`if len(new`, fieldName, `) > 0 {`)
+		g.P(`if err = db.Session(&`, generateImport("Session", gormImport, g), `{}).Create(&new`, fieldName, `).Error; err != nil {`)
+		g.P(`return nil, err`)
+		g.P(`}`)
+		g.P(`}`)
+	} else {
+		link := "Append"
+		if deleteMissing {
+			link = "Replace"
+		}
+		g.P(`if len(ormObj.`, fieldName, `) > 0 {`)
+		g.P(`if err = db.Session(&`, generateImport("Session", gormImport, g), `{}).Save(&ormObj.`, fieldName, `).Error; err != nil {`)
+		g.P(`return nil, err`)
+		g.P(`}`)
+		g.P(`if err = db.Model(&ormObj).Association("`, fieldName, `").`, link, `(ormObj.`, fieldName, `); err != nil {`)
+		g.P(`return nil, err`)
+		g.P(`}`)
+		if deleteMissing {
+			g.P(`} else if err = db.Model(&ormObj).Association("`, fieldName, `").Clear(); err != nil {`)
+			g.P(`return nil, err`)
+		}
+		g.P(`}`)
+	}
+	g.P(`}`)
+}
+
+func (b *ORMBuilder) removeChildAssociationsByName(message *protogen.Message, fieldName string, g *protogen.GeneratedFile) {
+	typeName := string(message.Desc.Name())
+	ormable := b.getOrmable(typeName)
+	field := ormable.Fields[fieldName]
+
+	if field == nil {
+		return
+	}
+
+	if field.GetHasMany() != nil || field.GetHasOne() != nil {
 		var assocKeyName, foreignKeyName string
 		switch {
 		case field.GetHasMany() != nil:
@@ -2429,7 +4090,7 @@ func (b *ORMBuilder) removeChildAssociationsByName(message *protogen.Message, fi
 			foreignKeyName = field.GetHasOne().GetForeignkey()
 		}
 		assocKeyType := ormable.Fields[assocKeyName].TypeName
-		assocOrmable := b.getOrmable(field.TypeName)
+		assocOrmable := field.Type
 		foreignKeyType := assocOrmable.Fields[foreignKeyName].TypeName
 		g.P(`filter`, fieldName, ` := `, strings.Trim(field.TypeName, "[]*"), `{}`)
 		zeroValue := b.guessZeroValue(assocKeyType, g)
@@ -2450,24 +4111,329 @@ func (b *ORMBuilder) removeChildAssociationsByName(message *protogen.Message, fi
 			ormDesc = "*" + ormDesc
 		}
 		g.P(filterDesc, " = ", ormDesc)
-		g.P(`if err = db.Where(filter`, fieldName, `).Delete(`, strings.Trim(field.TypeName, "[]*"), `{}).Error; err != nil {`)
+		if ownerType, ownerValue := polymorphicOwner(field); ownerType != "" {
+			// the children of the other owners may have the same key
+			g.P(`filter`, fieldName, `.`, ownerType, ` = "`, ownerValue, `"`)
+		}
+		if assocOrmable == ormable {
+			// the children of a tree own their subtrees, they are detached
+			// rather than deleted, the ones kept are attached again on save
+			detached := "nil"
+			if !strings.HasPrefix(foreignKeyType, "*") {
+				detached = b.guessZeroValue(foreignKeyType, g)
+			}
+			g.P(`if err = db.Model(&`, ormable.Name, `{}).Where(filter`, fieldName, `).Update("`, columnName(foreignKeyName, assocOrmable.Fields[foreignKeyName]), `", `, detached, `).Error; err != nil {`)
+		} else {
+			g.P(`if err = db.Where(filter`, fieldName, `).Delete(`, strings.Trim(field.TypeName, "[]*"), `{}).Error; err != nil {`)
+		}
 		g.P(`return nil, err`)
 		g.P(`}`)
 	}
 }
 
-func (b *ORMBuilder) generatePatchHandler(message *protogen.Message, g *protogen.GeneratedFile) {
-	var isMultiAccount bool
+// treeReference returns the foreign key referencing the parent of the objects
+// of a self-referential type and the key it references, when the type forms
+// a single tree
+func (b *ORMBuilder) treeReference(ormable *OrmableType) (string, string, bool) {
+	references := map[[2]string]struct{}{}
+	for _, field := range ormable.Fields {
+		if field.Type != ormable {
+			continue
+		}
+		if hasMany := field.GetHasMany(); hasMany != nil {
+			references[[2]string{hasMany.GetForeignkey(), hasMany.GetAssociationForeignkey()}] = struct{}{}
+		} else if belongsTo := field.GetBelongsTo(); belongsTo != nil {
+			references[[2]string{belongsTo.GetForeignkey(), belongsTo.GetAssociationForeignkey()}] = struct{}{}
+		}
+	}
+	if len(references) != 1 {
+		return "", "", false
+	}
+	for reference := range references {
+		return reference[0], reference[1], true
+	}
+	return "", "", false
+}
 
+// generateTreeHandlers renders the handlers listing the descendants and the
+// ancestors of an object of a self-referential type with recursive queries,
+// postgres only
+func (b *ORMBuilder) generateTreeHandlers(message *protogen.Message, g *protogen.GeneratedFile) {
 	typeName := string(message.Desc.Name())
 	ormable := b.getOrmable(typeName)
+	foreignKeyName, keyName, ok := b.treeReference(ormable)
+	if !ok || b.dbEngine != ENGINE_POSTGRES {
+		return
+	}
+	table := getTableName(message)
+	key := columnName(keyName, ormable.Fields[keyName])
+	foreignKey := columnName(foreignKeyName, ormable.Fields[foreignKeyName])
+
+	// the paths of the recursions stop them on cycles
+	descendants := fmt.Sprintf(`JOIN (WITH RECURSIVE descendants AS (`+
+		`SELECT %[2]s, 1 AS depth, ARRAY[%[3]s, %[2]s] AS path FROM %[1]s WHERE %[3]s = ? `+
+		`UNION ALL SELECT %[1]s.%[2]s, descendants.depth + 1, descendants.path || %[1]s.%[2]s FROM %[1]s `+
+		`JOIN descendants ON %[1]s.%[3]s = descendants.%[2]s WHERE NOT %[1]s.%[2]s = ANY(descendants.path)) `+
+		`SELECT %[2]s, depth FROM descendants) AS descendants ON descendants.%[2]s = %[1]s.%[2]s`, table, key, foreignKey)
+	ancestors := fmt.Sprintf(`JOIN (WITH RECURSIVE ancestors AS (`+
+		`SELECT %[3]s AS %[2]s, 1 AS depth, ARRAY[%[2]s, %[3]s] AS path FROM %[1]s WHERE %[2]s = ? `+
+		`UNION ALL SELECT %[1]s.%[3]s, ancestors.depth + 1, ancestors.path || %[1]s.%[3]s FROM %[1]s `+
+		`JOIN ancestors ON %[1]s.%[2]s = ancestors.%[2]s WHERE NOT %[1]s.%[3]s = ANY(ancestors.path)) `+
+		`SELECT %[2]s, depth FROM ancestors) AS ancestors ON ancestors.%[2]s = %[1]s.%[2]s`, table, key, foreignKey)
+
+	g.P(`// DefaultListDescendants`, typeName, ` lists the objects below the given one in the tree, nearest first`)
+	b.generateTreeHandler(message, `DefaultListDescendants`+typeName, descendants, "descendants", keyName, g)
+	g.P(`// DefaultListAncestors`, typeName, ` lists the objects above the given one in the tree, nearest first`)
+	b.generateTreeHandler(message, `DefaultListAncestors`+typeName, ancestors, "ancestors", keyName, g)
+}
+
+// generateTreeHandler renders a handler listing the objects joined with the
+// recursive query, by depth
+func (b *ORMBuilder) generateTreeHandler(message *protogen.Message, handlerName, join, alias, keyName string, g *protogen.GeneratedFile) {
+	typeName := string(message.Desc.Name())
+	ormable := b.getOrmable(typeName)
+	keyType := ormable.Fields[keyName].TypeName
 
+	g.P(`func `, handlerName, `(ctx context.Context, in *`, typeName, `, db *`, generateImport("DB", gormImport, g), `) ([]*`, typeName, `, error) {`)
+	g.P(`if in == nil {`)
+	g.P(`return nil, `, generateImport("NilArgumentError", gerrorsImport, g))
+	g.P(`}`)
+	g.P(`ormObj, err := in.ToORM(ctx)`)
+	g.P(`if err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	if strings.HasPrefix(keyType, "*") {
+		g.P(`if ormObj.`, keyName, ` == nil || *ormObj.`, keyName, ` == `, b.guessZeroValue(keyType, g), ` {`)
+	} else {
+		g.P(`if ormObj.`, keyName, ` == `, b.guessZeroValue(keyType, g), ` {`)
+	}
+	g.P(`return nil, `, generateImport("EmptyIdError", gerrorsImport, g))
+	g.P(`}`)
 	if getMessageOptions(message).GetMultiAccount() {
-		isMultiAccount = true
+		b.generateAccountIdWhereClause(g)
+	}
+	g.P(`ormResponse := []`, ormable.Name, `{}`)
+	g.P(`if err = db.Joins("`, join, `", ormObj.`, keyName, `).Order("`, alias, `.depth").Find(&ormResponse).Error; err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	g.P(`pbResponse := []*`, typeName, `{}`)
+	g.P(`for _, responseEntry := range ormResponse {`)
+	g.P(`temp, err := responseEntry.ToPB(ctx)`)
+	g.P(`if err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	g.P(`pbResponse = append(pbResponse, &temp)`)
+	g.P(`}`)
+	g.P(`return pbResponse, nil`)
+	g.P(`}`)
+	g.P()
+}
+
+// managesAssociation reports whether the association handlers are generated for
+// the field, a many-to-many association to a type of the same package with a
+// single primary key
+func (b *ORMBuilder) managesAssociation(ormable *OrmableType, fieldName string) bool {
+	field := ormable.Fields[fieldName]
+	if field == nil || field.GetManyToMany() == nil || strings.Contains(field.TypeName, ".") {
+		return false
+	}
+
+	return len(b.getPrimaryKeys(field.Type)) == 1
+}
+
+// generateAssociationHandlers renders the handlers adding, removing and listing the
+// objects of the many-to-many associations without updating the object itself
+func (b *ORMBuilder) generateAssociationHandlers(message *protogen.Message, g *protogen.GeneratedFile) {
+	ormable := b.getOrmable(string(message.Desc.Name()))
+
+	var fieldNames []string
+	for name := range ormable.Fields {
+		if b.managesAssociation(ormable, name) {
+			fieldNames = append(fieldNames, name)
+		}
+	}
+	sort.Strings(fieldNames)
+
+	for _, fieldName := range fieldNames {
+		b.generateAddAssociationHandler(message, fieldName, g)
+		b.generateRemoveAssociationHandler(message, fieldName, g)
+		b.generateListAssociationHandler(message, fieldName, g)
+	}
+}
+
+// generateAssociationOwnerRead renders the read of the stored object owning the
+// association, multi-account objects are looked up within the account of the context
+func (b *ORMBuilder) generateAssociationOwnerRead(message *protogen.Message, failure string, g *protogen.GeneratedFile) {
+	ormable := b.getOrmable(string(message.Desc.Name()))
+
+	g.P(`if in == nil {`)
+	g.P(failure, generateImport("NilArgumentError", gerrorsImport, g))
+	g.P(`}`)
+	g.P(`ormObj, err := in.ToORM(ctx)`)
+	g.P(`if err != nil {`)
+	g.P(failure, `err`)
+	g.P(`}`)
+
+	var filter []string
+	for _, key := range b.getPrimaryKeys(ormable) {
+		if cond := b.emptyKeyCondition("ormObj."+key.name, key.field, g); cond != "" {
+			g.P(`if `, cond, ` {`)
+			g.P(failure, generateImport("EmptyIdError", gerrorsImport, g))
+			g.P(`}`)
+		}
+		filter = append(filter, key.name+": ormObj."+key.name)
+	}
+	if getMessageOptions(message).GetMultiAccount() && !ormable.Fields["AccountID"].GetTag().GetPrimaryKey() {
+		filter = append(filter, "AccountID: ormObj.AccountID")
+	}
+	if getMessageOptions(message).GetMultiCompartment() {
+		filter = append(filter, "CompartmentID: ormObj.CompartmentID")
+	}
+	g.P(`owner := `, ormable.Name, `{}`)
+	g.P(`if err = db.Where(&`, ormable.Name, `{`, strings.Join(filter, ", "), `}).First(&owner).Error; err != nil {`)
+	g.P(failure, `err`)
+	g.P(`}`)
+}
+
+// generateAddAssociationHandler renders the handler linking stored objects to the
+// association, objects which don't exist, or belong to another account, are not found
+func (b *ORMBuilder) generateAddAssociationHandler(message *protogen.Message, fieldName string, g *protogen.GeneratedFile) {
+	typeName := string(message.Desc.Name())
+	ormable := b.getOrmable(typeName)
+	field := ormable.Fields[fieldName]
+	childOrmable := field.Type
+	key := b.getPrimaryKeys(childOrmable)[0]
+	_, childMultiAccount := childOrmable.Fields["AccountID"]
+	_, childMultiCompartment := childOrmable.Fields["CompartmentID"]
+
+	g.P(`// DefaultAdd`, fieldName, `To`, typeName, ` adds the stored objects to the `, fieldName, ` of the object`)
+	g.P(`func DefaultAdd`, fieldName, `To`, typeName, `(ctx context.Context, in *`, typeName, `, objects []*`, childOrmable.OriginName,
+		`, db *`, generateImport("DB", gormImport, g), `) error {`)
+	b.generateAssociationOwnerRead(message, "return ", g)
+	g.P(`keys := make(map[string]interface{}, len(objects))`)
+	if childMultiAccount {
+		g.P(`filter := `, childOrmable.Name, `{}`)
+	}
+	g.P(`for _, object := range objects {`)
+	g.P(`if object == nil {`)
+	g.P(`return `, generateImport("NilArgumentError", gerrorsImport, g))
+	g.P(`}`)
+	g.P(`child, err := object.ToORM(ctx)`)
+	g.P(`if err != nil {`)
+	g.P(`return err`)
+	g.P(`}`)
+	if cond := b.emptyKeyCondition("child."+key.name, key.field, g); cond != "" {
+		g.P(`if `, cond, ` {`)
+		g.P(`return `, generateImport("EmptyIdError", gerrorsImport, g))
+		g.P(`}`)
+	}
+	value := "child." + key.name
+	if strings.HasPrefix(key.field.TypeName, "*") {
+		value = "*" + value
 	}
+	g.P(`keys[`, generateImport("Sprintf", stdFmtImport, g), `("%#v", `, value, `)] = `, value)
+	if childMultiAccount {
+		g.P(`filter.AccountID = child.AccountID`)
+	}
+	if childMultiCompartment {
+		g.P(`filter.CompartmentID = child.CompartmentID`)
+	}
+	g.P(`}`)
+	g.P(`if len(keys) == 0 {`)
+	g.P(`return nil`)
+	g.P(`}`)
+	g.P(`values := make([]interface{}, 0, len(keys))`)
+	g.P(`for _, value := range keys {`)
+	g.P(`values = append(values, value)`)
+	g.P(`}`)
+	g.P(`children := []`, childOrmable.Name, `{}`)
+	query := `db`
+	if childMultiAccount {
+		query = `db.Where(&filter)`
+	}
+	g.P(`if err = `, query, `.Where("`, columnName(key.name, key.field), ` IN ?", values).Find(&children).Error; err != nil {`)
+	g.P(`return err`)
+	g.P(`}`)
+	g.P(`if len(children) != len(values) {`)
+	g.P(`return `, generateImport("ErrRecordNotFound", gormImport, g))
+	g.P(`}`)
+	g.P(`return db.Model(&owner).Association("`, fieldName, `").Append(&children)`)
+	g.P(`}`)
+	g.P()
+}
 
-	if isMultiAccount && !b.hasIDField(message) {
-		g.P(fmt.Sprintf("// Cannot autogen DefaultPatch%s: this is a multi-account table without an \"id\" field in the message.\n", typeName))
+// generateRemoveAssociationHandler renders the handler unlinking objects from the
+// association, the objects themselves are kept
+func (b *ORMBuilder) generateRemoveAssociationHandler(message *protogen.Message, fieldName string, g *protogen.GeneratedFile) {
+	typeName := string(message.Desc.Name())
+	ormable := b.getOrmable(typeName)
+	childOrmable := ormable.Fields[fieldName].Type
+	key := b.getPrimaryKeys(childOrmable)[0]
+
+	g.P(`// DefaultRemove`, fieldName, `From`, typeName, ` removes the objects from the `, fieldName, ` of the object`)
+	g.P(`func DefaultRemove`, fieldName, `From`, typeName, `(ctx context.Context, in *`, typeName, `, objects []*`, childOrmable.OriginName,
+		`, db *`, generateImport("DB", gormImport, g), `) error {`)
+	b.generateAssociationOwnerRead(message, "return ", g)
+	g.P(`children := make([]`, childOrmable.Name, `, 0, len(objects))`)
+	g.P(`for _, object := range objects {`)
+	g.P(`if object == nil {`)
+	g.P(`return `, generateImport("NilArgumentError", gerrorsImport, g))
+	g.P(`}`)
+	g.P(`child, err := object.ToORM(ctx)`)
+	g.P(`if err != nil {`)
+	g.P(`return err`)
+	g.P(`}`)
+	if cond := b.emptyKeyCondition("child."+key.name, key.field, g); cond != "" {
+		g.P(`if `, cond, ` {`)
+		g.P(`return `, generateImport("EmptyIdError", gerrorsImport, g))
+		g.P(`}`)
+	}
+	g.P(`children = append(children, child)`)
+	g.P(`}`)
+	g.P(`if len(children) == 0 {`)
+	g.P(`return nil`)
+	g.P(`}`)
+	g.P(`return db.Model(&owner).Association("`, fieldName, `").Delete(&children)`)
+	g.P(`}`)
+	g.P()
+}
+
+// generateListAssociationHandler renders the handler listing the objects of the association
+func (b *ORMBuilder) generateListAssociationHandler(message *protogen.Message, fieldName string, g *protogen.GeneratedFile) {
+	typeName := string(message.Desc.Name())
+	ormable := b.getOrmable(typeName)
+	childOrmable := ormable.Fields[fieldName].Type
+
+	g.P(`// DefaultList`, fieldName, `Of`, typeName, ` returns the `, fieldName, ` of the object`)
+	g.P(`func DefaultList`, fieldName, `Of`, typeName, `(ctx context.Context, in *`, typeName, `, db *`, generateImport("DB", gormImport, g),
+		`) ([]*`, childOrmable.OriginName, `, error) {`)
+	b.generateAssociationOwnerRead(message, "return nil, ", g)
+	g.P(`children := []`, childOrmable.Name, `{}`)
+	g.P(`if err = db.Model(&owner).Association("`, fieldName, `").Find(&children); err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	g.P(`pbResponse := make([]*`, childOrmable.OriginName, `, 0, len(children))`)
+	g.P(`for _, child := range children {`)
+	g.P(`temp, err := child.ToPB(ctx)`)
+	g.P(`if err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	g.P(`pbResponse = append(pbResponse, &temp)`)
+	g.P(`}`)
+	g.P(`return pbResponse, nil`)
+	g.P(`}`)
+	g.P()
+}
+
+func (b *ORMBuilder) generatePatchHandler(message *protogen.Message, g *protogen.GeneratedFile) {
+	typeName := string(message.Desc.Name())
+	ormable := b.getOrmable(typeName)
+
+	// the object can only be read before patching when its keys are set by the
+	// message, types with a key included at the ORM level are patched blindly
+	keyInitializers, ok := b.keyInitializers(message)
+	if !ok && b.requiresKeyInMessage(message) {
+		g.P(fmt.Sprintf("// Cannot autogen DefaultPatch%s: a primary key of the table is missing in the message.\n", typeName))
 		return
 	}
 
@@ -2478,36 +4444,25 @@ func (b *ORMBuilder) generatePatchHandler(message *protogen.Message, g *protogen
 	g.P(`if in == nil {`)
 	g.P(`return nil, `, generateImport("NilArgumentError", gerrorsImport, g))
 	g.P(`}`)
-	g.P(`var pbObj `, typeName)
+	g.P(`pbObj := &`, typeName, `{}`)
 	g.P(`var err error`)
 	b.generateBeforePatchHookCall(ormable, "Read", g)
 
-	// TODO: not in original code, but it doesn't make a lot of sense to generate code with id if message doesn't have it
-	if b.hasIDField(message) {
-		getIDFormatter := "{Id: in.GetId()},"
-		if b.IsIDFieldOptional(message) {
-			// This is necessary because the GetID returns a non pointer object
-			getIDFormatter = "{Id: in.Id},"
-		}
-		if b.readHasFieldSelection(ormable) {
-			g.P(`pbReadRes, err := DefaultRead`, typeName, `(ctx, &`, typeName, getIDFormatter, ` db, nil)`)
-		} else {
-			g.P(`pbReadRes, err := DefaultRead`, typeName, `(ctx, &`, typeName, getIDFormatter, ` db)`)
-		}
-
-		g.P(`if err != nil {`)
+	if ok {
+		// the read is scoped to the account of the context by DefaultRead
+		g.P(`if pbObj, err = DefaultRead`, typeName, `(ctx, &`, typeName, `{`, keyInitializers, `}, db`, b.readDefaultArgs(ormable), `); err != nil {`)
 		g.P(`return nil, err`)
 		g.P(`}`)
-		g.P(`pbObj = *pbReadRes`)
+		b.generateVersionCheck(ormable, "pbObj", g)
 	}
 
 	b.generateBeforePatchHookCall(ormable, "ApplyFieldMask", g)
-	g.P(`if _, err := DefaultApplyFieldMask`, typeName, `(ctx, &pbObj, in, updateMask, "", db); err != nil {`)
+	g.P(`if _, err := DefaultApplyFieldMask`, typeName, `(ctx, pbObj, in, updateMask, "", db); err != nil {`)
 	g.P(`return nil, err`)
 	g.P(`}`)
 
 	b.generateBeforePatchHookCall(ormable, "Save", g)
-	g.P(`pbResponse, err := DefaultStrictUpdate`, typeName, `(ctx, &pbObj, db)`)
+	g.P(`pbResponse, err := DefaultStrictUpdate`, typeName, `(ctx, pbObj, db)`)
 	g.P(`if err != nil {`)
 	g.P(`return nil, err`)
 	g.P(`}`)
@@ -2523,14 +4478,97 @@ func (b *ORMBuilder) generatePatchHandler(message *protogen.Message, g *protogen
 
 }
 
-func (b *ORMBuilder) hasIDField(message *protogen.Message) bool {
+// generateVersionCheck renders the comparison of the version the caller expects,
+// if any, with the one of the stored object
+func (b *ORMBuilder) generateVersionCheck(ormable *OrmableType, stored string, g *protogen.GeneratedFile) {
+	versionName, version := b.findVersionField(ormable)
+	if version == nil {
+		return
+	}
+	g.P(`if in.`, versionName, ` != 0 && in.`, versionName, ` != `, stored, `.`, versionName, ` {`)
+	g.P(`return nil, `, generateImport("VersionConflictError", gerrorsImport, g))
+	g.P(`}`)
+}
+
+// findVersionField returns the field marked with the version option, the
+// updates of the type compare and increment it
+func (b *ORMBuilder) findVersionField(ormable *OrmableType) (string, *Field) {
+	var versionName string
+	var version *Field
+	for fieldName, field := range ormable.Fields {
+		if !field.GetVersion() {
+			continue
+		}
+		if version != nil {
+			panic(fmt.Sprintf("Type %s has more than one version field", ormable.Name))
+		}
+		switch field.TypeName {
+		case "int32", "int64", "uint32", "uint64":
+		default:
+			panic(fmt.Sprintf("Version field %s of %s must be an integer", fieldName, ormable.Name))
+		}
+		if field.GetTag().GetPrimaryKey() {
+			panic(fmt.Sprintf("Version field %s of %s can't be a primary key", fieldName, ormable.Name))
+		}
+		versionName, version = fieldName, field
+	}
+	return versionName, version
+}
+
+// keyInitializers returns the struct literal fields copying the primary keys and
+// partition keys of in, it reports false when a key is not a field of the message.
+// The account of multi-account types is taken from the context instead.
+func (b *ORMBuilder) keyInitializers(message *protogen.Message) (string, bool) {
+	ormable := b.getOrmable(string(message.Desc.Name()))
+
+	keys := append(b.getPrimaryKeys(ormable), b.getPartitionKeys(message)...)
+	seen := map[string]bool{}
+	var initializers []string
+	for _, key := range keys {
+		if seen[key.name] {
+			continue
+		}
+		seen[key.name] = true
+		if key.name == "AccountID" && getMessageOptions(message).GetMultiAccount() {
+			continue
+		}
+		field := b.messageField(message, key.name)
+		if field == nil {
+			return "", false
+		}
+		if field.Desc.HasOptionalKeyword() {
+			// the getter of an optional field does not return a pointer
+			initializers = append(initializers, field.GoName+": in."+field.GoName)
+		} else {
+			initializers = append(initializers, field.GoName+": in.Get"+field.GoName+"()")
+		}
+	}
+
+	return strings.Join(initializers, ", "), true
+}
+
+// requiresKeyInMessage reports whether the patch handlers of the message can not be
+// generated unless every primary key is a field of the message
+func (b *ORMBuilder) requiresKeyInMessage(message *protogen.Message) bool {
+	ormable := b.getOrmable(string(message.Desc.Name()))
+	return b.hasCompositePrimaryKey(ormable) || getMessageOptions(message).GetMultiAccount()
+}
+
+// messageField returns the field of the message backing the ormable field
+func (b *ORMBuilder) messageField(message *protogen.Message, ormName string) *protogen.Field {
 	for _, field := range message.Fields {
-		if strings.ToLower(field.GoName) == "id" { // TODO: not sure
-			return true
+		if field.GoName == ormName || camelCase(field.GoName) == ormName {
+			return field
+		}
+	}
+	for _, field := range message.Fields {
+		// fields like AccountID do not follow camelCase of their column
+		if strings.EqualFold(field.GoName, ormName) {
+			return field
 		}
 	}
 
-	return false
+	return nil
 }
 
 // IsIDFieldOptional if the ID is an optional field
@@ -2542,73 +4580,434 @@ func (b *ORMBuilder) IsIDFieldOptional(message *protogen.Message) bool {
 			}
 		}
 	}
-	return false
-}
-
-func (b *ORMBuilder) generateBeforePatchHookCall(orm *OrmableType, suffix string, g *protogen.GeneratedFile) {
-	g.P(`if hook, ok := interface{}(&pbObj).(`, orm.OriginName, `WithBeforePatch`, suffix, `); ok {`)
-	g.P(`if db, err = hook.BeforePatch`, suffix, `(ctx, in, updateMask, db); err != nil {`)
-	g.P(`return nil, err`)
+	return false
+}
+
+func (b *ORMBuilder) generateBeforePatchHookCall(orm *OrmableType, suffix string, g *protogen.GeneratedFile) {
+	g.P(`if hook, ok := interface{}(pbObj).(`, orm.OriginName, `WithBeforePatch`, suffix, `); ok {`)
+	g.P(`if db, err = hook.BeforePatch`, suffix, `(ctx, in, updateMask, db); err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	g.P(`}`)
+}
+
+func (b *ORMBuilder) generateAfterPatchHookCall(orm *OrmableType, suffix string, g *protogen.GeneratedFile) {
+	g.P(`if hook, ok := interface{}(pbResponse).(`, orm.OriginName, `WithAfterPatch`, suffix, `); ok {`)
+	g.P(`if err = hook.AfterPatch`, suffix, `(ctx, in, updateMask, db); err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	g.P(`}`)
+}
+
+func (b *ORMBuilder) generateBeforePatchHookDef(orm *OrmableType, suffix string, g *protogen.GeneratedFile) {
+	g.P(`type `, orm.OriginName, `WithBeforePatch`, suffix, ` interface {`)
+	g.P(`BeforePatch`, suffix, `(context.Context, *`, orm.OriginName, `, *`, generateImport("FieldMask", fmImport, g), `, *`, generateImport("DB", gormImport, g),
+		`) (*`, generateImport("DB", gormImport, g), `, error)`)
+	g.P(`}`)
+}
+
+func (b *ORMBuilder) generateAfterPatchHookDef(orm *OrmableType, suffix string, g *protogen.GeneratedFile) {
+	g.P(`type `, orm.OriginName, `WithAfterPatch`, suffix, ` interface {`)
+	g.P(`AfterPatch`, suffix, `(context.Context, *`, orm.OriginName, `, *`, generateImport("FieldMask", fmImport, g), `, *`, generateImport("DB", gormImport, g),
+		`) error`)
+	g.P(`}`)
+}
+
+func (b *ORMBuilder) generatePatchSetHandler(message *protogen.Message, g *protogen.GeneratedFile) {
+	typeName := string(message.Desc.Name())
+	if _, ok := b.keyInitializers(message); !ok && b.requiresKeyInMessage(message) {
+		g.P(fmt.Sprintf("// Cannot autogen DefaultPatchSet%s: a primary key of the table is missing in the message.\n", typeName))
+		return
+	}
+
+	isMultiAccount := getMessageOptions(message).GetMultiAccount()
+	ormable := b.getOrmable(typeName)
+	composite := b.hasCompositePrimaryKey(ormable)
+	pks := b.getPrimaryKeys(ormable)
+	versionName, version := b.findVersionField(ormable)
+	gormDB := generateImport("DB", gormImport, g)
+	gormSession := generateImport("Session", gormImport, g)
+
+	_ = generateImport("", "fmt", g)
+	g.P(`// DefaultPatchSet`, typeName, ` patches the objects in a transaction: the masks are applied in memory to`)
+	g.P(`// the stored objects, read and locked with a single query, and the objects are written with an update`)
+	g.P(`// per batch of `, getBatchSize(message), ` objects. The objects are read and written with the handles their hooks`)
+	g.P(`// return, the objects of different handles don't share the queries. Only the associations in the`)
+	g.P(`// masks are updated, and objects sharing a primary key are rejected`)
+	g.P(`func DefaultPatchSet`, typeName, `(ctx context.Context, objects []*`,
+		typeName, `, updateMasks []*`, generateImport("FieldMask", fmImport, g), `, db *`, gormDB, `) ([]*`, typeName, `, error) {`)
+	g.P(`if len(objects) != len(updateMasks) {`)
+	g.P(`return nil, fmt.Errorf(`, generateImport("BadRepeatedFieldMaskTpl", gerrorsImport, g), `, len(updateMasks), len(objects))`)
+	g.P(`}`)
+	g.P(`if len(objects) == 0 {`)
+	g.P(`return []*`, typeName, `{}, nil`)
+	g.P(`}`)
+	g.P(`var err error`)
+
+	if isMultiAccount {
+		if getMessageOptions(message).GetMultiCompartment() {
+			b.generateAccountIdAndCompartmentIdWhereClause(g)
+		} else {
+			b.generateAccountIdWhereClause(g)
+		}
+	}
+
+	// keyOf renders the typed key an object is matched with its stored row by
+	keyOf := func(obj string) string {
+		var values []string
+		for _, key := range pks {
+			value := obj + "." + key.name
+			if strings.HasPrefix(key.field.TypeName, "*") {
+				value = "*" + value
+			}
+			if composite {
+				value = key.name + ": " + value
+			}
+			values = append(values, value)
+		}
+		if composite {
+			return `patchSetKey{` + strings.Join(values, ", ") + `}`
+		}
+		return values[0]
+	}
+
+	keyType := strings.TrimPrefix(pks[0].field.TypeName, "*")
+	var conds, keyValues []string
+	for _, key := range pks {
+		conds = append(conds, columnName(key.name, key.field)+" = ?")
+		if composite {
+			keyValues = append(keyValues, "key."+key.name)
+		} else {
+			keyValues = append(keyValues, "key")
+		}
+	}
+	if composite {
+		keyType = "patchSetKey"
+		g.P(`type patchSetKey struct {`)
+		for _, key := range pks {
+			g.P(key.name, ` `, strings.TrimPrefix(key.field.TypeName, "*"))
+		}
+		g.P(`}`)
+	}
+	g.P(`objectKeys := make([]`, keyType, `, 0, len(objects))`)
+	g.P(`seen := make(map[`, keyType, `]struct{}, len(objects))`)
+	g.P(`for _, in := range objects {`)
+	g.P(`if in == nil {`)
+	g.P(`return nil, `, generateImport("NilArgumentError", gerrorsImport, g))
+	g.P(`}`)
+	g.P(`ormObj, err := in.ToORM(ctx)`)
+	g.P(`if err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	for _, key := range pks {
+		if cond := b.emptyKeyCondition("ormObj."+key.name, key.field, g); cond != "" {
+			g.P(`if `, cond, ` {`)
+			g.P(`return nil, `, generateImport("EmptyIdError", gerrorsImport, g))
+			g.P(`}`)
+		}
+	}
+	g.P(`key := `, keyOf("ormObj"))
+	g.P(`if _, ok := seen[key]; ok {`)
+	g.P(`return nil, `, generateImport("DuplicateKeyError", gerrorsImport, g))
+	g.P(`}`)
+	g.P(`seen[key] = struct{}{}`)
+	g.P(`objectKeys = append(objectKeys, key)`)
+	g.P(`}`)
+
+	omitPaths := parseRecursiveFields(ormable,
+		func(f *Field) bool {
+			// check only fields with association info (e.g. other Gorm types)
+			if f.FieldAssociationInfo == nil {
+				return false
+			}
+			// omit field if autoupdate disabled
+			return f.FieldAssociationInfo.GetDisableAssociationAutoupdate()
+		},
+	)
+	omitPaths = append(omitPaths, b.mergedAssociationPaths(ormable)...)
+	associations, children := b.batchUpdateAssociations(ormable)
+	if _, ok := ormable.Fields["UpdatedAt"]; ok && len(associations) > 0 {
+		// gorm would set the update time with the associations
+		omitPaths = append(omitPaths, NewFieldPath("UpdatedAt"))
+	}
+
+	g.P(`batchSize := `, getBatchSize(message))
+	g.P(`results := make([]*`, typeName, `, len(objects))`)
+	g.P(`err = db.Transaction(func(db *`, gormDB, `) error {`)
+	g.P(`var err error`)
+	g.P(`// the objects sharing the handle their hooks return share the queries`)
+	g.P(`dbs := make([]*`, gormDB, `, len(objects))`)
+	g.P(`group := func() ([]*`, gormDB, `, map[*`, gormDB, `][]int) {`)
+	g.P(`var handles []*`, gormDB)
+	g.P(`groups := make(map[*`, gormDB, `][]int)`)
+	g.P(`for i, handle := range dbs {`)
+	g.P(`if _, ok := groups[handle]; !ok {`)
+	g.P(`handles = append(handles, handle)`)
+	g.P(`}`)
+	g.P(`groups[handle] = append(groups[handle], i)`)
+	g.P(`}`)
+	g.P(`return handles, groups`)
+	g.P(`}`)
+	g.P(`for i, in := range objects {`)
+	g.P(`dbs[i] = db`)
+	g.P(`if hook, ok := interface{}(&`, typeName, `{}).(`, ormable.OriginName, `WithBeforePatchRead); ok {`)
+	g.P(`if dbs[i], err = hook.BeforePatchRead(ctx, in, updateMasks[i], db); err != nil {`)
+	g.P(`return err`)
+	g.P(`}`)
+	g.P(`}`)
+	g.P(`}`)
+	g.P(`targets := make(map[`, keyType, `]`, ormable.Name, `, len(objects))`)
+	g.P(`handles, groups := group()`)
+	g.P(`for _, handle := range handles {`)
+	if composite {
+		g.P(`keys := make([][]interface{}, 0, len(groups[handle]))`)
+		g.P(`for _, i := range groups[handle] {`)
+		g.P(`key := objectKeys[i]`)
+		g.P(`keys = append(keys, []interface{}{`, strings.Join(keyValues, ", "), `})`)
+		g.P(`}`)
+	} else {
+		g.P(`keys := make([]`, keyType, `, 0, len(groups[handle]))`)
+		g.P(`for _, i := range groups[handle] {`)
+		g.P(`keys = append(keys, objectKeys[i])`)
+		g.P(`}`)
+	}
+	g.P(`query := handle.Session(&`, gormSession, `{}).Clauses(`, generateImport("Locking", gormClauseImport, g), `{Strength: "UPDATE"})`)
+	if composite {
+		b.generateCompositeKeysWhereClause(ormable, "query", g)
+	} else {
+		g.P(`query = query.Where("`, columnName(pks[0].name, pks[0].field), ` in (?)", keys)`)
+	}
+	g.P(`rows := []`, ormable.Name, `{}`)
+	g.P(`if err = query.Find(&rows).Error; err != nil {`)
+	g.P(`return err`)
+	g.P(`}`)
+	g.P(`for _, row := range rows {`)
+	g.P(`targets[`, keyOf("row"), `] = row`)
+	g.P(`}`)
+	g.P(`}`)
+
+	// each object is prepared with its own handle, so that the scopes its hooks
+	// add don't leak into the objects of the other handles
+	g.P(`ormObjs := make([]`, ormable.Name, `, len(objects))`)
+	g.P(`prepare := func(i int) (*`, gormDB, `, error) {`)
+	g.P(`in, key, updateMask, db := objects[i], objectKeys[i], updateMasks[i], dbs[i]`)
+	g.P(`target, ok := targets[key]`)
+	g.P(`if !ok {`)
+	g.P(`return nil, `, generateImport("ErrRecordNotFound", gormImport, g))
+	g.P(`}`)
+	b.generateVersionCheck(ormable, "target", g)
+	g.P(`stored, err := target.ToPB(ctx)`)
+	g.P(`if err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	g.P(`pbObj := &stored`)
+	b.generateBeforePatchHookCall(ormable, "ApplyFieldMask", g)
+	g.P(`if _, err := DefaultApplyFieldMask`, typeName, `(ctx, pbObj, in, updateMask, "", db); err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	b.generateBeforePatchHookCall(ormable, "Save", g)
+	g.P(`ormObj, err := pbObj.ToORM(ctx)`)
+	g.P(`if err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	if version != nil {
+		g.P(`ormObj.`, versionName, ` = target.`, versionName, ` + 1`)
+	}
+	b.generateBeforeHookCall(ormable, "StrictUpdateCleanup", g)
+	if len(children) > 0 {
+		// the associations aren't read with the stored objects, so the ones
+		// left out of the mask are left as they are
+		g.P(`masked := make(map[string]bool, len(updateMask.GetPaths()))`)
+		g.P(`for _, path := range updateMask.GetPaths() {`)
+		g.P(`masked[`, generateImport("SplitN", stdStringsImport, g), `(path, ".", 2)[0]] = true`)
+		g.P(`}`)
+		for _, fieldName := range children {
+			g.P(`if masked["`, fieldName, `"] || masked["*"] {`)
+			b.handleChildAssociationsByName(message, fieldName, g)
+			g.P(`}`)
+		}
+	}
+	b.generateBeforeHookCall(ormable, "StrictUpdateSave", g)
+	g.P(`ormObjs[i] = ormObj`)
+	g.P(`return db, nil`)
+	g.P(`}`)
+	g.P(`for i := range objects {`)
+	g.P(`if dbs[i], err = prepare(i); err != nil {`)
+	g.P(`return err`)
+	g.P(`}`)
+	g.P(`}`)
+
+	// the batch is written with a single update matching the rows by primary
+	// key, each column is set to the value of the object of the row
+	var keyArgs []string
+	for _, value := range keyValues {
+		keyArgs = append(keyArgs, strings.Replace(value, "key", "objectKeys[i]", 1))
+	}
+	rowCond := strings.Join(conds, " AND ")
+	// the types without columns besides their primary key have no rows to write
+	columns := b.batchUpdateColumns(ormable)
+	if len(columns) > 0 {
+		g.P(`write := func(db *`, gormDB, `, batch []int) error {`)
+		g.P(`conds := make([]string, 0, len(batch))`)
+		g.P(`args := make([]interface{}, 0, len(batch)*`, len(keyArgs)+1, `)`)
+		g.P(`for _, i := range batch {`)
+		if version != nil {
+			g.P(`conds = append(conds, "(`, rowCond, ` AND `, columnName(versionName, version), ` = ?)")`)
+			g.P(`args = append(args, `, strings.Join(keyArgs, ", "), `, targets[objectKeys[i]].`, versionName, `)`)
+		} else {
+			g.P(`conds = append(conds, "(`, rowCond, `)")`)
+			g.P(`args = append(args, `, strings.Join(keyArgs, ", "), `)`)
+		}
+		g.P(`}`)
+		g.P(`column := func(name string, value func(ormObj *`, ormable.Name, `) interface{}) `, generateImport("Expr", gormClauseImport, g), ` {`)
+		g.P(`whens := make([]interface{}, 0, len(batch)*`, len(keyArgs)+1, `)`)
+		g.P(`for _, i := range batch {`)
+		g.P(`whens = append(whens, `, strings.Join(keyArgs, ", "), `, value(&ormObjs[i]))`)
+		g.P(`}`)
+		g.P(`return `, generateImport("Expr", gormImport, g), `("CASE "+`, generateImport("Repeat", stdStringsImport, g), `("WHEN `, rowCond, ` THEN ? ", len(batch))+"ELSE "+name+" END", whens...)`)
+		g.P(`}`)
+		g.P(`res := db.Session(&`, gormSession, `{}).Model(&`, ormable.Name, `{}).Where(strings.Join(conds, " OR "), args...).Updates(map[string]interface{}{`)
+		for _, column := range columns {
+			g.P(`"`, column[0], `": column("`, column[0], `", func(ormObj *`, ormable.Name, `) interface{} { return ormObj.`, column[1], ` }),`)
+		}
+		g.P(`})`)
+		if version != nil {
+			g.P(`if res.Error != nil {`)
+			g.P(`return res.Error`)
+			g.P(`}`)
+			g.P(`if res.RowsAffected != int64(len(batch)) {`)
+			g.P(`return `, generateImport("VersionConflictError", gerrorsImport, g))
+			g.P(`}`)
+			g.P(`return nil`)
+		} else {
+			g.P(`return res.Error`)
+		}
+		g.P(`}`)
+	}
+
+	// the update is conditioned on the primary keys and never inserts, so a
+	// row deleted since the read isn't brought back
+	g.P(`handles, groups = group()`)
+	g.P(`for _, handle := range handles {`)
+	g.P(`indexes := groups[handle]`)
+	g.P(`for start := 0; start < len(indexes); start += batchSize {`)
+	g.P(`end := start + batchSize`)
+	g.P(`if end > len(indexes) {`)
+	g.P(`end = len(indexes)`)
+	g.P(`}`)
+	g.P(`batch := indexes[start:end]`)
+	if len(associations) > 0 {
+		var selectPaths []*fieldPath
+		for _, name := range associations {
+			selectPaths = append(selectPaths, NewFieldPath(name))
+		}
+		g.P(`objs := make([]*`, ormable.Name, `, 0, len(batch))`)
+		g.P(`for _, i := range batch {`)
+		g.P(`objs = append(objs, &ormObjs[i])`)
+		g.P(`}`)
+		g.P(`// the associations are saved first, the batch is then written with the`)
+		g.P(`// foreign keys of its belongs-to associations`)
+		query := `handle.Session(&` + gormSession + `{}).Select(` + fieldPathsToQuoted(selectPaths) + `)`
+		if len(omitPaths) > 0 {
+			query += `.Omit(` + fieldPathsToQuoted(omitPaths) + `)`
+		}
+		g.P(`if err = `, query, `.Model(&objs).Updates(map[string]interface{}{}).Error; err != nil {`)
+		g.P(`return err`)
+		g.P(`}`)
+	}
+	if len(columns) > 0 {
+		g.P(`if err = write(handle, batch); err != nil {`)
+		g.P(`return err`)
+		g.P(`}`)
+	}
+	b.generateSavePositionsCall(ormable, "objs...", "return ", g)
 	g.P(`}`)
 	g.P(`}`)
-}
 
-func (b *ORMBuilder) generateAfterPatchHookCall(orm *OrmableType, suffix string, g *protogen.GeneratedFile) {
-	g.P(`if hook, ok := interface{}(pbResponse).(`, orm.OriginName, `WithAfterPatch`, suffix, `); ok {`)
-	g.P(`if err = hook.AfterPatch`, suffix, `(ctx, in, updateMask, db); err != nil {`)
+	g.P(`finish := func(i int) (*`, typeName, `, error) {`)
+	g.P(`in, updateMask, db, ormObj := objects[i], updateMasks[i], dbs[i], ormObjs[i]`)
+	g.P(`var err error`)
+	b.generateAfterHookCall(ormable, "StrictUpdateSave", g)
+	g.P(`saved, err := ormObj.ToPB(ctx)`)
+	g.P(`if err != nil {`)
 	g.P(`return nil, err`)
 	g.P(`}`)
+	g.P(`pbResponse := &saved`)
+	b.generateAfterPatchHookCall(ormable, "Save", g)
+	g.P(`return pbResponse, nil`)
 	g.P(`}`)
-}
-
-func (b *ORMBuilder) generateBeforePatchHookDef(orm *OrmableType, suffix string, g *protogen.GeneratedFile) {
-	g.P(`type `, orm.OriginName, `WithBeforePatch`, suffix, ` interface {`)
-	g.P(`BeforePatch`, suffix, `(context.Context, *`, orm.OriginName, `, *`, generateImport("FieldMask", fmImport, g), `, *`, generateImport("DB", gormImport, g),
-		`) (*`, generateImport("DB", gormImport, g), `, error)`)
+	g.P(`for i := range objects {`)
+	g.P(`if results[i], err = finish(i); err != nil {`)
+	g.P(`return err`)
 	g.P(`}`)
-}
-
-func (b *ORMBuilder) generateAfterPatchHookDef(orm *OrmableType, suffix string, g *protogen.GeneratedFile) {
-	g.P(`type `, orm.OriginName, `WithAfterPatch`, suffix, ` interface {`)
-	g.P(`AfterPatch`, suffix, `(context.Context, *`, orm.OriginName, `, *`, generateImport("FieldMask", fmImport, g), `, *`, generateImport("DB", gormImport, g),
-		`) error`)
+	g.P(`}`)
+	g.P(`return nil`)
+	g.P(`})`)
+	g.P(`if err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	g.P(`return results, nil`)
 	g.P(`}`)
 }
 
-func (b *ORMBuilder) generatePatchSetHandler(message *protogen.Message, g *protogen.GeneratedFile) {
-	var isMultiAccount bool
-
-	typeName := string(message.Desc.Name())
-	if getMessageOptions(message).GetMultiAccount() {
-		isMultiAccount = true
+// batchUpdateAssociations returns the associations the batched updates of the
+// type save, and the ones among them whose stored children are cleaned up
+func (b *ORMBuilder) batchUpdateAssociations(ormable *OrmableType) (associations, children []string) {
+	var fieldNames []string
+	for name := range ormable.Fields {
+		fieldNames = append(fieldNames, name)
 	}
+	sort.Strings(fieldNames)
 
-	if isMultiAccount && !b.hasIDField(message) {
-		g.P(fmt.Sprintf("// Cannot autogen DefaultPatchSet%s: this is a multi-account table without an \"id\" field in the message.\n", typeName))
-		return
+	for _, fieldName := range fieldNames {
+		field := ormable.Fields[fieldName]
+		switch {
+		case field.GetHasMany() != nil, field.GetHasOne() != nil, field.GetManyToMany() != nil:
+			children = append(children, fieldName)
+		case field.GetBelongsTo() == nil:
+			continue
+		}
+		associations = append(associations, fieldName)
 	}
+	return associations, children
+}
 
-	_ = generateImport("", "fmt", g)
-	g.P(`// DefaultPatchSet`, typeName, ` executes a bulk gorm update call with patch behavior`)
-	g.P(`func DefaultPatchSet`, typeName, `(ctx context.Context, objects []*`,
-		typeName, `, updateMasks []*`, generateImport("FieldMask", fmImport, g), `, db *`, generateImport("DB", gormImport, g), `) ([]*`, typeName, `, error) {`)
-	g.P(`if len(objects) != len(updateMasks) {`)
-	g.P(`return nil, fmt.Errorf(`, generateImport("BadRepeatedFieldMaskTpl", gerrorsImport, g), `, len(updateMasks), len(objects))`)
-	g.P(`}`)
-	g.P(``)
-	g.P(`results := make([]*`, typeName, `, 0, len(objects))`)
-	g.P(`for i, patcher := range objects {`)
-	g.P(`pbResponse, err := DefaultPatch`, typeName, `(ctx, patcher, updateMasks[i], db)`)
-	g.P(`if err != nil {`)
-	g.P(`return nil, err`)
-	g.P(`}`)
-	g.P(``)
-	g.P(`results = append(results, pbResponse)`)
-	g.P(`}`)
-	g.P(``)
-	g.P(`return results, nil`)
-	g.P(`}`)
+// batchUpdateColumns returns the columns the batched updates of the type set,
+// along with the fields of the object holding their values. The primary key is
+// matched rather than set, and gorm sets the update time itself
+func (b *ORMBuilder) batchUpdateColumns(ormable *OrmableType) [][2]string {
+	primaryKeys := map[string]bool{}
+	for _, key := range b.getPrimaryKeys(ormable) {
+		primaryKeys[key.name] = true
+	}
+	sortedNames := func(fields map[string]*Field) []string {
+		var names []string
+		for name := range fields {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return names
+	}
 
+	var columns [][2]string
+	for _, fieldName := range sortedNames(ormable.Fields) {
+		field := ormable.Fields[fieldName]
+		switch {
+		case primaryKeys[fieldName], fieldName == "UpdatedAt", field.GetTag().GetIgnore():
+		case field.Type != nil && field.GetTag().GetEmbedded():
+			for _, subName := range sortedNames(field.Type.Fields) {
+				if subField := field.Type.Fields[subName]; subField.Type == nil {
+					columns = append(columns, [2]string{field.GetTag().GetEmbeddedPrefix() + columnName(subName, subField), fieldName + "." + subName})
+				}
+			}
+		case field.Type == nil:
+			columns = append(columns, [2]string{columnName(fieldName, field), fieldName})
+		}
+	}
+	sort.Slice(columns, func(i, j int) bool {
+		return columns[i][0] < columns[j][0]
+	})
+	return columns
 }
 
 func (b *ORMBuilder) generateApplyFieldMask(message *protogen.Message, g *protogen.GeneratedFile) {
@@ -2623,16 +5022,32 @@ func (b *ORMBuilder) generateApplyFieldMask(message *protogen.Message, g *protog
 	g.P(`} else if patchee == nil {`)
 	g.P(`return nil, `, generateImport("NilArgumentError", gerrorsImport, g))
 	g.P(`}`)
+	// a lone "*" path replaces every field, as described in AIP-134
+	var allPaths []string
+	for _, field := range message.Fields {
+		allPaths = append(allPaths, `prefix+"`+camelCase(field.GoName)+`"`)
+	}
+	g.P(`if len(updateMask.GetPaths()) == 1 && updateMask.Paths[0] == prefix+"*" {`)
+	g.P(`updateMask = &`, generateImport("FieldMask", fmImport, g), `{Paths: []string{`, strings.Join(allPaths, ", "), `}}`)
+	g.P(`}`)
+	g.P(`for _, f := range updateMask.GetPaths() {`)
+	g.P(`if `, generateImport("HasPrefix", stdStringsImport, g), `(f, prefix) && !DefaultHasFieldMaskPath`, typeName, `(strings.TrimPrefix(f, prefix)) {`)
+	g.P(`return nil, &`, generateImport("InvalidFieldMaskError", gerrorsImport, g), `{Path: f}`)
+	g.P(`}`)
+	g.P(`}`)
 	g.P(`var err error`)
 
 	hasNested := false
 	for _, field := range message.Fields {
 		fieldType := getFieldType(field)
 
-		if field.Message != nil && !isSpecialType(fieldType) && field.Desc.Cardinality() != protoreflect.Repeated {
+		if b.isMergedField(message, field) {
+			g.P(`var updated`, camelCase(field.GoName), ` bool`)
+			hasNested = true
+		} else if field.Message != nil && !isSpecialType(fieldType) && !isJSONField(field) && field.Desc.Cardinality() != protoreflect.Repeated {
 			g.P(`var updated`, camelCase(field.GoName), ` bool`)
 			hasNested = true
-		} else if strings.HasSuffix(fieldType, protoTypeJSON) && field.Desc.Cardinality() != protoreflect.Repeated {
+		} else if isJSONField(field) && field.Desc.Cardinality() != protoreflect.Repeated {
 			g.P(`var updated`, camelCase(field.GoName), ` bool`)
 		}
 	}
@@ -2647,12 +5062,14 @@ func (b *ORMBuilder) generateApplyFieldMask(message *protogen.Message, g *protog
 		ccName := camelCase(field.GoName)
 
 		fieldType := getFieldType(field)
-		//  for ormable message, do recursive patching
-		if field.Message != nil && b.isOrmable(fieldType) && field.Desc.Cardinality() != protoreflect.Repeated {
-			if field.Message != nil {
-				// a hack work around imported types
-				fieldType = b.typeName(field.Message.GoIdent, g)
-			}
+		//  for ormable and embedded messages, do recursive patching
+		if b.isMergedField(message, field) {
+			b.generateMergedFieldMask(message, field, g)
+		} else if (b.isOrmableField(field) || b.isEmbeddedField(message, field)) && field.Desc.Cardinality() != protoreflect.Repeated {
+			applier := b.typeName(protogen.GoIdent{
+				GoName:       `DefaultApplyFieldMask` + fieldType,
+				GoImportPath: field.Message.GoIdent.GoImportPath,
+			}, g)
 			_ = generateImport("", stdStringsImport, g)
 			g.P(`if !updated`, ccName, ` && strings.HasPrefix(f, prefix+"`, ccName, `.") {`)
 			g.P(`updated`, ccName, ` = true`)
@@ -2663,15 +5080,9 @@ func (b *ORMBuilder) generateApplyFieldMask(message *protogen.Message, g *protog
 			g.P(`if patchee.`, ccName, ` == nil {`)
 			g.P(`patchee.`, ccName, ` = &`, strings.TrimPrefix(b.typeName(getFieldIdent(field), g), "*"), `{}`)
 			g.P(`}`)
-			if s := strings.Split(fieldType, "."); len(s) == 2 {
-				g.P(`if o, err := `, strings.TrimLeft(s[0], "*"), `.DefaultApplyFieldMask`, s[1], `(ctx, patchee.`, ccName,
-					`, patcher.`, ccName, `, &`, generateImport("FieldMask", fmImport, g),
-					`{Paths:updateMask.Paths[i:]}, prefix+"`, ccName, `.", db); err != nil {`)
-			} else {
-				g.P(`if o, err := DefaultApplyFieldMask`, strings.TrimPrefix(fieldType, "*"), `(ctx, patchee.`, ccName,
-					`, patcher.`, ccName, `, &`, generateImport("FieldMask", fmImport, g),
-					`{Paths:updateMask.Paths[i:]}, prefix+"`, ccName, `.", db); err != nil {`)
-			}
+			g.P(`if o, err := `, applier, `(ctx, patchee.`, ccName,
+				`, patcher.`, ccName, `, &`, generateImport("FieldMask", fmImport, g),
+				`{Paths:updateMask.Paths[i:]}, prefix+"`, ccName, `.", db); err != nil {`)
 			g.P(`return nil, err`)
 			g.P(`} else {`)
 			g.P(`patchee.`, ccName, ` = o`)
@@ -2683,7 +5094,7 @@ func (b *ORMBuilder) generateApplyFieldMask(message *protogen.Message, g *protog
 			g.P(`patchee.`, ccName, ` = patcher.`, ccName)
 			g.P(`continue`)
 			g.P(`}`)
-		} else if field.Message != nil && !isSpecialType(fieldType) && field.Desc.Cardinality() != protoreflect.Repeated {
+		} else if field.Message != nil && !isSpecialType(fieldType) && !isJSONField(field) && field.Desc.Cardinality() != protoreflect.Repeated {
 			_ = generateImport("", stdStringsImport, g)
 			g.P(`if !updated`, ccName, ` && strings.HasPrefix(f, prefix+"`, ccName, `.") {`)
 			g.P(`if patcher.`, ccName, ` == nil {`)
@@ -2700,7 +5111,7 @@ func (b *ORMBuilder) generateApplyFieldMask(message *protogen.Message, g *protog
 			g.P(`}`)
 			g.P(`}`)
 			g.P(`if err := `, generateImport("MergeWithMask", tkgormImport, g), `(patcher.`, ccName, `, patchee.`, ccName, `, childMask); err != nil {`)
-			g.P(`return nil, nil`)
+			g.P(`return nil, &`, generateImport("InvalidFieldMaskError", gerrorsImport, g), `{Path: f}`)
 			g.P(`}`)
 			g.P(`}`)
 			g.P(`if f == prefix+"`, ccName, `" {`)
@@ -2708,7 +5119,7 @@ func (b *ORMBuilder) generateApplyFieldMask(message *protogen.Message, g *protog
 			g.P(`patchee.`, ccName, ` = patcher.`, ccName)
 			g.P(`continue`)
 			g.P(`}`)
-		} else if strings.HasSuffix(fieldType, protoTypeJSON) && field.Desc.Cardinality() != protoreflect.Repeated {
+		} else if isJSONField(field) && field.Desc.Cardinality() != protoreflect.Repeated {
 			_ = generateImport("", stdStringsImport, g)
 			g.P(`if !updated`, ccName, ` && strings.HasPrefix(f, prefix+"`, ccName, `") {`)
 			g.P(`patchee.`, ccName, ` = patcher.`, ccName)
@@ -2731,6 +5142,264 @@ func (b *ORMBuilder) generateApplyFieldMask(message *protogen.Message, g *protog
 	g.P()
 }
 
+// generateHasFieldMaskPath renders the check of a field mask path against the
+// fields of the message, the paths below ormable and embedded messages are
+// checked by their own function, the ones below other messages segment by
+// segment and JSON fields take any path below them
+func (b *ORMBuilder) generateHasFieldMaskPath(message *protogen.Message, g *protogen.GeneratedFile) {
+	typeName := string(message.Desc.Name())
+	g.P(`// DefaultHasFieldMaskPath`, typeName, ` reports whether `, typeName, ` has the field of the field mask path`)
+	g.P(`func DefaultHasFieldMaskPath`, typeName, `(path string) bool {`)
+	g.P(`parts := `, generateImport("SplitN", stdStringsImport, g), `(path, ".", 2)`)
+	g.P(`switch parts[0] {`)
+	var leaves, opaque []string
+	for _, field := range message.Fields {
+		ccName := camelCase(field.GoName)
+		fieldType := getFieldType(field)
+		repeated := field.Desc.Cardinality() == protoreflect.Repeated
+		switch {
+		case b.isMergedField(message, field) || b.isEmbeddedField(message, field) || b.isOrmableField(field) && !repeated:
+			checker := `DefaultHasFieldMaskPath` + fieldType
+			if field.Message.GoIdent.GoImportPath != message.GoIdent.GoImportPath {
+				checker = generateImport(checker, string(field.Message.GoIdent.GoImportPath), g)
+			}
+			g.P(`case "`, ccName, `":`)
+			g.P(`return len(parts) == 1 || `, checker, `(parts[1])`)
+		case isJSONField(field) && !repeated:
+			opaque = append(opaque, `"`+ccName+`"`)
+		case field.Message != nil && !isSpecialType(fieldType) && !repeated:
+			g.P(`case "`, ccName, `":`)
+			b.generateMessageFieldMaskPath(field.Message, map[protoreflect.FullName]bool{}, g)
+		default:
+			leaves = append(leaves, `"`+ccName+`"`)
+		}
+	}
+	if len(opaque) > 0 {
+		g.P(`case `, strings.Join(opaque, ", "), `:`)
+		g.P(`return true`)
+	}
+	if len(leaves) > 0 {
+		g.P(`case `, strings.Join(leaves, ", "), `:`)
+		g.P(`return len(parts) == 1`)
+	}
+	g.P(`}`)
+	g.P(`return false`)
+	g.P(`}`)
+	g.P()
+}
+
+// generateMessageFieldMaskPath renders the check of the rest of a field mask
+// path below a message that isn't ormable, whose segments are the Go names of
+// the fields merged by tkgorm.MergeWithMask. A message nested in itself only
+// takes the whole field, as the check is rendered inline
+func (b *ORMBuilder) generateMessageFieldMaskPath(message *protogen.Message, seen map[protoreflect.FullName]bool, g *protogen.GeneratedFile) {
+	seen[message.Desc.FullName()] = true
+	defer delete(seen, message.Desc.FullName())
+	if len(message.Fields) == 0 {
+		g.P(`return len(parts) == 1`)
+		return
+	}
+	g.P(`if len(parts) == 1 {`)
+	g.P(`return true`)
+	g.P(`}`)
+	g.P(`parts = `, generateImport("SplitN", stdStringsImport, g), `(parts[1], ".", 2)`)
+	g.P(`switch parts[0] {`)
+	var leaves []string
+	for _, field := range message.Fields {
+		if oneof := field.Oneof; oneof != nil && !oneof.Desc.IsSynthetic() {
+			if oneof.Fields[0] == field {
+				leaves = append(leaves, `"`+oneof.GoName+`"`)
+			}
+			continue
+		}
+		if field.Message != nil && field.Desc.Cardinality() != protoreflect.Repeated &&
+			!isSpecialType(getFieldType(field)) && !isJSONField(field) && !seen[field.Message.Desc.FullName()] {
+			g.P(`case "`, field.GoName, `":`)
+			b.generateMessageFieldMaskPath(field.Message, seen, g)
+			continue
+		}
+		leaves = append(leaves, `"`+field.GoName+`"`)
+	}
+	if len(leaves) > 0 {
+		g.P(`case `, strings.Join(leaves, ", "), `:`)
+		g.P(`return len(parts) == 1`)
+	}
+	g.P(`}`)
+	g.P(`return false`)
+}
+
+// generateImpliedFieldMask renders the field mask of the fields set in an
+// object, the proto3 optional and message fields are set when present and the
+// other ones when they aren't empty
+func (b *ORMBuilder) generateImpliedFieldMask(message *protogen.Message, g *protogen.GeneratedFile) {
+	typeName := string(message.Desc.Name())
+	fieldMask := generateImport("FieldMask", fmImport, g)
+	g.P(`// DefaultImpliedFieldMask`, typeName, ` returns the field mask of the fields set in the object`)
+	g.P(`func DefaultImpliedFieldMask`, typeName, `(in *`, typeName, `) *`, fieldMask, ` {`)
+	g.P(`mask := &`, fieldMask, `{}`)
+	g.P(`if in == nil {`)
+	g.P(`return mask`)
+	g.P(`}`)
+	g.P(`in.ProtoReflect().Range(func(fd `, generateImport("FieldDescriptor", "google.golang.org/protobuf/reflect/protoreflect", g), `, _ `, generateImport("Value", "google.golang.org/protobuf/reflect/protoreflect", g), `) bool {`)
+	g.P(`switch fd.Name() {`)
+	for _, field := range message.Fields {
+		g.P(`case "`, field.Desc.Name(), `":`)
+		g.P(`mask.Paths = append(mask.Paths, "`, camelCase(field.GoName), `")`)
+	}
+	g.P(`}`)
+	g.P(`return true`)
+	g.P(`})`)
+	g.P(`return mask`)
+	g.P(`}`)
+	g.P()
+}
+
+// updateHasImpliedMask reports whether an Update method of the type patches the
+// fields set in the payload when no update mask is given
+func (b *ORMBuilder) updateHasImpliedMask(ormable *OrmableType) bool {
+	for _, method := range ormable.Methods {
+		if method.verb == updateService && getMethodOptions(method.Method).GetImpliedUpdateMask() {
+			return true
+		}
+	}
+	return false
+}
+
+// generateInvalidFieldMaskStatus renders the conversion of an invalid field
+// mask error into an InvalidArgument status
+func (b *ORMBuilder) generateInvalidFieldMaskStatus(g *protogen.GeneratedFile) {
+	g.P(`var fmErr *`, generateImport("InvalidFieldMaskError", gerrorsImport, g))
+	g.P(`if `, generateImport("As", stdErrorsImport, g), `(err, &fmErr) {`)
+	g.P(`err = `, generateImport("Error", grpcStatusImport, g), `(`, generateImport("InvalidArgument", grpcCodesImport, g), `, err.Error())`)
+	g.P(`}`)
+}
+
+// generateVersionConflictStatus renders the conversion of a version conflict
+// error into an Aborted status, for the types with a version field
+func (b *ORMBuilder) generateVersionConflictStatus(typeName string, g *protogen.GeneratedFile) {
+	if _, version := b.findVersionField(b.getOrmable(typeName)); version == nil {
+		return
+	}
+	g.P(`if `, generateImport("Is", stdErrorsImport, g), `(err, `, generateImport("VersionConflictError", gerrorsImport, g), `) {`)
+	g.P(`err = `, generateImport("Error", grpcStatusImport, g), `(`, generateImport("Aborted", grpcCodesImport, g), `, err.Error())`)
+	g.P(`}`)
+}
+
+// generateInvalidPageTokenStatus renders the conversion of a malformed page token
+// error into an InvalidArgument status, for the types paged with page tokens
+func (b *ORMBuilder) generateInvalidPageTokenStatus(ormable *OrmableType, g *protogen.GeneratedFile) {
+	if !b.listHasCursorPagination(ormable) {
+		return
+	}
+	g.P(`if `, generateImport("Is", stdErrorsImport, g), `(err, `, generateImport("InvalidPageTokenError", gerrorsImport, g), `) {`)
+	g.P(`err = `, generateImport("Error", grpcStatusImport, g), `(`, generateImport("InvalidArgument", grpcCodesImport, g), `, err.Error())`)
+	g.P(`}`)
+}
+
+// isMergedField reports whether the field is an association merged by primary key
+func (b *ORMBuilder) isMergedField(message *protogen.Message, field *protogen.Field) bool {
+	ormField, ok := b.getMessageType(message).Fields[camelCase(field.GoName)]
+	if !ok {
+		return false
+	}
+	merge, _ := mergeOptions(ormField)
+	return merge
+}
+
+// isEmbeddedField reports whether the field is embedded into the table of the message
+func (b *ORMBuilder) isEmbeddedField(message *protogen.Message, field *protogen.Field) bool {
+	ormField, ok := b.getMessageType(message).Fields[camelCase(field.GoName)]
+	return ok && ormField.Type != nil && ormField.GetTag().GetEmbedded()
+}
+
+// generateMergedFieldMask renders the patching of a merged association, the
+// paths below it patch the stored children matching the patcher ones by primary
+// key, the other children of the patcher are added as they are
+func (b *ORMBuilder) generateMergedFieldMask(message *protogen.Message, field *protogen.Field, g *protogen.GeneratedFile) {
+	ormable := b.getOrmable(string(message.Desc.Name()))
+	ccName := camelCase(field.GoName)
+	ormField := ormable.Fields[ccName]
+	key := b.getMergeKey(ormable, ccName)
+	childType := string(field.Message.Desc.Name())
+	if field.Message.GoIdent.GoImportPath != message.GoIdent.GoImportPath {
+		panic(fmt.Sprintf("Merged association %s of %s needs children of the same package", ccName, ormable.Name))
+	}
+
+	_ = generateImport("", stdStringsImport, g)
+	g.P(`if !updated`, ccName, ` && strings.HasPrefix(f, prefix+"`, ccName, `.") {`)
+	g.P(`updated`, ccName, ` = true`)
+	g.P(`childMask := &`, generateImport("FieldMask", fmImport, g), `{}`)
+	g.P(`for j := i; j < len(updateMask.Paths); j++ {`)
+	g.P(`if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"`, ccName, `."); trimPath != updateMask.Paths[j] {`)
+	g.P(`childMask.Paths = append(childMask.Paths, trimPath)`)
+	g.P(`}`)
+	g.P(`}`)
+	g.P(`patcheeORM, err := patchee.ToORM(ctx)`)
+	g.P(`if err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	g.P(`stored`, ccName, ` := `, ormField.TypeName, `{}`)
+	g.P(`if err = db.Model(&patcheeORM).Association("`, ccName, `").Find(&stored`, ccName, `); err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	keyValue := func(obj string) string {
+		if strings.HasPrefix(key.field.TypeName, "*") {
+			return "*" + obj + "." + key.name
+		}
+		return obj + "." + key.name
+	}
+	g.P(`storedByKey := map[interface{}]*`, childType, `{}`)
+	g.P(`for _, child := range stored`, ccName, ` {`)
+	if strings.HasPrefix(key.field.TypeName, "*") {
+		g.P(`if child.`, key.name, ` == nil {`)
+		g.P(`continue`)
+		g.P(`}`)
+	}
+	g.P(`pbChild, err := child.ToPB(ctx)`)
+	g.P(`if err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	g.P(`storedByKey[`, keyValue("child"), `] = &pbChild`)
+	g.P(`}`)
+	g.P(`patchee.`, ccName, ` = nil`)
+	g.P(`for _, child := range patcher.`, ccName, ` {`)
+	g.P(`childORM, err := child.ToORM(ctx)`)
+	g.P(`if err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	if strings.HasPrefix(key.field.TypeName, "*") {
+		g.P(`if childORM.`, key.name, ` != nil {`)
+	}
+	g.P(`if storedChild, ok := storedByKey[`, keyValue("childORM"), `]; ok {`)
+	g.P(`if child, err = DefaultApplyFieldMask`, childType, `(ctx, storedChild, child, childMask, "", db); err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	g.P(`}`)
+	if strings.HasPrefix(key.field.TypeName, "*") {
+		g.P(`}`)
+	}
+	g.P(`patchee.`, ccName, ` = append(patchee.`, ccName, `, child)`)
+	g.P(`}`)
+	g.P(`continue`)
+	g.P(`}`)
+	g.P(`if f == prefix+"`, ccName, `" {`)
+	g.P(`updated`, ccName, ` = true`)
+	g.P(`patchee.`, ccName, ` = patcher.`, ccName)
+	g.P(`if patchee.`, ccName, ` == nil {`)
+	g.P(`// the masked collection is emptied rather than left as it is`)
+	g.P(`patchee.`, ccName, ` = []*`, childType, `{}`)
+	g.P(`}`)
+	g.P(`continue`)
+	g.P(`}`)
+}
+
+// isJSONField reports whether the field holds a JSON document, whose paths
+// below the field replace the whole document
+func isJSONField(field *protogen.Field) bool {
+	return strings.HasSuffix(getFieldType(field), protoTypeJSON) ||
+		field.Message != nil && field.Message.Desc.FullName() == "google.protobuf.Struct"
+}
+
 func isSpecialType(typeName string) bool {
 	switch typeName {
 	case protoTypeJSON, protoTypeBigInt, protoTypeUUID, protoTypeUUIDValue, protoTypeResource, protoTypeInet, protoTimeOnly:
@@ -2744,52 +5413,231 @@ func (b *ORMBuilder) generateListHandler(message *protogen.Message, g *protogen.
 	typeName := string(message.Desc.Name())
 	ormable := b.getOrmable(typeName)
 
+	cursor := b.listHasCursorPagination(ormable)
 	g.P(`// DefaultList`, typeName, ` executes a gorm list call`)
-	listSign := fmt.Sprint(`func DefaultList`, typeName, `(ctx context.Context, db *`, generateImport("DB", gormImport, g))
-	var f, s, pg, fs string
+	if cursor {
+		g.P(`// and returns the page token of the next page, empty on the last page`)
+	}
+	listSign := fmt.Sprint(`func DefaultList`, typeName, `(ctx context.Context, db *`, generateImport("DB", gormImport, g), b.listParams(ormable, g))
+	f, s, pg, fs := b.listArgs(ormable)
+	listSign += `) ([]*` + typeName
+	if cursor {
+		listSign += `, string`
+	}
+	if ormable.WithTotalCount {
+		listSign += `, int64`
+	}
+	listSign += `, error) {`
+	g.P(listSign)
+	g.P(`in := `, typeName, `{}`)
+	g.P(`ormObj, err := in.ToORM(ctx)`)
+	g.P(`if err != nil {`)
+	g.P(b.listFailure(ormable), `err`)
+	g.P(`}`)
+	if b.listHasShowDeleted(ormable) {
+		b.generateUnscoped(ormable, g)
+	}
+	b.generateBeforeListHookCall(ormable, "ApplyQuery", b.listFailure(ormable), g)
+	if cursor {
+		b.generateCursorSeek(message, s != "nil", b.listFailure(ormable), g)
+		g.P(`limit := p.GetLimit()`)
+		g.P(`if limit > 0 {`)
+		g.P(`// the row following the page tells whether there is a next one`)
+		g.P(`p.Limit++`)
+		g.P(`}`)
+	}
+	if f != "nil" || s != "nil" || pg != "nil" || fs != "nil" {
+		g.P(`db, err = `, generateImport("ApplyCollectionOperators", tkgormImport, g), `(ctx, db, &`, ormable.Name, `{}, &`, typeName, `{}, `, f, `,`, s, `,`, pg, `,`, fs, `)`)
+		g.P(`if err != nil {`)
+		g.P(b.listFailure(ormable), `err`)
+		g.P(`}`)
+	}
+	b.generateBeforeListHookCall(ormable, "Find", b.listFailure(ormable), g)
+	g.P(`db = db.Where(&ormObj)`)
+	if ormable.WithTotalCount {
+		// the count runs on a copy of the scoped query, without the paging, the
+		// preloads and the seek of the page
+		g.P(`countDB := db.Session(&`, generateImport("Session", gormImport, g), `{}).Limit(-1).Offset(-1)`)
+		g.P(`countDB.Statement.Preloads = nil`)
+		g.P(`var total int64`)
+		g.P(`if err = countDB.Model(&`, ormable.Name, `{}).Count(&total).Error; err != nil {`)
+		g.P(b.listFailure(ormable), `err`)
+		g.P(`}`)
+	}
+	if cursor {
+		b.generateCursorSeekCondition(g)
+	}
+
+	b.generateListOrder(ormable, cursor, g)
+
+	g.P(`ormResponse := []`, ormable.Name, `{}`)
+	g.P(`if err := db.Find(&ormResponse).Error; err != nil {`)
+	g.P(b.listFailure(ormable), `err`)
+	g.P(`}`)
+	if cursor {
+		g.P(`var pageToken string`)
+		g.P(`if limit > 0 && int32(len(ormResponse)) > limit {`)
+		g.P(`ormResponse = ormResponse[:limit]`)
+		g.P(`if pageToken, err = DefaultPageToken`, typeName, `(ctx, &ormResponse[limit-1], `, s, `); err != nil {`)
+		g.P(b.listFailure(ormable), `err`)
+		g.P(`}`)
+		g.P(`}`)
+	}
+	if len(b.positionedAssociations(ormable)) > 0 {
+		g.P(`positioned := make([]*`, ormable.Name, `, len(ormResponse))`)
+		g.P(`for i := range ormResponse {`)
+		g.P(`positioned[i] = &ormResponse[i]`)
+		g.P(`}`)
+		b.generateSortPositionsCall(ormable, "positioned...", b.listFailure(ormable), g)
+	}
+	b.generateAfterListHookCall(ormable, g)
+	g.P(`pbResponse := []*`, typeName, `{}`)
+	g.P(`for _, responseEntry := range ormResponse {`)
+	g.P(`temp, err := responseEntry.ToPB(ctx)`)
+	g.P(`if err != nil {`)
+	g.P(b.listFailure(ormable), `err`)
+	g.P(`}`)
+	g.P(`pbResponse = append(pbResponse, &temp)`)
+	g.P(`}`)
+	results := `pbResponse`
+	if cursor {
+		results += `, pageToken`
+	}
+	if ormable.WithTotalCount {
+		results += `, total`
+	}
+	g.P(`return `, results, `, nil`)
+	g.P(`}`)
+	b.generateBeforeListHookDef(ormable, "ApplyQuery", g)
+	b.generateBeforeListHookDef(ormable, "Find", g)
+	b.generateAfterListHookDef(ormable, g)
+}
+
+// listParams renders the collection operator parameters the list handlers
+// of the type take, after the db one
+func (b *ORMBuilder) listParams(ormable *OrmableType, g *protogen.GeneratedFile) string {
+	var params string
+	if b.listHasFiltering(ormable) {
+		params += fmt.Sprint(`, f `, `*`, generateImport("Filtering", queryImport, g))
+	}
+	if b.listHasSorting(ormable) {
+		params += fmt.Sprint(`, s `, `*`, generateImport("Sorting", queryImport, g))
+	}
+	if b.listHasPagination(ormable) {
+		params += fmt.Sprint(`, p `, `*`, generateImport("Pagination", queryImport, g))
+	}
+	if b.listHasFieldSelection(ormable) {
+		params += fmt.Sprint(`, fs `, `*`, generateImport("FieldSelection", queryImport, g))
+	}
+	if b.listHasShowDeleted(ormable) {
+		params += `, showDeleted bool`
+	}
+	return params
+}
+
+// listArgs returns the collection operators passed to ApplyCollectionOperators,
+// nil for the ones the list handlers don't take
+func (b *ORMBuilder) listArgs(ormable *OrmableType) (f, s, pg, fs string) {
+	f, s, pg, fs = "nil", "nil", "nil", "nil"
 	if b.listHasFiltering(ormable) {
-		listSign += fmt.Sprint(`, f `, `*`, generateImport("Filtering", queryImport, g))
 		f = "f"
-	} else {
-		f = "nil"
 	}
 	if b.listHasSorting(ormable) {
-		listSign += fmt.Sprint(`, s `, `*`, generateImport("Sorting", queryImport, g))
 		s = "s"
-	} else {
-		s = "nil"
 	}
 	if b.listHasPagination(ormable) {
-		listSign += fmt.Sprint(`, p `, `*`, generateImport("Pagination", queryImport, g))
 		pg = "p"
-	} else {
-		pg = "nil"
 	}
 	if b.listHasFieldSelection(ormable) {
-		listSign += fmt.Sprint(`, fs `, `*`, generateImport("FieldSelection", queryImport, g))
 		fs = "fs"
-	} else {
-		fs = "nil"
 	}
-	listSign += fmt.Sprint(`) ([]*`, typeName, `, error) {`)
-	g.P(listSign)
+	return f, s, pg, fs
+}
+
+// generateStreamListHandler renders the list handler sending the objects one
+// by one as the rows are read from the db cursor, the associations of the
+// objects aren't preloaded
+func (b *ORMBuilder) generateStreamListHandler(message *protogen.Message, g *protogen.GeneratedFile) {
+	typeName := string(message.Desc.Name())
+	ormable := b.getOrmable(typeName)
+
+	g.P(`// DefaultStreamList`, typeName, ` executes a gorm list call and sends each row`)
+	g.P(`func DefaultStreamList`, typeName, `(ctx context.Context, db *`, generateImport("DB", gormImport, g), b.listParams(ormable, g), `, send func(*`, typeName, `) error) error {`)
+	f, s, pg, fs := b.listArgs(ormable)
 	g.P(`in := `, typeName, `{}`)
 	g.P(`ormObj, err := in.ToORM(ctx)`)
 	g.P(`if err != nil {`)
-	g.P(`return nil, err`)
+	g.P(`return err`)
 	g.P(`}`)
-	b.generateBeforeListHookCall(ormable, "ApplyQuery", g)
+	if b.listHasShowDeleted(ormable) {
+		b.generateUnscoped(ormable, g)
+	}
+	b.generateBeforeListHookCall(ormable, "ApplyQuery", `return `, g)
+	cursor := b.listHasCursorPagination(ormable)
+	if cursor {
+		b.generateCursorSeek(message, s != "nil", `return `, g)
+	}
 	if f != "nil" || s != "nil" || pg != "nil" || fs != "nil" {
 		g.P(`db, err = `, generateImport("ApplyCollectionOperators", tkgormImport, g), `(ctx, db, &`, ormable.Name, `{}, &`, typeName, `{}, `, f, `,`, s, `,`, pg, `,`, fs, `)`)
 		g.P(`if err != nil {`)
-		g.P(`return nil, err`)
+		g.P(`return err`)
 		g.P(`}`)
 	}
-	b.generateBeforeListHookCall(ormable, "Find", g)
+	b.generateBeforeListHookCall(ormable, "Find", `return `, g)
 	g.P(`db = db.Where(&ormObj)`)
+	if cursor {
+		b.generateCursorSeekCondition(g)
+	}
+
+	b.generateListOrder(ormable, cursor, g)
+
+	g.P(`rows, err := db.Model(&`, ormable.Name, `{}).Rows()`)
+	g.P(`if err != nil {`)
+	g.P(`return err`)
+	g.P(`}`)
+	g.P(`defer rows.Close()`)
+	g.P(`for rows.Next() {`)
+	g.P(`row := `, ormable.Name, `{}`)
+	g.P(`if err = db.ScanRows(rows, &row); err != nil {`)
+	g.P(`return err`)
+	g.P(`}`)
+	g.P(`pbObj, err := row.ToPB(ctx)`)
+	g.P(`if err != nil {`)
+	g.P(`return err`)
+	g.P(`}`)
+	g.P(`if err = send(&pbObj); err != nil {`)
+	g.P(`return err`)
+	g.P(`}`)
+	g.P(`}`)
+	g.P(`return rows.Err()`)
+	g.P(`}`)
+}
+
+func (b *ORMBuilder) listHasStreaming(ormable *OrmableType) bool {
+	for _, method := range ormable.Methods {
+		if method.verb == listService && method.streaming {
+			return true
+		}
+	}
+	return false
+}
 
+// generateListOrder renders the ordering of the listed rows by primary key
+func (b *ORMBuilder) generateListOrder(ormable *OrmableType, cursor bool, g *protogen.GeneratedFile) {
 	// TODO handle composite primary keys order considering priority tag
-	if b.hasCompositePrimaryKey(ormable) {
+	if cursor {
+		// the primary key breaks the ties of the sort key in its direction
+		for _, key := range b.getPrimaryKeys(ormable) {
+			column := columnName(key.name, key.field)
+			g.P(`if !sorted["`, column, `"] {`)
+			g.P(`if desc {`)
+			g.P(`db = db.Order("`, column, ` desc")`)
+			g.P(`} else {`)
+			g.P(`db = db.Order("`, column, `")`)
+			g.P(`}`)
+			g.P(`}`)
+		}
+	} else if b.hasCompositePrimaryKey(ormable) {
 		pkFieldMapping := b.getPrimaryKeys(ormable)
 		var columns []string
 		for _, pkFieldObj := range pkFieldMapping {
@@ -2808,28 +5656,205 @@ func (b *ORMBuilder) generateListHandler(message *protogen.Message, g *protogen.
 		}
 		g.P(`db = db.Order("`, column, `")`)
 	}
+}
 
-	g.P(`ormResponse := []`, ormable.Name, `{}`)
-	g.P(`if err := db.Find(&ormResponse).Error; err != nil {`)
-	g.P(`return nil, err`)
+// listFailure returns the start of the statement returning an error from the
+// List handler of the type
+func (b *ORMBuilder) listFailure(ormable *OrmableType) string {
+	failure := `return nil, `
+	if b.listHasCursorPagination(ormable) {
+		failure += `"", `
+	}
+	if ormable.WithTotalCount {
+		failure += `0, `
+	}
+	return failure
+}
+
+// generateCountHandler renders the handler counting the rows the List handler
+// of the type would return, regardless of the paging
+func (b *ORMBuilder) generateCountHandler(message *protogen.Message, g *protogen.GeneratedFile) {
+	typeName := string(message.Desc.Name())
+	ormable := b.getOrmable(typeName)
+
+	g.P(`// DefaultCount`, typeName, ` executes a gorm count call with the filtering of the list`)
+	countSign := fmt.Sprint(`func DefaultCount`, typeName, `(ctx context.Context, db *`, generateImport("DB", gormImport, g))
+	f := "nil"
+	if b.listHasFiltering(ormable) {
+		countSign += fmt.Sprint(`, f *`, generateImport("Filtering", queryImport, g))
+		f = "f"
+	}
+	if b.listHasShowDeleted(ormable) {
+		countSign += `, showDeleted bool`
+	}
+	g.P(countSign, `) (int64, error) {`)
+	g.P(`in := `, typeName, `{}`)
+	g.P(`ormObj, err := in.ToORM(ctx)`)
+	g.P(`if err != nil {`)
+	g.P(`return 0, err`)
 	g.P(`}`)
-	b.generateAfterListHookCall(ormable, g)
-	g.P(`pbResponse := []*`, typeName, `{}`)
-	g.P(`for _, responseEntry := range ormResponse {`)
-	g.P(`temp, err := responseEntry.ToPB(ctx)`)
+	if b.listHasShowDeleted(ormable) {
+		b.generateUnscoped(ormable, g)
+	}
+	if f != "nil" {
+		// only the filtering applies, the other collection operators would
+		// preload the associations a count doesn't allow
+		g.P(`db, assocToJoin, err := `, generateImport("ApplyFilteringEx", tkgormImport, g), `(ctx, db, f, &`, ormable.Name, `{}, `, generateImport("NewDefaultPbToOrmConverter", tkgormImport, g), `(&`, typeName, `{}))`)
+		g.P(`if err != nil {`)
+		g.P(`return 0, err`)
+		g.P(`}`)
+		g.P(`if db, err = `, generateImport("JoinAssociations", tkgormImport, g), `(ctx, db, assocToJoin, &`, ormable.Name, `{}); err != nil {`)
+		g.P(`return 0, err`)
+		g.P(`}`)
+	}
+	g.P(`var count int64`)
+	g.P(`if err = db.Model(&`, ormable.Name, `{}).Where(&ormObj).Count(&count).Error; err != nil {`)
+	g.P(`return 0, err`)
+	g.P(`}`)
+	g.P(`return count, nil`)
+	g.P(`}`)
+	g.P()
+}
+
+// sortableFields returns the fields of message a cursor paginated list can be
+// sorted by, with the columns they are stored in. Nullable columns are left
+// out, as the seek predicate never matches the rows holding NULL
+func (b *ORMBuilder) sortableFields(message *protogen.Message) (fields []*protogen.Field, columns []string) {
+	ormable := b.getOrmable(string(message.Desc.Name()))
+	for _, field := range message.Fields {
+		fieldName := camelCase(string(field.Desc.Name()))
+		ormField, ok := ormable.Fields[fieldName]
+		if !ok || ormField.Type != nil {
+			continue
+		}
+		if strings.HasPrefix(ormField.TypeName, "*") && !ormField.GetTag().GetNotNull() && !ormField.GetTag().GetPrimaryKey() {
+			continue
+		}
+		fields = append(fields, field)
+		columns = append(columns, columnName(fieldName, ormField))
+	}
+	return fields, columns
+}
+
+// generateCursorSeek renders the seek predicate of cursor pagination, the rows
+// following the sort key the page token holds in the order of the sorting
+func (b *ORMBuilder) generateCursorSeek(message *protogen.Message, sorting bool, failure string, g *protogen.GeneratedFile) {
+	typeName := string(message.Desc.Name())
+	ormable := b.getOrmable(typeName)
+	_ = generateImport("", "fmt", g)
+	_ = generateImport("", stdStringsImport, g)
+
+	g.P(`desc := false`)
+	g.P(`columns := []string{}`)
+	// the primary key only breaks the ties when the sorting doesn't include it
+	g.P(`sorted := map[string]bool{}`)
+	if sorting {
+		g.P(`for i, cr := range s.GetCriterias() {`)
+		g.P(`if i > 0 && cr.IsDesc() != desc {`)
+		g.P(failure, `fmt.Errorf("cursor pagination of `, typeName, ` needs a single sort direction")`)
+		g.P(`}`)
+		g.P(`desc = cr.IsDesc()`)
+		g.P(`switch cr.GetTag() {`)
+		fields, columns := b.sortableFields(message)
+		for i, field := range fields {
+			g.P(`case "`, field.Desc.Name(), `":`)
+			g.P(`columns = append(columns, "`, columns[i], `")`)
+			g.P(`sorted["`, columns[i], `"] = true`)
+		}
+		g.P(`default:`)
+		g.P(failure, `fmt.Errorf("cannot paginate `, typeName, ` sorted by %q", cr.GetTag())`)
+		g.P(`}`)
+		g.P(`}`)
+	}
+	for _, key := range b.getPrimaryKeys(ormable) {
+		column := columnName(key.name, key.field)
+		g.P(`if !sorted["`, column, `"] {`)
+		g.P(`columns = append(columns, "`, column, `")`)
+		g.P(`}`)
+	}
+	g.P(`var seek []interface{}`)
+	g.P(`if token := p.GetPageToken(); token != "" {`)
+	g.P(`raw, err := `, generateImport("URLEncoding", "encoding/base64", g), `.DecodeString(token)`)
 	g.P(`if err != nil {`)
-	g.P(`return nil, err`)
+	g.P(failure, generateImport("InvalidPageTokenError", gerrorsImport, g))
 	g.P(`}`)
-	g.P(`pbResponse = append(pbResponse, &temp)`)
+	// numbers are kept as json.Number, so that large keys don't lose precision
+	g.P(`decoder := `, generateImport("NewDecoder", encodingJsonImport, g), `(`, generateImport("NewReader", "bytes", g), `(raw))`)
+	g.P(`decoder.UseNumber()`)
+	g.P(`if err = decoder.Decode(&seek); err != nil || len(seek) != len(columns) {`)
+	g.P(failure, generateImport("InvalidPageTokenError", gerrorsImport, g))
 	g.P(`}`)
-	g.P(`return pbResponse, nil`)
 	g.P(`}`)
-	b.generateBeforeListHookDef(ormable, "ApplyQuery", g)
-	b.generateBeforeListHookDef(ormable, "Find", g)
-	b.generateAfterListHookDef(ormable, g)
+	g.P(`p = &`, generateImport("Pagination", queryImport, g), `{Limit: p.GetLimit()}`)
+}
+
+// generateCursorSeekCondition renders the condition keeping the rows past the
+// sort key decoded by generateCursorSeek
+func (b *ORMBuilder) generateCursorSeekCondition(g *protogen.GeneratedFile) {
+	g.P(`if seek != nil {`)
+	g.P(`op := ">"`)
+	g.P(`if desc {`)
+	g.P(`op = "<"`)
+	g.P(`}`)
+	g.P(`db = db.Where("("+strings.Join(columns, ", ")+") "+op+" ?", seek)`)
+	g.P(`}`)
+}
+
+// generatePageTokenHandler renders the helper encoding the sort key of the last
+// object of a page into the token of the next one
+func (b *ORMBuilder) generatePageTokenHandler(message *protogen.Message, g *protogen.GeneratedFile) {
+	typeName := string(message.Desc.Name())
+	ormable := b.getOrmable(typeName)
+	_ = generateImport("", "fmt", g)
+
+	g.P(`// DefaultPageToken`, typeName, ` encodes the sort key of in, the last row of a page sorted`)
+	g.P(`// by s, into the page token of the next page`)
+	g.P(`func DefaultPageToken`, typeName, `(ctx context.Context, in *`, ormable.Name, `, s *`, generateImport("Sorting", queryImport, g), `) (string, error) {`)
+	g.P(`if in == nil {`)
+	g.P(`return "", `, generateImport("NilArgumentError", gerrorsImport, g))
+	g.P(`}`)
+	g.P(`key := []interface{}{}`)
+	g.P(`sorted := map[string]bool{}`)
+	g.P(`for _, cr := range s.GetCriterias() {`)
+	g.P(`switch cr.GetTag() {`)
+	fields, columns := b.sortableFields(message)
+	for i, field := range fields {
+		g.P(`case "`, field.Desc.Name(), `":`)
+		g.P(`key = append(key, in.`, camelCase(string(field.Desc.Name())), `)`)
+		g.P(`sorted["`, columns[i], `"] = true`)
+	}
+	g.P(`default:`)
+	g.P(`return "", fmt.Errorf("cannot paginate `, typeName, ` sorted by %q", cr.GetTag())`)
+	g.P(`}`)
+	g.P(`}`)
+	for _, key := range b.getPrimaryKeys(ormable) {
+		column := columnName(key.name, key.field)
+		g.P(`if !sorted["`, column, `"] {`)
+		g.P(`key = append(key, in.`, key.name, `)`)
+		g.P(`}`)
+	}
+	g.P(`raw, err := `, generateImport("Marshal", encodingJsonImport, g), `(key)`)
+	g.P(`if err != nil {`)
+	g.P(`return "", err`)
+	g.P(`}`)
+	g.P(`return `, generateImport("URLEncoding", "encoding/base64", g), `.EncodeToString(raw), nil`)
+	g.P(`}`)
+	g.P()
+}
+
+// listHasCursorPagination reports whether the List handler of the type pages
+// with page tokens, which needs a pagination argument and a primary key
+func (b *ORMBuilder) listHasCursorPagination(ormable *OrmableType) bool {
+	if !ormable.CursorPagination || !b.listHasPagination(ormable) {
+		return false
+	}
+	if !b.hasPrimaryKey(ormable) {
+		panic(fmt.Sprintf("Cursor pagination of %s needs a primary key", ormable.Name))
+	}
+	return true
 }
 
-func (b *ORMBuilder) generateBeforeListHookCall(orm *OrmableType, suffix string, g *protogen.GeneratedFile) {
+func (b *ORMBuilder) generateBeforeListHookCall(orm *OrmableType, suffix, failure string, g *protogen.GeneratedFile) {
 	g.P(`if hook, ok := interface{}(&ormObj).(`, orm.Name, `WithBeforeList`, suffix, `); ok {`)
 	hookCall := fmt.Sprint(`if db, err = hook.BeforeList`, suffix, `(ctx, db`)
 	if b.listHasFiltering(orm) {
@@ -2846,7 +5871,7 @@ func (b *ORMBuilder) generateBeforeListHookCall(orm *OrmableType, suffix string,
 	}
 	hookCall += `); err != nil {`
 	g.P(hookCall)
-	g.P(`return nil, err`)
+	g.P(failure, `err`)
 	g.P(`}`)
 	g.P(`}`)
 }
@@ -2868,7 +5893,7 @@ func (b *ORMBuilder) generateAfterListHookCall(orm *OrmableType, g *protogen.Gen
 	}
 	hookCall += `); err != nil {`
 	g.P(hookCall)
-	g.P(`return nil, err`)
+	g.P(b.listFailure(orm), `err`)
 	g.P(`}`)
 	g.P(`}`)
 }
@@ -2951,7 +5976,7 @@ func (b *ORMBuilder) listHasPagination(ormable *OrmableType) bool {
 
 func (b *ORMBuilder) listHasFieldSelection(ormable *OrmableType) bool {
 	for _, method := range ormable.Methods {
-		if s := b.getFieldSelection(method.inType); s != "" {
+		if s := b.getFieldSelection(method.inType); s != "" || b.getView(method.inType) != nil {
 			return true
 		}
 	}
@@ -2980,15 +6005,30 @@ func (b *ORMBuilder) parseServices(file *protogen.File) {
 			input := method.Input
 			output := method.Output
 			methodName := string(method.Desc.Name())
-			var verb, fmName, baseType string
-			var follows bool
-
-			if strings.HasPrefix(methodName, createService) {
+			var verb, fmName, baseType, association string
+			var follows, streaming bool
+
+			if method.Desc.IsStreamingClient() {
+				// only the server side streaming of the results is supported
+			} else if method.Desc.IsStreamingServer() {
+				if strings.HasPrefix(methodName, listService) {
+					verb = listService
+					streaming = true
+					follows, baseType = b.followsStreamListConventions(input, output, listService)
+				}
+			} else if strings.HasPrefix(methodName, createSetService) {
+				verb = createSetService
+				follows, baseType = b.followsCreateSetConventions(input, output, createSetService)
+			} else if strings.HasPrefix(methodName, createService) {
 				verb = createService
 				follows, baseType = b.followsCreateConventions(input, output, createService)
 			} else if strings.HasPrefix(methodName, readService) {
 				verb = readService
-				follows, baseType = b.followsReadConventions(input, output, readService)
+				if getMethodOptions(method).GetReadKey() != "" {
+					follows, baseType = b.followsReadByKeyConventions(input, output, method)
+				} else {
+					follows, baseType = b.followsReadConventions(input, output, readService)
+				}
 			} else if strings.HasPrefix(methodName, updateSetService) {
 				verb = updateSetService
 				follows, baseType, fmName = b.followsUpdateSetConventions(input, output, updateSetService)
@@ -3004,6 +6044,21 @@ func (b *ORMBuilder) parseServices(file *protogen.File) {
 			} else if strings.HasPrefix(methodName, listService) {
 				verb = listService
 				follows, baseType = b.followsListConventions(input, output, listService)
+			} else if strings.HasPrefix(methodName, upsertService) {
+				verb = upsertService
+				follows, baseType, fmName = b.followsUpsertConventions(input, output, method)
+			} else if strings.HasPrefix(methodName, undeleteService) {
+				verb = undeleteService
+				follows, baseType = b.followsUndeleteConventions(input, output, undeleteService)
+			} else if strings.HasPrefix(methodName, purgeService) {
+				verb = purgeService
+				follows, baseType = b.followsPurgeConventions(input, output, method)
+			} else if strings.HasPrefix(methodName, addService) {
+				verb = addService
+				follows, baseType, association = b.followsAssociationConventions(input, output, method)
+			} else if strings.HasPrefix(methodName, removeService) {
+				verb = removeService
+				follows, baseType, association = b.followsAssociationConventions(input, output, method)
 			}
 
 			genMethod := autogenMethod{
@@ -3015,6 +6070,8 @@ func (b *ORMBuilder) parseServices(file *protogen.File) {
 				verb:              verb,
 				followsConvention: follows,
 				baseType:          baseType,
+				streaming:         streaming,
+				association:       association,
 			}
 
 			genSvc.methods = append(genSvc.methods, genMethod)
@@ -3056,6 +6113,52 @@ func (b *ORMBuilder) followsCreateConventions(inType *protogen.Message, outType
 			inType.Desc.Name(), outType.Desc.Name())
 		return false, ""
 	}
+	if b.getOrmable(inTypeName).View != nil {
+		fmt.Fprintf(os.Stderr, "stub will be generated for %s since %s ormable type is a read-only view.\n", methodName, inTypeName)
+		return false, ""
+	}
+	return true, inTypeName
+}
+
+func (b *ORMBuilder) followsCreateSetConventions(inType *protogen.Message, outType *protogen.Message, methodName string) (bool, string) {
+	var inEntity, outEntity *protogen.Field
+	for _, field := range inType.Fields {
+		if string(field.Desc.Name()) == "objects" {
+			inEntity = field
+		}
+	}
+	for _, field := range outType.Fields {
+		if string(field.Desc.Name()) == "results" {
+			outEntity = field
+		}
+	}
+
+	if inEntity == nil || outEntity == nil || inEntity.Message == nil || outEntity.Message == nil {
+		fmt.Fprintf(os.Stderr, "method: %q, request should has repeated field 'objects' in request and repeated field 'results' in response.\n", methodName)
+		return false, ""
+	}
+
+	if inEntity.Desc.Cardinality() != protoreflect.Repeated || outEntity.Desc.Cardinality() != protoreflect.Repeated {
+		fmt.Fprintf(os.Stderr, "method: %q, field 'objects' in request and field 'results' in response should be repeated.\n", methodName)
+		return false, ""
+	}
+
+	inTypeName, outTypeName := string(inEntity.Message.Desc.Name()), string(outEntity.Message.Desc.Name())
+	if !b.isOrmable(inTypeName) {
+		fmt.Fprintf(os.Stderr, "method: %q, type %q must be ormable.\n", methodName, inTypeName)
+		return false, ""
+	}
+
+	if inTypeName != outTypeName {
+		fmt.Fprintf(os.Stderr, "method: %q, field 'objects' in request has type: %q but field 'results' in response has: %q.\n", methodName, inTypeName, outTypeName)
+		return false, ""
+	}
+
+	if b.getOrmable(inTypeName).View != nil {
+		fmt.Fprintf(os.Stderr, "method: %q, type %q is a read-only view.\n", methodName, inTypeName)
+		return false, ""
+	}
+
 	return true, inTypeName
 }
 
@@ -3066,11 +6169,6 @@ func (b *ORMBuilder) followsReadConventions(inType *protogen.Message, outType *p
 			hasID = true
 		}
 	}
-	if !hasID {
-		fmt.Fprintf(os.Stderr, "stub will be generated for %s since %s incoming message doesn't have \"id\" field", methodName, inType.Desc.Name())
-		return false, ""
-	}
-
 	var outTypeName string
 	var typeOrmable bool
 	for _, field := range outType.Fields {
@@ -3090,10 +6188,68 @@ func (b *ORMBuilder) followsReadConventions(inType *protogen.Message, outType *p
 		fmt.Fprintf(os.Stderr, "stub will be generated for %s since %s ormable type doesn't have a primary key.\n", methodName, outTypeName)
 		return false, ""
 	}
+	if b.hasCompositePrimaryKey(b.getOrmable(outTypeName)) {
+		if _, ok := b.requestKeyInitializers(inType, b.getOrmable(outTypeName)); !ok {
+			fmt.Fprintf(os.Stderr, "stub will be generated for %s since %s incoming message doesn't have a field for each primary key of %s.\n", methodName, inType.Desc.Name(), outTypeName)
+			return false, ""
+		}
+	} else if !hasID {
+		fmt.Fprintf(os.Stderr, "stub will be generated for %s since %s incoming message doesn't have \"id\" field", methodName, inType.Desc.Name())
+		return false, ""
+	}
+
+	return true, outTypeName
+}
+
+func (b *ORMBuilder) followsReadByKeyConventions(inType *protogen.Message, outType *protogen.Message, method *protogen.Method) (bool, string) {
+	methodName := string(method.Desc.Name())
+	result := b.resultMessage(outType)
+	if result == nil || !b.isOrmable(string(result.Desc.Name())) {
+		fmt.Fprintf(os.Stderr, "stub will be generated for %s since %s outcoming message doesn't have \"result\" field of ormable type.\n", methodName, outType.Desc.Name())
+		return false, ""
+	}
+	outTypeName := string(result.Desc.Name())
+	if !b.hasPrimaryKey(b.getOrmable(outTypeName)) {
+		fmt.Fprintf(os.Stderr, "stub will be generated for %s since %s ormable type doesn't have a primary key.\n", methodName, outTypeName)
+		return false, ""
+	}
+	readKey := getMethodOptions(method).GetReadKey()
+	key, ok := b.findUniqueKey(b.getOrmable(outTypeName), readKey)
+	if !ok {
+		fmt.Fprintf(os.Stderr, "stub will be generated for %s since %s ormable type doesn't have a unique key %q.\n", methodName, outTypeName, readKey)
+		return false, ""
+	}
+	if _, ok := b.requestFieldInitializers(inType, b.requestedKeyFields(result, key)); !ok {
+		fmt.Fprintf(os.Stderr, "stub will be generated for %s since %s incoming message doesn't have a field for each field of the %s key of %s.\n", methodName, inType.Desc.Name(), readKey, outTypeName)
+		return false, ""
+	}
 
 	return true, outTypeName
 }
 
+// resultMessage returns the message of the "result" field of a response
+func (b *ORMBuilder) resultMessage(outType *protogen.Message) *protogen.Message {
+	for _, field := range outType.Fields {
+		if string(field.Desc.Name()) == "result" && field.Message != nil {
+			return field.Message
+		}
+	}
+	return nil
+}
+
+// requestedKeyFields returns the fields of a unique key the request has to set,
+// the account of multi-account types is taken from the context instead
+func (b *ORMBuilder) requestedKeyFields(message *protogen.Message, key uniqueKey) []pkFieldObjs {
+	var fields []pkFieldObjs
+	for _, field := range key.fields {
+		if field.name == "AccountID" && getMessageOptions(message).GetMultiAccount() {
+			continue
+		}
+		fields = append(fields, field)
+	}
+	return fields
+}
+
 func (b *ORMBuilder) followsUpdateSetConventions(inType *protogen.Message, outType *protogen.Message, methodName string) (bool, string, string) {
 	var (
 		inEntity    *protogen.Field
@@ -3150,6 +6306,11 @@ func (b *ORMBuilder) followsUpdateSetConventions(inType *protogen.Message, outTy
 		return false, "", ""
 	}
 
+	if b.getOrmable(inTypeName).View != nil {
+		fmt.Fprintf(os.Stderr, "method: %q, type %q is a read-only view.\n", methodName, inTypeName)
+		return false, "", ""
+	}
+
 	return true, inTypeName, camelCase(inFieldMask.GoName)
 }
 
@@ -3197,26 +6358,105 @@ func (b *ORMBuilder) followsUpdateConventions(inType *protogen.Message, outType
 		fmt.Fprintf(os.Stderr, `stub will be generated for %s since %s ormable type doesn't have a primary key.\n`, methodName, outTypeName)
 		return false, "", ""
 	}
+	if b.getOrmable(inTypeName).View != nil {
+		fmt.Fprintf(os.Stderr, `stub will be generated for %s since %s ormable type is a read-only view.\n`, methodName, inTypeName)
+		return false, "", ""
+	}
 
 	return true, inTypeName, camelCase(updateMask)
 }
 
+func (b *ORMBuilder) followsUpsertConventions(inType *protogen.Message, outType *protogen.Message, method *protogen.Method) (bool, string, string) {
+	methodName := string(method.Desc.Name())
+	follows, typeName, updateMask := b.followsUpdateConventions(inType, outType, methodName)
+	if !follows {
+		return false, "", ""
+	}
+
+	if _, version := b.findVersionField(b.getOrmable(typeName)); version != nil {
+		fmt.Fprintf(os.Stderr, `stub will be generated for %s since %s ormable type has a version field, which upserts can't compare.\n`, methodName, typeName)
+		return false, "", ""
+	}
+	if !b.hasUpsert(b.getOrmable(typeName)) {
+		fmt.Fprintf(os.Stderr, `stub will be generated for %s since %s multi-account ormable type needs the postgres engine to guard upserts.\n`, methodName, typeName)
+		return false, "", ""
+	}
+
+	if target := getMethodOptions(method).GetConflictTarget(); target != "" {
+		var found bool
+		for _, key := range b.getUniqueKeys(b.getOrmable(typeName)) {
+			if key.named(target) {
+				found = true
+			}
+		}
+		if !found {
+			fmt.Fprintf(os.Stderr, `stub will be generated for %s since %s ormable type doesn't have a unique index %q.\n`, methodName, typeName, target)
+			return false, "", ""
+		}
+	}
+
+	return true, typeName, updateMask
+}
+
 func (b *ORMBuilder) followsDeleteSetConventions(inType *protogen.Message, outType *protogen.Message, method *protogen.Method) (bool, string) {
 	var hasIDs bool
+	var objectsType string
 
 	for _, field := range inType.Fields {
 		if string(field.Desc.Name()) == "ids" && field.Desc.Cardinality() == protoreflect.Repeated {
 			hasIDs = true
 		}
+		if string(field.Desc.Name()) == "objects" && field.Desc.Cardinality() == protoreflect.Repeated && field.Message != nil {
+			objectsType = string(field.Message.Desc.Name())
+		}
 	}
 
 	methodName := string(method.Desc.Name())
-	if !hasIDs {
+	typeName := camelCase(getMethodOptions(method).GetObjectType())
+
+	if typeName == "" {
+		fmt.Fprintf(os.Stderr, `stub will be generated for %s since (gorm.method).object_type option is not specified.\n`, methodName)
+		return false, ""
+	}
+
+	if !b.isOrmable(typeName) {
+		fmt.Fprintf(os.Stderr, `stub will be generated for %s since %s is not an ormable type.\n`, methodName, typeName)
+		return false, ""
+	}
+
+	if !b.hasPrimaryKey(b.getOrmable(typeName)) {
+		fmt.Fprintf(os.Stderr, `stub will be generated for %s since %s ormable type doesn't have a primary key.\n`, methodName, typeName)
+		return false, ""
+	}
+
+	if b.hasCompositePrimaryKey(b.getOrmable(typeName)) {
+		if objectsType != typeName {
+			fmt.Fprintf(os.Stderr, `stub will be generated for %s since %s incoming message doesn't have repeated "objects" field of %s type.\n`, methodName, inType.Desc.Name(), typeName)
+			return false, ""
+		}
+	} else if !hasIDs {
 		fmt.Fprintf(os.Stderr, `stub will be generated for %s since %s incoming message doesn't have "ids" field.\n`, methodName, inType.Desc.Name())
 		return false, ""
 	}
-	typeName := camelCase(getMethodOptions(method).GetObjectType())
 
+	if b.getOrmable(typeName).View != nil {
+		fmt.Fprintf(os.Stderr, `stub will be generated for %s since %s ormable type is a read-only view.\n`, methodName, typeName)
+		return false, ""
+	}
+
+	return true, typeName
+}
+
+func (b *ORMBuilder) followsDeleteConventions(inType *protogen.Message, outType *protogen.Message, method *protogen.Method) (bool, string) {
+	var hasID bool
+	for _, field := range inType.Fields {
+		if string(field.Desc.Name()) == "id" {
+			hasID = true
+		}
+	}
+
+	methodName := string(method.Desc.Name())
+	typeName := camelCase(getMethodOptions(method).GetObjectType())
 	if typeName == "" {
 		fmt.Fprintf(os.Stderr, `stub will be generated for %s since (gorm.method).object_type option is not specified.\n`, methodName)
 		return false, ""
@@ -3228,46 +6468,118 @@ func (b *ORMBuilder) followsDeleteSetConventions(inType *protogen.Message, outTy
 	}
 
 	if !b.hasPrimaryKey(b.getOrmable(typeName)) {
-		fmt.Fprintf(os.Stderr, `stub will be generated for %s since %s ormable type doesn't have a primary key.\n`, methodName, typeName)
+		fmt.Fprintf(os.Stderr, `stub will be generated for %s since %s ormable type doesn't have a primary key`, methodName, typeName)
+		return false, ""
+	}
+
+	if b.hasCompositePrimaryKey(b.getOrmable(typeName)) {
+		if _, ok := b.requestKeyInitializers(inType, b.getOrmable(typeName)); !ok {
+			fmt.Fprintf(os.Stderr, `stub will be generated for %s since %s incoming message doesn't have a field for each primary key of %s.\n`, methodName, inType.Desc.Name(), typeName)
+			return false, ""
+		}
+	} else if !hasID {
+		fmt.Fprintf(os.Stderr, `stub will be generated for %s since %s incoming message doesn't have "id" field.\n`, methodName, inType.Desc.Name())
+		return false, ""
+	}
+
+	if b.getOrmable(typeName).View != nil {
+		fmt.Fprintf(os.Stderr, `stub will be generated for %s since %s ormable type is a read-only view.\n`, methodName, typeName)
 		return false, ""
 	}
 
 	return true, typeName
 }
 
-func (b *ORMBuilder) followsDeleteConventions(inType *protogen.Message, outType *protogen.Message, method *protogen.Method) (bool, string) {
-	var hasID bool
+// followsAssociationConventions accepts the Delete request shape with a repeated
+// field named like a many-to-many association of the type, holding the objects
+// added to or removed from it
+func (b *ORMBuilder) followsAssociationConventions(inType *protogen.Message, outType *protogen.Message, method *protogen.Method) (bool, string, string) {
+	follows, typeName := b.followsDeleteConventions(inType, outType, method)
+	if !follows {
+		return false, "", ""
+	}
+
+	ormable := b.getOrmable(typeName)
 	for _, field := range inType.Fields {
-		if string(field.Desc.Name()) == "id" {
-			hasID = true
+		if field.Desc.Cardinality() != protoreflect.Repeated || !b.isOrmableField(field) {
+			continue
+		}
+		fieldName := camelCase(field.GoName)
+		if !b.managesAssociation(ormable, fieldName) {
+			continue
+		}
+		if ormable.Fields[fieldName].Type == b.getOrmable(string(field.Message.Desc.FullName())) {
+			return true, typeName, fieldName
 		}
 	}
 
-	methodName := string(method.Desc.Name())
-	if !hasID {
-		fmt.Fprintf(os.Stderr, `stub will be generated for %s since %s incoming message doesn't have "id" field.\n`, methodName, inType.Desc.Name())
+	fmt.Fprintf(os.Stderr, "stub will be generated for %s since %s incoming message doesn't have a repeated field named like a many-to-many association of %s.\n",
+		method.Desc.Name(), inType.Desc.Name(), typeName)
+	return false, "", ""
+}
+
+// followsUndeleteConventions accepts the Read request and response shapes for
+// types supporting soft delete
+func (b *ORMBuilder) followsUndeleteConventions(inType *protogen.Message, outType *protogen.Message, methodName string) (bool, string) {
+	follows, typeName := b.followsReadConventions(inType, outType, methodName)
+	if !follows {
 		return false, ""
 	}
-
-	typeName := camelCase(getMethodOptions(method).GetObjectType())
-	if typeName == "" {
-		fmt.Fprintf(os.Stderr, `stub will be generated for %s since (gorm.method).object_type option is not specified.\n`, methodName)
+	if ormable := b.getOrmable(typeName); ormable.View != nil || !b.hasSoftDelete(ormable) {
+		fmt.Fprintf(os.Stderr, "stub will be generated for %s since %s ormable type doesn't support soft delete.\n", methodName, typeName)
 		return false, ""
 	}
 
-	if !b.isOrmable(typeName) {
-		fmt.Fprintf(os.Stderr, `stub will be generated for %s since %s is not an ormable type.\n`, methodName, typeName)
+	return true, typeName
+}
+
+// followsPurgeConventions accepts the Delete request and response shapes for
+// types supporting soft delete
+func (b *ORMBuilder) followsPurgeConventions(inType *protogen.Message, outType *protogen.Message, method *protogen.Method) (bool, string) {
+	follows, typeName := b.followsDeleteConventions(inType, outType, method)
+	if !follows {
 		return false, ""
 	}
-
-	if !b.hasPrimaryKey(b.getOrmable(typeName)) {
-		fmt.Fprintf(os.Stderr, `stub will be generated for %s since %s ormable type doesn't have a primary key`, methodName, typeName)
+	if !b.hasSoftDelete(b.getOrmable(typeName)) {
+		fmt.Fprintf(os.Stderr, "stub will be generated for %s since %s ormable type doesn't support soft delete.\n", method.Desc.Name(), typeName)
 		return false, ""
 	}
 
 	return true, typeName
 }
 
+// requestKeyInitializers returns the struct literal fields copying the composite
+// primary keys of ormable from the request, it reports false when the request
+// misses one of them
+func (b *ORMBuilder) requestKeyInitializers(inType *protogen.Message, ormable *OrmableType) (string, bool) {
+	return b.requestFieldInitializers(inType, b.getPrimaryKeys(ormable))
+}
+
+// requestFieldInitializers renders the initializers of the object fields taken
+// from the request fields of the same name
+func (b *ORMBuilder) requestFieldInitializers(inType *protogen.Message, keys []pkFieldObjs) (string, bool) {
+	var initializers []string
+	for _, key := range keys {
+		var found bool
+		for _, field := range inType.Fields {
+			if field.GoName != key.name {
+				continue
+			}
+			found = true
+			if field.Desc.HasOptionalKeyword() {
+				initializers = append(initializers, key.name+": in."+key.name)
+			} else {
+				initializers = append(initializers, key.name+": in.Get"+key.name+"()")
+			}
+		}
+		if !found {
+			return "", false
+		}
+	}
+
+	return strings.Join(initializers, ", "), true
+}
+
 func (b *ORMBuilder) followsListConventions(inType *protogen.Message, outType *protogen.Message, methodName string) (bool, string) {
 	var outTypeName string
 	var typeOrmable bool
@@ -3288,6 +6600,16 @@ func (b *ORMBuilder) followsListConventions(inType *protogen.Message, outType *p
 	return true, outTypeName
 }
 
+// followsStreamListConventions accepts the streams of the ormable type itself
+// and of list responses, sent with a single result each
+func (b *ORMBuilder) followsStreamListConventions(inType *protogen.Message, outType *protogen.Message, methodName string) (bool, string) {
+	outTypeName := string(outType.Desc.Name())
+	if b.isOrmable(outTypeName) {
+		return true, outTypeName
+	}
+	return b.followsListConventions(inType, outType, methodName)
+}
+
 func getServiceOptions(service *protogen.Service) *gormopts.AutoServerOptions {
 	options := service.Desc.Options().(*descriptorpb.ServiceOptions)
 	if options == nil {
@@ -3351,6 +6673,8 @@ func (b *ORMBuilder) generateDefaultServer(file *protogen.File, g *protogen.Gene
 			switch method.verb {
 			case createService:
 				b.generateCreateServerMethod(service, method, g)
+			case createSetService:
+				b.generateCreateSetServerMethod(service, method, g)
 			case readService:
 				b.generateReadServerMethod(service, method, g)
 			case updateService:
@@ -3362,7 +6686,19 @@ func (b *ORMBuilder) generateDefaultServer(file *protogen.File, g *protogen.Gene
 			case deleteSetService:
 				b.generateDeleteSetServerMethod(service, method, g)
 			case listService:
-				b.generateListServerMethod(service, method, g)
+				if method.streaming {
+					b.generateStreamListServerMethod(service, method, g)
+				} else {
+					b.generateListServerMethod(service, method, g)
+				}
+			case upsertService:
+				b.generateUpsertServerMethod(service, method, g)
+			case undeleteService:
+				b.generateUndeleteServerMethod(service, method, g)
+			case purgeService:
+				b.generatePurgeServerMethod(service, method, g)
+			case addService, removeService:
+				b.generateAssociationServerMethod(service, method, g)
 			default:
 				b.generateMethodStub(service, method, g)
 			}
@@ -3416,6 +6752,7 @@ func (b *ORMBuilder) generateCreateServerMethod(service autogenService, method a
 		g.P(`if err != nil {`)
 		g.P(`return nil, `, b.wrapSpanError(service, "err"))
 		g.P(`}`)
+		b.generateEtagHeader(service, method, g)
 		g.P(`out := &`, b.typeName(method.outType.GoIdent, g), `{Result: res}`)
 		if b.gateway {
 			g.P(`err = `, generateImport("SetCreated", gatewayImport, g), `(ctx, "")`)
@@ -3435,6 +6772,34 @@ func (b *ORMBuilder) generateCreateServerMethod(service autogenService, method a
 	}
 }
 
+func (b *ORMBuilder) generateCreateSetServerMethod(service autogenService, method autogenMethod, g *protogen.GeneratedFile) {
+	b.generateMethodSignature(service, method, g)
+	if method.followsConvention {
+		b.generateDBSetup(service, g)
+		b.generatePreserviceCall(service, method.baseType, method.ccName, g)
+		g.P(`res, err := DefaultCreate`, method.baseType, `Set(ctx, in.GetObjects(), db)`)
+		g.P(`if err != nil {`)
+		g.P(`return nil, `, b.wrapSpanError(service, "err"))
+		g.P(`}`)
+		g.P(`out := &`, b.typeName(method.outType.GoIdent, g), `{Results: res}`)
+		if b.gateway {
+			g.P(`err = `, generateImport("SetCreated", gatewayImport, g), `(ctx, "")`)
+			g.P(`if err != nil {`)
+			g.P(`return nil, `, b.wrapSpanError(service, "err"))
+			g.P(`}`)
+		}
+
+		b.generatePostserviceCall(service, method.baseType, method.ccName, g)
+		b.spanResultHandling(service, g)
+		g.P(`return out, nil`)
+		g.P(`}`)
+		b.generatePreserviceHook(service.ccName, method.baseType, method.ccName, g)
+		b.generatePostserviceHook(service.ccName, method.baseType, b.typeName(method.outType.GoIdent, g), method.ccName, g)
+	} else {
+		b.generateEmptyBody(service, method.outType, g)
+	}
+}
+
 func (b *ORMBuilder) generateMethodSignature(service autogenService, method autogenMethod, g *protogen.GeneratedFile) {
 	in := b.typeName(method.inType.GoIdent, g)
 	out := b.typeName(method.outType.GoIdent, g)
@@ -3452,6 +6817,30 @@ func (b *ORMBuilder) generateMethodSignature(service autogenService, method auto
 	}
 }
 
+// generateStreamMethodSignature renders the signature of the streaming methods,
+// the request of server side streams is passed along with the stream
+func (b *ORMBuilder) generateStreamMethodSignature(service autogenService, method autogenMethod, g *protogen.GeneratedFile) {
+	stream := b.typeName(protogen.GoIdent{
+		GoName:       fmt.Sprint(service.GoName, `_`, method.GoName, `Server`),
+		GoImportPath: service.file.GoImportPath,
+	}, g)
+
+	g.P(`// `, method.ccName, ` ...`)
+	if method.Desc.IsStreamingClient() {
+		g.P(`func (m *`, service.GoName, `DefaultServer) `, method.ccName, ` (stream `, stream, `) error {`)
+		return
+	}
+	g.P(`func (m *`, service.GoName, `DefaultServer) `, method.ccName, ` (in *`, b.typeName(method.inType.GoIdent, g), `, stream `, stream, `) error {`)
+	withSpan := getServiceOptions(service.Service).WithTracing
+	if withSpan {
+		g.P(`span, errSpanCreate := m.spanCreate(stream.Context(), in, "`, method.ccName, `")`)
+		g.P(`if errSpanCreate != nil {`)
+		g.P(`return errSpanCreate`)
+		g.P(`}`)
+		g.P(`defer span.End()`)
+	}
+}
+
 func (b ORMBuilder) generateEmptyBody(service autogenService, outType *protogen.Message, g *protogen.GeneratedFile) {
 	g.P(`out:= &`, b.typeName(outType.GoIdent, g), `{}`)
 	b.spanResultHandling(service, g)
@@ -3531,18 +6920,33 @@ func (b *ORMBuilder) generateReadServerMethod(service autogenService, method aut
 		b.generateDBSetup(service, g)
 		b.generatePreserviceCall(service, method.baseType, method.ccName, g)
 		typeName := method.baseType
-		getIDFormatter := "{Id: in.GetId()},"
-		if b.IsIDFieldOptional(method.inType) {
-			getIDFormatter = "{Id: in.Id},"
-		}
-		if fields := b.getFieldSelection(method.inType); fields != "" {
-			g.P(`res, err := DefaultRead`, typeName, `(ctx, &`, typeName, getIDFormatter, `db, in.`, fields, `)`)
+		ormable := b.getOrmable(typeName)
+		var args string
+		if b.getView(method.inType) != nil {
+			b.generateViewFieldSelection(method, g)
+			args += `, fs`
+		} else if fields := b.getFieldSelection(method.inType); fields != "" {
+			args += `, in.` + fields
+		} else if b.readHasFieldSelection(ormable) {
+			args += `, nil`
+		}
+		if sd := b.getShowDeleted(method.inType); sd != "" && b.readHasShowDeleted(ormable) {
+			args += `, in.Get` + sd + `()`
+		} else if b.readHasShowDeleted(ormable) {
+			args += `, false`
+		}
+		if readKey := getMethodOptions(method.Method).GetReadKey(); readKey != "" {
+			result := b.resultMessage(method.outType)
+			key, _ := b.findUniqueKey(ormable, readKey)
+			keys, _ := b.requestFieldInitializers(method.inType, b.requestedKeyFields(result, key))
+			g.P(`res, err := `, readByHandlerName(key, typeName), `(ctx, &`, typeName, `{`, keys, `}, db`, args, `)`)
 		} else {
-			g.P(`res, err := DefaultRead`, typeName, `(ctx, &`, typeName, getIDFormatter, ` db)`)
+			g.P(`res, err := DefaultRead`, typeName, `(ctx, &`, typeName, b.requestKeyFormatter(method), ` db`, args, `)`)
 		}
 		g.P(`if err != nil {`)
 		g.P(`return nil, `, b.wrapSpanError(service, "err"))
 		g.P(`}`)
+		b.generateEtagHeader(service, method, g)
 		g.P(`out := &`, b.typeName(method.outType.GoIdent, g), `{Result: res}`)
 		b.generatePostserviceCall(service, method.baseType, method.ccName, g)
 		b.spanResultHandling(service, g)
@@ -3559,6 +6963,48 @@ func (b *ORMBuilder) getFieldSelection(message *protogen.Message) string {
 	return b.getFieldOfType(message, "FieldSelection")
 }
 
+// getView returns the "view" enum field of the request choosing how much of the
+// object is loaded, AIP-157 style, when its enum has a FULL value
+func (b *ORMBuilder) getView(message *protogen.Message) *protogen.Field {
+	for _, field := range message.Fields {
+		if string(field.Desc.Name()) == "view" && field.Enum != nil && b.fullViewValue(field) != nil {
+			return field
+		}
+	}
+	return nil
+}
+
+// fullViewValue returns the value of the view enum loading the associations
+func (b *ORMBuilder) fullViewValue(field *protogen.Field) *protogen.EnumValue {
+	for _, value := range field.Enum.Values {
+		if name := string(value.Desc.Name()); name == "FULL" || strings.HasSuffix(name, "_FULL") {
+			return value
+		}
+	}
+	return nil
+}
+
+// generateViewFieldSelection renders the field selection of a request with a
+// view, the associations are preloaded for the full view or when the request
+// selects them, the basic view leaves them out
+func (b *ORMBuilder) generateViewFieldSelection(method autogenMethod, g *protogen.GeneratedFile) {
+	view := b.getView(method.inType)
+	if view == nil {
+		return
+	}
+	fieldSelection := generateImport("FieldSelection", queryImport, g)
+	full := b.typeName(b.fullViewValue(view).GoIdent, g)
+	if fs := b.getFieldSelection(method.inType); fs != "" {
+		g.P(`fs := in.Get`, fs, `()`)
+		g.P(`if fs == nil && in.Get`, view.GoName, `() != `, full, ` {`)
+	} else {
+		g.P(`var fs *`, fieldSelection)
+		g.P(`if in.Get`, view.GoName, `() != `, full, ` {`)
+	}
+	g.P(`fs = &`, fieldSelection, `{Fields: map[string]*`, generateImport("Field", queryImport, g), `{}}`)
+	g.P(`}`)
+}
+
 func (b *ORMBuilder) getFiltering(message *protogen.Message) string {
 	return b.getFieldOfType(message, "Filtering")
 }
@@ -3599,7 +7045,19 @@ func (b *ORMBuilder) generateUpdateServerMethod(service autogenService, method a
 		g.P(`var res *`, typeName)
 		b.generateDBSetup(service, g)
 		b.generatePreserviceCall(service, method.baseType, method.ccName, g)
-		if method.fieldMaskName != "" {
+		implied := getMethodOptions(method.Method).GetImpliedUpdateMask()
+		if implied {
+			// a partial payload only overwrites the fields it sets
+			if method.fieldMaskName != "" {
+				g.P(`updateMask := in.Get`, method.fieldMaskName, `()`)
+			} else {
+				g.P(`var updateMask *`, generateImport("FieldMask", fmImport, g))
+			}
+			g.P(`if len(updateMask.GetPaths()) == 0 {`)
+			g.P(`updateMask = DefaultImpliedFieldMask`, typeName, `(in.GetPayload())`)
+			g.P(`}`)
+			g.P(`res, err = DefaultPatch`, typeName, `(ctx, in.GetPayload(), updateMask, db)`)
+		} else if method.fieldMaskName != "" {
 			g.P(`if in.Get`, method.fieldMaskName, `() == nil {`)
 			g.P(`res, err = DefaultStrictUpdate`, typeName, `(ctx, in.GetPayload(), db)`)
 			g.P(`} else {`)
@@ -3609,6 +7067,38 @@ func (b *ORMBuilder) generateUpdateServerMethod(service autogenService, method a
 			g.P(`res, err = DefaultStrictUpdate`, typeName, `(ctx, in.GetPayload(), db)`)
 		}
 		g.P(`if err != nil {`)
+		if method.fieldMaskName != "" || implied {
+			b.generateInvalidFieldMaskStatus(g)
+		}
+		b.generateVersionConflictStatus(typeName, g)
+		g.P(`return nil, `, b.wrapSpanError(service, "err"))
+		g.P(`}`)
+		b.generateEtagHeader(service, method, g)
+		g.P(`out := &`, b.typeName(method.outType.GoIdent, g), `{Result: res}`)
+		b.generatePostserviceCall(service, method.baseType, method.ccName, g)
+		b.spanResultHandling(service, g)
+		g.P(`return out, nil`)
+		g.P(`}`)
+		b.generatePreserviceHook(service.ccName, method.baseType, method.ccName, g)
+		b.generatePostserviceHook(service.ccName, method.baseType, b.typeName(method.outType.GoIdent, g), method.ccName, g)
+	} else {
+		b.generateEmptyBody(service, method.outType, g)
+	}
+}
+
+func (b *ORMBuilder) generateUpsertServerMethod(service autogenService, method autogenMethod, g *protogen.GeneratedFile) {
+	b.generateMethodSignature(service, method, g)
+	if method.followsConvention {
+		typeName := method.baseType
+		b.generateDBSetup(service, g)
+		b.generatePreserviceCall(service, method.baseType, method.ccName, g)
+		updateMask := `nil`
+		if method.fieldMaskName != "" {
+			updateMask = `in.Get` + method.fieldMaskName + `()`
+		}
+		g.P(`res, err := DefaultUpsert`, typeName, `(ctx, in.GetPayload(), "`, getMethodOptions(method.Method).GetConflictTarget(), `", `, updateMask, `, db)`)
+		g.P(`if err != nil {`)
+		b.generateInvalidFieldMaskStatus(g)
 		g.P(`return nil, `, b.wrapSpanError(service, "err"))
 		g.P(`}`)
 		g.P(`out := &`, b.typeName(method.outType.GoIdent, g), `{Result: res}`)
@@ -3639,6 +7129,8 @@ func (b *ORMBuilder) generateUpdateSetServerMethod(service autogenService, metho
 		g.P(``)
 		g.P(`res, err := DefaultPatchSet`, typeName, `(ctx, in.GetObjects(), in.Get`, method.fieldMaskName, `(), db)`)
 		g.P(`if err != nil {`)
+		b.generateInvalidFieldMaskStatus(g)
+		b.generateVersionConflictStatus(typeName, g)
 		g.P(`return nil, `, b.wrapSpanError(service, "err"))
 		g.P(`}`)
 		g.P(``)
@@ -3670,11 +7162,61 @@ func (b *ORMBuilder) generateDeleteServerMethod(service autogenService, method a
 		typeName := method.baseType
 		b.generateDBSetup(service, g)
 		b.generatePreserviceCall(service, method.baseType, method.ccName, g)
-		getIDFormatter := "{Id: in.GetId()},"
-		if b.IsIDFieldOptional(method.inType) {
-			getIDFormatter = "{Id: in.Id},"
+		g.P(`err := DefaultDelete`, typeName, `(ctx, &`, typeName, b.requestKeyFormatter(method), ` db)`)
+		g.P(`if err != nil {`)
+		g.P(`return nil, `, b.wrapSpanError(service, "err"))
+		g.P(`}`)
+		g.P(`out := &`, b.typeName(method.outType.GoIdent, g), `{}`)
+		b.generatePostserviceCall(service, method.baseType, method.ccName, g)
+		b.spanResultHandling(service, g)
+		g.P(`return out, nil`)
+		g.P(`}`)
+		b.generatePreserviceHook(service.ccName, method.baseType, method.ccName, g)
+		b.generatePostserviceHook(service.ccName, method.baseType, b.typeName(method.outType.GoIdent, g), method.ccName, g)
+	} else {
+		b.generateEmptyBody(service, method.outType, g)
+	}
+}
+
+// generateEtagHeader renders the etag response header carrying the version of
+// the returned object, for the types having a version field served by the gateway
+func (b *ORMBuilder) generateEtagHeader(service autogenService, method autogenMethod, g *protogen.GeneratedFile) {
+	versionName, version := b.findVersionField(b.getOrmable(method.baseType))
+	if !b.gateway || version == nil {
+		return
+	}
+	g.P(`if err = `, generateImport("SetHeader", "google.golang.org/grpc", g), `(ctx, `, generateImport("Pairs", "google.golang.org/grpc/metadata", g),
+		`("etag", `, generateImport("Quote", "strconv", g), `(`, generateImport("Sprint", stdFmtImport, g), `(res.Get`, versionName, `())))); err != nil {`)
+	g.P(`return nil, `, b.wrapSpanError(service, "err"))
+	g.P(`}`)
+}
+
+// requestKeyFormatter returns the struct literal body copying the primary keys
+// of the method base type from the request
+func (b *ORMBuilder) requestKeyFormatter(method autogenMethod) string {
+	if ormable := b.getOrmable(method.baseType); b.hasCompositePrimaryKey(ormable) {
+		keys, _ := b.requestKeyInitializers(method.inType, ormable)
+		return "{" + keys + "},"
+	}
+	if b.IsIDFieldOptional(method.inType) {
+		return "{Id: in.Id},"
+	}
+	return "{Id: in.GetId()},"
+}
+
+// generateAssociationServerMethod renders the Add and Remove methods changing a
+// many-to-many association of the object named by the request
+func (b *ORMBuilder) generateAssociationServerMethod(service autogenService, method autogenMethod, g *protogen.GeneratedFile) {
+	b.generateMethodSignature(service, method, g)
+	if method.followsConvention {
+		typeName := method.baseType
+		handlerName := `DefaultAdd` + method.association + `To` + typeName
+		if method.verb == removeService {
+			handlerName = `DefaultRemove` + method.association + `From` + typeName
 		}
-		g.P(`err := DefaultDelete`, typeName, `(ctx, &`, typeName, getIDFormatter, ` db)`)
+		b.generateDBSetup(service, g)
+		b.generatePreserviceCall(service, method.baseType, method.ccName, g)
+		g.P(`err := `, handlerName, `(ctx, &`, typeName, b.requestKeyFormatter(method), ` in.Get`, method.association, `(), db)`)
 		g.P(`if err != nil {`)
 		g.P(`return nil, `, b.wrapSpanError(service, "err"))
 		g.P(`}`)
@@ -3690,15 +7232,64 @@ func (b *ORMBuilder) generateDeleteServerMethod(service autogenService, method a
 	}
 }
 
-func (b *ORMBuilder) generateDeleteSetServerMethod(service autogenService, method autogenMethod, g *protogen.GeneratedFile) {
+func (b *ORMBuilder) generateUndeleteServerMethod(service autogenService, method autogenMethod, g *protogen.GeneratedFile) {
+	b.generateMethodSignature(service, method, g)
+	if method.followsConvention {
+		typeName := method.baseType
+		b.generateDBSetup(service, g)
+		b.generatePreserviceCall(service, method.baseType, method.ccName, g)
+		g.P(`res, err := DefaultUndelete`, typeName, `(ctx, &`, typeName, b.requestKeyFormatter(method), ` db)`)
+		g.P(`if err != nil {`)
+		g.P(`return nil, `, b.wrapSpanError(service, "err"))
+		g.P(`}`)
+		b.generateEtagHeader(service, method, g)
+		g.P(`out := &`, b.typeName(method.outType.GoIdent, g), `{Result: res}`)
+		b.generatePostserviceCall(service, method.baseType, method.ccName, g)
+		b.spanResultHandling(service, g)
+		g.P(`return out, nil`)
+		g.P(`}`)
+		b.generatePreserviceHook(service.ccName, method.baseType, method.ccName, g)
+		b.generatePostserviceHook(service.ccName, method.baseType, b.typeName(method.outType.GoIdent, g), method.ccName, g)
+	} else {
+		b.generateEmptyBody(service, method.outType, g)
+	}
+}
+
+func (b *ORMBuilder) generatePurgeServerMethod(service autogenService, method autogenMethod, g *protogen.GeneratedFile) {
 	b.generateMethodSignature(service, method, g)
 	if method.followsConvention {
 		typeName := method.baseType
 		b.generateDBSetup(service, g)
-		g.P(`objs := []*`, typeName, `{}`)
-		g.P(`for _, id := range in.Ids {`)
-		g.P(`objs = append(objs, &`, typeName, `{Id: id})`)
+		b.generatePreserviceCall(service, method.baseType, method.ccName, g)
+		g.P(`err := DefaultHardDelete`, typeName, `(ctx, &`, typeName, b.requestKeyFormatter(method), ` db)`)
+		g.P(`if err != nil {`)
+		g.P(`return nil, `, b.wrapSpanError(service, "err"))
 		g.P(`}`)
+		g.P(`out := &`, b.typeName(method.outType.GoIdent, g), `{}`)
+		b.generatePostserviceCall(service, method.baseType, method.ccName, g)
+		b.spanResultHandling(service, g)
+		g.P(`return out, nil`)
+		g.P(`}`)
+		b.generatePreserviceHook(service.ccName, method.baseType, method.ccName, g)
+		b.generatePostserviceHook(service.ccName, method.baseType, b.typeName(method.outType.GoIdent, g), method.ccName, g)
+	} else {
+		b.generateEmptyBody(service, method.outType, g)
+	}
+}
+
+func (b *ORMBuilder) generateDeleteSetServerMethod(service autogenService, method autogenMethod, g *protogen.GeneratedFile) {
+	b.generateMethodSignature(service, method, g)
+	if method.followsConvention {
+		typeName := method.baseType
+		b.generateDBSetup(service, g)
+		if b.hasCompositePrimaryKey(b.getOrmable(typeName)) {
+			g.P(`objs := in.GetObjects()`)
+		} else {
+			g.P(`objs := []*`, typeName, `{}`)
+			g.P(`for _, id := range in.Ids {`)
+			g.P(`objs = append(objs, &`, typeName, `{Id: id})`)
+			g.P(`}`)
+		}
 		b.generatePreserviceCall(service, method.baseType, method.ccName, g)
 		g.P(`err := DefaultDelete`, typeName, `Set(ctx, objs, db)`)
 		g.P(`if err != nil {`)
@@ -3724,38 +7315,42 @@ func (b *ORMBuilder) generateListServerMethod(service autogenService, method aut
 		b.generatePreserviceCall(service, method.baseType, method.ccName, g)
 		pg := b.getPagination(method.inType)
 		pi := b.getPageInfo(method.outType)
-		if pg != "" && pi != "" {
+		paged := pg != "" && pi != ""
+		cursor := b.listHasCursorPagination(ormable)
+		if paged && !cursor {
 			b.generatePagedRequestSetup(pg, g)
 		}
-		handlerCall := fmt.Sprint(`res, err := DefaultList`, method.baseType, `(ctx, db`)
-		if f := b.getFiltering(method.inType); f != "" {
-			handlerCall += fmt.Sprint(",in.", f)
-		} else if b.listHasFiltering(ormable) {
-			handlerCall += fmt.Sprint(", nil")
+		results := `res`
+		if cursor && paged {
+			results += `, pageToken`
+		} else if cursor {
+			results += `, _`
 		}
-		if s := b.getSorting(method.inType); s != "" {
-			handlerCall += fmt.Sprint(",in.", s)
-		} else if b.listHasSorting(ormable) {
-			handlerCall += fmt.Sprint(", nil")
-		}
-		if pg != "" {
-			handlerCall += fmt.Sprint(",in.", pg)
-		} else if b.listHasPagination(ormable) {
-			handlerCall += fmt.Sprint(", nil")
-		}
-		if fs := b.getFieldSelection(method.inType); fs != "" {
-			handlerCall += fmt.Sprint(",in.", fs)
-		} else if b.listHasFieldSelection(ormable) {
-			handlerCall += fmt.Sprint(", nil")
-		}
-		handlerCall += ")"
-		g.P(handlerCall)
+		if ormable.WithTotalCount && paged {
+			results += `, total`
+		} else if ormable.WithTotalCount {
+			results += `, _`
+		}
+		results += `, err`
+		b.generateViewFieldSelection(method, g)
+		g.P(results, ` := DefaultList`, method.baseType, `(ctx, db`, b.listServerArgs(method, ormable), `)`)
 		g.P(`if err != nil {`)
+		b.generateInvalidPageTokenStatus(ormable, g)
 		g.P(`return nil, `, b.wrapSpanError(service, "err"))
 		g.P(`}`)
 		var pageInfoIfExist string
-		if pg != "" && pi != "" {
-			b.generatePagedRequestHandling(pg, g)
+		if paged {
+			if cursor {
+				b.generateCursorPagedRequestHandling(pg, g)
+			} else {
+				b.generatePagedRequestHandling(pg, g)
+			}
+			if ormable.WithTotalCount {
+				g.P(`if resPaging == nil {`)
+				g.P(`resPaging = &`, generateImport("PageInfo", queryImport, g), `{}`)
+				g.P(`}`)
+				g.P(`resPaging.Size = int32(total)`)
+			}
 			pageInfoIfExist = ", " + pi + ": resPaging"
 		}
 		g.P(`out := &`, b.typeName(method.outType.GoIdent, g), `{Results: res`, pageInfoIfExist, ` }`)
@@ -3770,6 +7365,105 @@ func (b *ORMBuilder) generateListServerMethod(service autogenService, method aut
 	}
 }
 
+// listServerArgs renders the request fields passed to the list handlers, after
+// the db argument
+func (b *ORMBuilder) listServerArgs(method autogenMethod, ormable *OrmableType) string {
+	var args string
+	if f := b.getFiltering(method.inType); f != "" {
+		args += fmt.Sprint(",in.", f)
+	} else if b.listHasFiltering(ormable) {
+		args += fmt.Sprint(", nil")
+	}
+	if s := b.getSorting(method.inType); s != "" {
+		args += fmt.Sprint(",in.", s)
+	} else if b.listHasSorting(ormable) {
+		args += fmt.Sprint(", nil")
+	}
+	if pg := b.getPagination(method.inType); pg != "" {
+		args += fmt.Sprint(",in.", pg)
+	} else if b.listHasPagination(ormable) {
+		args += fmt.Sprint(", nil")
+	}
+	if b.getView(method.inType) != nil {
+		// set up by generateViewFieldSelection
+		args += fmt.Sprint(", fs")
+	} else if fs := b.getFieldSelection(method.inType); fs != "" {
+		args += fmt.Sprint(",in.", fs)
+	} else if b.listHasFieldSelection(ormable) {
+		args += fmt.Sprint(", nil")
+	}
+	if sd := b.getShowDeleted(method.inType); sd != "" && b.listHasShowDeleted(ormable) {
+		args += fmt.Sprint(", in.Get", sd, "()")
+	} else if b.listHasShowDeleted(ormable) {
+		args += fmt.Sprint(", false")
+	}
+	return args
+}
+
+// generateStreamListServerMethod renders the server streaming list method,
+// sending the objects as DefaultStreamList reads them
+func (b *ORMBuilder) generateStreamListServerMethod(service autogenService, method autogenMethod, g *protogen.GeneratedFile) {
+	b.generateStreamMethodSignature(service, method, g)
+	if !method.followsConvention {
+		g.P(`return nil`)
+		g.P(`}`)
+		return
+	}
+	ormable := b.getOrmable(method.baseType)
+	g.P(`ctx := stream.Context()`)
+	if service.usesTxnMiddleware {
+		g.P(`txn, ok := `, generateImport("FromContext", tkgormImport, g), `(ctx)`)
+		g.P(`if !ok {`)
+		g.P(`return `, generateImport("NoTransactionError", gerrorsImport, g))
+		g.P(`}`)
+		g.P(`db := txn.Begin()`)
+		g.P(`if db.Error != nil {`)
+		g.P(`return db.Error`)
+		g.P(`}`)
+	} else {
+		g.P(`db := m.DB`)
+	}
+	g.P(`if custom, ok := interface{}(in).(`, service.ccName, method.baseType, `WithBefore`, method.ccName, `); ok {`)
+	g.P(`var err error`)
+	g.P(`if db, err = custom.Before`, method.ccName, `(ctx, db); err != nil {`)
+	g.P(`return `, b.wrapSpanError(service, "err"))
+	g.P(`}`)
+	g.P(`}`)
+	send := `stream.Send(obj)`
+	if string(method.outType.Desc.Name()) != method.baseType {
+		send = fmt.Sprint(`stream.Send(&`, b.typeName(method.outType.GoIdent, g), `{Results: []*`, method.baseType, `{obj}})`)
+	}
+	b.generateViewFieldSelection(method, g)
+	// the result of the stream is the count of the objects sent
+	g.P(`sent := 0`)
+	g.P(`err := DefaultStreamList`, method.baseType, `(ctx, db`, b.listServerArgs(method, ormable), `, func(obj *`, method.baseType, `) error {`)
+	g.P(`sent++`)
+	g.P(`return `, send)
+	g.P(`})`)
+	g.P(`if err != nil {`)
+	b.generateInvalidPageTokenStatus(ormable, g)
+	g.P(`return `, b.wrapSpanError(service, "err"))
+	g.P(`}`)
+	g.P(`if custom, ok := interface{}(in).(`, service.ccName, method.baseType, `WithAfter`, method.ccName, `); ok {`)
+	g.P(`if err = custom.After`, method.ccName, `(ctx, sent, db); err != nil {`)
+	g.P(`return `, b.wrapSpanError(service, "err"))
+	g.P(`}`)
+	g.P(`}`)
+	if getServiceOptions(service.Service).WithTracing {
+		g.P(`if errSpanResult := m.spanResult(span, sent); errSpanResult != nil {`)
+		g.P(`return `, b.wrapSpanError(service, "errSpanResult"))
+		g.P(`}`)
+	}
+	g.P(`return nil`)
+	g.P(`}`)
+	b.generatePreserviceHook(service.ccName, method.baseType, method.ccName, g)
+	g.P(`// `, service.ccName, method.baseType, `WithAfter`, method.ccName, ` called after DefaultStreamList`, method.baseType, ` in the default `, method.ccName, ` handler`)
+	g.P(`// with the count of the objects sent`)
+	g.P(`type `, service.ccName, method.baseType, `WithAfter`, method.ccName, ` interface {`)
+	g.P(`After`, method.ccName, `(context.Context, int, *`, generateImport("DB", gormImport, g), `) error`)
+	g.P(`}`)
+}
+
 func (b *ORMBuilder) generatePagedRequestSetup(pg string, g *protogen.GeneratedFile) {
 	g.P(`pagedRequest := false`)
 	g.P(fmt.Sprintf(`if in.Get%s().GetLimit()>=1 {`, pg))
@@ -3792,7 +7486,22 @@ func (b *ORMBuilder) generatePagedRequestHandling(pg string, g *protogen.Generat
 	g.P(`}`)
 }
 
+// generateCursorPagedRequestHandling renders the page info holding the token of
+// the next page the List handler returns
+func (b *ORMBuilder) generateCursorPagedRequestHandling(pg string, g *protogen.GeneratedFile) {
+	g.P(`var resPaging *`, generateImport("PageInfo", queryImport, g))
+	g.P(`if in.Get`, pg, `().GetLimit() >= 1 {`)
+	g.P(`resPaging = &`, generateImport("PageInfo", queryImport, g), `{PageToken: pageToken}`)
+	g.P(`}`)
+}
+
 func (b *ORMBuilder) generateMethodStub(service autogenService, method autogenMethod, g *protogen.GeneratedFile) {
+	if method.Desc.IsStreamingClient() || method.Desc.IsStreamingServer() {
+		b.generateStreamMethodSignature(service, method, g)
+		g.P(`return nil`)
+		g.P(`}`)
+		return
+	}
 	b.generateMethodSignature(service, method, g)
 	b.generateEmptyBody(service, method.outType, g)
 }
@@ -3806,14 +7515,43 @@ func (b *ORMBuilder) typeName(ident protogen.GoIdent, g *protogen.GeneratedFile)
 	return generateImport(ident.GoName, string(ident.GoImportPath), g)
 }
 
+// GetOrmable looks up an ormable by its full proto name, or by a message or Go
+// type name optionally qualified with the Go package name, e.g. "[]*user.UserORM"
 func GetOrmable(ormableTypes map[string]*OrmableType, typeName string) (*OrmableType, error) {
-	parts := strings.Split(typeName, ".")
-	ormable, ok := ormableTypes[strings.TrimSuffix(strings.Trim(parts[len(parts)-1], "[]*"), "ORM")]
-	var err error
-	if !ok {
-		err = ErrNotOrmable
+	name := strings.Trim(typeName, "[]*")
+	if ormable, ok := ormableTypes[name]; ok {
+		return ormable, nil
+	}
+
+	var qualifier string
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		qualifier, name = name[:i], name[i+1:]
+	}
+	name = strings.TrimSuffix(name, "ORM")
+
+	var found, qualified []*OrmableType
+	for _, ormable := range ormableTypes {
+		if ormable.OriginName != name {
+			continue
+		}
+		found = append(found, ormable)
+		if qualifier != "" && ormable.Package == qualifier {
+			qualified = append(qualified, ormable)
+		}
+	}
+	// the qualifier may be an import alias, it only narrows ambiguous names
+	if len(found) > 1 && len(qualified) > 0 {
+		found = qualified
+	}
+
+	switch len(found) {
+	case 0:
+		return nil, ErrNotOrmable
+	case 1:
+		return found[0], nil
+	default:
+		return nil, fmt.Errorf("%w: %s is ambiguous, it is defined in several packages", ErrNotOrmable, typeName)
 	}
-	return ormable, err
 }
 
 func (b *ORMBuilder) countHasAssociationDimension(message *protogen.Message, typeName string) int {
@@ -0,0 +1,171 @@
+package plugin
+
+import (
+	"errors"
+	"testing"
+
+	gormopts "github.com/infobloxopen/protoc-gen-gorm/options"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+// newTestMessage returns a builder along with the message of a proto file
+// declaring it with the given gorm options
+func newTestMessage(t *testing.T, name string, opts *gormopts.GormMessageOptions) (*ORMBuilder, *protogen.Message) {
+	t.Helper()
+	options := &descriptorpb.MessageOptions{}
+	proto.SetExtension(options, gormopts.E_Opts, opts)
+	file := &descriptorpb.FileDescriptorProto{
+		Name:        proto.String("test.proto"),
+		Package:     proto.String("test"),
+		Syntax:      proto.String("proto3"),
+		Options:     &descriptorpb.FileOptions{GoPackage: proto.String("example.com/test;test")},
+		MessageType: []*descriptorpb.DescriptorProto{{Name: proto.String(name), Options: options}},
+	}
+	b, err := New(protogen.Options{}, &pluginpb.CodeGeneratorRequest{
+		FileToGenerate: []string{"test.proto"},
+		ProtoFile:      []*descriptorpb.FileDescriptorProto{file},
+	})
+	if err != nil {
+		t.Fatalf("New=%v; want success", err)
+	}
+
+	return b, b.plugin.Files[0].Messages[0]
+}
+
+func TestGetPartitionKeys(t *testing.T) {
+	fields := map[string]*Field{
+		"Id":        {TypeName: "uint64"},
+		"AccountID": {TypeName: "string"},
+		"CreatedAt": {TypeName: "*time.Time"},
+	}
+	for _, tc := range []struct {
+		name string
+		keys []string
+		want []string
+	}{
+		{"declaration order", []string{"created_at", "id"}, []string{"CreatedAt", "Id"}},
+		{"field not camel cased", []string{"account_id"}, []string{"AccountID"}},
+		{"no partition", nil, nil},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			opts := &gormopts.GormMessageOptions{Ormable: true}
+			if tc.keys != nil {
+				opts.Partition = &gormopts.PartitionOptions{Strategy: "range", Keys: tc.keys}
+			}
+			b, message := newTestMessage(t, "Event", opts)
+			b.ormableTypes["test.Event"] = &OrmableType{Name: "EventORM", OriginName: "Event", Package: "test", Fields: fields}
+
+			keys := b.getPartitionKeys(message)
+			if len(keys) != len(tc.want) {
+				t.Fatalf("getPartitionKeys=%v; want %v", keys, tc.want)
+			}
+			for i, key := range keys {
+				if key.name != tc.want[i] || key.field != fields[tc.want[i]] {
+					t.Errorf("getPartitionKeys[%d]=%s; want %s", i, key.name, tc.want[i])
+				}
+			}
+		})
+	}
+
+	t.Run("missing field", func(t *testing.T) {
+		opts := &gormopts.GormMessageOptions{Ormable: true, Partition: &gormopts.PartitionOptions{Keys: []string{"region"}}}
+		b, message := newTestMessage(t, "Event", opts)
+		b.ormableTypes["test.Event"] = &OrmableType{Name: "EventORM", OriginName: "Event", Package: "test", Fields: fields}
+		defer func() {
+			if r := recover(); r == nil {
+				t.Errorf("getPartitionKeys didn't panic on a missing field")
+			}
+		}()
+		b.getPartitionKeys(message)
+	})
+}
+
+func TestReadByHandlerName(t *testing.T) {
+	name := pkFieldObjs{"Name", &Field{TypeName: "string"}}
+	region := pkFieldObjs{"Region", &Field{TypeName: "string"}}
+	for _, tc := range []struct {
+		key  uniqueKey
+		want string
+	}{
+		{uniqueKey{name: "name", fields: []pkFieldObjs{name}}, "DefaultReadByNameSetting"},
+		{uniqueKey{name: "idx_setting_name", fields: []pkFieldObjs{name}}, "DefaultReadByNameSetting"},
+		{uniqueKey{name: "idx_setting_name_region", fields: []pkFieldObjs{name, region}}, "DefaultReadByIdxSettingNameRegionSetting"},
+	} {
+		if got := readByHandlerName(tc.key, "Setting"); got != tc.want {
+			t.Errorf("readByHandlerName(%s)=%s; want %s", tc.key.name, got, tc.want)
+		}
+	}
+
+	t.Run("unique column of a unique index", func(t *testing.T) {
+		tag := &gormopts.GormTag{Unique: true, UniqueIndex: "idx_setting_name"}
+		ormable := &OrmableType{Fields: map[string]*Field{
+			"Name": {GormFieldOptions: &gormopts.GormFieldOptions{Tag: tag}, TypeName: "string"},
+		}}
+		keys := (&ORMBuilder{}).getUniqueKeys(ormable)
+		if len(keys) != 1 {
+			t.Fatalf("getUniqueKeys=%v; want a single key", keys)
+		}
+		if got, want := readByHandlerName(keys[0], "Setting"), "DefaultReadByNameSetting"; got != want {
+			t.Errorf("readByHandlerName(%s)=%s; want %s", keys[0].name, got, want)
+		}
+		for _, name := range []string{"idx_setting_name", "name"} {
+			if !keys[0].named(name) {
+				t.Errorf("unique key %s isn't named %s", keys[0].name, name)
+			}
+		}
+	})
+}
+
+func TestGetOrmable(t *testing.T) {
+	example := &OrmableType{Name: "AddressORM", OriginName: "Address", Package: "example"}
+	user := &OrmableType{Name: "AddressORM", OriginName: "Address", Package: "user"}
+	contact := &OrmableType{Name: "ContactORM", OriginName: "Contact", Package: "user"}
+	ormableTypes := map[string]*OrmableType{
+		"example.Address": example,
+		"user.Address":    user,
+		"user.Contact":    contact,
+	}
+	for _, tc := range []struct {
+		typeName string
+		want     *OrmableType
+	}{
+		{"user.Address", user},
+		{"Contact", contact},
+		{"ContactORM", contact},
+		{"[]*user.ContactORM", contact},
+		{"*example.AddressORM", example},
+		{"[]*user.AddressORM", user},
+		// an import alias doesn't resolve a name defined once
+		{"*user1.ContactORM", contact},
+	} {
+		got, err := GetOrmable(ormableTypes, tc.typeName)
+		if err != nil {
+			t.Errorf("GetOrmable(%s)=%v; want success", tc.typeName, err)
+		} else if got != tc.want {
+			t.Errorf("GetOrmable(%s)=%s.%s; want %s.%s", tc.typeName, got.Package, got.Name, tc.want.Package, tc.want.Name)
+		}
+	}
+	for _, typeName := range []string{"Address", "*AddressORM", "*user1.AddressORM", "Phone"} {
+		if _, err := GetOrmable(ormableTypes, typeName); !errors.Is(err, ErrNotOrmable) {
+			t.Errorf("GetOrmable(%s)=%v; want %v", typeName, err, ErrNotOrmable)
+		}
+	}
+}
+
+func TestLookupOrmable(t *testing.T) {
+	example := &OrmableType{Name: "AddressORM", OriginName: "Address", Package: "example"}
+	user := &OrmableType{Name: "AddressORM", OriginName: "Address", Package: "user"}
+	b := &ORMBuilder{ormableTypes: map[string]*OrmableType{"example.Address": example, "user.Address": user}}
+
+	// bare names resolve within the proto package of the current file
+	b.currentProtoPackage = "user"
+	if got, err := b.lookupOrmable("Address"); err != nil || got != user {
+		t.Errorf("lookupOrmable(Address)=%v, %v; want the user type", got, err)
+	}
+	if got, err := b.lookupOrmable("example.Address"); err != nil || got != example {
+		t.Errorf("lookupOrmable(example.Address)=%v, %v; want the example type", got, err)
+	}
+}